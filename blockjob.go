@@ -0,0 +1,55 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// BlockJobInfo describes a block job running on one of a domain's disks,
+// such as a copy started by DomainBlockCopy.
+type BlockJobInfo struct {
+	Type DomainBlockJobType
+	// Bandwidth is the job's bandwidth limit, in MiB/s, or in bytes/s if
+	// DomainBlockJobInfoBandwidthBytes was passed. 0 means unlimited.
+	Bandwidth uint64
+	// Cur and End measure the job's progress; the units are arbitrary. Cur
+	// reaches End once the job is complete, though End may grow while a copy
+	// or active commit keeps up with the guest's writes.
+	Cur uint64
+	End uint64
+}
+
+// Progress returns how far the job has got, as a percentage.
+func (i BlockJobInfo) Progress() float64 {
+	if i.End == 0 {
+		return 0
+	}
+	return float64(i.Cur) / float64(i.End) * 100
+}
+
+// DomainBlockJobInfo returns information about the block job running on the
+// disk with the given path or target name, e.g. "vda". The second return
+// value is false if there is no block job running on the disk.
+func (l *Libvirt) DomainBlockJobInfo(dom Domain, path string,
+	flags DomainBlockJobInfoFlags) (BlockJobInfo, bool, error) {
+	found, typ, bandwidth, cur, end, err := l.DomainGetBlockJobInfo(dom, path, uint32(flags))
+	if err != nil || found == 0 {
+		return BlockJobInfo{}, false, err
+	}
+
+	return BlockJobInfo{
+		Type:      DomainBlockJobType(typ),
+		Bandwidth: bandwidth,
+		Cur:       cur,
+		End:       end,
+	}, true, nil
+}
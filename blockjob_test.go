@@ -0,0 +1,67 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainBlockJobInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom, err := l.DomainLookupByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ret := range []DomainGetBlockJobInfoRet{
+		{Found: 1, Type: int32(DomainBlockJobTypeCopy), Bandwidth: 10, Cur: 25, End: 100},
+		{Found: 0},
+	} {
+		payload, err := encode(&ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, constants.ProcDomainGetBlockJobInfo, payload)
+	}
+
+	info, found, err := l.DomainBlockJobInfo(dom, "vda", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := BlockJobInfo{Type: DomainBlockJobTypeCopy, Bandwidth: 10, Cur: 25, End: 100}
+	if !found || info != want {
+		t.Errorf("expected %+v, got %+v (found %v)", want, info, found)
+	}
+	if p := info.Progress(); p != 25 {
+		t.Errorf("expected 25%% progress, got %v", p)
+	}
+
+	if _, found, err = l.DomainBlockJobInfo(dom, "vda", 0); err != nil || found {
+		t.Errorf("expected no job, got found %v, error %v", found, err)
+	}
+
+	if p := (BlockJobInfo{}).Progress(); p != 0 {
+		t.Errorf("expected 0%% progress for a job with no end, got %v", p)
+	}
+}
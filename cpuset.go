@@ -0,0 +1,144 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxCPUSetCPU is the highest CPU number ParseCPUSet accepts, one less than
+// libvirt's limit on the size of a domain's CPU mask.
+const maxCPUSetCPU = 16384 - 1
+
+// CPUSet is a set of host CPUs, such as those a virtual CPU may run on. Each
+// element reports whether the CPU with that number is in the set.
+type CPUSet []bool
+
+// ParseCPUSet parses a set of CPUs in libvirt's syntax: a comma-separated list
+// of CPU numbers and ranges, where "^" before a CPU number excludes it, as in
+// "0-7,^3".
+func ParseCPUSet(s string) (CPUSet, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("empty cpuset")
+	}
+
+	var set CPUSet
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "^") {
+			cpu, err := parseCPU(part[1:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid cpuset %q: %v", s, err)
+			}
+			set.Clear(cpu)
+			continue
+		}
+
+		first, last := part, part
+		if i := strings.IndexByte(part, '-'); i != -1 {
+			first, last = part[:i], part[i+1:]
+		}
+		lo, err := parseCPU(first)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpuset %q: %v", s, err)
+		}
+		hi, err := parseCPU(last)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpuset %q: %v", s, err)
+		}
+		if hi < lo {
+			return nil, fmt.Errorf("invalid cpuset %q: range %v is reversed", s, part)
+		}
+		for cpu := lo; cpu <= hi; cpu++ {
+			set.Set(cpu)
+		}
+	}
+	return set, nil
+}
+
+func parseCPU(s string) (int, error) {
+	cpu, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || cpu < 0 || cpu > maxCPUSetCPU {
+		return 0, fmt.Errorf("invalid cpu number %q", s)
+	}
+	return cpu, nil
+}
+
+// Set adds cpu to the set, growing it if needed.
+func (s *CPUSet) Set(cpu int) {
+	for len(*s) <= cpu {
+		*s = append(*s, false)
+	}
+	(*s)[cpu] = true
+}
+
+// Clear removes cpu from the set.
+func (s CPUSet) Clear(cpu int) {
+	if cpu >= 0 && cpu < len(s) {
+		s[cpu] = false
+	}
+}
+
+// Contains reports whether cpu is in the set.
+func (s CPUSet) Contains(cpu int) bool {
+	return cpu >= 0 && cpu < len(s) && s[cpu]
+}
+
+// Bytes returns the set in libvirt's cpumap representation, a bitmap with the
+// lowest numbered CPU in the least significant bit of the first byte.
+func (s CPUSet) Bytes() []byte {
+	m := make([]byte, (len(s)+7)/8)
+	for cpu, ok := range s {
+		if ok {
+			m[cpu/8] |= 1 << (cpu % 8)
+		}
+	}
+	return m
+}
+
+// String returns the set in libvirt's syntax, such as "0-3,5".
+func (s CPUSet) String() string {
+	var parts []string
+	for cpu := 0; cpu < len(s); cpu++ {
+		if !s[cpu] {
+			continue
+		}
+		last := cpu
+		for last+1 < len(s) && s[last+1] {
+			last++
+		}
+		if last == cpu {
+			parts = append(parts, strconv.Itoa(cpu))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", cpu, last))
+		}
+		cpu = last
+	}
+	return strings.Join(parts, ",")
+}
+
+// cpuSetFromMap unpacks the first ncpus CPUs of a cpumap.
+func cpuSetFromMap(m []byte, ncpus int) CPUSet {
+	s := make(CPUSet, ncpus)
+	for cpu := range s {
+		if cpu/8 < len(m) {
+			s[cpu] = m[cpu/8]&(1<<(cpu%8)) != 0
+		}
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestCPUSetBytes(t *testing.T) {
+	s := CPUSet{true, false, true, false, false, false, false, false, false, true}
+	m := s.Bytes()
+	if want := []byte{0x05, 0x02}; !bytes.Equal(m, want) {
+		t.Errorf("expected cpumap %x, got %x", want, m)
+	}
+	if got := cpuSetFromMap(m, len(s)); !reflect.DeepEqual(got, s) {
+		t.Errorf("expected %v, got %v", s, got)
+	}
+
+	// CPUs beyond the end of the map aren't in the set.
+	if got := cpuSetFromMap([]byte{0xff}, 10); !got[7] || got[8] || got[9] {
+		t.Errorf("unexpected set %v", got)
+	}
+}
+
+func TestCPUSetMembers(t *testing.T) {
+	var s CPUSet
+	s.Set(3)
+	s.Set(1)
+	if len(s) != 4 || !s.Contains(1) || !s.Contains(3) || s.Contains(2) {
+		t.Errorf("unexpected set %v", []bool(s))
+	}
+	s.Clear(3)
+	s.Clear(10)
+	if s.Contains(3) || s.Contains(10) || s.Contains(-1) {
+		t.Errorf("unexpected set %v", []bool(s))
+	}
+}
+
+func TestParseCPUSet(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"0-3,5", "0-3,5", true},
+		{"5,0-3", "0-3,5", true},
+		{"0-7,^3", "0-2,4-7", true},
+		{" 1 , 2 ", "1-2", true},
+		{"^1,1", "1", true},
+		{"4", "4", true},
+		{"", "", false},
+		{"3-1", "", false},
+		{"a", "", false},
+		{"1-", "", false},
+		{"-1", "", false},
+		{"0-16384", "", false},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseCPUSet(tt.in)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("ParseCPUSet(%q): expected an error, got %v", tt.in, s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseCPUSet(%q): %v", tt.in, err)
+			continue
+		}
+		if s.String() != tt.want {
+			t.Errorf("ParseCPUSet(%q): expected %v, got %v", tt.in, tt.want, s)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/internal/event"
+)
+
+// DomainEventMsg is a domain event sent by libvirt, as returned by
+// SubscribeDomainEventAny. Its concrete type is a pointer to the message
+// struct for the event ID subscribed to; use a type switch to get at it:
+//
+//	switch e := ev.(type) {
+//	case *DomainEventCallbackRebootMsg:
+//		fmt.Println(e.Msg.Dom.Name, "rebooted")
+//	case *DomainEventCallbackWatchdogMsg:
+//		fmt.Println(e.Msg.Dom.Name, "watchdog fired:", e.Msg.Action)
+//	}
+type DomainEventMsg interface {
+	// GetCallbackID returns the ID of the registration the event was sent
+	// for.
+	GetCallbackID() int32
+}
+
+// domainEvents maps the procedures libvirt sends domain events with to a
+// function returning the message struct to decode each into. Every event ID
+// has its own procedure; most are named after the event, but block job 2,
+// block threshold and memory failure events don't have Callback in their
+// name.
+var domainEvents = map[uint32]func() event.Event{
+	constants.ProcDomainEventCallbackLifecycle:           func() event.Event { return &DomainEventCallbackLifecycleMsg{} },
+	constants.ProcDomainEventCallbackReboot:              func() event.Event { return &DomainEventCallbackRebootMsg{} },
+	constants.ProcDomainEventCallbackRtcChange:           func() event.Event { return &DomainEventCallbackRtcChangeMsg{} },
+	constants.ProcDomainEventCallbackWatchdog:            func() event.Event { return &DomainEventCallbackWatchdogMsg{} },
+	constants.ProcDomainEventCallbackIOError:             func() event.Event { return &DomainEventCallbackIOErrorMsg{} },
+	constants.ProcDomainEventCallbackGraphics:            func() event.Event { return &DomainEventCallbackGraphicsMsg{} },
+	constants.ProcDomainEventCallbackIOErrorReason:       func() event.Event { return &DomainEventCallbackIOErrorReasonMsg{} },
+	constants.ProcDomainEventCallbackControlError:        func() event.Event { return &DomainEventCallbackControlErrorMsg{} },
+	constants.ProcDomainEventCallbackBlockJob:            func() event.Event { return &DomainEventCallbackBlockJobMsg{} },
+	constants.ProcDomainEventCallbackDiskChange:          func() event.Event { return &DomainEventCallbackDiskChangeMsg{} },
+	constants.ProcDomainEventCallbackTrayChange:          func() event.Event { return &DomainEventCallbackTrayChangeMsg{} },
+	constants.ProcDomainEventCallbackPmwakeup:            func() event.Event { return &DomainEventCallbackPmwakeupMsg{} },
+	constants.ProcDomainEventCallbackPmsuspend:           func() event.Event { return &DomainEventCallbackPmsuspendMsg{} },
+	constants.ProcDomainEventCallbackBalloonChange:       func() event.Event { return &DomainEventCallbackBalloonChangeMsg{} },
+	constants.ProcDomainEventCallbackPmsuspendDisk:       func() event.Event { return &DomainEventCallbackPmsuspendDiskMsg{} },
+	constants.ProcDomainEventCallbackDeviceRemoved:       func() event.Event { return &DomainEventCallbackDeviceRemovedMsg{} },
+	constants.ProcDomainEventBlockJob2:                   func() event.Event { return &DomainEventBlockJob2Msg{} },
+	constants.ProcDomainEventCallbackTunable:             func() event.Event { return &DomainEventCallbackTunableMsg{} },
+	constants.ProcDomainEventCallbackAgentLifecycle:      func() event.Event { return &DomainEventCallbackAgentLifecycleMsg{} },
+	constants.ProcDomainEventCallbackDeviceAdded:         func() event.Event { return &DomainEventCallbackDeviceAddedMsg{} },
+	constants.ProcDomainEventCallbackMigrationIteration:  func() event.Event { return &DomainEventCallbackMigrationIterationMsg{} },
+	constants.ProcDomainEventCallbackJobCompleted:        func() event.Event { return &DomainEventCallbackJobCompletedMsg{} },
+	constants.ProcDomainEventCallbackDeviceRemovalFailed: func() event.Event { return &DomainEventCallbackDeviceRemovalFailedMsg{} },
+	constants.ProcDomainEventCallbackMetadataChange:      func() event.Event { return &DomainEventCallbackMetadataChangeMsg{} },
+	constants.ProcDomainEventBlockThreshold:              func() event.Event { return &DomainEventBlockThresholdMsg{} },
+	constants.ProcDomainEventMemoryFailure:               func() event.Event { return &DomainEventMemoryFailureMsg{} },
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackRebootMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackRtcChangeMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackWatchdogMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackIOErrorMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackGraphicsMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackIOErrorReasonMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackControlErrorMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackBlockJobMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackDiskChangeMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackTrayChangeMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackPmwakeupMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackPmsuspendMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackBalloonChangeMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackPmsuspendDiskMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackDeviceRemovedMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventBlockJob2Msg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackTunableMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackAgentLifecycleMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackDeviceAddedMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackMigrationIterationMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackJobCompletedMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackDeviceRemovalFailedMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventCallbackMetadataChangeMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventBlockThresholdMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// GetCallbackID returns the callback ID of the event.
+func (m DomainEventMemoryFailureMsg) GetCallbackID() int32 {
+	return m.CallbackID
+}
+
+// SubscribeDomainEventAny streams the domain events of the given type, for all
+// domains, until the provided context is cancelled, after which libvirt is
+// asked to stop sending them and the returned channel is closed. Each event is
+// a pointer to the message struct for eventID, such as
+// *DomainEventCallbackRebootMsg for DomainEventIDReboot. If a problem is
+// encountered registering for events, an error will be returned.
+func (l *Libvirt) SubscribeDomainEventAny(ctx context.Context, eventID DomainEventID) (<-chan DomainEventMsg, error) {
+	stream, err := l.subscribe(constants.Program, func(l *Libvirt) (int32, error) {
+		return l.ConnectDomainEventCallbackRegisterAny(int32(eventID), nil)
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan DomainEventMsg)
+
+	go func() {
+		defer l.unsubscribeEvents(stream)
+		defer stream.Shutdown()
+		defer close(ch)
+
+		for {
+			select {
+			case ev, ok := <-stream.Recv():
+				if !ok {
+					return
+				}
+				select {
+				case ch <- ev:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
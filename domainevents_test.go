@@ -0,0 +1,173 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestSubscribeDomainEventAny(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := l.SubscribeDomainEventAny(ctx, DomainEventIDWatchdog)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mock registers callbacks with ID 1.
+	payload, err := encode(&DomainEventCallbackWatchdogMsg{
+		CallbackID: 1,
+		Msg: DomainEventWatchdogMsg{
+			Dom:    Domain{Name: "test"},
+			Action: int32(DomainEventWatchdogReset),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.Test.Write(libvirttest.EventPacket(constants.Program,
+		constants.ProcDomainEventCallbackWatchdog, payload))
+
+	select {
+	case ev := <-stream:
+		e, ok := ev.(*DomainEventCallbackWatchdogMsg)
+		if !ok {
+			t.Fatalf("expected a watchdog event, got %T", ev)
+		}
+		if e.Msg.Dom.Name != "test" {
+			t.Errorf("expected domain %q, got %q", "test", e.Msg.Dom.Name)
+		}
+		if DomainEventWatchdogAction(e.Msg.Action) != DomainEventWatchdogReset {
+			t.Errorf("expected action %v, got %v", DomainEventWatchdogReset, e.Msg.Action)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestSubscribeDomainEventAnyRouting(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// libvirt gives each registration its own callback ID.
+	subscribe := func(id int32, eventID DomainEventID) <-chan DomainEventMsg {
+		payload, err := encode(&ConnectDomainEventCallbackRegisterAnyRet{CallbackID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, constants.ProcConnectDomainEventCallbackRegisterAny, payload)
+		stream, err := l.SubscribeDomainEventAny(ctx, eventID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stream
+	}
+	lifecycle := subscribe(1, DomainEventIDLifecycle)
+	blockJob := subscribe(2, DomainEventIDBlockJob)
+
+	send := func(procedure uint32, msg interface{}) {
+		payload, err := encode(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.Test.Write(libvirttest.EventPacket(constants.Program, procedure, payload))
+	}
+	send(constants.ProcDomainEventCallbackBlockJob, &DomainEventCallbackBlockJobMsg{
+		CallbackID: 2,
+		Msg:        DomainEventBlockJobMsg{Dom: Domain{Name: "job"}, Path: "vda"},
+	})
+	// An event for a registration nobody subscribed to goes to neither.
+	send(constants.ProcDomainEventCallbackLifecycle, &DomainEventCallbackLifecycleMsg{
+		CallbackID: 3,
+		Msg:        DomainEventLifecycleMsg{Dom: Domain{Name: "other"}},
+	})
+	send(constants.ProcDomainEventCallbackLifecycle, &DomainEventCallbackLifecycleMsg{
+		CallbackID: 1,
+		Msg:        DomainEventLifecycleMsg{Dom: Domain{Name: "life"}},
+	})
+
+	recv := func(stream <-chan DomainEventMsg) DomainEventMsg {
+		select {
+		case ev := <-stream:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return nil
+	}
+	if e, ok := recv(lifecycle).(*DomainEventCallbackLifecycleMsg); !ok || e.Msg.Dom.Name != "life" {
+		t.Errorf("expected the lifecycle event for %q, got %+v", "life", e)
+	}
+	if e, ok := recv(blockJob).(*DomainEventCallbackBlockJobMsg); !ok || e.Msg.Dom.Name != "job" {
+		t.Errorf("expected the block job event for %q, got %+v", "job", e)
+	}
+
+	// Nothing else arrives on either stream.
+	select {
+	case ev := <-lifecycle:
+		t.Errorf("unexpected lifecycle event %+v", ev)
+	case ev := <-blockJob:
+		t.Errorf("unexpected block job event %+v", ev)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDecodeDomainEvents(t *testing.T) {
+	// Events with typed params need the typed param decoder.
+	payload, err := encode(&DomainEventCallbackTunableMsg{
+		CallbackID: 3,
+		Dom:        Domain{Name: "test"},
+		Params: []TypedParam{
+			{Field: "cputune.vcpu_quota", Value: *NewTypedParamValueLlong(-1)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newEvent, ok := domainEvents[constants.ProcDomainEventCallbackTunable]
+	if !ok {
+		t.Fatal("expected a decoder for tunable events")
+	}
+	ev := newEvent()
+	if err := eventDecoder(payload, ev); err != nil {
+		t.Fatal(err)
+	}
+	e := ev.(*DomainEventCallbackTunableMsg)
+	if e.GetCallbackID() != 3 || len(e.Params) != 1 || e.Params[0].Value.I != int64(-1) {
+		t.Errorf("unexpected event %+v", e)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "time"
+
+// DomainInfo is the summary of a domain's state and resources returned by
+// DomainInfo.
+type DomainInfo struct {
+	State DomainState
+	// MaxMem is the most memory the domain may use, and Memory the memory it
+	// currently uses, both in KiB.
+	MaxMem uint64
+	Memory uint64
+	// NrVirtCPU is the number of virtual CPUs the domain has.
+	NrVirtCPU uint16
+	// CPUTime is the CPU time the domain has used; libvirt reports it in
+	// nanoseconds.
+	CPUTime time.Duration
+}
+
+// DomainInfo returns the state, memory and virtual CPU usage of a domain. It
+// wraps DomainGetInfo.
+func (l *Libvirt) DomainInfo(dom Domain) (DomainInfo, error) {
+	state, maxMem, memory, nrVirtCPU, cpuTime, err := l.DomainGetInfo(dom)
+	if err != nil {
+		return DomainInfo{}, err
+	}
+
+	return DomainInfo{
+		State:     DomainState(state),
+		MaxMem:    maxMem,
+		Memory:    memory,
+		NrVirtCPU: nrVirtCPU,
+		CPUTime:   time.Duration(cpuTime),
+	}, nil
+}
+
+// virshStates are the names virsh gives domain states.
+var virshStates = map[DomainState]string{
+	DomainNostate:     "no state",
+	DomainRunning:     "running",
+	DomainBlocked:     "idle",
+	DomainPaused:      "paused",
+	DomainShutdown:    "in shutdown",
+	DomainShutoff:     "shut off",
+	DomainCrashed:     "crashed",
+	DomainPmsuspended: "pmsuspended",
+}
+
+// VirshString returns the name virsh uses for the state, such as "running" or
+// "shut off", as shown by "virsh list" and "virsh domstate". As in virsh,
+// states it doesn't know are "no state".
+func (s DomainState) VirshString() string {
+	if name, ok := virshStates[s]; ok {
+		return name
+	}
+	return virshStates[DomainNostate]
+}
+
+// DomainBlockInfo describes the size of one of a domain's disks, as returned
+// by DomainBlockInfo. All sizes are in bytes.
+type DomainBlockInfo struct {
+	// Capacity is the size of the disk as the guest sees it.
+	Capacity uint64
+	// Allocation is how much of the disk's storage is in use. For a thinly
+	// provisioned or sparse image it grows as the guest writes to the disk.
+	Allocation uint64
+	// Physical is the size of the disk's storage on the host, such as the
+	// image file's size or the block device's size.
+	Physical uint64
+}
+
+// DomainBlockInfo returns the size of one of a domain's disks. The disk is
+// named by its target, such as "vda", or by the path of its source, such as
+// "/var/lib/libvirt/images/disk.qcow2", as in the domain XML. If the domain
+// has no such disk, libvirt returns an Error with the code ErrInvalidArg,
+// which IsErrorCode(err, ErrInvalidArg) detects. It wraps DomainGetBlockInfo,
+// and flags is currently unused.
+func (l *Libvirt) DomainBlockInfo(dom Domain, disk string, flags uint32) (DomainBlockInfo, error) {
+	allocation, capacity, physical, err := l.DomainGetBlockInfo(dom, disk, flags)
+	if err != nil {
+		return DomainBlockInfo{}, err
+	}
+
+	return DomainBlockInfo{
+		Capacity:   capacity,
+		Allocation: allocation,
+		Physical:   physical,
+	}, nil
+}
+
+// DomainDiskResize changes the size of one of a running domain's disks to size
+// bytes, as the guest sees it, once the disk's storage has been grown, for
+// example with StorageVolResize. The disk is named as for DomainBlockInfo.
+//
+// It wraps DomainBlockResize, which takes the size in KiB unless the flags
+// include DomainBlockResizeBytes; DomainDiskResize always sets the flag, so the
+// size is never mistaken for KiB. Sizes which aren't a multiple of 512 bytes may
+// be rounded up by the hypervisor.
+func (l *Libvirt) DomainDiskResize(dom Domain, disk string, size uint64) error {
+	return l.DomainBlockResize(dom, disk, size, DomainBlockResizeBytes)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&DomainGetInfoRet{
+		State:     uint8(DomainPaused),
+		MaxMem:    4 << 20,
+		Memory:    2 << 20,
+		NrVirtCPU: 4,
+		CPUTime:   uint64(90 * time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetInfo, payload)
+
+	info, err := l.DomainInfo(Domain{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := DomainInfo{
+		State:     DomainPaused,
+		MaxMem:    4 << 20,
+		Memory:    2 << 20,
+		NrVirtCPU: 4,
+		CPUTime:   90 * time.Second,
+	}
+	if info != want {
+		t.Errorf("expected %+v, got %+v", want, info)
+	}
+
+	dialer.QueueError(constants.Program, constants.ProcDomainGetInfo, testNoDomainError)
+	if _, err := l.DomainInfo(Domain{Name: "missing"}); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDomainStateVirshString(t *testing.T) {
+	tests := []struct {
+		state DomainState
+		want  string
+	}{
+		{DomainNostate, "no state"},
+		{DomainRunning, "running"},
+		{DomainBlocked, "idle"},
+		{DomainPaused, "paused"},
+		{DomainShutdown, "in shutdown"},
+		{DomainShutoff, "shut off"},
+		{DomainCrashed, "crashed"},
+		{DomainPmsuspended, "pmsuspended"},
+		{DomainState(42), "no state"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.state.VirshString(); got != tt.want {
+			t.Errorf("expected state %d to be %q, got %q", tt.state, tt.want, got)
+		}
+	}
+}
+
+func TestDomainBlockInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&DomainGetBlockInfoRet{
+		Allocation: 2 << 30,
+		Capacity:   20 << 30,
+		Physical:   3 << 30,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetBlockInfo, payload)
+
+	info, err := l.DomainBlockInfo(Domain{Name: "test"}, "vda", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DomainBlockInfo{Capacity: 20 << 30, Allocation: 2 << 30, Physical: 3 << 30}
+	if info != want {
+		t.Errorf("expected %+v, got %+v", want, info)
+	}
+
+	payload, err = encode(&struct {
+		Code     uint32
+		DomainID uint32
+		Padding  uint8
+		Message  string
+		Level    uint32
+	}{uint32(ErrInvalidArg), uint32(fromQemu), 1, "invalid argument: invalid path vdz not assigned to domain", 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueError(constants.Program, constants.ProcDomainGetBlockInfo, payload)
+	if _, err := l.DomainBlockInfo(Domain{Name: "test"}, "vdz", 0); !IsErrorCode(err, ErrInvalidArg) {
+		t.Errorf("expected an invalid argument error, got %v", err)
+	}
+}
+
+func TestDomainDiskResize(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainBlockResize, nil)
+	if err := l.DomainDiskResize(Domain{Name: "test"}, "vda", 20<<30); err != nil {
+		t.Fatal(err)
+	}
+
+	var args DomainBlockResizeArgs
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainBlockResize {
+			continue
+		}
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatalf("unmarshal failed: %v", err)
+		}
+	}
+	if args.Disk != "vda" {
+		t.Errorf("expected disk %q, got %q", "vda", args.Disk)
+	}
+	if args.Size != 20<<30 {
+		t.Errorf("expected size %d, got %d", uint64(20<<30), args.Size)
+	}
+	if args.Flags != DomainBlockResizeBytes {
+		t.Errorf("expected the size to be given in bytes, got flags %v", args.Flags)
+	}
+}
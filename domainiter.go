@@ -0,0 +1,67 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+)
+
+// minDomainSize is the size of the smallest encoded Domain: an empty name, the
+// UUID and the ID.
+const minDomainSize = 4 + UUIDBuflen + 4
+
+// ConnectListAllDomainsIter lists domains like ConnectListAllDomains, but
+// returns a function which decodes them from libvirt's reply one at a time,
+// rather than building a slice of them all. Each call to the function returns
+// the next domain and true, or false once there are no more domains. The
+// function isn't safe for concurrent use.
+func (l *Libvirt) ConnectListAllDomainsIter(flags ConnectListAllDomainsFlags) (func() (Domain, bool, error), error) {
+	buf, err := encode(&ConnectListAllDomainsArgs{NeedResults: 1, Flags: flags})
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := l.request(constants.ProcConnectListAllDomains, constants.Program, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	rdr := bytes.NewReader(r.Payload)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), nil)
+	count, _, err := dec.DecodeUint()
+	if err != nil {
+		return nil, err
+	}
+	if count > constants.DomainListMax || int64(count)*minDomainSize > int64(rdr.Len()) {
+		return nil, fmt.Errorf("invalid domain list length %d", count)
+	}
+
+	return func() (Domain, bool, error) {
+		if count == 0 {
+			return Domain{}, false, nil
+		}
+		var d Domain
+		if _, err := dec.Decode(&d); err != nil {
+			count = 0
+			return Domain{}, false, err
+		}
+		count--
+		return d, true, nil
+	}, nil
+}
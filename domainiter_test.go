@@ -0,0 +1,72 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestConnectListAllDomainsIter(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	doms := []Domain{
+		{Name: "a", UUID: testUUID, ID: 1},
+		{Name: "b", UUID: testUUID, ID: 2},
+		{Name: "c", UUID: testUUID, ID: -1},
+	}
+	payload, err := encode(&ConnectListAllDomainsRet{Domains: doms, Ret: uint32(len(doms))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectListAllDomains, payload)
+
+	next, err := l.ConnectListAllDomainsIter(ConnectListDomainsActive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []Domain
+	for {
+		d, ok, err := next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+		got = append(got, d)
+	}
+	if !reflect.DeepEqual(got, doms) {
+		t.Errorf("expected domains %v, got %v", doms, got)
+	}
+	if _, ok, err := next(); ok || err != nil {
+		t.Errorf("expected the iterator to stay exhausted, got %v, %v", ok, err)
+	}
+
+	// A count which doesn't fit in the reply is rejected up front.
+	dialer.QueueReply(constants.Program, constants.ProcConnectListAllDomains,
+		[]byte{0x00, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00})
+	if _, err := l.ConnectListAllDomainsIter(0); err == nil {
+		t.Error("expected an invalid list length to fail")
+	}
+}
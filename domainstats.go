@@ -0,0 +1,472 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+)
+
+// DomainStats holds the statistics for one domain, returned by
+// AllDomainStats.
+type DomainStats struct {
+	Domain Domain
+	// Params maps the name of each statistic, such as "state.state" or
+	// "block.0.rd.bytes", to its value. See TypedParams.Map for the types
+	// values have.
+	Params map[string]interface{}
+}
+
+// AllDomainStats returns statistics for doms, or for all domains if doms is
+// empty, in a single call. The stats argument selects the groups of
+// statistics to return, and 0 returns every group the hypervisor supports.
+// When doms is empty, flags can also filter the domains returned, e.g. by
+// ConnectGetAllDomainsStatsActive.
+//
+// It wraps ConnectGetAllDomainStats, decoding each domain's typed parameters
+// into a map.
+func (l *Libvirt) AllDomainStats(doms []Domain, stats DomainStatsTypes, flags ConnectGetAllDomainStatsFlags) ([]DomainStats, error) {
+	recs, err := l.ConnectGetAllDomainStats(doms, uint32(stats), flags)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]DomainStats, 0, len(recs))
+	for _, rec := range recs {
+		res = append(res, DomainStats{
+			Domain: rec.Dom,
+			Params: TypedParams(rec.Params).Map(),
+		})
+	}
+	return res, nil
+}
+
+// DomainStatsReport holds the statistics for one domain decoded into typed
+// fields, returned by DomainStats. Statistics the hypervisor doesn't report,
+// or that are in groups not requested, are zero.
+type DomainStatsReport struct {
+	Domain  Domain
+	State   DomainStateStats
+	CPU     DomainCPUStats
+	Balloon DomainBalloonStats
+	// VCPUCurrent and VCPUMaximum are the domain's current and maximum number
+	// of virtual CPUs, and VCPUs is indexed by virtual CPU number.
+	VCPUCurrent uint32
+	VCPUMaximum uint32
+	VCPUs       []DomainVCPUStats
+	Block       []DomainBlockDeviceStats
+	Net         []DomainNetStats
+}
+
+// DomainStateStats holds the "state.*" statistics.
+type DomainStateStats struct {
+	State  DomainState
+	Reason int32
+}
+
+// DomainCPUStats holds the "cpu.*" statistics, in nanoseconds.
+type DomainCPUStats struct {
+	Time   uint64
+	User   uint64
+	System uint64
+}
+
+// DomainBalloonStats holds the "balloon.*" statistics. Sizes are in KiB.
+type DomainBalloonStats struct {
+	Current    uint64
+	Maximum    uint64
+	SwapIn     uint64
+	SwapOut    uint64
+	MajorFault uint64
+	MinorFault uint64
+	Unused     uint64
+	Available  uint64
+	Usable     uint64
+	Rss        uint64
+	DiskCaches uint64
+	// LastUpdate is when the guest last updated the statistics, in seconds
+	// since the epoch.
+	LastUpdate uint64
+}
+
+// DomainVCPUStats holds the "vcpu.<num>.*" statistics for one virtual CPU.
+type DomainVCPUStats struct {
+	State VCPUState
+	// Time and Wait are the time spent running and waiting to run, in
+	// nanoseconds.
+	Time   uint64
+	Wait   uint64
+	Halted bool
+}
+
+// DomainBlockDeviceStats holds the "block.<num>.*" statistics for one block device.
+// Times are in nanoseconds and sizes in bytes.
+type DomainBlockDeviceStats struct {
+	Name         string
+	Path         string
+	BackingIndex uint32
+	RdReqs       uint64
+	RdBytes      uint64
+	RdTimes      uint64
+	WrReqs       uint64
+	WrBytes      uint64
+	WrTimes      uint64
+	FlReqs       uint64
+	FlTimes      uint64
+	Errors       uint64
+	Allocation   uint64
+	Capacity     uint64
+	Physical     uint64
+}
+
+// DomainNetStats holds the "net.<num>.*" statistics for one network
+// interface.
+type DomainNetStats struct {
+	Name    string
+	RxBytes uint64
+	RxPkts  uint64
+	RxErrs  uint64
+	RxDrop  uint64
+	TxBytes uint64
+	TxPkts  uint64
+	TxErrs  uint64
+	TxDrop  uint64
+}
+
+// DomainStats returns statistics for dom, decoded into a DomainStatsReport.
+// The stats argument selects the groups of statistics to return, and 0
+// returns every group the hypervisor supports. Use AllDomainStats for
+// statistics not decoded here, such as the "perf.*" group.
+func (l *Libvirt) DomainStats(dom Domain, stats DomainStatsTypes, flags ConnectGetAllDomainStatsFlags) (DomainStatsReport, error) {
+	recs, err := l.ConnectGetAllDomainStats([]Domain{dom}, uint32(stats), flags)
+	if err != nil {
+		return DomainStatsReport{}, err
+	}
+	if len(recs) == 0 {
+		return DomainStatsReport{}, fmt.Errorf("no statistics returned for domain %q", dom.Name)
+	}
+
+	r, err := parseDomainStats(TypedParams(recs[0].Params))
+	if err != nil {
+		return DomainStatsReport{}, err
+	}
+	r.Domain = recs[0].Dom
+	return r, nil
+}
+
+// parseDomainStats decodes the statistics in p, ignoring any it doesn't know
+// or that have an unexpected type. It returns an error if the index of a
+// virtual CPU, block device or interface is out of range, rather than growing
+// the slices to whatever index the daemon sends.
+func parseDomainStats(p TypedParams) (DomainStatsReport, error) {
+	limits := map[string]int{
+		// Offline virtual CPUs aren't reported, so their indexes needn't
+		// be less than the number of statistics.
+		"vcpu":  statLimit(p, "vcpu.maximum", int(constants.VcpuinfoMax)),
+		"block": statLimit(p, "block.count", len(p)),
+		"net":   statLimit(p, "net.count", len(p)),
+	}
+
+	var r DomainStatsReport
+	for _, param := range p {
+		v := paramValue(param)
+		group, rest := splitStat(param.Field)
+		idx, key := splitStat(rest)
+		n, err := strconv.Atoi(idx)
+		if err != nil {
+			n, key = -1, rest
+		} else if limit, ok := limits[group]; ok && (n < 0 || n >= limit) {
+			return DomainStatsReport{}, statIndexError(param.Field, n, limit)
+		}
+
+		switch {
+		case group == "state" && n < 0:
+			switch vi, _ := v.(int32); key {
+			case "state":
+				r.State.State = DomainState(vi)
+			case "reason":
+				r.State.Reason = vi
+			}
+
+		case group == "cpu" && n < 0:
+			vu, _ := v.(uint64)
+			switch key {
+			case "time":
+				r.CPU.Time = vu
+			case "user":
+				r.CPU.User = vu
+			case "system":
+				r.CPU.System = vu
+			}
+
+		case group == "balloon" && n < 0:
+			vu, _ := v.(uint64)
+			switch key {
+			case "current":
+				r.Balloon.Current = vu
+			case "maximum":
+				r.Balloon.Maximum = vu
+			case "swap_in":
+				r.Balloon.SwapIn = vu
+			case "swap_out":
+				r.Balloon.SwapOut = vu
+			case "major_fault":
+				r.Balloon.MajorFault = vu
+			case "minor_fault":
+				r.Balloon.MinorFault = vu
+			case "unused":
+				r.Balloon.Unused = vu
+			case "available":
+				r.Balloon.Available = vu
+			case "usable":
+				r.Balloon.Usable = vu
+			case "rss":
+				r.Balloon.Rss = vu
+			case "disk_caches":
+				r.Balloon.DiskCaches = vu
+			case "last-update":
+				r.Balloon.LastUpdate = vu
+			}
+
+		case group == "vcpu" && n < 0:
+			switch vu, _ := v.(uint32); key {
+			case "current":
+				r.VCPUCurrent = vu
+			case "maximum":
+				r.VCPUMaximum = vu
+			}
+
+		case group == "vcpu":
+			for len(r.VCPUs) <= n {
+				r.VCPUs = append(r.VCPUs, DomainVCPUStats{})
+			}
+			vcpu := &r.VCPUs[n]
+			switch key {
+			case "state":
+				vi, _ := v.(int32)
+				vcpu.State = VCPUState(vi)
+			case "time":
+				vcpu.Time, _ = v.(uint64)
+			case "wait":
+				vcpu.Wait, _ = v.(uint64)
+			case "halted":
+				vcpu.Halted, _ = v.(bool)
+			}
+
+		case group == "block" && n >= 0:
+			for len(r.Block) <= n {
+				r.Block = append(r.Block, DomainBlockDeviceStats{})
+			}
+			b := &r.Block[n]
+			vu, _ := v.(uint64)
+			switch key {
+			case "name":
+				b.Name, _ = v.(string)
+			case "path":
+				b.Path, _ = v.(string)
+			case "backingIndex":
+				b.BackingIndex, _ = v.(uint32)
+			case "rd.reqs":
+				b.RdReqs = vu
+			case "rd.bytes":
+				b.RdBytes = vu
+			case "rd.times":
+				b.RdTimes = vu
+			case "wr.reqs":
+				b.WrReqs = vu
+			case "wr.bytes":
+				b.WrBytes = vu
+			case "wr.times":
+				b.WrTimes = vu
+			case "fl.reqs":
+				b.FlReqs = vu
+			case "fl.times":
+				b.FlTimes = vu
+			case "errors":
+				b.Errors = vu
+			case "allocation":
+				b.Allocation = vu
+			case "capacity":
+				b.Capacity = vu
+			case "physical":
+				b.Physical = vu
+			}
+
+		case group == "net" && n >= 0:
+			for len(r.Net) <= n {
+				r.Net = append(r.Net, DomainNetStats{})
+			}
+			i := &r.Net[n]
+			vu, _ := v.(uint64)
+			switch key {
+			case "name":
+				i.Name, _ = v.(string)
+			case "rx.bytes":
+				i.RxBytes = vu
+			case "rx.pkts":
+				i.RxPkts = vu
+			case "rx.errs":
+				i.RxErrs = vu
+			case "rx.drop":
+				i.RxDrop = vu
+			case "tx.bytes":
+				i.TxBytes = vu
+			case "tx.pkts":
+				i.TxPkts = vu
+			case "tx.errs":
+				i.TxErrs = vu
+			case "tx.drop":
+				i.TxDrop = vu
+			}
+		}
+	}
+	return r, nil
+}
+
+// statLimit returns the number of entries in a group of "<group>.<num>.*"
+// statistics in p, given by its count statistic, such as "block.count", and
+// capped at max. Without a count, it returns len(p), as each entry has at
+// least one statistic.
+func statLimit(p TypedParams, count string, max int) int {
+	for _, param := range p {
+		if param.Field != count {
+			continue
+		}
+		if v, ok := paramValue(param).(uint32); ok && int64(v) < int64(max) {
+			return int(v)
+		}
+		return max
+	}
+	return len(p)
+}
+
+// statIndexError returns the error for a statistic whose index n isn't in
+// [0, limit).
+func statIndexError(name string, n, limit int) error {
+	return fmt.Errorf("statistic %q: index %d out of range [0, %d)", name, n, limit)
+}
+
+// splitStat splits a statistic name at its first dot.
+func splitStat(name string) (string, string) {
+	if i := strings.IndexByte(name, '.'); i != -1 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// DomainCPUTimes holds the CPU times used by a domain, returned by
+// DomainCPUTimes, either in total or on one of the host's CPUs. Times the
+// hypervisor doesn't report are zero.
+type DomainCPUTimes struct {
+	// CPU is the host CPU the times were spent on, or AllCPUs for the domain's
+	// total.
+	CPU int32
+	// CPUTime is the CPU time the domain used. In the total, UserTime and
+	// SystemTime split it into time spent in user space and in the kernel;
+	// on one CPU, VCPUTime is the part spent running its virtual CPUs,
+	// without the hypervisor's overhead.
+	CPUTime    time.Duration
+	UserTime   time.Duration
+	SystemTime time.Duration
+	VCPUTime   time.Duration
+	// Params holds all the statistics returned, including any not decoded
+	// above.
+	Params TypedParams
+}
+
+// DomainCPUTimes returns the CPU times used by dom on ncpus of the host's CPUs
+// starting at startCPU, one entry per CPU, or on all of them from startCPU if
+// ncpus is 0. If startCPU is AllCPUs, it returns the domain's total instead,
+// as its only entry.
+//
+// Unlike DomainGetCPUStats, which must first be called to find the number of
+// statistics, and of CPUs, and then again to fetch them, this makes all the
+// calls, splitting the CPUs between calls as libvirt's limits require.
+func (l *Libvirt) DomainCPUTimes(dom Domain, startCPU int32, ncpus uint32, flags TypedParameterFlags) ([]DomainCPUTimes, error) {
+	countCPU := startCPU
+	if startCPU == AllCPUs {
+		ncpus = 1
+	} else {
+		countCPU = 0
+		if ncpus == 0 {
+			_, n, err := l.DomainGetCPUStats(dom, 0, 0, 0, flags)
+			if err != nil || n <= startCPU {
+				return nil, err
+			}
+			ncpus = uint32(n - startCPU)
+		}
+	}
+
+	_, nparams, err := l.DomainGetCPUStats(dom, 0, countCPU, 1, flags)
+	if err != nil || nparams <= 0 {
+		return nil, err
+	}
+	batch := constants.DomainGetCPUStatsNcpusMax
+	if b := constants.DomainGetCPUStatsMax / uint32(nparams); b < batch {
+		batch = b
+	}
+
+	var res []DomainCPUTimes
+	for cpu := startCPU; ncpus > 0; {
+		n := ncpus
+		if n > batch {
+			n = batch
+		}
+		params, stride, err := l.DomainGetCPUStats(dom, uint32(nparams), cpu, n, flags)
+		if err != nil {
+			return nil, err
+		}
+		if stride <= 0 {
+			break
+		}
+		// The statistics of each CPU follow those of the one before, stride
+		// at a time.
+		for i := 0; i+int(stride) <= len(params); i += int(stride) {
+			t := parseCPUTimes(TypedParams(params[i : i+int(stride)]))
+			t.CPU = cpu
+			if startCPU != AllCPUs {
+				t.CPU += int32(i) / stride
+			}
+			res = append(res, t)
+		}
+		cpu += int32(n)
+		ncpus -= n
+	}
+	return res, nil
+}
+
+// parseCPUTimes decodes the CPU times in p, ignoring any statistics it doesn't
+// know or that have an unexpected type.
+func parseCPUTimes(p TypedParams) DomainCPUTimes {
+	t := DomainCPUTimes{Params: p}
+	for _, param := range p {
+		v, _ := paramValue(param).(uint64)
+		switch param.Field {
+		case DomainCPUStatsCputime:
+			t.CPUTime = time.Duration(v)
+		case DomainCPUStatsUsertime:
+			t.UserTime = time.Duration(v)
+		case DomainCPUStatsSystemtime:
+			t.SystemTime = time.Duration(v)
+		case DomainCPUStatsVcputime:
+			t.VCPUTime = time.Duration(v)
+		}
+	}
+	return t
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestAllDomainStats(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var p1, p2 TypedParams
+	p1.SetInt("state.state", 1)
+	p1.SetUllong("balloon.current", 1048576)
+	p1.SetBool("vcpu.0.halted", true)
+	p2.SetString("block.0.name", "vda")
+
+	dom1 := Domain{Name: "one", ID: 1}
+	dom2 := Domain{Name: "two", ID: 2}
+	payload, err := encode(&ConnectGetAllDomainStatsRet{RetStats: []DomainStatsRecord{
+		{Dom: dom1, Params: p1},
+		{Dom: dom2, Params: p2},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectGetAllDomainStats, payload)
+
+	got, err := l.AllDomainStats(nil, DomainStatsState|DomainStatsBalloon, ConnectGetAllDomainsStatsActive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []DomainStats{
+		{Domain: dom1, Params: map[string]interface{}{
+			"state.state":     int32(1),
+			"balloon.current": uint64(1048576),
+			"vcpu.0.halted":   true,
+		}},
+		{Domain: dom2, Params: map[string]interface{}{
+			"block.0.name": "vda",
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcConnectGetAllDomainStats {
+			continue
+		}
+		var args ConnectGetAllDomainStatsArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if args.Stats != uint32(DomainStatsState|DomainStatsBalloon) {
+			t.Errorf("expected stats %d, got %d", DomainStatsState|DomainStatsBalloon, args.Stats)
+		}
+	}
+}
+
+func TestDomainStats(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var p TypedParams
+	p.SetInt("state.state", int32(DomainRunning))
+	p.SetInt("state.reason", 1)
+	p.SetUllong("cpu.time", 3000)
+	p.SetUllong("cpu.user", 1000)
+	p.SetUllong("balloon.current", 1048576)
+	p.SetUllong("balloon.last-update", 1700000000)
+	p.SetUint("vcpu.current", 2)
+	p.SetUint("vcpu.maximum", 4)
+	p.SetInt("vcpu.1.state", int32(VCPURunning))
+	p.SetUllong("vcpu.1.time", 500)
+	p.SetBool("vcpu.1.halted", true)
+	p.SetInt("vcpu.0.state", int32(VCPURunning))
+	p.SetUint("block.count", 1)
+	p.SetString("block.0.name", "vda")
+	p.SetString("block.0.path", "/var/lib/libvirt/images/one.qcow2")
+	p.SetUllong("block.0.rd.bytes", 4096)
+	p.SetUllong("block.0.capacity", 10737418240)
+	p.SetUint("net.count", 1)
+	p.SetString("net.0.name", "vnet0")
+	p.SetUllong("net.0.rx.bytes", 1500)
+	p.SetUllong("net.0.tx.drop", 2)
+	p.SetUllong("perf.cmt", 7)
+
+	dom := Domain{Name: "one", ID: 1}
+	payload, err := encode(&ConnectGetAllDomainStatsRet{RetStats: []DomainStatsRecord{
+		{Dom: dom, Params: p},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectGetAllDomainStats, payload)
+
+	got, err := l.DomainStats(dom, 0, ConnectGetAllDomainsStatsEnforceStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DomainStatsReport{
+		Domain:      dom,
+		State:       DomainStateStats{State: DomainRunning, Reason: 1},
+		CPU:         DomainCPUStats{Time: 3000, User: 1000},
+		Balloon:     DomainBalloonStats{Current: 1048576, LastUpdate: 1700000000},
+		VCPUCurrent: 2,
+		VCPUMaximum: 4,
+		VCPUs: []DomainVCPUStats{
+			{State: VCPURunning},
+			{State: VCPURunning, Time: 500, Halted: true},
+		},
+		Block: []DomainBlockDeviceStats{{
+			Name:     "vda",
+			Path:     "/var/lib/libvirt/images/one.qcow2",
+			RdBytes:  4096,
+			Capacity: 10737418240,
+		}},
+		Net: []DomainNetStats{{Name: "vnet0", RxBytes: 1500, TxDrop: 2}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcConnectGetAllDomainStats {
+			continue
+		}
+		var args ConnectGetAllDomainStatsArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if len(args.Doms) != 1 || args.Doms[0].Name != "one" {
+			t.Errorf("expected stats for domain one, got %+v", args.Doms)
+		}
+	}
+}
+
+func TestParseDomainStatsIndexes(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   func(p *TypedParams)
+		valid bool
+	}{
+		{"in range", func(p *TypedParams) { p.SetString("block.0.name", "vda") }, true},
+		{"negative", func(p *TypedParams) { p.SetString("block.-1.name", "vda") }, false},
+		{"beyond params", func(p *TypedParams) { p.SetString("net.2000000000.name", "vnet0") }, false},
+		{"beyond count", func(p *TypedParams) {
+			p.SetUint("block.count", 1)
+			p.SetString("block.1.name", "vdb")
+		}, false},
+		{"offline vcpus", func(p *TypedParams) {
+			p.SetUint("vcpu.maximum", 8)
+			p.SetInt("vcpu.7.state", int32(VCPURunning))
+		}, true},
+		{"beyond vcpu maximum", func(p *TypedParams) {
+			p.SetUint("vcpu.maximum", 8)
+			p.SetInt("vcpu.8.state", int32(VCPURunning))
+		}, false},
+		{"huge vcpu maximum", func(p *TypedParams) {
+			p.SetUint("vcpu.maximum", 1<<31)
+			p.SetInt("vcpu.2000000000.state", int32(VCPURunning))
+		}, false},
+		{"other group", func(p *TypedParams) { p.SetUllong("iothread.5.poll-max-ns", 1) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p TypedParams
+			tt.set(&p)
+			_, err := parseDomainStats(p)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error for an out of range index")
+			}
+		})
+	}
+}
+
+func TestDomainCPUTimes(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	queue := func(params TypedParams, nparams int32) {
+		payload, err := encode(&DomainGetCPUStatsRet{Params: params, Nparams: nparams})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, constants.ProcDomainGetCPUStats, payload)
+	}
+	dom := Domain{Name: "test"}
+
+	var total TypedParams
+	total.SetUllong(DomainCPUStatsCputime, 3000)
+	total.SetUllong(DomainCPUStatsUsertime, 1000)
+	total.SetUllong(DomainCPUStatsSystemtime, 500)
+	queue(nil, 3)
+	queue(total, 3)
+	got, err := l.DomainCPUTimes(dom, AllCPUs, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []DomainCPUTimes{
+		{CPU: AllCPUs, CPUTime: 3000, UserTime: 1000, SystemTime: 500, Params: total},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	// Per CPU, each CPU's statistics follow those of the one before, so the
+	// names repeat.
+	perCPU := TypedParams{
+		{Field: DomainCPUStatsCputime, Value: *NewTypedParamValueUllong(100)},
+		{Field: DomainCPUStatsVcputime, Value: *NewTypedParamValueUllong(80)},
+		{Field: DomainCPUStatsCputime, Value: *NewTypedParamValueUllong(200)},
+		{Field: DomainCPUStatsVcputime, Value: *NewTypedParamValueUllong(150)},
+	}
+	queue(nil, 3)
+	queue(nil, 2)
+	queue(perCPU, 2)
+	got, err = l.DomainCPUTimes(dom, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []DomainCPUTimes{
+		{CPU: 1, CPUTime: 100, VCPUTime: 80, Params: perCPU[:2]},
+		{CPU: 2, CPUTime: 200, VCPUTime: 150, Params: perCPU[2:]},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	var calls []DomainGetCPUStatsArgs
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainGetCPUStats {
+			continue
+		}
+		var args DomainGetCPUStatsArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		args.Dom = Domain{}
+		calls = append(calls, args)
+	}
+	wantCalls := []DomainGetCPUStatsArgs{
+		{Nparams: 0, StartCPU: -1, Ncpus: 1},
+		{Nparams: 3, StartCPU: -1, Ncpus: 1},
+		{Nparams: 0, StartCPU: 0, Ncpus: 0},
+		{Nparams: 0, StartCPU: 0, Ncpus: 1},
+		{Nparams: 2, StartCPU: 1, Ncpus: 2},
+	}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("expected calls %+v, got %+v", wantCalls, calls)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "testing"
+
+func TestEnumParse(t *testing.T) {
+	for _, p := range []Procedure{ProcConnectOpen, ProcDomainGetInfo, ProcAuthSaslStep} {
+		got, err := ParseProcedure(p.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != p {
+			t.Errorf("expected %v to parse as %d, got %d", p, p, got)
+		}
+	}
+
+	if a, err := ParseAuthType("AuthSasl"); err != nil || a != AuthSasl {
+		t.Errorf("expected AuthSasl, got %v, %v", a, err)
+	}
+	if _, err := ParseAuthType("AuthKerberos"); err == nil {
+		t.Error("expected an error for an unknown name")
+	}
+	if q, err := ParseQEMUProcedure("QEMUProcDomainMonitorCommand"); err != nil || q != QEMUProcDomainMonitorCommand {
+		t.Errorf("expected QEMUProcDomainMonitorCommand, got %v, %v", q, err)
+	}
+	if s, err := ParseDomainState(DomainPaused.String()); err != nil || s != DomainPaused {
+		t.Errorf("expected DomainPaused, got %v, %v", s, err)
+	}
+	if _, err := ParseDomainState("DomainAsleep"); err == nil {
+		t.Error("expected an error for an unknown domain state")
+	}
+}
+
+func TestEnumIsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		want  bool
+	}{
+		{"AuthPolkit", AuthPolkit.IsValid(), true},
+		{"AuthType(3)", AuthType(3).IsValid(), false},
+		{"ProcConnectOpen", ProcConnectOpen.IsValid(), true},
+		{"Procedure(0)", Procedure(0).IsValid(), false},
+		{"LXCProcDomainOpenNamespace", LXCProcDomainOpenNamespace.IsValid(), true},
+		{"LXCProcedure(-1)", LXCProcedure(-1).IsValid(), false},
+		{"DomainPmsuspended", DomainPmsuspended.IsValid(), true},
+		{"DomainState(42)", DomainState(42).IsValid(), false},
+	}
+
+	for _, tt := range tests {
+		if tt.valid != tt.want {
+			t.Errorf("%v: expected valid to be %v", tt.name, tt.want)
+		}
+	}
+}
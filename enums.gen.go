@@ -0,0 +1,4928 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// Code generated by internal/lvgen/generate.go. DO NOT EDIT.
+//
+// To regenerate, run 'go generate' in internal/lvgen.
+//
+
+package libvirt
+
+import "fmt"
+
+// String returns the name of the ConnectCloseReason value.
+func (e ConnectCloseReason) String() string {
+	switch e {
+	case ConnectCloseReasonError:
+		return "ConnectCloseReasonError"
+	case ConnectCloseReasonEOF:
+		return "ConnectCloseReasonEOF"
+	case ConnectCloseReasonKeepalive:
+		return "ConnectCloseReasonKeepalive"
+	case ConnectCloseReasonClient:
+		return "ConnectCloseReasonClient"
+	}
+	return fmt.Sprintf("ConnectCloseReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectCloseReason values.
+func (e ConnectCloseReason) IsValid() bool {
+	switch e {
+	case ConnectCloseReasonError,
+		ConnectCloseReasonEOF,
+		ConnectCloseReasonKeepalive,
+		ConnectCloseReasonClient:
+		return true
+	}
+	return false
+}
+
+// ParseConnectCloseReason returns the ConnectCloseReason value with the given name, as returned
+// by String.
+func ParseConnectCloseReason(s string) (ConnectCloseReason, error) {
+	switch s {
+	case "ConnectCloseReasonError":
+		return ConnectCloseReasonError, nil
+	case "ConnectCloseReasonEOF":
+		return ConnectCloseReasonEOF, nil
+	case "ConnectCloseReasonKeepalive":
+		return ConnectCloseReasonKeepalive, nil
+	case "ConnectCloseReasonClient":
+		return ConnectCloseReasonClient, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectCloseReason %q", s)
+}
+
+// String returns the name of the TypedParameterType value.
+func (e TypedParameterType) String() string {
+	switch e {
+	case TypedParamInt:
+		return "TypedParamInt"
+	case TypedParamUint:
+		return "TypedParamUint"
+	case TypedParamLlong:
+		return "TypedParamLlong"
+	case TypedParamUllong:
+		return "TypedParamUllong"
+	case TypedParamDouble:
+		return "TypedParamDouble"
+	case TypedParamBoolean:
+		return "TypedParamBoolean"
+	case TypedParamString:
+		return "TypedParamString"
+	}
+	return fmt.Sprintf("TypedParameterType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the TypedParameterType values.
+func (e TypedParameterType) IsValid() bool {
+	switch e {
+	case TypedParamInt,
+		TypedParamUint,
+		TypedParamLlong,
+		TypedParamUllong,
+		TypedParamDouble,
+		TypedParamBoolean,
+		TypedParamString:
+		return true
+	}
+	return false
+}
+
+// ParseTypedParameterType returns the TypedParameterType value with the given name, as returned
+// by String.
+func ParseTypedParameterType(s string) (TypedParameterType, error) {
+	switch s {
+	case "TypedParamInt":
+		return TypedParamInt, nil
+	case "TypedParamUint":
+		return TypedParamUint, nil
+	case "TypedParamLlong":
+		return TypedParamLlong, nil
+	case "TypedParamUllong":
+		return TypedParamUllong, nil
+	case "TypedParamDouble":
+		return TypedParamDouble, nil
+	case "TypedParamBoolean":
+		return TypedParamBoolean, nil
+	case "TypedParamString":
+		return TypedParamString, nil
+	}
+	return 0, fmt.Errorf("invalid TypedParameterType %q", s)
+}
+
+// String returns the name of the NodeSuspendTarget value.
+func (e NodeSuspendTarget) String() string {
+	switch e {
+	case NodeSuspendTargetMem:
+		return "NodeSuspendTargetMem"
+	case NodeSuspendTargetDisk:
+		return "NodeSuspendTargetDisk"
+	case NodeSuspendTargetHybrid:
+		return "NodeSuspendTargetHybrid"
+	}
+	return fmt.Sprintf("NodeSuspendTarget(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NodeSuspendTarget values.
+func (e NodeSuspendTarget) IsValid() bool {
+	switch e {
+	case NodeSuspendTargetMem,
+		NodeSuspendTargetDisk,
+		NodeSuspendTargetHybrid:
+		return true
+	}
+	return false
+}
+
+// ParseNodeSuspendTarget returns the NodeSuspendTarget value with the given name, as returned
+// by String.
+func ParseNodeSuspendTarget(s string) (NodeSuspendTarget, error) {
+	switch s {
+	case "NodeSuspendTargetMem":
+		return NodeSuspendTargetMem, nil
+	case "NodeSuspendTargetDisk":
+		return NodeSuspendTargetDisk, nil
+	case "NodeSuspendTargetHybrid":
+		return NodeSuspendTargetHybrid, nil
+	}
+	return 0, fmt.Errorf("invalid NodeSuspendTarget %q", s)
+}
+
+// String returns the name of the NodeGetCPUStatsAllCPUs value.
+func (e NodeGetCPUStatsAllCPUs) String() string {
+	switch e {
+	case NodeCPUStatsAllCpus:
+		return "NodeCPUStatsAllCpus"
+	}
+	return fmt.Sprintf("NodeGetCPUStatsAllCPUs(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NodeGetCPUStatsAllCPUs values.
+func (e NodeGetCPUStatsAllCPUs) IsValid() bool {
+	switch e {
+	case NodeCPUStatsAllCpus:
+		return true
+	}
+	return false
+}
+
+// ParseNodeGetCPUStatsAllCPUs returns the NodeGetCPUStatsAllCPUs value with the given name, as returned
+// by String.
+func ParseNodeGetCPUStatsAllCPUs(s string) (NodeGetCPUStatsAllCPUs, error) {
+	switch s {
+	case "NodeCPUStatsAllCpus":
+		return NodeCPUStatsAllCpus, nil
+	}
+	return 0, fmt.Errorf("invalid NodeGetCPUStatsAllCPUs %q", s)
+}
+
+// String returns the name of the NodeGetMemoryStatsAllCells value.
+func (e NodeGetMemoryStatsAllCells) String() string {
+	switch e {
+	case NodeMemoryStatsAllCells:
+		return "NodeMemoryStatsAllCells"
+	}
+	return fmt.Sprintf("NodeGetMemoryStatsAllCells(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NodeGetMemoryStatsAllCells values.
+func (e NodeGetMemoryStatsAllCells) IsValid() bool {
+	switch e {
+	case NodeMemoryStatsAllCells:
+		return true
+	}
+	return false
+}
+
+// ParseNodeGetMemoryStatsAllCells returns the NodeGetMemoryStatsAllCells value with the given name, as returned
+// by String.
+func ParseNodeGetMemoryStatsAllCells(s string) (NodeGetMemoryStatsAllCells, error) {
+	switch s {
+	case "NodeMemoryStatsAllCells":
+		return NodeMemoryStatsAllCells, nil
+	}
+	return 0, fmt.Errorf("invalid NodeGetMemoryStatsAllCells %q", s)
+}
+
+// String returns the name of the ConnectCredentialType value.
+func (e ConnectCredentialType) String() string {
+	switch e {
+	case CredUsername:
+		return "CredUsername"
+	case CredAuthname:
+		return "CredAuthname"
+	case CredLanguage:
+		return "CredLanguage"
+	case CredCnonce:
+		return "CredCnonce"
+	case CredPassphrase:
+		return "CredPassphrase"
+	case CredEchoprompt:
+		return "CredEchoprompt"
+	case CredNoechoprompt:
+		return "CredNoechoprompt"
+	case CredRealm:
+		return "CredRealm"
+	case CredExternal:
+		return "CredExternal"
+	}
+	return fmt.Sprintf("ConnectCredentialType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectCredentialType values.
+func (e ConnectCredentialType) IsValid() bool {
+	switch e {
+	case CredUsername,
+		CredAuthname,
+		CredLanguage,
+		CredCnonce,
+		CredPassphrase,
+		CredEchoprompt,
+		CredNoechoprompt,
+		CredRealm,
+		CredExternal:
+		return true
+	}
+	return false
+}
+
+// ParseConnectCredentialType returns the ConnectCredentialType value with the given name, as returned
+// by String.
+func ParseConnectCredentialType(s string) (ConnectCredentialType, error) {
+	switch s {
+	case "CredUsername":
+		return CredUsername, nil
+	case "CredAuthname":
+		return CredAuthname, nil
+	case "CredLanguage":
+		return CredLanguage, nil
+	case "CredCnonce":
+		return CredCnonce, nil
+	case "CredPassphrase":
+		return CredPassphrase, nil
+	case "CredEchoprompt":
+		return CredEchoprompt, nil
+	case "CredNoechoprompt":
+		return CredNoechoprompt, nil
+	case "CredRealm":
+		return CredRealm, nil
+	case "CredExternal":
+		return CredExternal, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectCredentialType %q", s)
+}
+
+// String returns the name of the CPUCompareResult value.
+func (e CPUCompareResult) String() string {
+	switch e {
+	case CPUCompareError:
+		return "CPUCompareError"
+	case CPUCompareIncompatible:
+		return "CPUCompareIncompatible"
+	case CPUCompareIdentical:
+		return "CPUCompareIdentical"
+	case CPUCompareSuperset:
+		return "CPUCompareSuperset"
+	}
+	return fmt.Sprintf("CPUCompareResult(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the CPUCompareResult values.
+func (e CPUCompareResult) IsValid() bool {
+	switch e {
+	case CPUCompareError,
+		CPUCompareIncompatible,
+		CPUCompareIdentical,
+		CPUCompareSuperset:
+		return true
+	}
+	return false
+}
+
+// ParseCPUCompareResult returns the CPUCompareResult value with the given name, as returned
+// by String.
+func ParseCPUCompareResult(s string) (CPUCompareResult, error) {
+	switch s {
+	case "CPUCompareError":
+		return CPUCompareError, nil
+	case "CPUCompareIncompatible":
+		return CPUCompareIncompatible, nil
+	case "CPUCompareIdentical":
+		return CPUCompareIdentical, nil
+	case "CPUCompareSuperset":
+		return CPUCompareSuperset, nil
+	}
+	return 0, fmt.Errorf("invalid CPUCompareResult %q", s)
+}
+
+// String returns the name of the DomainState value.
+func (e DomainState) String() string {
+	switch e {
+	case DomainNostate:
+		return "DomainNostate"
+	case DomainRunning:
+		return "DomainRunning"
+	case DomainBlocked:
+		return "DomainBlocked"
+	case DomainPaused:
+		return "DomainPaused"
+	case DomainShutdown:
+		return "DomainShutdown"
+	case DomainShutoff:
+		return "DomainShutoff"
+	case DomainCrashed:
+		return "DomainCrashed"
+	case DomainPmsuspended:
+		return "DomainPmsuspended"
+	}
+	return fmt.Sprintf("DomainState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainState values.
+func (e DomainState) IsValid() bool {
+	switch e {
+	case DomainNostate,
+		DomainRunning,
+		DomainBlocked,
+		DomainPaused,
+		DomainShutdown,
+		DomainShutoff,
+		DomainCrashed,
+		DomainPmsuspended:
+		return true
+	}
+	return false
+}
+
+// ParseDomainState returns the DomainState value with the given name, as returned
+// by String.
+func ParseDomainState(s string) (DomainState, error) {
+	switch s {
+	case "DomainNostate":
+		return DomainNostate, nil
+	case "DomainRunning":
+		return DomainRunning, nil
+	case "DomainBlocked":
+		return DomainBlocked, nil
+	case "DomainPaused":
+		return DomainPaused, nil
+	case "DomainShutdown":
+		return DomainShutdown, nil
+	case "DomainShutoff":
+		return DomainShutoff, nil
+	case "DomainCrashed":
+		return DomainCrashed, nil
+	case "DomainPmsuspended":
+		return DomainPmsuspended, nil
+	}
+	return 0, fmt.Errorf("invalid DomainState %q", s)
+}
+
+// String returns the name of the DomainNostateReason value.
+func (e DomainNostateReason) String() string {
+	switch e {
+	case DomainNostateUnknown:
+		return "DomainNostateUnknown"
+	}
+	return fmt.Sprintf("DomainNostateReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainNostateReason values.
+func (e DomainNostateReason) IsValid() bool {
+	switch e {
+	case DomainNostateUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseDomainNostateReason returns the DomainNostateReason value with the given name, as returned
+// by String.
+func ParseDomainNostateReason(s string) (DomainNostateReason, error) {
+	switch s {
+	case "DomainNostateUnknown":
+		return DomainNostateUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid DomainNostateReason %q", s)
+}
+
+// String returns the name of the DomainRunningReason value.
+func (e DomainRunningReason) String() string {
+	switch e {
+	case DomainRunningUnknown:
+		return "DomainRunningUnknown"
+	case DomainRunningBooted:
+		return "DomainRunningBooted"
+	case DomainRunningMigrated:
+		return "DomainRunningMigrated"
+	case DomainRunningRestored:
+		return "DomainRunningRestored"
+	case DomainRunningFromSnapshot:
+		return "DomainRunningFromSnapshot"
+	case DomainRunningUnpaused:
+		return "DomainRunningUnpaused"
+	case DomainRunningMigrationCanceled:
+		return "DomainRunningMigrationCanceled"
+	case DomainRunningSaveCanceled:
+		return "DomainRunningSaveCanceled"
+	case DomainRunningWakeup:
+		return "DomainRunningWakeup"
+	case DomainRunningCrashed:
+		return "DomainRunningCrashed"
+	case DomainRunningPostcopy:
+		return "DomainRunningPostcopy"
+	}
+	return fmt.Sprintf("DomainRunningReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainRunningReason values.
+func (e DomainRunningReason) IsValid() bool {
+	switch e {
+	case DomainRunningUnknown,
+		DomainRunningBooted,
+		DomainRunningMigrated,
+		DomainRunningRestored,
+		DomainRunningFromSnapshot,
+		DomainRunningUnpaused,
+		DomainRunningMigrationCanceled,
+		DomainRunningSaveCanceled,
+		DomainRunningWakeup,
+		DomainRunningCrashed,
+		DomainRunningPostcopy:
+		return true
+	}
+	return false
+}
+
+// ParseDomainRunningReason returns the DomainRunningReason value with the given name, as returned
+// by String.
+func ParseDomainRunningReason(s string) (DomainRunningReason, error) {
+	switch s {
+	case "DomainRunningUnknown":
+		return DomainRunningUnknown, nil
+	case "DomainRunningBooted":
+		return DomainRunningBooted, nil
+	case "DomainRunningMigrated":
+		return DomainRunningMigrated, nil
+	case "DomainRunningRestored":
+		return DomainRunningRestored, nil
+	case "DomainRunningFromSnapshot":
+		return DomainRunningFromSnapshot, nil
+	case "DomainRunningUnpaused":
+		return DomainRunningUnpaused, nil
+	case "DomainRunningMigrationCanceled":
+		return DomainRunningMigrationCanceled, nil
+	case "DomainRunningSaveCanceled":
+		return DomainRunningSaveCanceled, nil
+	case "DomainRunningWakeup":
+		return DomainRunningWakeup, nil
+	case "DomainRunningCrashed":
+		return DomainRunningCrashed, nil
+	case "DomainRunningPostcopy":
+		return DomainRunningPostcopy, nil
+	}
+	return 0, fmt.Errorf("invalid DomainRunningReason %q", s)
+}
+
+// String returns the name of the DomainBlockedReason value.
+func (e DomainBlockedReason) String() string {
+	switch e {
+	case DomainBlockedUnknown:
+		return "DomainBlockedUnknown"
+	}
+	return fmt.Sprintf("DomainBlockedReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainBlockedReason values.
+func (e DomainBlockedReason) IsValid() bool {
+	switch e {
+	case DomainBlockedUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseDomainBlockedReason returns the DomainBlockedReason value with the given name, as returned
+// by String.
+func ParseDomainBlockedReason(s string) (DomainBlockedReason, error) {
+	switch s {
+	case "DomainBlockedUnknown":
+		return DomainBlockedUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid DomainBlockedReason %q", s)
+}
+
+// String returns the name of the DomainPausedReason value.
+func (e DomainPausedReason) String() string {
+	switch e {
+	case DomainPausedUnknown:
+		return "DomainPausedUnknown"
+	case DomainPausedUser:
+		return "DomainPausedUser"
+	case DomainPausedMigration:
+		return "DomainPausedMigration"
+	case DomainPausedSave:
+		return "DomainPausedSave"
+	case DomainPausedDump:
+		return "DomainPausedDump"
+	case DomainPausedIoerror:
+		return "DomainPausedIoerror"
+	case DomainPausedWatchdog:
+		return "DomainPausedWatchdog"
+	case DomainPausedFromSnapshot:
+		return "DomainPausedFromSnapshot"
+	case DomainPausedShuttingDown:
+		return "DomainPausedShuttingDown"
+	case DomainPausedSnapshot:
+		return "DomainPausedSnapshot"
+	case DomainPausedCrashed:
+		return "DomainPausedCrashed"
+	case DomainPausedStartingUp:
+		return "DomainPausedStartingUp"
+	case DomainPausedPostcopy:
+		return "DomainPausedPostcopy"
+	case DomainPausedPostcopyFailed:
+		return "DomainPausedPostcopyFailed"
+	}
+	return fmt.Sprintf("DomainPausedReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainPausedReason values.
+func (e DomainPausedReason) IsValid() bool {
+	switch e {
+	case DomainPausedUnknown,
+		DomainPausedUser,
+		DomainPausedMigration,
+		DomainPausedSave,
+		DomainPausedDump,
+		DomainPausedIoerror,
+		DomainPausedWatchdog,
+		DomainPausedFromSnapshot,
+		DomainPausedShuttingDown,
+		DomainPausedSnapshot,
+		DomainPausedCrashed,
+		DomainPausedStartingUp,
+		DomainPausedPostcopy,
+		DomainPausedPostcopyFailed:
+		return true
+	}
+	return false
+}
+
+// ParseDomainPausedReason returns the DomainPausedReason value with the given name, as returned
+// by String.
+func ParseDomainPausedReason(s string) (DomainPausedReason, error) {
+	switch s {
+	case "DomainPausedUnknown":
+		return DomainPausedUnknown, nil
+	case "DomainPausedUser":
+		return DomainPausedUser, nil
+	case "DomainPausedMigration":
+		return DomainPausedMigration, nil
+	case "DomainPausedSave":
+		return DomainPausedSave, nil
+	case "DomainPausedDump":
+		return DomainPausedDump, nil
+	case "DomainPausedIoerror":
+		return DomainPausedIoerror, nil
+	case "DomainPausedWatchdog":
+		return DomainPausedWatchdog, nil
+	case "DomainPausedFromSnapshot":
+		return DomainPausedFromSnapshot, nil
+	case "DomainPausedShuttingDown":
+		return DomainPausedShuttingDown, nil
+	case "DomainPausedSnapshot":
+		return DomainPausedSnapshot, nil
+	case "DomainPausedCrashed":
+		return DomainPausedCrashed, nil
+	case "DomainPausedStartingUp":
+		return DomainPausedStartingUp, nil
+	case "DomainPausedPostcopy":
+		return DomainPausedPostcopy, nil
+	case "DomainPausedPostcopyFailed":
+		return DomainPausedPostcopyFailed, nil
+	}
+	return 0, fmt.Errorf("invalid DomainPausedReason %q", s)
+}
+
+// String returns the name of the DomainShutdownReason value.
+func (e DomainShutdownReason) String() string {
+	switch e {
+	case DomainShutdownUnknown:
+		return "DomainShutdownUnknown"
+	case DomainShutdownUser:
+		return "DomainShutdownUser"
+	}
+	return fmt.Sprintf("DomainShutdownReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainShutdownReason values.
+func (e DomainShutdownReason) IsValid() bool {
+	switch e {
+	case DomainShutdownUnknown,
+		DomainShutdownUser:
+		return true
+	}
+	return false
+}
+
+// ParseDomainShutdownReason returns the DomainShutdownReason value with the given name, as returned
+// by String.
+func ParseDomainShutdownReason(s string) (DomainShutdownReason, error) {
+	switch s {
+	case "DomainShutdownUnknown":
+		return DomainShutdownUnknown, nil
+	case "DomainShutdownUser":
+		return DomainShutdownUser, nil
+	}
+	return 0, fmt.Errorf("invalid DomainShutdownReason %q", s)
+}
+
+// String returns the name of the DomainShutoffReason value.
+func (e DomainShutoffReason) String() string {
+	switch e {
+	case DomainShutoffUnknown:
+		return "DomainShutoffUnknown"
+	case DomainShutoffShutdown:
+		return "DomainShutoffShutdown"
+	case DomainShutoffDestroyed:
+		return "DomainShutoffDestroyed"
+	case DomainShutoffCrashed:
+		return "DomainShutoffCrashed"
+	case DomainShutoffMigrated:
+		return "DomainShutoffMigrated"
+	case DomainShutoffSaved:
+		return "DomainShutoffSaved"
+	case DomainShutoffFailed:
+		return "DomainShutoffFailed"
+	case DomainShutoffFromSnapshot:
+		return "DomainShutoffFromSnapshot"
+	case DomainShutoffDaemon:
+		return "DomainShutoffDaemon"
+	}
+	return fmt.Sprintf("DomainShutoffReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainShutoffReason values.
+func (e DomainShutoffReason) IsValid() bool {
+	switch e {
+	case DomainShutoffUnknown,
+		DomainShutoffShutdown,
+		DomainShutoffDestroyed,
+		DomainShutoffCrashed,
+		DomainShutoffMigrated,
+		DomainShutoffSaved,
+		DomainShutoffFailed,
+		DomainShutoffFromSnapshot,
+		DomainShutoffDaemon:
+		return true
+	}
+	return false
+}
+
+// ParseDomainShutoffReason returns the DomainShutoffReason value with the given name, as returned
+// by String.
+func ParseDomainShutoffReason(s string) (DomainShutoffReason, error) {
+	switch s {
+	case "DomainShutoffUnknown":
+		return DomainShutoffUnknown, nil
+	case "DomainShutoffShutdown":
+		return DomainShutoffShutdown, nil
+	case "DomainShutoffDestroyed":
+		return DomainShutoffDestroyed, nil
+	case "DomainShutoffCrashed":
+		return DomainShutoffCrashed, nil
+	case "DomainShutoffMigrated":
+		return DomainShutoffMigrated, nil
+	case "DomainShutoffSaved":
+		return DomainShutoffSaved, nil
+	case "DomainShutoffFailed":
+		return DomainShutoffFailed, nil
+	case "DomainShutoffFromSnapshot":
+		return DomainShutoffFromSnapshot, nil
+	case "DomainShutoffDaemon":
+		return DomainShutoffDaemon, nil
+	}
+	return 0, fmt.Errorf("invalid DomainShutoffReason %q", s)
+}
+
+// String returns the name of the DomainCrashedReason value.
+func (e DomainCrashedReason) String() string {
+	switch e {
+	case DomainCrashedUnknown:
+		return "DomainCrashedUnknown"
+	case DomainCrashedPanicked:
+		return "DomainCrashedPanicked"
+	}
+	return fmt.Sprintf("DomainCrashedReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainCrashedReason values.
+func (e DomainCrashedReason) IsValid() bool {
+	switch e {
+	case DomainCrashedUnknown,
+		DomainCrashedPanicked:
+		return true
+	}
+	return false
+}
+
+// ParseDomainCrashedReason returns the DomainCrashedReason value with the given name, as returned
+// by String.
+func ParseDomainCrashedReason(s string) (DomainCrashedReason, error) {
+	switch s {
+	case "DomainCrashedUnknown":
+		return DomainCrashedUnknown, nil
+	case "DomainCrashedPanicked":
+		return DomainCrashedPanicked, nil
+	}
+	return 0, fmt.Errorf("invalid DomainCrashedReason %q", s)
+}
+
+// String returns the name of the DomainPMSuspendedReason value.
+func (e DomainPMSuspendedReason) String() string {
+	switch e {
+	case DomainPmsuspendedUnknown:
+		return "DomainPmsuspendedUnknown"
+	}
+	return fmt.Sprintf("DomainPMSuspendedReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainPMSuspendedReason values.
+func (e DomainPMSuspendedReason) IsValid() bool {
+	switch e {
+	case DomainPmsuspendedUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseDomainPMSuspendedReason returns the DomainPMSuspendedReason value with the given name, as returned
+// by String.
+func ParseDomainPMSuspendedReason(s string) (DomainPMSuspendedReason, error) {
+	switch s {
+	case "DomainPmsuspendedUnknown":
+		return DomainPmsuspendedUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid DomainPMSuspendedReason %q", s)
+}
+
+// String returns the name of the DomainPMSuspendedDiskReason value.
+func (e DomainPMSuspendedDiskReason) String() string {
+	switch e {
+	case DomainPmsuspendedDiskUnknown:
+		return "DomainPmsuspendedDiskUnknown"
+	}
+	return fmt.Sprintf("DomainPMSuspendedDiskReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainPMSuspendedDiskReason values.
+func (e DomainPMSuspendedDiskReason) IsValid() bool {
+	switch e {
+	case DomainPmsuspendedDiskUnknown:
+		return true
+	}
+	return false
+}
+
+// ParseDomainPMSuspendedDiskReason returns the DomainPMSuspendedDiskReason value with the given name, as returned
+// by String.
+func ParseDomainPMSuspendedDiskReason(s string) (DomainPMSuspendedDiskReason, error) {
+	switch s {
+	case "DomainPmsuspendedDiskUnknown":
+		return DomainPmsuspendedDiskUnknown, nil
+	}
+	return 0, fmt.Errorf("invalid DomainPMSuspendedDiskReason %q", s)
+}
+
+// String returns the name of the DomainControlState value.
+func (e DomainControlState) String() string {
+	switch e {
+	case DomainControlOk:
+		return "DomainControlOk"
+	case DomainControlJob:
+		return "DomainControlJob"
+	case DomainControlOccupied:
+		return "DomainControlOccupied"
+	case DomainControlError:
+		return "DomainControlError"
+	}
+	return fmt.Sprintf("DomainControlState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainControlState values.
+func (e DomainControlState) IsValid() bool {
+	switch e {
+	case DomainControlOk,
+		DomainControlJob,
+		DomainControlOccupied,
+		DomainControlError:
+		return true
+	}
+	return false
+}
+
+// ParseDomainControlState returns the DomainControlState value with the given name, as returned
+// by String.
+func ParseDomainControlState(s string) (DomainControlState, error) {
+	switch s {
+	case "DomainControlOk":
+		return DomainControlOk, nil
+	case "DomainControlJob":
+		return DomainControlJob, nil
+	case "DomainControlOccupied":
+		return DomainControlOccupied, nil
+	case "DomainControlError":
+		return DomainControlError, nil
+	}
+	return 0, fmt.Errorf("invalid DomainControlState %q", s)
+}
+
+// String returns the name of the DomainControlErrorReason value.
+func (e DomainControlErrorReason) String() string {
+	switch e {
+	case DomainControlErrorReasonNone:
+		return "DomainControlErrorReasonNone"
+	case DomainControlErrorReasonUnknown:
+		return "DomainControlErrorReasonUnknown"
+	case DomainControlErrorReasonMonitor:
+		return "DomainControlErrorReasonMonitor"
+	case DomainControlErrorReasonInternal:
+		return "DomainControlErrorReasonInternal"
+	}
+	return fmt.Sprintf("DomainControlErrorReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainControlErrorReason values.
+func (e DomainControlErrorReason) IsValid() bool {
+	switch e {
+	case DomainControlErrorReasonNone,
+		DomainControlErrorReasonUnknown,
+		DomainControlErrorReasonMonitor,
+		DomainControlErrorReasonInternal:
+		return true
+	}
+	return false
+}
+
+// ParseDomainControlErrorReason returns the DomainControlErrorReason value with the given name, as returned
+// by String.
+func ParseDomainControlErrorReason(s string) (DomainControlErrorReason, error) {
+	switch s {
+	case "DomainControlErrorReasonNone":
+		return DomainControlErrorReasonNone, nil
+	case "DomainControlErrorReasonUnknown":
+		return DomainControlErrorReasonUnknown, nil
+	case "DomainControlErrorReasonMonitor":
+		return DomainControlErrorReasonMonitor, nil
+	case "DomainControlErrorReasonInternal":
+		return DomainControlErrorReasonInternal, nil
+	}
+	return 0, fmt.Errorf("invalid DomainControlErrorReason %q", s)
+}
+
+// String returns the name of the DomainModificationImpact value.
+func (e DomainModificationImpact) String() string {
+	switch e {
+	case DomainAffectCurrent:
+		return "DomainAffectCurrent"
+	case DomainAffectLive:
+		return "DomainAffectLive"
+	case DomainAffectConfig:
+		return "DomainAffectConfig"
+	}
+	return fmt.Sprintf("DomainModificationImpact(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainModificationImpact values.
+func (e DomainModificationImpact) IsValid() bool {
+	switch e {
+	case DomainAffectCurrent,
+		DomainAffectLive,
+		DomainAffectConfig:
+		return true
+	}
+	return false
+}
+
+// ParseDomainModificationImpact returns the DomainModificationImpact value with the given name, as returned
+// by String.
+func ParseDomainModificationImpact(s string) (DomainModificationImpact, error) {
+	switch s {
+	case "DomainAffectCurrent":
+		return DomainAffectCurrent, nil
+	case "DomainAffectLive":
+		return DomainAffectLive, nil
+	case "DomainAffectConfig":
+		return DomainAffectConfig, nil
+	}
+	return 0, fmt.Errorf("invalid DomainModificationImpact %q", s)
+}
+
+// String returns the name of the DomainCoreDumpFormat value.
+func (e DomainCoreDumpFormat) String() string {
+	switch e {
+	case DomainCoreDumpFormatRaw:
+		return "DomainCoreDumpFormatRaw"
+	case DomainCoreDumpFormatKdumpZlib:
+		return "DomainCoreDumpFormatKdumpZlib"
+	case DomainCoreDumpFormatKdumpLzo:
+		return "DomainCoreDumpFormatKdumpLzo"
+	case DomainCoreDumpFormatKdumpSnappy:
+		return "DomainCoreDumpFormatKdumpSnappy"
+	}
+	return fmt.Sprintf("DomainCoreDumpFormat(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainCoreDumpFormat values.
+func (e DomainCoreDumpFormat) IsValid() bool {
+	switch e {
+	case DomainCoreDumpFormatRaw,
+		DomainCoreDumpFormatKdumpZlib,
+		DomainCoreDumpFormatKdumpLzo,
+		DomainCoreDumpFormatKdumpSnappy:
+		return true
+	}
+	return false
+}
+
+// ParseDomainCoreDumpFormat returns the DomainCoreDumpFormat value with the given name, as returned
+// by String.
+func ParseDomainCoreDumpFormat(s string) (DomainCoreDumpFormat, error) {
+	switch s {
+	case "DomainCoreDumpFormatRaw":
+		return DomainCoreDumpFormatRaw, nil
+	case "DomainCoreDumpFormatKdumpZlib":
+		return DomainCoreDumpFormatKdumpZlib, nil
+	case "DomainCoreDumpFormatKdumpLzo":
+		return DomainCoreDumpFormatKdumpLzo, nil
+	case "DomainCoreDumpFormatKdumpSnappy":
+		return DomainCoreDumpFormatKdumpSnappy, nil
+	}
+	return 0, fmt.Errorf("invalid DomainCoreDumpFormat %q", s)
+}
+
+// String returns the name of the DomainNumatuneMemMode value.
+func (e DomainNumatuneMemMode) String() string {
+	switch e {
+	case DomainNumatuneMemStrict:
+		return "DomainNumatuneMemStrict"
+	case DomainNumatuneMemPreferred:
+		return "DomainNumatuneMemPreferred"
+	case DomainNumatuneMemInterleave:
+		return "DomainNumatuneMemInterleave"
+	}
+	return fmt.Sprintf("DomainNumatuneMemMode(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainNumatuneMemMode values.
+func (e DomainNumatuneMemMode) IsValid() bool {
+	switch e {
+	case DomainNumatuneMemStrict,
+		DomainNumatuneMemPreferred,
+		DomainNumatuneMemInterleave:
+		return true
+	}
+	return false
+}
+
+// ParseDomainNumatuneMemMode returns the DomainNumatuneMemMode value with the given name, as returned
+// by String.
+func ParseDomainNumatuneMemMode(s string) (DomainNumatuneMemMode, error) {
+	switch s {
+	case "DomainNumatuneMemStrict":
+		return DomainNumatuneMemStrict, nil
+	case "DomainNumatuneMemPreferred":
+		return DomainNumatuneMemPreferred, nil
+	case "DomainNumatuneMemInterleave":
+		return DomainNumatuneMemInterleave, nil
+	}
+	return 0, fmt.Errorf("invalid DomainNumatuneMemMode %q", s)
+}
+
+// String returns the name of the DomainMetadataType value.
+func (e DomainMetadataType) String() string {
+	switch e {
+	case DomainMetadataDescription:
+		return "DomainMetadataDescription"
+	case DomainMetadataTitle:
+		return "DomainMetadataTitle"
+	case DomainMetadataElement:
+		return "DomainMetadataElement"
+	}
+	return fmt.Sprintf("DomainMetadataType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainMetadataType values.
+func (e DomainMetadataType) IsValid() bool {
+	switch e {
+	case DomainMetadataDescription,
+		DomainMetadataTitle,
+		DomainMetadataElement:
+		return true
+	}
+	return false
+}
+
+// ParseDomainMetadataType returns the DomainMetadataType value with the given name, as returned
+// by String.
+func ParseDomainMetadataType(s string) (DomainMetadataType, error) {
+	switch s {
+	case "DomainMetadataDescription":
+		return DomainMetadataDescription, nil
+	case "DomainMetadataTitle":
+		return DomainMetadataTitle, nil
+	case "DomainMetadataElement":
+		return DomainMetadataElement, nil
+	}
+	return 0, fmt.Errorf("invalid DomainMetadataType %q", s)
+}
+
+// String returns the name of the VCPUState value.
+func (e VCPUState) String() string {
+	switch e {
+	case VCPUOffline:
+		return "VCPUOffline"
+	case VCPURunning:
+		return "VCPURunning"
+	case VCPUBlocked:
+		return "VCPUBlocked"
+	}
+	return fmt.Sprintf("VCPUState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the VCPUState values.
+func (e VCPUState) IsValid() bool {
+	switch e {
+	case VCPUOffline,
+		VCPURunning,
+		VCPUBlocked:
+		return true
+	}
+	return false
+}
+
+// ParseVCPUState returns the VCPUState value with the given name, as returned
+// by String.
+func ParseVCPUState(s string) (VCPUState, error) {
+	switch s {
+	case "VCPUOffline":
+		return VCPUOffline, nil
+	case "VCPURunning":
+		return VCPURunning, nil
+	case "VCPUBlocked":
+		return VCPUBlocked, nil
+	}
+	return 0, fmt.Errorf("invalid VCPUState %q", s)
+}
+
+// String returns the name of the VCPUHostCPUState value.
+func (e VCPUHostCPUState) String() string {
+	switch e {
+	case VCPUInfoCPUOffline:
+		return "VCPUInfoCPUOffline"
+	case VCPUInfoCPUUnavailable:
+		return "VCPUInfoCPUUnavailable"
+	}
+	return fmt.Sprintf("VCPUHostCPUState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the VCPUHostCPUState values.
+func (e VCPUHostCPUState) IsValid() bool {
+	switch e {
+	case VCPUInfoCPUOffline,
+		VCPUInfoCPUUnavailable:
+		return true
+	}
+	return false
+}
+
+// ParseVCPUHostCPUState returns the VCPUHostCPUState value with the given name, as returned
+// by String.
+func ParseVCPUHostCPUState(s string) (VCPUHostCPUState, error) {
+	switch s {
+	case "VCPUInfoCPUOffline":
+		return VCPUInfoCPUOffline, nil
+	case "VCPUInfoCPUUnavailable":
+		return VCPUInfoCPUUnavailable, nil
+	}
+	return 0, fmt.Errorf("invalid VCPUHostCPUState %q", s)
+}
+
+// String returns the name of the ConnectGetAllDomainStatsFlags value.
+func (e ConnectGetAllDomainStatsFlags) String() string {
+	switch e {
+	case ConnectGetAllDomainsStatsActive:
+		return "ConnectGetAllDomainsStatsActive"
+	case ConnectGetAllDomainsStatsInactive:
+		return "ConnectGetAllDomainsStatsInactive"
+	case ConnectGetAllDomainsStatsPersistent:
+		return "ConnectGetAllDomainsStatsPersistent"
+	case ConnectGetAllDomainsStatsTransient:
+		return "ConnectGetAllDomainsStatsTransient"
+	case ConnectGetAllDomainsStatsRunning:
+		return "ConnectGetAllDomainsStatsRunning"
+	case ConnectGetAllDomainsStatsPaused:
+		return "ConnectGetAllDomainsStatsPaused"
+	case ConnectGetAllDomainsStatsShutoff:
+		return "ConnectGetAllDomainsStatsShutoff"
+	case ConnectGetAllDomainsStatsOther:
+		return "ConnectGetAllDomainsStatsOther"
+	case ConnectGetAllDomainsStatsNowait:
+		return "ConnectGetAllDomainsStatsNowait"
+	case ConnectGetAllDomainsStatsBacking:
+		return "ConnectGetAllDomainsStatsBacking"
+	case ConnectGetAllDomainsStatsEnforceStats:
+		return "ConnectGetAllDomainsStatsEnforceStats"
+	}
+	return fmt.Sprintf("ConnectGetAllDomainStatsFlags(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectGetAllDomainStatsFlags values.
+func (e ConnectGetAllDomainStatsFlags) IsValid() bool {
+	switch e {
+	case ConnectGetAllDomainsStatsActive,
+		ConnectGetAllDomainsStatsInactive,
+		ConnectGetAllDomainsStatsPersistent,
+		ConnectGetAllDomainsStatsTransient,
+		ConnectGetAllDomainsStatsRunning,
+		ConnectGetAllDomainsStatsPaused,
+		ConnectGetAllDomainsStatsShutoff,
+		ConnectGetAllDomainsStatsOther,
+		ConnectGetAllDomainsStatsNowait,
+		ConnectGetAllDomainsStatsBacking,
+		ConnectGetAllDomainsStatsEnforceStats:
+		return true
+	}
+	return false
+}
+
+// ParseConnectGetAllDomainStatsFlags returns the ConnectGetAllDomainStatsFlags value with the given name, as returned
+// by String.
+func ParseConnectGetAllDomainStatsFlags(s string) (ConnectGetAllDomainStatsFlags, error) {
+	switch s {
+	case "ConnectGetAllDomainsStatsActive":
+		return ConnectGetAllDomainsStatsActive, nil
+	case "ConnectGetAllDomainsStatsInactive":
+		return ConnectGetAllDomainsStatsInactive, nil
+	case "ConnectGetAllDomainsStatsPersistent":
+		return ConnectGetAllDomainsStatsPersistent, nil
+	case "ConnectGetAllDomainsStatsTransient":
+		return ConnectGetAllDomainsStatsTransient, nil
+	case "ConnectGetAllDomainsStatsRunning":
+		return ConnectGetAllDomainsStatsRunning, nil
+	case "ConnectGetAllDomainsStatsPaused":
+		return ConnectGetAllDomainsStatsPaused, nil
+	case "ConnectGetAllDomainsStatsShutoff":
+		return ConnectGetAllDomainsStatsShutoff, nil
+	case "ConnectGetAllDomainsStatsOther":
+		return ConnectGetAllDomainsStatsOther, nil
+	case "ConnectGetAllDomainsStatsNowait":
+		return ConnectGetAllDomainsStatsNowait, nil
+	case "ConnectGetAllDomainsStatsBacking":
+		return ConnectGetAllDomainsStatsBacking, nil
+	case "ConnectGetAllDomainsStatsEnforceStats":
+		return ConnectGetAllDomainsStatsEnforceStats, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectGetAllDomainStatsFlags %q", s)
+}
+
+// String returns the name of the DomainBlockJobType value.
+func (e DomainBlockJobType) String() string {
+	switch e {
+	case DomainBlockJobTypeUnknown:
+		return "DomainBlockJobTypeUnknown"
+	case DomainBlockJobTypePull:
+		return "DomainBlockJobTypePull"
+	case DomainBlockJobTypeCopy:
+		return "DomainBlockJobTypeCopy"
+	case DomainBlockJobTypeCommit:
+		return "DomainBlockJobTypeCommit"
+	case DomainBlockJobTypeActiveCommit:
+		return "DomainBlockJobTypeActiveCommit"
+	case DomainBlockJobTypeBackup:
+		return "DomainBlockJobTypeBackup"
+	}
+	return fmt.Sprintf("DomainBlockJobType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainBlockJobType values.
+func (e DomainBlockJobType) IsValid() bool {
+	switch e {
+	case DomainBlockJobTypeUnknown,
+		DomainBlockJobTypePull,
+		DomainBlockJobTypeCopy,
+		DomainBlockJobTypeCommit,
+		DomainBlockJobTypeActiveCommit,
+		DomainBlockJobTypeBackup:
+		return true
+	}
+	return false
+}
+
+// ParseDomainBlockJobType returns the DomainBlockJobType value with the given name, as returned
+// by String.
+func ParseDomainBlockJobType(s string) (DomainBlockJobType, error) {
+	switch s {
+	case "DomainBlockJobTypeUnknown":
+		return DomainBlockJobTypeUnknown, nil
+	case "DomainBlockJobTypePull":
+		return DomainBlockJobTypePull, nil
+	case "DomainBlockJobTypeCopy":
+		return DomainBlockJobTypeCopy, nil
+	case "DomainBlockJobTypeCommit":
+		return DomainBlockJobTypeCommit, nil
+	case "DomainBlockJobTypeActiveCommit":
+		return DomainBlockJobTypeActiveCommit, nil
+	case "DomainBlockJobTypeBackup":
+		return DomainBlockJobTypeBackup, nil
+	}
+	return 0, fmt.Errorf("invalid DomainBlockJobType %q", s)
+}
+
+// String returns the name of the DomainDiskErrorCode value.
+func (e DomainDiskErrorCode) String() string {
+	switch e {
+	case DomainDiskErrorNone:
+		return "DomainDiskErrorNone"
+	case DomainDiskErrorUnspec:
+		return "DomainDiskErrorUnspec"
+	case DomainDiskErrorNoSpace:
+		return "DomainDiskErrorNoSpace"
+	}
+	return fmt.Sprintf("DomainDiskErrorCode(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainDiskErrorCode values.
+func (e DomainDiskErrorCode) IsValid() bool {
+	switch e {
+	case DomainDiskErrorNone,
+		DomainDiskErrorUnspec,
+		DomainDiskErrorNoSpace:
+		return true
+	}
+	return false
+}
+
+// ParseDomainDiskErrorCode returns the DomainDiskErrorCode value with the given name, as returned
+// by String.
+func ParseDomainDiskErrorCode(s string) (DomainDiskErrorCode, error) {
+	switch s {
+	case "DomainDiskErrorNone":
+		return DomainDiskErrorNone, nil
+	case "DomainDiskErrorUnspec":
+		return DomainDiskErrorUnspec, nil
+	case "DomainDiskErrorNoSpace":
+		return DomainDiskErrorNoSpace, nil
+	}
+	return 0, fmt.Errorf("invalid DomainDiskErrorCode %q", s)
+}
+
+// String returns the name of the KeycodeSet value.
+func (e KeycodeSet) String() string {
+	switch e {
+	case KeycodeSetLinux:
+		return "KeycodeSetLinux"
+	case KeycodeSetXt:
+		return "KeycodeSetXt"
+	case KeycodeSetAtset1:
+		return "KeycodeSetAtset1"
+	case KeycodeSetAtset2:
+		return "KeycodeSetAtset2"
+	case KeycodeSetAtset3:
+		return "KeycodeSetAtset3"
+	case KeycodeSetOsx:
+		return "KeycodeSetOsx"
+	case KeycodeSetXtKbd:
+		return "KeycodeSetXtKbd"
+	case KeycodeSetUsb:
+		return "KeycodeSetUsb"
+	case KeycodeSetWin32:
+		return "KeycodeSetWin32"
+	case KeycodeSetQnum:
+		return "KeycodeSetQnum"
+	}
+	return fmt.Sprintf("KeycodeSet(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the KeycodeSet values.
+func (e KeycodeSet) IsValid() bool {
+	switch e {
+	case KeycodeSetLinux,
+		KeycodeSetXt,
+		KeycodeSetAtset1,
+		KeycodeSetAtset2,
+		KeycodeSetAtset3,
+		KeycodeSetOsx,
+		KeycodeSetXtKbd,
+		KeycodeSetUsb,
+		KeycodeSetWin32,
+		KeycodeSetQnum:
+		return true
+	}
+	return false
+}
+
+// ParseKeycodeSet returns the KeycodeSet value with the given name, as returned
+// by String.
+func ParseKeycodeSet(s string) (KeycodeSet, error) {
+	switch s {
+	case "KeycodeSetLinux":
+		return KeycodeSetLinux, nil
+	case "KeycodeSetXt":
+		return KeycodeSetXt, nil
+	case "KeycodeSetAtset1":
+		return KeycodeSetAtset1, nil
+	case "KeycodeSetAtset2":
+		return KeycodeSetAtset2, nil
+	case "KeycodeSetAtset3":
+		return KeycodeSetAtset3, nil
+	case "KeycodeSetOsx":
+		return KeycodeSetOsx, nil
+	case "KeycodeSetXtKbd":
+		return KeycodeSetXtKbd, nil
+	case "KeycodeSetUsb":
+		return KeycodeSetUsb, nil
+	case "KeycodeSetWin32":
+		return KeycodeSetWin32, nil
+	case "KeycodeSetQnum":
+		return KeycodeSetQnum, nil
+	}
+	return 0, fmt.Errorf("invalid KeycodeSet %q", s)
+}
+
+// String returns the name of the DomainProcessSignal value.
+func (e DomainProcessSignal) String() string {
+	switch e {
+	case DomainProcessSignalNop:
+		return "DomainProcessSignalNop"
+	case DomainProcessSignalHup:
+		return "DomainProcessSignalHup"
+	case DomainProcessSignalInt:
+		return "DomainProcessSignalInt"
+	case DomainProcessSignalQuit:
+		return "DomainProcessSignalQuit"
+	case DomainProcessSignalIll:
+		return "DomainProcessSignalIll"
+	case DomainProcessSignalTrap:
+		return "DomainProcessSignalTrap"
+	case DomainProcessSignalAbrt:
+		return "DomainProcessSignalAbrt"
+	case DomainProcessSignalBus:
+		return "DomainProcessSignalBus"
+	case DomainProcessSignalFpe:
+		return "DomainProcessSignalFpe"
+	case DomainProcessSignalKill:
+		return "DomainProcessSignalKill"
+	case DomainProcessSignalUsr1:
+		return "DomainProcessSignalUsr1"
+	case DomainProcessSignalSegv:
+		return "DomainProcessSignalSegv"
+	case DomainProcessSignalUsr2:
+		return "DomainProcessSignalUsr2"
+	case DomainProcessSignalPipe:
+		return "DomainProcessSignalPipe"
+	case DomainProcessSignalAlrm:
+		return "DomainProcessSignalAlrm"
+	case DomainProcessSignalTerm:
+		return "DomainProcessSignalTerm"
+	case DomainProcessSignalStkflt:
+		return "DomainProcessSignalStkflt"
+	case DomainProcessSignalChld:
+		return "DomainProcessSignalChld"
+	case DomainProcessSignalCont:
+		return "DomainProcessSignalCont"
+	case DomainProcessSignalStop:
+		return "DomainProcessSignalStop"
+	case DomainProcessSignalTstp:
+		return "DomainProcessSignalTstp"
+	case DomainProcessSignalTtin:
+		return "DomainProcessSignalTtin"
+	case DomainProcessSignalTtou:
+		return "DomainProcessSignalTtou"
+	case DomainProcessSignalUrg:
+		return "DomainProcessSignalUrg"
+	case DomainProcessSignalXcpu:
+		return "DomainProcessSignalXcpu"
+	case DomainProcessSignalXfsz:
+		return "DomainProcessSignalXfsz"
+	case DomainProcessSignalVtalrm:
+		return "DomainProcessSignalVtalrm"
+	case DomainProcessSignalProf:
+		return "DomainProcessSignalProf"
+	case DomainProcessSignalWinch:
+		return "DomainProcessSignalWinch"
+	case DomainProcessSignalPoll:
+		return "DomainProcessSignalPoll"
+	case DomainProcessSignalPwr:
+		return "DomainProcessSignalPwr"
+	case DomainProcessSignalSys:
+		return "DomainProcessSignalSys"
+	case DomainProcessSignalRt0:
+		return "DomainProcessSignalRt0"
+	case DomainProcessSignalRt1:
+		return "DomainProcessSignalRt1"
+	case DomainProcessSignalRt2:
+		return "DomainProcessSignalRt2"
+	case DomainProcessSignalRt3:
+		return "DomainProcessSignalRt3"
+	case DomainProcessSignalRt4:
+		return "DomainProcessSignalRt4"
+	case DomainProcessSignalRt5:
+		return "DomainProcessSignalRt5"
+	case DomainProcessSignalRt6:
+		return "DomainProcessSignalRt6"
+	case DomainProcessSignalRt7:
+		return "DomainProcessSignalRt7"
+	case DomainProcessSignalRt8:
+		return "DomainProcessSignalRt8"
+	case DomainProcessSignalRt9:
+		return "DomainProcessSignalRt9"
+	case DomainProcessSignalRt10:
+		return "DomainProcessSignalRt10"
+	case DomainProcessSignalRt11:
+		return "DomainProcessSignalRt11"
+	case DomainProcessSignalRt12:
+		return "DomainProcessSignalRt12"
+	case DomainProcessSignalRt13:
+		return "DomainProcessSignalRt13"
+	case DomainProcessSignalRt14:
+		return "DomainProcessSignalRt14"
+	case DomainProcessSignalRt15:
+		return "DomainProcessSignalRt15"
+	case DomainProcessSignalRt16:
+		return "DomainProcessSignalRt16"
+	case DomainProcessSignalRt17:
+		return "DomainProcessSignalRt17"
+	case DomainProcessSignalRt18:
+		return "DomainProcessSignalRt18"
+	case DomainProcessSignalRt19:
+		return "DomainProcessSignalRt19"
+	case DomainProcessSignalRt20:
+		return "DomainProcessSignalRt20"
+	case DomainProcessSignalRt21:
+		return "DomainProcessSignalRt21"
+	case DomainProcessSignalRt22:
+		return "DomainProcessSignalRt22"
+	case DomainProcessSignalRt23:
+		return "DomainProcessSignalRt23"
+	case DomainProcessSignalRt24:
+		return "DomainProcessSignalRt24"
+	case DomainProcessSignalRt25:
+		return "DomainProcessSignalRt25"
+	case DomainProcessSignalRt26:
+		return "DomainProcessSignalRt26"
+	case DomainProcessSignalRt27:
+		return "DomainProcessSignalRt27"
+	case DomainProcessSignalRt28:
+		return "DomainProcessSignalRt28"
+	case DomainProcessSignalRt29:
+		return "DomainProcessSignalRt29"
+	case DomainProcessSignalRt30:
+		return "DomainProcessSignalRt30"
+	case DomainProcessSignalRt31:
+		return "DomainProcessSignalRt31"
+	case DomainProcessSignalRt32:
+		return "DomainProcessSignalRt32"
+	}
+	return fmt.Sprintf("DomainProcessSignal(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainProcessSignal values.
+func (e DomainProcessSignal) IsValid() bool {
+	switch e {
+	case DomainProcessSignalNop,
+		DomainProcessSignalHup,
+		DomainProcessSignalInt,
+		DomainProcessSignalQuit,
+		DomainProcessSignalIll,
+		DomainProcessSignalTrap,
+		DomainProcessSignalAbrt,
+		DomainProcessSignalBus,
+		DomainProcessSignalFpe,
+		DomainProcessSignalKill,
+		DomainProcessSignalUsr1,
+		DomainProcessSignalSegv,
+		DomainProcessSignalUsr2,
+		DomainProcessSignalPipe,
+		DomainProcessSignalAlrm,
+		DomainProcessSignalTerm,
+		DomainProcessSignalStkflt,
+		DomainProcessSignalChld,
+		DomainProcessSignalCont,
+		DomainProcessSignalStop,
+		DomainProcessSignalTstp,
+		DomainProcessSignalTtin,
+		DomainProcessSignalTtou,
+		DomainProcessSignalUrg,
+		DomainProcessSignalXcpu,
+		DomainProcessSignalXfsz,
+		DomainProcessSignalVtalrm,
+		DomainProcessSignalProf,
+		DomainProcessSignalWinch,
+		DomainProcessSignalPoll,
+		DomainProcessSignalPwr,
+		DomainProcessSignalSys,
+		DomainProcessSignalRt0,
+		DomainProcessSignalRt1,
+		DomainProcessSignalRt2,
+		DomainProcessSignalRt3,
+		DomainProcessSignalRt4,
+		DomainProcessSignalRt5,
+		DomainProcessSignalRt6,
+		DomainProcessSignalRt7,
+		DomainProcessSignalRt8,
+		DomainProcessSignalRt9,
+		DomainProcessSignalRt10,
+		DomainProcessSignalRt11,
+		DomainProcessSignalRt12,
+		DomainProcessSignalRt13,
+		DomainProcessSignalRt14,
+		DomainProcessSignalRt15,
+		DomainProcessSignalRt16,
+		DomainProcessSignalRt17,
+		DomainProcessSignalRt18,
+		DomainProcessSignalRt19,
+		DomainProcessSignalRt20,
+		DomainProcessSignalRt21,
+		DomainProcessSignalRt22,
+		DomainProcessSignalRt23,
+		DomainProcessSignalRt24,
+		DomainProcessSignalRt25,
+		DomainProcessSignalRt26,
+		DomainProcessSignalRt27,
+		DomainProcessSignalRt28,
+		DomainProcessSignalRt29,
+		DomainProcessSignalRt30,
+		DomainProcessSignalRt31,
+		DomainProcessSignalRt32:
+		return true
+	}
+	return false
+}
+
+// ParseDomainProcessSignal returns the DomainProcessSignal value with the given name, as returned
+// by String.
+func ParseDomainProcessSignal(s string) (DomainProcessSignal, error) {
+	switch s {
+	case "DomainProcessSignalNop":
+		return DomainProcessSignalNop, nil
+	case "DomainProcessSignalHup":
+		return DomainProcessSignalHup, nil
+	case "DomainProcessSignalInt":
+		return DomainProcessSignalInt, nil
+	case "DomainProcessSignalQuit":
+		return DomainProcessSignalQuit, nil
+	case "DomainProcessSignalIll":
+		return DomainProcessSignalIll, nil
+	case "DomainProcessSignalTrap":
+		return DomainProcessSignalTrap, nil
+	case "DomainProcessSignalAbrt":
+		return DomainProcessSignalAbrt, nil
+	case "DomainProcessSignalBus":
+		return DomainProcessSignalBus, nil
+	case "DomainProcessSignalFpe":
+		return DomainProcessSignalFpe, nil
+	case "DomainProcessSignalKill":
+		return DomainProcessSignalKill, nil
+	case "DomainProcessSignalUsr1":
+		return DomainProcessSignalUsr1, nil
+	case "DomainProcessSignalSegv":
+		return DomainProcessSignalSegv, nil
+	case "DomainProcessSignalUsr2":
+		return DomainProcessSignalUsr2, nil
+	case "DomainProcessSignalPipe":
+		return DomainProcessSignalPipe, nil
+	case "DomainProcessSignalAlrm":
+		return DomainProcessSignalAlrm, nil
+	case "DomainProcessSignalTerm":
+		return DomainProcessSignalTerm, nil
+	case "DomainProcessSignalStkflt":
+		return DomainProcessSignalStkflt, nil
+	case "DomainProcessSignalChld":
+		return DomainProcessSignalChld, nil
+	case "DomainProcessSignalCont":
+		return DomainProcessSignalCont, nil
+	case "DomainProcessSignalStop":
+		return DomainProcessSignalStop, nil
+	case "DomainProcessSignalTstp":
+		return DomainProcessSignalTstp, nil
+	case "DomainProcessSignalTtin":
+		return DomainProcessSignalTtin, nil
+	case "DomainProcessSignalTtou":
+		return DomainProcessSignalTtou, nil
+	case "DomainProcessSignalUrg":
+		return DomainProcessSignalUrg, nil
+	case "DomainProcessSignalXcpu":
+		return DomainProcessSignalXcpu, nil
+	case "DomainProcessSignalXfsz":
+		return DomainProcessSignalXfsz, nil
+	case "DomainProcessSignalVtalrm":
+		return DomainProcessSignalVtalrm, nil
+	case "DomainProcessSignalProf":
+		return DomainProcessSignalProf, nil
+	case "DomainProcessSignalWinch":
+		return DomainProcessSignalWinch, nil
+	case "DomainProcessSignalPoll":
+		return DomainProcessSignalPoll, nil
+	case "DomainProcessSignalPwr":
+		return DomainProcessSignalPwr, nil
+	case "DomainProcessSignalSys":
+		return DomainProcessSignalSys, nil
+	case "DomainProcessSignalRt0":
+		return DomainProcessSignalRt0, nil
+	case "DomainProcessSignalRt1":
+		return DomainProcessSignalRt1, nil
+	case "DomainProcessSignalRt2":
+		return DomainProcessSignalRt2, nil
+	case "DomainProcessSignalRt3":
+		return DomainProcessSignalRt3, nil
+	case "DomainProcessSignalRt4":
+		return DomainProcessSignalRt4, nil
+	case "DomainProcessSignalRt5":
+		return DomainProcessSignalRt5, nil
+	case "DomainProcessSignalRt6":
+		return DomainProcessSignalRt6, nil
+	case "DomainProcessSignalRt7":
+		return DomainProcessSignalRt7, nil
+	case "DomainProcessSignalRt8":
+		return DomainProcessSignalRt8, nil
+	case "DomainProcessSignalRt9":
+		return DomainProcessSignalRt9, nil
+	case "DomainProcessSignalRt10":
+		return DomainProcessSignalRt10, nil
+	case "DomainProcessSignalRt11":
+		return DomainProcessSignalRt11, nil
+	case "DomainProcessSignalRt12":
+		return DomainProcessSignalRt12, nil
+	case "DomainProcessSignalRt13":
+		return DomainProcessSignalRt13, nil
+	case "DomainProcessSignalRt14":
+		return DomainProcessSignalRt14, nil
+	case "DomainProcessSignalRt15":
+		return DomainProcessSignalRt15, nil
+	case "DomainProcessSignalRt16":
+		return DomainProcessSignalRt16, nil
+	case "DomainProcessSignalRt17":
+		return DomainProcessSignalRt17, nil
+	case "DomainProcessSignalRt18":
+		return DomainProcessSignalRt18, nil
+	case "DomainProcessSignalRt19":
+		return DomainProcessSignalRt19, nil
+	case "DomainProcessSignalRt20":
+		return DomainProcessSignalRt20, nil
+	case "DomainProcessSignalRt21":
+		return DomainProcessSignalRt21, nil
+	case "DomainProcessSignalRt22":
+		return DomainProcessSignalRt22, nil
+	case "DomainProcessSignalRt23":
+		return DomainProcessSignalRt23, nil
+	case "DomainProcessSignalRt24":
+		return DomainProcessSignalRt24, nil
+	case "DomainProcessSignalRt25":
+		return DomainProcessSignalRt25, nil
+	case "DomainProcessSignalRt26":
+		return DomainProcessSignalRt26, nil
+	case "DomainProcessSignalRt27":
+		return DomainProcessSignalRt27, nil
+	case "DomainProcessSignalRt28":
+		return DomainProcessSignalRt28, nil
+	case "DomainProcessSignalRt29":
+		return DomainProcessSignalRt29, nil
+	case "DomainProcessSignalRt30":
+		return DomainProcessSignalRt30, nil
+	case "DomainProcessSignalRt31":
+		return DomainProcessSignalRt31, nil
+	case "DomainProcessSignalRt32":
+		return DomainProcessSignalRt32, nil
+	}
+	return 0, fmt.Errorf("invalid DomainProcessSignal %q", s)
+}
+
+// String returns the name of the DomainEventType value.
+func (e DomainEventType) String() string {
+	switch e {
+	case DomainEventDefined:
+		return "DomainEventDefined"
+	case DomainEventUndefined:
+		return "DomainEventUndefined"
+	case DomainEventStarted:
+		return "DomainEventStarted"
+	case DomainEventSuspended:
+		return "DomainEventSuspended"
+	case DomainEventResumed:
+		return "DomainEventResumed"
+	case DomainEventStopped:
+		return "DomainEventStopped"
+	case DomainEventShutdown:
+		return "DomainEventShutdown"
+	case DomainEventPmsuspended:
+		return "DomainEventPmsuspended"
+	case DomainEventCrashed:
+		return "DomainEventCrashed"
+	}
+	return fmt.Sprintf("DomainEventType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventType values.
+func (e DomainEventType) IsValid() bool {
+	switch e {
+	case DomainEventDefined,
+		DomainEventUndefined,
+		DomainEventStarted,
+		DomainEventSuspended,
+		DomainEventResumed,
+		DomainEventStopped,
+		DomainEventShutdown,
+		DomainEventPmsuspended,
+		DomainEventCrashed:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventType returns the DomainEventType value with the given name, as returned
+// by String.
+func ParseDomainEventType(s string) (DomainEventType, error) {
+	switch s {
+	case "DomainEventDefined":
+		return DomainEventDefined, nil
+	case "DomainEventUndefined":
+		return DomainEventUndefined, nil
+	case "DomainEventStarted":
+		return DomainEventStarted, nil
+	case "DomainEventSuspended":
+		return DomainEventSuspended, nil
+	case "DomainEventResumed":
+		return DomainEventResumed, nil
+	case "DomainEventStopped":
+		return DomainEventStopped, nil
+	case "DomainEventShutdown":
+		return DomainEventShutdown, nil
+	case "DomainEventPmsuspended":
+		return DomainEventPmsuspended, nil
+	case "DomainEventCrashed":
+		return DomainEventCrashed, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventType %q", s)
+}
+
+// String returns the name of the DomainEventDefinedDetailType value.
+func (e DomainEventDefinedDetailType) String() string {
+	switch e {
+	case DomainEventDefinedAdded:
+		return "DomainEventDefinedAdded"
+	case DomainEventDefinedUpdated:
+		return "DomainEventDefinedUpdated"
+	case DomainEventDefinedRenamed:
+		return "DomainEventDefinedRenamed"
+	case DomainEventDefinedFromSnapshot:
+		return "DomainEventDefinedFromSnapshot"
+	}
+	return fmt.Sprintf("DomainEventDefinedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventDefinedDetailType values.
+func (e DomainEventDefinedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventDefinedAdded,
+		DomainEventDefinedUpdated,
+		DomainEventDefinedRenamed,
+		DomainEventDefinedFromSnapshot:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventDefinedDetailType returns the DomainEventDefinedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventDefinedDetailType(s string) (DomainEventDefinedDetailType, error) {
+	switch s {
+	case "DomainEventDefinedAdded":
+		return DomainEventDefinedAdded, nil
+	case "DomainEventDefinedUpdated":
+		return DomainEventDefinedUpdated, nil
+	case "DomainEventDefinedRenamed":
+		return DomainEventDefinedRenamed, nil
+	case "DomainEventDefinedFromSnapshot":
+		return DomainEventDefinedFromSnapshot, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventDefinedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventUndefinedDetailType value.
+func (e DomainEventUndefinedDetailType) String() string {
+	switch e {
+	case DomainEventUndefinedRemoved:
+		return "DomainEventUndefinedRemoved"
+	case DomainEventUndefinedRenamed:
+		return "DomainEventUndefinedRenamed"
+	}
+	return fmt.Sprintf("DomainEventUndefinedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventUndefinedDetailType values.
+func (e DomainEventUndefinedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventUndefinedRemoved,
+		DomainEventUndefinedRenamed:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventUndefinedDetailType returns the DomainEventUndefinedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventUndefinedDetailType(s string) (DomainEventUndefinedDetailType, error) {
+	switch s {
+	case "DomainEventUndefinedRemoved":
+		return DomainEventUndefinedRemoved, nil
+	case "DomainEventUndefinedRenamed":
+		return DomainEventUndefinedRenamed, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventUndefinedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventStartedDetailType value.
+func (e DomainEventStartedDetailType) String() string {
+	switch e {
+	case DomainEventStartedBooted:
+		return "DomainEventStartedBooted"
+	case DomainEventStartedMigrated:
+		return "DomainEventStartedMigrated"
+	case DomainEventStartedRestored:
+		return "DomainEventStartedRestored"
+	case DomainEventStartedFromSnapshot:
+		return "DomainEventStartedFromSnapshot"
+	case DomainEventStartedWakeup:
+		return "DomainEventStartedWakeup"
+	}
+	return fmt.Sprintf("DomainEventStartedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventStartedDetailType values.
+func (e DomainEventStartedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventStartedBooted,
+		DomainEventStartedMigrated,
+		DomainEventStartedRestored,
+		DomainEventStartedFromSnapshot,
+		DomainEventStartedWakeup:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventStartedDetailType returns the DomainEventStartedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventStartedDetailType(s string) (DomainEventStartedDetailType, error) {
+	switch s {
+	case "DomainEventStartedBooted":
+		return DomainEventStartedBooted, nil
+	case "DomainEventStartedMigrated":
+		return DomainEventStartedMigrated, nil
+	case "DomainEventStartedRestored":
+		return DomainEventStartedRestored, nil
+	case "DomainEventStartedFromSnapshot":
+		return DomainEventStartedFromSnapshot, nil
+	case "DomainEventStartedWakeup":
+		return DomainEventStartedWakeup, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventStartedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventSuspendedDetailType value.
+func (e DomainEventSuspendedDetailType) String() string {
+	switch e {
+	case DomainEventSuspendedPaused:
+		return "DomainEventSuspendedPaused"
+	case DomainEventSuspendedMigrated:
+		return "DomainEventSuspendedMigrated"
+	case DomainEventSuspendedIoerror:
+		return "DomainEventSuspendedIoerror"
+	case DomainEventSuspendedWatchdog:
+		return "DomainEventSuspendedWatchdog"
+	case DomainEventSuspendedRestored:
+		return "DomainEventSuspendedRestored"
+	case DomainEventSuspendedFromSnapshot:
+		return "DomainEventSuspendedFromSnapshot"
+	case DomainEventSuspendedAPIError:
+		return "DomainEventSuspendedAPIError"
+	case DomainEventSuspendedPostcopy:
+		return "DomainEventSuspendedPostcopy"
+	case DomainEventSuspendedPostcopyFailed:
+		return "DomainEventSuspendedPostcopyFailed"
+	}
+	return fmt.Sprintf("DomainEventSuspendedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventSuspendedDetailType values.
+func (e DomainEventSuspendedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventSuspendedPaused,
+		DomainEventSuspendedMigrated,
+		DomainEventSuspendedIoerror,
+		DomainEventSuspendedWatchdog,
+		DomainEventSuspendedRestored,
+		DomainEventSuspendedFromSnapshot,
+		DomainEventSuspendedAPIError,
+		DomainEventSuspendedPostcopy,
+		DomainEventSuspendedPostcopyFailed:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventSuspendedDetailType returns the DomainEventSuspendedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventSuspendedDetailType(s string) (DomainEventSuspendedDetailType, error) {
+	switch s {
+	case "DomainEventSuspendedPaused":
+		return DomainEventSuspendedPaused, nil
+	case "DomainEventSuspendedMigrated":
+		return DomainEventSuspendedMigrated, nil
+	case "DomainEventSuspendedIoerror":
+		return DomainEventSuspendedIoerror, nil
+	case "DomainEventSuspendedWatchdog":
+		return DomainEventSuspendedWatchdog, nil
+	case "DomainEventSuspendedRestored":
+		return DomainEventSuspendedRestored, nil
+	case "DomainEventSuspendedFromSnapshot":
+		return DomainEventSuspendedFromSnapshot, nil
+	case "DomainEventSuspendedAPIError":
+		return DomainEventSuspendedAPIError, nil
+	case "DomainEventSuspendedPostcopy":
+		return DomainEventSuspendedPostcopy, nil
+	case "DomainEventSuspendedPostcopyFailed":
+		return DomainEventSuspendedPostcopyFailed, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventSuspendedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventResumedDetailType value.
+func (e DomainEventResumedDetailType) String() string {
+	switch e {
+	case DomainEventResumedUnpaused:
+		return "DomainEventResumedUnpaused"
+	case DomainEventResumedMigrated:
+		return "DomainEventResumedMigrated"
+	case DomainEventResumedFromSnapshot:
+		return "DomainEventResumedFromSnapshot"
+	case DomainEventResumedPostcopy:
+		return "DomainEventResumedPostcopy"
+	}
+	return fmt.Sprintf("DomainEventResumedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventResumedDetailType values.
+func (e DomainEventResumedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventResumedUnpaused,
+		DomainEventResumedMigrated,
+		DomainEventResumedFromSnapshot,
+		DomainEventResumedPostcopy:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventResumedDetailType returns the DomainEventResumedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventResumedDetailType(s string) (DomainEventResumedDetailType, error) {
+	switch s {
+	case "DomainEventResumedUnpaused":
+		return DomainEventResumedUnpaused, nil
+	case "DomainEventResumedMigrated":
+		return DomainEventResumedMigrated, nil
+	case "DomainEventResumedFromSnapshot":
+		return DomainEventResumedFromSnapshot, nil
+	case "DomainEventResumedPostcopy":
+		return DomainEventResumedPostcopy, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventResumedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventStoppedDetailType value.
+func (e DomainEventStoppedDetailType) String() string {
+	switch e {
+	case DomainEventStoppedShutdown:
+		return "DomainEventStoppedShutdown"
+	case DomainEventStoppedDestroyed:
+		return "DomainEventStoppedDestroyed"
+	case DomainEventStoppedCrashed:
+		return "DomainEventStoppedCrashed"
+	case DomainEventStoppedMigrated:
+		return "DomainEventStoppedMigrated"
+	case DomainEventStoppedSaved:
+		return "DomainEventStoppedSaved"
+	case DomainEventStoppedFailed:
+		return "DomainEventStoppedFailed"
+	case DomainEventStoppedFromSnapshot:
+		return "DomainEventStoppedFromSnapshot"
+	}
+	return fmt.Sprintf("DomainEventStoppedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventStoppedDetailType values.
+func (e DomainEventStoppedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventStoppedShutdown,
+		DomainEventStoppedDestroyed,
+		DomainEventStoppedCrashed,
+		DomainEventStoppedMigrated,
+		DomainEventStoppedSaved,
+		DomainEventStoppedFailed,
+		DomainEventStoppedFromSnapshot:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventStoppedDetailType returns the DomainEventStoppedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventStoppedDetailType(s string) (DomainEventStoppedDetailType, error) {
+	switch s {
+	case "DomainEventStoppedShutdown":
+		return DomainEventStoppedShutdown, nil
+	case "DomainEventStoppedDestroyed":
+		return DomainEventStoppedDestroyed, nil
+	case "DomainEventStoppedCrashed":
+		return DomainEventStoppedCrashed, nil
+	case "DomainEventStoppedMigrated":
+		return DomainEventStoppedMigrated, nil
+	case "DomainEventStoppedSaved":
+		return DomainEventStoppedSaved, nil
+	case "DomainEventStoppedFailed":
+		return DomainEventStoppedFailed, nil
+	case "DomainEventStoppedFromSnapshot":
+		return DomainEventStoppedFromSnapshot, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventStoppedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventShutdownDetailType value.
+func (e DomainEventShutdownDetailType) String() string {
+	switch e {
+	case DomainEventShutdownFinished:
+		return "DomainEventShutdownFinished"
+	case DomainEventShutdownGuest:
+		return "DomainEventShutdownGuest"
+	case DomainEventShutdownHost:
+		return "DomainEventShutdownHost"
+	}
+	return fmt.Sprintf("DomainEventShutdownDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventShutdownDetailType values.
+func (e DomainEventShutdownDetailType) IsValid() bool {
+	switch e {
+	case DomainEventShutdownFinished,
+		DomainEventShutdownGuest,
+		DomainEventShutdownHost:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventShutdownDetailType returns the DomainEventShutdownDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventShutdownDetailType(s string) (DomainEventShutdownDetailType, error) {
+	switch s {
+	case "DomainEventShutdownFinished":
+		return DomainEventShutdownFinished, nil
+	case "DomainEventShutdownGuest":
+		return DomainEventShutdownGuest, nil
+	case "DomainEventShutdownHost":
+		return DomainEventShutdownHost, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventShutdownDetailType %q", s)
+}
+
+// String returns the name of the DomainEventPMSuspendedDetailType value.
+func (e DomainEventPMSuspendedDetailType) String() string {
+	switch e {
+	case DomainEventPmsuspendedMemory:
+		return "DomainEventPmsuspendedMemory"
+	case DomainEventPmsuspendedDisk:
+		return "DomainEventPmsuspendedDisk"
+	}
+	return fmt.Sprintf("DomainEventPMSuspendedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventPMSuspendedDetailType values.
+func (e DomainEventPMSuspendedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventPmsuspendedMemory,
+		DomainEventPmsuspendedDisk:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventPMSuspendedDetailType returns the DomainEventPMSuspendedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventPMSuspendedDetailType(s string) (DomainEventPMSuspendedDetailType, error) {
+	switch s {
+	case "DomainEventPmsuspendedMemory":
+		return DomainEventPmsuspendedMemory, nil
+	case "DomainEventPmsuspendedDisk":
+		return DomainEventPmsuspendedDisk, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventPMSuspendedDetailType %q", s)
+}
+
+// String returns the name of the DomainEventCrashedDetailType value.
+func (e DomainEventCrashedDetailType) String() string {
+	switch e {
+	case DomainEventCrashedPanicked:
+		return "DomainEventCrashedPanicked"
+	case DomainEventCrashedCrashloaded:
+		return "DomainEventCrashedCrashloaded"
+	}
+	return fmt.Sprintf("DomainEventCrashedDetailType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventCrashedDetailType values.
+func (e DomainEventCrashedDetailType) IsValid() bool {
+	switch e {
+	case DomainEventCrashedPanicked,
+		DomainEventCrashedCrashloaded:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventCrashedDetailType returns the DomainEventCrashedDetailType value with the given name, as returned
+// by String.
+func ParseDomainEventCrashedDetailType(s string) (DomainEventCrashedDetailType, error) {
+	switch s {
+	case "DomainEventCrashedPanicked":
+		return DomainEventCrashedPanicked, nil
+	case "DomainEventCrashedCrashloaded":
+		return DomainEventCrashedCrashloaded, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventCrashedDetailType %q", s)
+}
+
+// String returns the name of the DomainMemoryFailureRecipientType value.
+func (e DomainMemoryFailureRecipientType) String() string {
+	switch e {
+	case DomainEventMemoryFailureRecipientHypervisor:
+		return "DomainEventMemoryFailureRecipientHypervisor"
+	case DomainEventMemoryFailureRecipientGuest:
+		return "DomainEventMemoryFailureRecipientGuest"
+	}
+	return fmt.Sprintf("DomainMemoryFailureRecipientType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainMemoryFailureRecipientType values.
+func (e DomainMemoryFailureRecipientType) IsValid() bool {
+	switch e {
+	case DomainEventMemoryFailureRecipientHypervisor,
+		DomainEventMemoryFailureRecipientGuest:
+		return true
+	}
+	return false
+}
+
+// ParseDomainMemoryFailureRecipientType returns the DomainMemoryFailureRecipientType value with the given name, as returned
+// by String.
+func ParseDomainMemoryFailureRecipientType(s string) (DomainMemoryFailureRecipientType, error) {
+	switch s {
+	case "DomainEventMemoryFailureRecipientHypervisor":
+		return DomainEventMemoryFailureRecipientHypervisor, nil
+	case "DomainEventMemoryFailureRecipientGuest":
+		return DomainEventMemoryFailureRecipientGuest, nil
+	}
+	return 0, fmt.Errorf("invalid DomainMemoryFailureRecipientType %q", s)
+}
+
+// String returns the name of the DomainMemoryFailureActionType value.
+func (e DomainMemoryFailureActionType) String() string {
+	switch e {
+	case DomainEventMemoryFailureActionIgnore:
+		return "DomainEventMemoryFailureActionIgnore"
+	case DomainEventMemoryFailureActionInject:
+		return "DomainEventMemoryFailureActionInject"
+	case DomainEventMemoryFailureActionFatal:
+		return "DomainEventMemoryFailureActionFatal"
+	case DomainEventMemoryFailureActionReset:
+		return "DomainEventMemoryFailureActionReset"
+	}
+	return fmt.Sprintf("DomainMemoryFailureActionType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainMemoryFailureActionType values.
+func (e DomainMemoryFailureActionType) IsValid() bool {
+	switch e {
+	case DomainEventMemoryFailureActionIgnore,
+		DomainEventMemoryFailureActionInject,
+		DomainEventMemoryFailureActionFatal,
+		DomainEventMemoryFailureActionReset:
+		return true
+	}
+	return false
+}
+
+// ParseDomainMemoryFailureActionType returns the DomainMemoryFailureActionType value with the given name, as returned
+// by String.
+func ParseDomainMemoryFailureActionType(s string) (DomainMemoryFailureActionType, error) {
+	switch s {
+	case "DomainEventMemoryFailureActionIgnore":
+		return DomainEventMemoryFailureActionIgnore, nil
+	case "DomainEventMemoryFailureActionInject":
+		return DomainEventMemoryFailureActionInject, nil
+	case "DomainEventMemoryFailureActionFatal":
+		return DomainEventMemoryFailureActionFatal, nil
+	case "DomainEventMemoryFailureActionReset":
+		return DomainEventMemoryFailureActionReset, nil
+	}
+	return 0, fmt.Errorf("invalid DomainMemoryFailureActionType %q", s)
+}
+
+// String returns the name of the DomainJobType value.
+func (e DomainJobType) String() string {
+	switch e {
+	case DomainJobNone:
+		return "DomainJobNone"
+	case DomainJobBounded:
+		return "DomainJobBounded"
+	case DomainJobUnbounded:
+		return "DomainJobUnbounded"
+	case DomainJobCompleted:
+		return "DomainJobCompleted"
+	case DomainJobFailed:
+		return "DomainJobFailed"
+	case DomainJobCancelled:
+		return "DomainJobCancelled"
+	}
+	return fmt.Sprintf("DomainJobType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainJobType values.
+func (e DomainJobType) IsValid() bool {
+	switch e {
+	case DomainJobNone,
+		DomainJobBounded,
+		DomainJobUnbounded,
+		DomainJobCompleted,
+		DomainJobFailed,
+		DomainJobCancelled:
+		return true
+	}
+	return false
+}
+
+// ParseDomainJobType returns the DomainJobType value with the given name, as returned
+// by String.
+func ParseDomainJobType(s string) (DomainJobType, error) {
+	switch s {
+	case "DomainJobNone":
+		return DomainJobNone, nil
+	case "DomainJobBounded":
+		return DomainJobBounded, nil
+	case "DomainJobUnbounded":
+		return DomainJobUnbounded, nil
+	case "DomainJobCompleted":
+		return DomainJobCompleted, nil
+	case "DomainJobFailed":
+		return DomainJobFailed, nil
+	case "DomainJobCancelled":
+		return DomainJobCancelled, nil
+	}
+	return 0, fmt.Errorf("invalid DomainJobType %q", s)
+}
+
+// String returns the name of the DomainJobOperation value.
+func (e DomainJobOperation) String() string {
+	switch e {
+	case DomainJobOperationStrUnknown:
+		return "DomainJobOperationStrUnknown"
+	case DomainJobOperationStrStart:
+		return "DomainJobOperationStrStart"
+	case DomainJobOperationStrSave:
+		return "DomainJobOperationStrSave"
+	case DomainJobOperationStrRestore:
+		return "DomainJobOperationStrRestore"
+	case DomainJobOperationStrMigrationIn:
+		return "DomainJobOperationStrMigrationIn"
+	case DomainJobOperationStrMigrationOut:
+		return "DomainJobOperationStrMigrationOut"
+	case DomainJobOperationStrSnapshot:
+		return "DomainJobOperationStrSnapshot"
+	case DomainJobOperationStrSnapshotRevert:
+		return "DomainJobOperationStrSnapshotRevert"
+	case DomainJobOperationStrDump:
+		return "DomainJobOperationStrDump"
+	case DomainJobOperationStrBackup:
+		return "DomainJobOperationStrBackup"
+	}
+	return fmt.Sprintf("DomainJobOperation(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainJobOperation values.
+func (e DomainJobOperation) IsValid() bool {
+	switch e {
+	case DomainJobOperationStrUnknown,
+		DomainJobOperationStrStart,
+		DomainJobOperationStrSave,
+		DomainJobOperationStrRestore,
+		DomainJobOperationStrMigrationIn,
+		DomainJobOperationStrMigrationOut,
+		DomainJobOperationStrSnapshot,
+		DomainJobOperationStrSnapshotRevert,
+		DomainJobOperationStrDump,
+		DomainJobOperationStrBackup:
+		return true
+	}
+	return false
+}
+
+// ParseDomainJobOperation returns the DomainJobOperation value with the given name, as returned
+// by String.
+func ParseDomainJobOperation(s string) (DomainJobOperation, error) {
+	switch s {
+	case "DomainJobOperationStrUnknown":
+		return DomainJobOperationStrUnknown, nil
+	case "DomainJobOperationStrStart":
+		return DomainJobOperationStrStart, nil
+	case "DomainJobOperationStrSave":
+		return DomainJobOperationStrSave, nil
+	case "DomainJobOperationStrRestore":
+		return DomainJobOperationStrRestore, nil
+	case "DomainJobOperationStrMigrationIn":
+		return DomainJobOperationStrMigrationIn, nil
+	case "DomainJobOperationStrMigrationOut":
+		return DomainJobOperationStrMigrationOut, nil
+	case "DomainJobOperationStrSnapshot":
+		return DomainJobOperationStrSnapshot, nil
+	case "DomainJobOperationStrSnapshotRevert":
+		return DomainJobOperationStrSnapshotRevert, nil
+	case "DomainJobOperationStrDump":
+		return DomainJobOperationStrDump, nil
+	case "DomainJobOperationStrBackup":
+		return DomainJobOperationStrBackup, nil
+	}
+	return 0, fmt.Errorf("invalid DomainJobOperation %q", s)
+}
+
+// String returns the name of the DomainEventWatchdogAction value.
+func (e DomainEventWatchdogAction) String() string {
+	switch e {
+	case DomainEventWatchdogNone:
+		return "DomainEventWatchdogNone"
+	case DomainEventWatchdogPause:
+		return "DomainEventWatchdogPause"
+	case DomainEventWatchdogReset:
+		return "DomainEventWatchdogReset"
+	case DomainEventWatchdogPoweroff:
+		return "DomainEventWatchdogPoweroff"
+	case DomainEventWatchdogShutdown:
+		return "DomainEventWatchdogShutdown"
+	case DomainEventWatchdogDebug:
+		return "DomainEventWatchdogDebug"
+	case DomainEventWatchdogInjectnmi:
+		return "DomainEventWatchdogInjectnmi"
+	}
+	return fmt.Sprintf("DomainEventWatchdogAction(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventWatchdogAction values.
+func (e DomainEventWatchdogAction) IsValid() bool {
+	switch e {
+	case DomainEventWatchdogNone,
+		DomainEventWatchdogPause,
+		DomainEventWatchdogReset,
+		DomainEventWatchdogPoweroff,
+		DomainEventWatchdogShutdown,
+		DomainEventWatchdogDebug,
+		DomainEventWatchdogInjectnmi:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventWatchdogAction returns the DomainEventWatchdogAction value with the given name, as returned
+// by String.
+func ParseDomainEventWatchdogAction(s string) (DomainEventWatchdogAction, error) {
+	switch s {
+	case "DomainEventWatchdogNone":
+		return DomainEventWatchdogNone, nil
+	case "DomainEventWatchdogPause":
+		return DomainEventWatchdogPause, nil
+	case "DomainEventWatchdogReset":
+		return DomainEventWatchdogReset, nil
+	case "DomainEventWatchdogPoweroff":
+		return DomainEventWatchdogPoweroff, nil
+	case "DomainEventWatchdogShutdown":
+		return DomainEventWatchdogShutdown, nil
+	case "DomainEventWatchdogDebug":
+		return DomainEventWatchdogDebug, nil
+	case "DomainEventWatchdogInjectnmi":
+		return DomainEventWatchdogInjectnmi, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventWatchdogAction %q", s)
+}
+
+// String returns the name of the DomainEventIOErrorAction value.
+func (e DomainEventIOErrorAction) String() string {
+	switch e {
+	case DomainEventIoErrorNone:
+		return "DomainEventIoErrorNone"
+	case DomainEventIoErrorPause:
+		return "DomainEventIoErrorPause"
+	case DomainEventIoErrorReport:
+		return "DomainEventIoErrorReport"
+	}
+	return fmt.Sprintf("DomainEventIOErrorAction(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventIOErrorAction values.
+func (e DomainEventIOErrorAction) IsValid() bool {
+	switch e {
+	case DomainEventIoErrorNone,
+		DomainEventIoErrorPause,
+		DomainEventIoErrorReport:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventIOErrorAction returns the DomainEventIOErrorAction value with the given name, as returned
+// by String.
+func ParseDomainEventIOErrorAction(s string) (DomainEventIOErrorAction, error) {
+	switch s {
+	case "DomainEventIoErrorNone":
+		return DomainEventIoErrorNone, nil
+	case "DomainEventIoErrorPause":
+		return DomainEventIoErrorPause, nil
+	case "DomainEventIoErrorReport":
+		return DomainEventIoErrorReport, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventIOErrorAction %q", s)
+}
+
+// String returns the name of the DomainEventGraphicsPhase value.
+func (e DomainEventGraphicsPhase) String() string {
+	switch e {
+	case DomainEventGraphicsConnect:
+		return "DomainEventGraphicsConnect"
+	case DomainEventGraphicsInitialize:
+		return "DomainEventGraphicsInitialize"
+	case DomainEventGraphicsDisconnect:
+		return "DomainEventGraphicsDisconnect"
+	}
+	return fmt.Sprintf("DomainEventGraphicsPhase(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventGraphicsPhase values.
+func (e DomainEventGraphicsPhase) IsValid() bool {
+	switch e {
+	case DomainEventGraphicsConnect,
+		DomainEventGraphicsInitialize,
+		DomainEventGraphicsDisconnect:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventGraphicsPhase returns the DomainEventGraphicsPhase value with the given name, as returned
+// by String.
+func ParseDomainEventGraphicsPhase(s string) (DomainEventGraphicsPhase, error) {
+	switch s {
+	case "DomainEventGraphicsConnect":
+		return DomainEventGraphicsConnect, nil
+	case "DomainEventGraphicsInitialize":
+		return DomainEventGraphicsInitialize, nil
+	case "DomainEventGraphicsDisconnect":
+		return DomainEventGraphicsDisconnect, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventGraphicsPhase %q", s)
+}
+
+// String returns the name of the DomainEventGraphicsAddressType value.
+func (e DomainEventGraphicsAddressType) String() string {
+	switch e {
+	case DomainEventGraphicsAddressIpv4:
+		return "DomainEventGraphicsAddressIpv4"
+	case DomainEventGraphicsAddressIpv6:
+		return "DomainEventGraphicsAddressIpv6"
+	case DomainEventGraphicsAddressUnix:
+		return "DomainEventGraphicsAddressUnix"
+	}
+	return fmt.Sprintf("DomainEventGraphicsAddressType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventGraphicsAddressType values.
+func (e DomainEventGraphicsAddressType) IsValid() bool {
+	switch e {
+	case DomainEventGraphicsAddressIpv4,
+		DomainEventGraphicsAddressIpv6,
+		DomainEventGraphicsAddressUnix:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventGraphicsAddressType returns the DomainEventGraphicsAddressType value with the given name, as returned
+// by String.
+func ParseDomainEventGraphicsAddressType(s string) (DomainEventGraphicsAddressType, error) {
+	switch s {
+	case "DomainEventGraphicsAddressIpv4":
+		return DomainEventGraphicsAddressIpv4, nil
+	case "DomainEventGraphicsAddressIpv6":
+		return DomainEventGraphicsAddressIpv6, nil
+	case "DomainEventGraphicsAddressUnix":
+		return DomainEventGraphicsAddressUnix, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventGraphicsAddressType %q", s)
+}
+
+// String returns the name of the ConnectDomainEventBlockJobStatus value.
+func (e ConnectDomainEventBlockJobStatus) String() string {
+	switch e {
+	case DomainBlockJobCompleted:
+		return "DomainBlockJobCompleted"
+	case DomainBlockJobFailed:
+		return "DomainBlockJobFailed"
+	case DomainBlockJobCanceled:
+		return "DomainBlockJobCanceled"
+	case DomainBlockJobReady:
+		return "DomainBlockJobReady"
+	}
+	return fmt.Sprintf("ConnectDomainEventBlockJobStatus(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectDomainEventBlockJobStatus values.
+func (e ConnectDomainEventBlockJobStatus) IsValid() bool {
+	switch e {
+	case DomainBlockJobCompleted,
+		DomainBlockJobFailed,
+		DomainBlockJobCanceled,
+		DomainBlockJobReady:
+		return true
+	}
+	return false
+}
+
+// ParseConnectDomainEventBlockJobStatus returns the ConnectDomainEventBlockJobStatus value with the given name, as returned
+// by String.
+func ParseConnectDomainEventBlockJobStatus(s string) (ConnectDomainEventBlockJobStatus, error) {
+	switch s {
+	case "DomainBlockJobCompleted":
+		return DomainBlockJobCompleted, nil
+	case "DomainBlockJobFailed":
+		return DomainBlockJobFailed, nil
+	case "DomainBlockJobCanceled":
+		return DomainBlockJobCanceled, nil
+	case "DomainBlockJobReady":
+		return DomainBlockJobReady, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectDomainEventBlockJobStatus %q", s)
+}
+
+// String returns the name of the ConnectDomainEventDiskChangeReason value.
+func (e ConnectDomainEventDiskChangeReason) String() string {
+	switch e {
+	case DomainEventDiskChangeMissingOnStart:
+		return "DomainEventDiskChangeMissingOnStart"
+	case DomainEventDiskDropMissingOnStart:
+		return "DomainEventDiskDropMissingOnStart"
+	}
+	return fmt.Sprintf("ConnectDomainEventDiskChangeReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectDomainEventDiskChangeReason values.
+func (e ConnectDomainEventDiskChangeReason) IsValid() bool {
+	switch e {
+	case DomainEventDiskChangeMissingOnStart,
+		DomainEventDiskDropMissingOnStart:
+		return true
+	}
+	return false
+}
+
+// ParseConnectDomainEventDiskChangeReason returns the ConnectDomainEventDiskChangeReason value with the given name, as returned
+// by String.
+func ParseConnectDomainEventDiskChangeReason(s string) (ConnectDomainEventDiskChangeReason, error) {
+	switch s {
+	case "DomainEventDiskChangeMissingOnStart":
+		return DomainEventDiskChangeMissingOnStart, nil
+	case "DomainEventDiskDropMissingOnStart":
+		return DomainEventDiskDropMissingOnStart, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectDomainEventDiskChangeReason %q", s)
+}
+
+// String returns the name of the DomainEventTrayChangeReason value.
+func (e DomainEventTrayChangeReason) String() string {
+	switch e {
+	case DomainEventTrayChangeOpen:
+		return "DomainEventTrayChangeOpen"
+	case DomainEventTrayChangeClose:
+		return "DomainEventTrayChangeClose"
+	}
+	return fmt.Sprintf("DomainEventTrayChangeReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventTrayChangeReason values.
+func (e DomainEventTrayChangeReason) IsValid() bool {
+	switch e {
+	case DomainEventTrayChangeOpen,
+		DomainEventTrayChangeClose:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventTrayChangeReason returns the DomainEventTrayChangeReason value with the given name, as returned
+// by String.
+func ParseDomainEventTrayChangeReason(s string) (DomainEventTrayChangeReason, error) {
+	switch s {
+	case "DomainEventTrayChangeOpen":
+		return DomainEventTrayChangeOpen, nil
+	case "DomainEventTrayChangeClose":
+		return DomainEventTrayChangeClose, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventTrayChangeReason %q", s)
+}
+
+// String returns the name of the ConnectDomainEventAgentLifecycleState value.
+func (e ConnectDomainEventAgentLifecycleState) String() string {
+	switch e {
+	case ConnectDomainEventAgentLifecycleStateConnected:
+		return "ConnectDomainEventAgentLifecycleStateConnected"
+	case ConnectDomainEventAgentLifecycleStateDisconnected:
+		return "ConnectDomainEventAgentLifecycleStateDisconnected"
+	}
+	return fmt.Sprintf("ConnectDomainEventAgentLifecycleState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectDomainEventAgentLifecycleState values.
+func (e ConnectDomainEventAgentLifecycleState) IsValid() bool {
+	switch e {
+	case ConnectDomainEventAgentLifecycleStateConnected,
+		ConnectDomainEventAgentLifecycleStateDisconnected:
+		return true
+	}
+	return false
+}
+
+// ParseConnectDomainEventAgentLifecycleState returns the ConnectDomainEventAgentLifecycleState value with the given name, as returned
+// by String.
+func ParseConnectDomainEventAgentLifecycleState(s string) (ConnectDomainEventAgentLifecycleState, error) {
+	switch s {
+	case "ConnectDomainEventAgentLifecycleStateConnected":
+		return ConnectDomainEventAgentLifecycleStateConnected, nil
+	case "ConnectDomainEventAgentLifecycleStateDisconnected":
+		return ConnectDomainEventAgentLifecycleStateDisconnected, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectDomainEventAgentLifecycleState %q", s)
+}
+
+// String returns the name of the ConnectDomainEventAgentLifecycleReason value.
+func (e ConnectDomainEventAgentLifecycleReason) String() string {
+	switch e {
+	case ConnectDomainEventAgentLifecycleReasonUnknown:
+		return "ConnectDomainEventAgentLifecycleReasonUnknown"
+	case ConnectDomainEventAgentLifecycleReasonDomainStarted:
+		return "ConnectDomainEventAgentLifecycleReasonDomainStarted"
+	case ConnectDomainEventAgentLifecycleReasonChannel:
+		return "ConnectDomainEventAgentLifecycleReasonChannel"
+	}
+	return fmt.Sprintf("ConnectDomainEventAgentLifecycleReason(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ConnectDomainEventAgentLifecycleReason values.
+func (e ConnectDomainEventAgentLifecycleReason) IsValid() bool {
+	switch e {
+	case ConnectDomainEventAgentLifecycleReasonUnknown,
+		ConnectDomainEventAgentLifecycleReasonDomainStarted,
+		ConnectDomainEventAgentLifecycleReasonChannel:
+		return true
+	}
+	return false
+}
+
+// ParseConnectDomainEventAgentLifecycleReason returns the ConnectDomainEventAgentLifecycleReason value with the given name, as returned
+// by String.
+func ParseConnectDomainEventAgentLifecycleReason(s string) (ConnectDomainEventAgentLifecycleReason, error) {
+	switch s {
+	case "ConnectDomainEventAgentLifecycleReasonUnknown":
+		return ConnectDomainEventAgentLifecycleReasonUnknown, nil
+	case "ConnectDomainEventAgentLifecycleReasonDomainStarted":
+		return ConnectDomainEventAgentLifecycleReasonDomainStarted, nil
+	case "ConnectDomainEventAgentLifecycleReasonChannel":
+		return ConnectDomainEventAgentLifecycleReasonChannel, nil
+	}
+	return 0, fmt.Errorf("invalid ConnectDomainEventAgentLifecycleReason %q", s)
+}
+
+// String returns the name of the DomainEventID value.
+func (e DomainEventID) String() string {
+	switch e {
+	case DomainEventIDLifecycle:
+		return "DomainEventIDLifecycle"
+	case DomainEventIDReboot:
+		return "DomainEventIDReboot"
+	case DomainEventIDRtcChange:
+		return "DomainEventIDRtcChange"
+	case DomainEventIDWatchdog:
+		return "DomainEventIDWatchdog"
+	case DomainEventIDIoError:
+		return "DomainEventIDIoError"
+	case DomainEventIDGraphics:
+		return "DomainEventIDGraphics"
+	case DomainEventIDIoErrorReason:
+		return "DomainEventIDIoErrorReason"
+	case DomainEventIDControlError:
+		return "DomainEventIDControlError"
+	case DomainEventIDBlockJob:
+		return "DomainEventIDBlockJob"
+	case DomainEventIDDiskChange:
+		return "DomainEventIDDiskChange"
+	case DomainEventIDTrayChange:
+		return "DomainEventIDTrayChange"
+	case DomainEventIDPmwakeup:
+		return "DomainEventIDPmwakeup"
+	case DomainEventIDPmsuspend:
+		return "DomainEventIDPmsuspend"
+	case DomainEventIDBalloonChange:
+		return "DomainEventIDBalloonChange"
+	case DomainEventIDPmsuspendDisk:
+		return "DomainEventIDPmsuspendDisk"
+	case DomainEventIDDeviceRemoved:
+		return "DomainEventIDDeviceRemoved"
+	case DomainEventIDBlockJob2:
+		return "DomainEventIDBlockJob2"
+	case DomainEventIDTunable:
+		return "DomainEventIDTunable"
+	case DomainEventIDAgentLifecycle:
+		return "DomainEventIDAgentLifecycle"
+	case DomainEventIDDeviceAdded:
+		return "DomainEventIDDeviceAdded"
+	case DomainEventIDMigrationIteration:
+		return "DomainEventIDMigrationIteration"
+	case DomainEventIDJobCompleted:
+		return "DomainEventIDJobCompleted"
+	case DomainEventIDDeviceRemovalFailed:
+		return "DomainEventIDDeviceRemovalFailed"
+	case DomainEventIDMetadataChange:
+		return "DomainEventIDMetadataChange"
+	case DomainEventIDBlockThreshold:
+		return "DomainEventIDBlockThreshold"
+	case DomainEventIDMemoryFailure:
+		return "DomainEventIDMemoryFailure"
+	}
+	return fmt.Sprintf("DomainEventID(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainEventID values.
+func (e DomainEventID) IsValid() bool {
+	switch e {
+	case DomainEventIDLifecycle,
+		DomainEventIDReboot,
+		DomainEventIDRtcChange,
+		DomainEventIDWatchdog,
+		DomainEventIDIoError,
+		DomainEventIDGraphics,
+		DomainEventIDIoErrorReason,
+		DomainEventIDControlError,
+		DomainEventIDBlockJob,
+		DomainEventIDDiskChange,
+		DomainEventIDTrayChange,
+		DomainEventIDPmwakeup,
+		DomainEventIDPmsuspend,
+		DomainEventIDBalloonChange,
+		DomainEventIDPmsuspendDisk,
+		DomainEventIDDeviceRemoved,
+		DomainEventIDBlockJob2,
+		DomainEventIDTunable,
+		DomainEventIDAgentLifecycle,
+		DomainEventIDDeviceAdded,
+		DomainEventIDMigrationIteration,
+		DomainEventIDJobCompleted,
+		DomainEventIDDeviceRemovalFailed,
+		DomainEventIDMetadataChange,
+		DomainEventIDBlockThreshold,
+		DomainEventIDMemoryFailure:
+		return true
+	}
+	return false
+}
+
+// ParseDomainEventID returns the DomainEventID value with the given name, as returned
+// by String.
+func ParseDomainEventID(s string) (DomainEventID, error) {
+	switch s {
+	case "DomainEventIDLifecycle":
+		return DomainEventIDLifecycle, nil
+	case "DomainEventIDReboot":
+		return DomainEventIDReboot, nil
+	case "DomainEventIDRtcChange":
+		return DomainEventIDRtcChange, nil
+	case "DomainEventIDWatchdog":
+		return DomainEventIDWatchdog, nil
+	case "DomainEventIDIoError":
+		return DomainEventIDIoError, nil
+	case "DomainEventIDGraphics":
+		return DomainEventIDGraphics, nil
+	case "DomainEventIDIoErrorReason":
+		return DomainEventIDIoErrorReason, nil
+	case "DomainEventIDControlError":
+		return DomainEventIDControlError, nil
+	case "DomainEventIDBlockJob":
+		return DomainEventIDBlockJob, nil
+	case "DomainEventIDDiskChange":
+		return DomainEventIDDiskChange, nil
+	case "DomainEventIDTrayChange":
+		return DomainEventIDTrayChange, nil
+	case "DomainEventIDPmwakeup":
+		return DomainEventIDPmwakeup, nil
+	case "DomainEventIDPmsuspend":
+		return DomainEventIDPmsuspend, nil
+	case "DomainEventIDBalloonChange":
+		return DomainEventIDBalloonChange, nil
+	case "DomainEventIDPmsuspendDisk":
+		return DomainEventIDPmsuspendDisk, nil
+	case "DomainEventIDDeviceRemoved":
+		return DomainEventIDDeviceRemoved, nil
+	case "DomainEventIDBlockJob2":
+		return DomainEventIDBlockJob2, nil
+	case "DomainEventIDTunable":
+		return DomainEventIDTunable, nil
+	case "DomainEventIDAgentLifecycle":
+		return DomainEventIDAgentLifecycle, nil
+	case "DomainEventIDDeviceAdded":
+		return DomainEventIDDeviceAdded, nil
+	case "DomainEventIDMigrationIteration":
+		return DomainEventIDMigrationIteration, nil
+	case "DomainEventIDJobCompleted":
+		return DomainEventIDJobCompleted, nil
+	case "DomainEventIDDeviceRemovalFailed":
+		return DomainEventIDDeviceRemovalFailed, nil
+	case "DomainEventIDMetadataChange":
+		return DomainEventIDMetadataChange, nil
+	case "DomainEventIDBlockThreshold":
+		return DomainEventIDBlockThreshold, nil
+	case "DomainEventIDMemoryFailure":
+		return DomainEventIDMemoryFailure, nil
+	}
+	return 0, fmt.Errorf("invalid DomainEventID %q", s)
+}
+
+// String returns the name of the SchedParameterType value.
+func (e SchedParameterType) String() string {
+	switch e {
+	case DomainSchedFieldInt:
+		return "DomainSchedFieldInt"
+	case DomainSchedFieldUint:
+		return "DomainSchedFieldUint"
+	case DomainSchedFieldLlong:
+		return "DomainSchedFieldLlong"
+	case DomainSchedFieldUllong:
+		return "DomainSchedFieldUllong"
+	case DomainSchedFieldDouble:
+		return "DomainSchedFieldDouble"
+	case DomainSchedFieldBoolean:
+		return "DomainSchedFieldBoolean"
+	}
+	return fmt.Sprintf("SchedParameterType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the SchedParameterType values.
+func (e SchedParameterType) IsValid() bool {
+	switch e {
+	case DomainSchedFieldInt,
+		DomainSchedFieldUint,
+		DomainSchedFieldLlong,
+		DomainSchedFieldUllong,
+		DomainSchedFieldDouble,
+		DomainSchedFieldBoolean:
+		return true
+	}
+	return false
+}
+
+// ParseSchedParameterType returns the SchedParameterType value with the given name, as returned
+// by String.
+func ParseSchedParameterType(s string) (SchedParameterType, error) {
+	switch s {
+	case "DomainSchedFieldInt":
+		return DomainSchedFieldInt, nil
+	case "DomainSchedFieldUint":
+		return DomainSchedFieldUint, nil
+	case "DomainSchedFieldLlong":
+		return DomainSchedFieldLlong, nil
+	case "DomainSchedFieldUllong":
+		return DomainSchedFieldUllong, nil
+	case "DomainSchedFieldDouble":
+		return DomainSchedFieldDouble, nil
+	case "DomainSchedFieldBoolean":
+		return DomainSchedFieldBoolean, nil
+	}
+	return 0, fmt.Errorf("invalid SchedParameterType %q", s)
+}
+
+// String returns the name of the BlkioParameterType value.
+func (e BlkioParameterType) String() string {
+	switch e {
+	case DomainBlkioParamInt:
+		return "DomainBlkioParamInt"
+	case DomainBlkioParamUint:
+		return "DomainBlkioParamUint"
+	case DomainBlkioParamLlong:
+		return "DomainBlkioParamLlong"
+	case DomainBlkioParamUllong:
+		return "DomainBlkioParamUllong"
+	case DomainBlkioParamDouble:
+		return "DomainBlkioParamDouble"
+	case DomainBlkioParamBoolean:
+		return "DomainBlkioParamBoolean"
+	}
+	return fmt.Sprintf("BlkioParameterType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the BlkioParameterType values.
+func (e BlkioParameterType) IsValid() bool {
+	switch e {
+	case DomainBlkioParamInt,
+		DomainBlkioParamUint,
+		DomainBlkioParamLlong,
+		DomainBlkioParamUllong,
+		DomainBlkioParamDouble,
+		DomainBlkioParamBoolean:
+		return true
+	}
+	return false
+}
+
+// ParseBlkioParameterType returns the BlkioParameterType value with the given name, as returned
+// by String.
+func ParseBlkioParameterType(s string) (BlkioParameterType, error) {
+	switch s {
+	case "DomainBlkioParamInt":
+		return DomainBlkioParamInt, nil
+	case "DomainBlkioParamUint":
+		return DomainBlkioParamUint, nil
+	case "DomainBlkioParamLlong":
+		return DomainBlkioParamLlong, nil
+	case "DomainBlkioParamUllong":
+		return DomainBlkioParamUllong, nil
+	case "DomainBlkioParamDouble":
+		return DomainBlkioParamDouble, nil
+	case "DomainBlkioParamBoolean":
+		return DomainBlkioParamBoolean, nil
+	}
+	return 0, fmt.Errorf("invalid BlkioParameterType %q", s)
+}
+
+// String returns the name of the MemoryParameterType value.
+func (e MemoryParameterType) String() string {
+	switch e {
+	case DomainMemoryParamInt:
+		return "DomainMemoryParamInt"
+	case DomainMemoryParamUint:
+		return "DomainMemoryParamUint"
+	case DomainMemoryParamLlong:
+		return "DomainMemoryParamLlong"
+	case DomainMemoryParamUllong:
+		return "DomainMemoryParamUllong"
+	case DomainMemoryParamDouble:
+		return "DomainMemoryParamDouble"
+	case DomainMemoryParamBoolean:
+		return "DomainMemoryParamBoolean"
+	}
+	return fmt.Sprintf("MemoryParameterType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the MemoryParameterType values.
+func (e MemoryParameterType) IsValid() bool {
+	switch e {
+	case DomainMemoryParamInt,
+		DomainMemoryParamUint,
+		DomainMemoryParamLlong,
+		DomainMemoryParamUllong,
+		DomainMemoryParamDouble,
+		DomainMemoryParamBoolean:
+		return true
+	}
+	return false
+}
+
+// ParseMemoryParameterType returns the MemoryParameterType value with the given name, as returned
+// by String.
+func ParseMemoryParameterType(s string) (MemoryParameterType, error) {
+	switch s {
+	case "DomainMemoryParamInt":
+		return DomainMemoryParamInt, nil
+	case "DomainMemoryParamUint":
+		return DomainMemoryParamUint, nil
+	case "DomainMemoryParamLlong":
+		return DomainMemoryParamLlong, nil
+	case "DomainMemoryParamUllong":
+		return DomainMemoryParamUllong, nil
+	case "DomainMemoryParamDouble":
+		return DomainMemoryParamDouble, nil
+	case "DomainMemoryParamBoolean":
+		return DomainMemoryParamBoolean, nil
+	}
+	return 0, fmt.Errorf("invalid MemoryParameterType %q", s)
+}
+
+// String returns the name of the DomainInterfaceAddressesSource value.
+func (e DomainInterfaceAddressesSource) String() string {
+	switch e {
+	case DomainInterfaceAddressesSrcLease:
+		return "DomainInterfaceAddressesSrcLease"
+	case DomainInterfaceAddressesSrcAgent:
+		return "DomainInterfaceAddressesSrcAgent"
+	case DomainInterfaceAddressesSrcArp:
+		return "DomainInterfaceAddressesSrcArp"
+	}
+	return fmt.Sprintf("DomainInterfaceAddressesSource(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainInterfaceAddressesSource values.
+func (e DomainInterfaceAddressesSource) IsValid() bool {
+	switch e {
+	case DomainInterfaceAddressesSrcLease,
+		DomainInterfaceAddressesSrcAgent,
+		DomainInterfaceAddressesSrcArp:
+		return true
+	}
+	return false
+}
+
+// ParseDomainInterfaceAddressesSource returns the DomainInterfaceAddressesSource value with the given name, as returned
+// by String.
+func ParseDomainInterfaceAddressesSource(s string) (DomainInterfaceAddressesSource, error) {
+	switch s {
+	case "DomainInterfaceAddressesSrcLease":
+		return DomainInterfaceAddressesSrcLease, nil
+	case "DomainInterfaceAddressesSrcAgent":
+		return DomainInterfaceAddressesSrcAgent, nil
+	case "DomainInterfaceAddressesSrcArp":
+		return DomainInterfaceAddressesSrcArp, nil
+	}
+	return 0, fmt.Errorf("invalid DomainInterfaceAddressesSource %q", s)
+}
+
+// String returns the name of the DomainLifecycle value.
+func (e DomainLifecycle) String() string {
+	switch e {
+	case DomainLifecyclePoweroff:
+		return "DomainLifecyclePoweroff"
+	case DomainLifecycleReboot:
+		return "DomainLifecycleReboot"
+	case DomainLifecycleCrash:
+		return "DomainLifecycleCrash"
+	}
+	return fmt.Sprintf("DomainLifecycle(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainLifecycle values.
+func (e DomainLifecycle) IsValid() bool {
+	switch e {
+	case DomainLifecyclePoweroff,
+		DomainLifecycleReboot,
+		DomainLifecycleCrash:
+		return true
+	}
+	return false
+}
+
+// ParseDomainLifecycle returns the DomainLifecycle value with the given name, as returned
+// by String.
+func ParseDomainLifecycle(s string) (DomainLifecycle, error) {
+	switch s {
+	case "DomainLifecyclePoweroff":
+		return DomainLifecyclePoweroff, nil
+	case "DomainLifecycleReboot":
+		return DomainLifecycleReboot, nil
+	case "DomainLifecycleCrash":
+		return DomainLifecycleCrash, nil
+	}
+	return 0, fmt.Errorf("invalid DomainLifecycle %q", s)
+}
+
+// String returns the name of the DomainLifecycleAction value.
+func (e DomainLifecycleAction) String() string {
+	switch e {
+	case DomainLifecycleActionDestroy:
+		return "DomainLifecycleActionDestroy"
+	case DomainLifecycleActionRestart:
+		return "DomainLifecycleActionRestart"
+	case DomainLifecycleActionRestartRename:
+		return "DomainLifecycleActionRestartRename"
+	case DomainLifecycleActionPreserve:
+		return "DomainLifecycleActionPreserve"
+	case DomainLifecycleActionCoredumpDestroy:
+		return "DomainLifecycleActionCoredumpDestroy"
+	case DomainLifecycleActionCoredumpRestart:
+		return "DomainLifecycleActionCoredumpRestart"
+	}
+	return fmt.Sprintf("DomainLifecycleAction(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainLifecycleAction values.
+func (e DomainLifecycleAction) IsValid() bool {
+	switch e {
+	case DomainLifecycleActionDestroy,
+		DomainLifecycleActionRestart,
+		DomainLifecycleActionRestartRename,
+		DomainLifecycleActionPreserve,
+		DomainLifecycleActionCoredumpDestroy,
+		DomainLifecycleActionCoredumpRestart:
+		return true
+	}
+	return false
+}
+
+// ParseDomainLifecycleAction returns the DomainLifecycleAction value with the given name, as returned
+// by String.
+func ParseDomainLifecycleAction(s string) (DomainLifecycleAction, error) {
+	switch s {
+	case "DomainLifecycleActionDestroy":
+		return DomainLifecycleActionDestroy, nil
+	case "DomainLifecycleActionRestart":
+		return DomainLifecycleActionRestart, nil
+	case "DomainLifecycleActionRestartRename":
+		return DomainLifecycleActionRestartRename, nil
+	case "DomainLifecycleActionPreserve":
+		return DomainLifecycleActionPreserve, nil
+	case "DomainLifecycleActionCoredumpDestroy":
+		return DomainLifecycleActionCoredumpDestroy, nil
+	case "DomainLifecycleActionCoredumpRestart":
+		return DomainLifecycleActionCoredumpRestart, nil
+	}
+	return 0, fmt.Errorf("invalid DomainLifecycleAction %q", s)
+}
+
+// String returns the name of the DomainAgentResponseTimeoutValues value.
+func (e DomainAgentResponseTimeoutValues) String() string {
+	switch e {
+	case DomainAgentResponseTimeoutBlock:
+		return "DomainAgentResponseTimeoutBlock"
+	case DomainAgentResponseTimeoutDefault:
+		return "DomainAgentResponseTimeoutDefault"
+	case DomainAgentResponseTimeoutNowait:
+		return "DomainAgentResponseTimeoutNowait"
+	}
+	return fmt.Sprintf("DomainAgentResponseTimeoutValues(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainAgentResponseTimeoutValues values.
+func (e DomainAgentResponseTimeoutValues) IsValid() bool {
+	switch e {
+	case DomainAgentResponseTimeoutBlock,
+		DomainAgentResponseTimeoutDefault,
+		DomainAgentResponseTimeoutNowait:
+		return true
+	}
+	return false
+}
+
+// ParseDomainAgentResponseTimeoutValues returns the DomainAgentResponseTimeoutValues value with the given name, as returned
+// by String.
+func ParseDomainAgentResponseTimeoutValues(s string) (DomainAgentResponseTimeoutValues, error) {
+	switch s {
+	case "DomainAgentResponseTimeoutBlock":
+		return DomainAgentResponseTimeoutBlock, nil
+	case "DomainAgentResponseTimeoutDefault":
+		return DomainAgentResponseTimeoutDefault, nil
+	case "DomainAgentResponseTimeoutNowait":
+		return DomainAgentResponseTimeoutNowait, nil
+	}
+	return 0, fmt.Errorf("invalid DomainAgentResponseTimeoutValues %q", s)
+}
+
+// String returns the name of the DomainMessageType value.
+func (e DomainMessageType) String() string {
+	switch e {
+	case DomainMessageDeprecation:
+		return "DomainMessageDeprecation"
+	case DomainMessageTainting:
+		return "DomainMessageTainting"
+	}
+	return fmt.Sprintf("DomainMessageType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the DomainMessageType values.
+func (e DomainMessageType) IsValid() bool {
+	switch e {
+	case DomainMessageDeprecation,
+		DomainMessageTainting:
+		return true
+	}
+	return false
+}
+
+// ParseDomainMessageType returns the DomainMessageType value with the given name, as returned
+// by String.
+func ParseDomainMessageType(s string) (DomainMessageType, error) {
+	switch s {
+	case "DomainMessageDeprecation":
+		return DomainMessageDeprecation, nil
+	case "DomainMessageTainting":
+		return DomainMessageTainting, nil
+	}
+	return 0, fmt.Errorf("invalid DomainMessageType %q", s)
+}
+
+// String returns the name of the NetworkUpdateCommand value.
+func (e NetworkUpdateCommand) String() string {
+	switch e {
+	case NetworkUpdateCommandNone:
+		return "NetworkUpdateCommandNone"
+	case NetworkUpdateCommandModify:
+		return "NetworkUpdateCommandModify"
+	case NetworkUpdateCommandDelete:
+		return "NetworkUpdateCommandDelete"
+	case NetworkUpdateCommandAddLast:
+		return "NetworkUpdateCommandAddLast"
+	case NetworkUpdateCommandAddFirst:
+		return "NetworkUpdateCommandAddFirst"
+	}
+	return fmt.Sprintf("NetworkUpdateCommand(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NetworkUpdateCommand values.
+func (e NetworkUpdateCommand) IsValid() bool {
+	switch e {
+	case NetworkUpdateCommandNone,
+		NetworkUpdateCommandModify,
+		NetworkUpdateCommandDelete,
+		NetworkUpdateCommandAddLast,
+		NetworkUpdateCommandAddFirst:
+		return true
+	}
+	return false
+}
+
+// ParseNetworkUpdateCommand returns the NetworkUpdateCommand value with the given name, as returned
+// by String.
+func ParseNetworkUpdateCommand(s string) (NetworkUpdateCommand, error) {
+	switch s {
+	case "NetworkUpdateCommandNone":
+		return NetworkUpdateCommandNone, nil
+	case "NetworkUpdateCommandModify":
+		return NetworkUpdateCommandModify, nil
+	case "NetworkUpdateCommandDelete":
+		return NetworkUpdateCommandDelete, nil
+	case "NetworkUpdateCommandAddLast":
+		return NetworkUpdateCommandAddLast, nil
+	case "NetworkUpdateCommandAddFirst":
+		return NetworkUpdateCommandAddFirst, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkUpdateCommand %q", s)
+}
+
+// String returns the name of the NetworkUpdateSection value.
+func (e NetworkUpdateSection) String() string {
+	switch e {
+	case NetworkSectionNone:
+		return "NetworkSectionNone"
+	case NetworkSectionBridge:
+		return "NetworkSectionBridge"
+	case NetworkSectionDomain:
+		return "NetworkSectionDomain"
+	case NetworkSectionIP:
+		return "NetworkSectionIP"
+	case NetworkSectionIPDhcpHost:
+		return "NetworkSectionIPDhcpHost"
+	case NetworkSectionIPDhcpRange:
+		return "NetworkSectionIPDhcpRange"
+	case NetworkSectionForward:
+		return "NetworkSectionForward"
+	case NetworkSectionForwardInterface:
+		return "NetworkSectionForwardInterface"
+	case NetworkSectionForwardPf:
+		return "NetworkSectionForwardPf"
+	case NetworkSectionPortgroup:
+		return "NetworkSectionPortgroup"
+	case NetworkSectionDNSHost:
+		return "NetworkSectionDNSHost"
+	case NetworkSectionDNSTxt:
+		return "NetworkSectionDNSTxt"
+	case NetworkSectionDNSSrv:
+		return "NetworkSectionDNSSrv"
+	}
+	return fmt.Sprintf("NetworkUpdateSection(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NetworkUpdateSection values.
+func (e NetworkUpdateSection) IsValid() bool {
+	switch e {
+	case NetworkSectionNone,
+		NetworkSectionBridge,
+		NetworkSectionDomain,
+		NetworkSectionIP,
+		NetworkSectionIPDhcpHost,
+		NetworkSectionIPDhcpRange,
+		NetworkSectionForward,
+		NetworkSectionForwardInterface,
+		NetworkSectionForwardPf,
+		NetworkSectionPortgroup,
+		NetworkSectionDNSHost,
+		NetworkSectionDNSTxt,
+		NetworkSectionDNSSrv:
+		return true
+	}
+	return false
+}
+
+// ParseNetworkUpdateSection returns the NetworkUpdateSection value with the given name, as returned
+// by String.
+func ParseNetworkUpdateSection(s string) (NetworkUpdateSection, error) {
+	switch s {
+	case "NetworkSectionNone":
+		return NetworkSectionNone, nil
+	case "NetworkSectionBridge":
+		return NetworkSectionBridge, nil
+	case "NetworkSectionDomain":
+		return NetworkSectionDomain, nil
+	case "NetworkSectionIP":
+		return NetworkSectionIP, nil
+	case "NetworkSectionIPDhcpHost":
+		return NetworkSectionIPDhcpHost, nil
+	case "NetworkSectionIPDhcpRange":
+		return NetworkSectionIPDhcpRange, nil
+	case "NetworkSectionForward":
+		return NetworkSectionForward, nil
+	case "NetworkSectionForwardInterface":
+		return NetworkSectionForwardInterface, nil
+	case "NetworkSectionForwardPf":
+		return NetworkSectionForwardPf, nil
+	case "NetworkSectionPortgroup":
+		return NetworkSectionPortgroup, nil
+	case "NetworkSectionDNSHost":
+		return NetworkSectionDNSHost, nil
+	case "NetworkSectionDNSTxt":
+		return NetworkSectionDNSTxt, nil
+	case "NetworkSectionDNSSrv":
+		return NetworkSectionDNSSrv, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkUpdateSection %q", s)
+}
+
+// String returns the name of the NetworkEventLifecycleType value.
+func (e NetworkEventLifecycleType) String() string {
+	switch e {
+	case NetworkEventDefined:
+		return "NetworkEventDefined"
+	case NetworkEventUndefined:
+		return "NetworkEventUndefined"
+	case NetworkEventStarted:
+		return "NetworkEventStarted"
+	case NetworkEventStopped:
+		return "NetworkEventStopped"
+	}
+	return fmt.Sprintf("NetworkEventLifecycleType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NetworkEventLifecycleType values.
+func (e NetworkEventLifecycleType) IsValid() bool {
+	switch e {
+	case NetworkEventDefined,
+		NetworkEventUndefined,
+		NetworkEventStarted,
+		NetworkEventStopped:
+		return true
+	}
+	return false
+}
+
+// ParseNetworkEventLifecycleType returns the NetworkEventLifecycleType value with the given name, as returned
+// by String.
+func ParseNetworkEventLifecycleType(s string) (NetworkEventLifecycleType, error) {
+	switch s {
+	case "NetworkEventDefined":
+		return NetworkEventDefined, nil
+	case "NetworkEventUndefined":
+		return NetworkEventUndefined, nil
+	case "NetworkEventStarted":
+		return NetworkEventStarted, nil
+	case "NetworkEventStopped":
+		return NetworkEventStopped, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkEventLifecycleType %q", s)
+}
+
+// String returns the name of the NetworkEventID value.
+func (e NetworkEventID) String() string {
+	switch e {
+	case NetworkEventIDLifecycle:
+		return "NetworkEventIDLifecycle"
+	}
+	return fmt.Sprintf("NetworkEventID(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NetworkEventID values.
+func (e NetworkEventID) IsValid() bool {
+	switch e {
+	case NetworkEventIDLifecycle:
+		return true
+	}
+	return false
+}
+
+// ParseNetworkEventID returns the NetworkEventID value with the given name, as returned
+// by String.
+func ParseNetworkEventID(s string) (NetworkEventID, error) {
+	switch s {
+	case "NetworkEventIDLifecycle":
+		return NetworkEventIDLifecycle, nil
+	}
+	return 0, fmt.Errorf("invalid NetworkEventID %q", s)
+}
+
+// String returns the name of the IPAddrType value.
+func (e IPAddrType) String() string {
+	switch e {
+	case IPAddrTypeIpv4:
+		return "IPAddrTypeIpv4"
+	case IPAddrTypeIpv6:
+		return "IPAddrTypeIpv6"
+	}
+	return fmt.Sprintf("IPAddrType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the IPAddrType values.
+func (e IPAddrType) IsValid() bool {
+	switch e {
+	case IPAddrTypeIpv4,
+		IPAddrTypeIpv6:
+		return true
+	}
+	return false
+}
+
+// ParseIPAddrType returns the IPAddrType value with the given name, as returned
+// by String.
+func ParseIPAddrType(s string) (IPAddrType, error) {
+	switch s {
+	case "IPAddrTypeIpv4":
+		return IPAddrTypeIpv4, nil
+	case "IPAddrTypeIpv6":
+		return IPAddrTypeIpv6, nil
+	}
+	return 0, fmt.Errorf("invalid IPAddrType %q", s)
+}
+
+// String returns the name of the NodeDeviceEventID value.
+func (e NodeDeviceEventID) String() string {
+	switch e {
+	case NodeDeviceEventIDLifecycle:
+		return "NodeDeviceEventIDLifecycle"
+	case NodeDeviceEventIDUpdate:
+		return "NodeDeviceEventIDUpdate"
+	}
+	return fmt.Sprintf("NodeDeviceEventID(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NodeDeviceEventID values.
+func (e NodeDeviceEventID) IsValid() bool {
+	switch e {
+	case NodeDeviceEventIDLifecycle,
+		NodeDeviceEventIDUpdate:
+		return true
+	}
+	return false
+}
+
+// ParseNodeDeviceEventID returns the NodeDeviceEventID value with the given name, as returned
+// by String.
+func ParseNodeDeviceEventID(s string) (NodeDeviceEventID, error) {
+	switch s {
+	case "NodeDeviceEventIDLifecycle":
+		return NodeDeviceEventIDLifecycle, nil
+	case "NodeDeviceEventIDUpdate":
+		return NodeDeviceEventIDUpdate, nil
+	}
+	return 0, fmt.Errorf("invalid NodeDeviceEventID %q", s)
+}
+
+// String returns the name of the NodeDeviceEventLifecycleType value.
+func (e NodeDeviceEventLifecycleType) String() string {
+	switch e {
+	case NodeDeviceEventCreated:
+		return "NodeDeviceEventCreated"
+	case NodeDeviceEventDeleted:
+		return "NodeDeviceEventDeleted"
+	}
+	return fmt.Sprintf("NodeDeviceEventLifecycleType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the NodeDeviceEventLifecycleType values.
+func (e NodeDeviceEventLifecycleType) IsValid() bool {
+	switch e {
+	case NodeDeviceEventCreated,
+		NodeDeviceEventDeleted:
+		return true
+	}
+	return false
+}
+
+// ParseNodeDeviceEventLifecycleType returns the NodeDeviceEventLifecycleType value with the given name, as returned
+// by String.
+func ParseNodeDeviceEventLifecycleType(s string) (NodeDeviceEventLifecycleType, error) {
+	switch s {
+	case "NodeDeviceEventCreated":
+		return NodeDeviceEventCreated, nil
+	case "NodeDeviceEventDeleted":
+		return NodeDeviceEventDeleted, nil
+	}
+	return 0, fmt.Errorf("invalid NodeDeviceEventLifecycleType %q", s)
+}
+
+// String returns the name of the SecretUsageType value.
+func (e SecretUsageType) String() string {
+	switch e {
+	case SecretUsageTypeNone:
+		return "SecretUsageTypeNone"
+	case SecretUsageTypeVolume:
+		return "SecretUsageTypeVolume"
+	case SecretUsageTypeCeph:
+		return "SecretUsageTypeCeph"
+	case SecretUsageTypeIscsi:
+		return "SecretUsageTypeIscsi"
+	case SecretUsageTypeTLS:
+		return "SecretUsageTypeTLS"
+	case SecretUsageTypeVtpm:
+		return "SecretUsageTypeVtpm"
+	}
+	return fmt.Sprintf("SecretUsageType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the SecretUsageType values.
+func (e SecretUsageType) IsValid() bool {
+	switch e {
+	case SecretUsageTypeNone,
+		SecretUsageTypeVolume,
+		SecretUsageTypeCeph,
+		SecretUsageTypeIscsi,
+		SecretUsageTypeTLS,
+		SecretUsageTypeVtpm:
+		return true
+	}
+	return false
+}
+
+// ParseSecretUsageType returns the SecretUsageType value with the given name, as returned
+// by String.
+func ParseSecretUsageType(s string) (SecretUsageType, error) {
+	switch s {
+	case "SecretUsageTypeNone":
+		return SecretUsageTypeNone, nil
+	case "SecretUsageTypeVolume":
+		return SecretUsageTypeVolume, nil
+	case "SecretUsageTypeCeph":
+		return SecretUsageTypeCeph, nil
+	case "SecretUsageTypeIscsi":
+		return SecretUsageTypeIscsi, nil
+	case "SecretUsageTypeTLS":
+		return SecretUsageTypeTLS, nil
+	case "SecretUsageTypeVtpm":
+		return SecretUsageTypeVtpm, nil
+	}
+	return 0, fmt.Errorf("invalid SecretUsageType %q", s)
+}
+
+// String returns the name of the SecretEventID value.
+func (e SecretEventID) String() string {
+	switch e {
+	case SecretEventIDLifecycle:
+		return "SecretEventIDLifecycle"
+	case SecretEventIDValueChanged:
+		return "SecretEventIDValueChanged"
+	}
+	return fmt.Sprintf("SecretEventID(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the SecretEventID values.
+func (e SecretEventID) IsValid() bool {
+	switch e {
+	case SecretEventIDLifecycle,
+		SecretEventIDValueChanged:
+		return true
+	}
+	return false
+}
+
+// ParseSecretEventID returns the SecretEventID value with the given name, as returned
+// by String.
+func ParseSecretEventID(s string) (SecretEventID, error) {
+	switch s {
+	case "SecretEventIDLifecycle":
+		return SecretEventIDLifecycle, nil
+	case "SecretEventIDValueChanged":
+		return SecretEventIDValueChanged, nil
+	}
+	return 0, fmt.Errorf("invalid SecretEventID %q", s)
+}
+
+// String returns the name of the SecretEventLifecycleType value.
+func (e SecretEventLifecycleType) String() string {
+	switch e {
+	case SecretEventDefined:
+		return "SecretEventDefined"
+	case SecretEventUndefined:
+		return "SecretEventUndefined"
+	}
+	return fmt.Sprintf("SecretEventLifecycleType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the SecretEventLifecycleType values.
+func (e SecretEventLifecycleType) IsValid() bool {
+	switch e {
+	case SecretEventDefined,
+		SecretEventUndefined:
+		return true
+	}
+	return false
+}
+
+// ParseSecretEventLifecycleType returns the SecretEventLifecycleType value with the given name, as returned
+// by String.
+func ParseSecretEventLifecycleType(s string) (SecretEventLifecycleType, error) {
+	switch s {
+	case "SecretEventDefined":
+		return SecretEventDefined, nil
+	case "SecretEventUndefined":
+		return SecretEventUndefined, nil
+	}
+	return 0, fmt.Errorf("invalid SecretEventLifecycleType %q", s)
+}
+
+// String returns the name of the StoragePoolState value.
+func (e StoragePoolState) String() string {
+	switch e {
+	case StoragePoolInactive:
+		return "StoragePoolInactive"
+	case StoragePoolBuilding:
+		return "StoragePoolBuilding"
+	case StoragePoolRunning:
+		return "StoragePoolRunning"
+	case StoragePoolDegraded:
+		return "StoragePoolDegraded"
+	case StoragePoolInaccessible:
+		return "StoragePoolInaccessible"
+	}
+	return fmt.Sprintf("StoragePoolState(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the StoragePoolState values.
+func (e StoragePoolState) IsValid() bool {
+	switch e {
+	case StoragePoolInactive,
+		StoragePoolBuilding,
+		StoragePoolRunning,
+		StoragePoolDegraded,
+		StoragePoolInaccessible:
+		return true
+	}
+	return false
+}
+
+// ParseStoragePoolState returns the StoragePoolState value with the given name, as returned
+// by String.
+func ParseStoragePoolState(s string) (StoragePoolState, error) {
+	switch s {
+	case "StoragePoolInactive":
+		return StoragePoolInactive, nil
+	case "StoragePoolBuilding":
+		return StoragePoolBuilding, nil
+	case "StoragePoolRunning":
+		return StoragePoolRunning, nil
+	case "StoragePoolDegraded":
+		return StoragePoolDegraded, nil
+	case "StoragePoolInaccessible":
+		return StoragePoolInaccessible, nil
+	}
+	return 0, fmt.Errorf("invalid StoragePoolState %q", s)
+}
+
+// String returns the name of the StorageVolType value.
+func (e StorageVolType) String() string {
+	switch e {
+	case StorageVolFile:
+		return "StorageVolFile"
+	case StorageVolBlock:
+		return "StorageVolBlock"
+	case StorageVolDir:
+		return "StorageVolDir"
+	case StorageVolNetwork:
+		return "StorageVolNetwork"
+	case StorageVolNetdir:
+		return "StorageVolNetdir"
+	case StorageVolPloop:
+		return "StorageVolPloop"
+	}
+	return fmt.Sprintf("StorageVolType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the StorageVolType values.
+func (e StorageVolType) IsValid() bool {
+	switch e {
+	case StorageVolFile,
+		StorageVolBlock,
+		StorageVolDir,
+		StorageVolNetwork,
+		StorageVolNetdir,
+		StorageVolPloop:
+		return true
+	}
+	return false
+}
+
+// ParseStorageVolType returns the StorageVolType value with the given name, as returned
+// by String.
+func ParseStorageVolType(s string) (StorageVolType, error) {
+	switch s {
+	case "StorageVolFile":
+		return StorageVolFile, nil
+	case "StorageVolBlock":
+		return StorageVolBlock, nil
+	case "StorageVolDir":
+		return StorageVolDir, nil
+	case "StorageVolNetwork":
+		return StorageVolNetwork, nil
+	case "StorageVolNetdir":
+		return StorageVolNetdir, nil
+	case "StorageVolPloop":
+		return StorageVolPloop, nil
+	}
+	return 0, fmt.Errorf("invalid StorageVolType %q", s)
+}
+
+// String returns the name of the StorageVolWipeAlgorithm value.
+func (e StorageVolWipeAlgorithm) String() string {
+	switch e {
+	case StorageVolWipeAlgZero:
+		return "StorageVolWipeAlgZero"
+	case StorageVolWipeAlgNnsa:
+		return "StorageVolWipeAlgNnsa"
+	case StorageVolWipeAlgDod:
+		return "StorageVolWipeAlgDod"
+	case StorageVolWipeAlgBsi:
+		return "StorageVolWipeAlgBsi"
+	case StorageVolWipeAlgGutmann:
+		return "StorageVolWipeAlgGutmann"
+	case StorageVolWipeAlgSchneier:
+		return "StorageVolWipeAlgSchneier"
+	case StorageVolWipeAlgPfitzner7:
+		return "StorageVolWipeAlgPfitzner7"
+	case StorageVolWipeAlgPfitzner33:
+		return "StorageVolWipeAlgPfitzner33"
+	case StorageVolWipeAlgRandom:
+		return "StorageVolWipeAlgRandom"
+	case StorageVolWipeAlgTrim:
+		return "StorageVolWipeAlgTrim"
+	}
+	return fmt.Sprintf("StorageVolWipeAlgorithm(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the StorageVolWipeAlgorithm values.
+func (e StorageVolWipeAlgorithm) IsValid() bool {
+	switch e {
+	case StorageVolWipeAlgZero,
+		StorageVolWipeAlgNnsa,
+		StorageVolWipeAlgDod,
+		StorageVolWipeAlgBsi,
+		StorageVolWipeAlgGutmann,
+		StorageVolWipeAlgSchneier,
+		StorageVolWipeAlgPfitzner7,
+		StorageVolWipeAlgPfitzner33,
+		StorageVolWipeAlgRandom,
+		StorageVolWipeAlgTrim:
+		return true
+	}
+	return false
+}
+
+// ParseStorageVolWipeAlgorithm returns the StorageVolWipeAlgorithm value with the given name, as returned
+// by String.
+func ParseStorageVolWipeAlgorithm(s string) (StorageVolWipeAlgorithm, error) {
+	switch s {
+	case "StorageVolWipeAlgZero":
+		return StorageVolWipeAlgZero, nil
+	case "StorageVolWipeAlgNnsa":
+		return StorageVolWipeAlgNnsa, nil
+	case "StorageVolWipeAlgDod":
+		return StorageVolWipeAlgDod, nil
+	case "StorageVolWipeAlgBsi":
+		return StorageVolWipeAlgBsi, nil
+	case "StorageVolWipeAlgGutmann":
+		return StorageVolWipeAlgGutmann, nil
+	case "StorageVolWipeAlgSchneier":
+		return StorageVolWipeAlgSchneier, nil
+	case "StorageVolWipeAlgPfitzner7":
+		return StorageVolWipeAlgPfitzner7, nil
+	case "StorageVolWipeAlgPfitzner33":
+		return StorageVolWipeAlgPfitzner33, nil
+	case "StorageVolWipeAlgRandom":
+		return StorageVolWipeAlgRandom, nil
+	case "StorageVolWipeAlgTrim":
+		return StorageVolWipeAlgTrim, nil
+	}
+	return 0, fmt.Errorf("invalid StorageVolWipeAlgorithm %q", s)
+}
+
+// String returns the name of the StoragePoolEventID value.
+func (e StoragePoolEventID) String() string {
+	switch e {
+	case StoragePoolEventIDLifecycle:
+		return "StoragePoolEventIDLifecycle"
+	case StoragePoolEventIDRefresh:
+		return "StoragePoolEventIDRefresh"
+	}
+	return fmt.Sprintf("StoragePoolEventID(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the StoragePoolEventID values.
+func (e StoragePoolEventID) IsValid() bool {
+	switch e {
+	case StoragePoolEventIDLifecycle,
+		StoragePoolEventIDRefresh:
+		return true
+	}
+	return false
+}
+
+// ParseStoragePoolEventID returns the StoragePoolEventID value with the given name, as returned
+// by String.
+func ParseStoragePoolEventID(s string) (StoragePoolEventID, error) {
+	switch s {
+	case "StoragePoolEventIDLifecycle":
+		return StoragePoolEventIDLifecycle, nil
+	case "StoragePoolEventIDRefresh":
+		return StoragePoolEventIDRefresh, nil
+	}
+	return 0, fmt.Errorf("invalid StoragePoolEventID %q", s)
+}
+
+// String returns the name of the StoragePoolEventLifecycleType value.
+func (e StoragePoolEventLifecycleType) String() string {
+	switch e {
+	case StoragePoolEventDefined:
+		return "StoragePoolEventDefined"
+	case StoragePoolEventUndefined:
+		return "StoragePoolEventUndefined"
+	case StoragePoolEventStarted:
+		return "StoragePoolEventStarted"
+	case StoragePoolEventStopped:
+		return "StoragePoolEventStopped"
+	case StoragePoolEventCreated:
+		return "StoragePoolEventCreated"
+	case StoragePoolEventDeleted:
+		return "StoragePoolEventDeleted"
+	}
+	return fmt.Sprintf("StoragePoolEventLifecycleType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the StoragePoolEventLifecycleType values.
+func (e StoragePoolEventLifecycleType) IsValid() bool {
+	switch e {
+	case StoragePoolEventDefined,
+		StoragePoolEventUndefined,
+		StoragePoolEventStarted,
+		StoragePoolEventStopped,
+		StoragePoolEventCreated,
+		StoragePoolEventDeleted:
+		return true
+	}
+	return false
+}
+
+// ParseStoragePoolEventLifecycleType returns the StoragePoolEventLifecycleType value with the given name, as returned
+// by String.
+func ParseStoragePoolEventLifecycleType(s string) (StoragePoolEventLifecycleType, error) {
+	switch s {
+	case "StoragePoolEventDefined":
+		return StoragePoolEventDefined, nil
+	case "StoragePoolEventUndefined":
+		return StoragePoolEventUndefined, nil
+	case "StoragePoolEventStarted":
+		return StoragePoolEventStarted, nil
+	case "StoragePoolEventStopped":
+		return StoragePoolEventStopped, nil
+	case "StoragePoolEventCreated":
+		return StoragePoolEventCreated, nil
+	case "StoragePoolEventDeleted":
+		return StoragePoolEventDeleted, nil
+	}
+	return 0, fmt.Errorf("invalid StoragePoolEventLifecycleType %q", s)
+}
+
+// String returns the name of the ErrorLevel value.
+func (e ErrorLevel) String() string {
+	switch e {
+	case ErrNone:
+		return "ErrNone"
+	case ErrWarning:
+		return "ErrWarning"
+	case ErrError:
+		return "ErrError"
+	}
+	return fmt.Sprintf("ErrorLevel(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ErrorLevel values.
+func (e ErrorLevel) IsValid() bool {
+	switch e {
+	case ErrNone,
+		ErrWarning,
+		ErrError:
+		return true
+	}
+	return false
+}
+
+// ParseErrorLevel returns the ErrorLevel value with the given name, as returned
+// by String.
+func ParseErrorLevel(s string) (ErrorLevel, error) {
+	switch s {
+	case "ErrNone":
+		return ErrNone, nil
+	case "ErrWarning":
+		return ErrWarning, nil
+	case "ErrError":
+		return ErrError, nil
+	}
+	return 0, fmt.Errorf("invalid ErrorLevel %q", s)
+}
+
+// String returns the name of the ErrorDomain value.
+func (e ErrorDomain) String() string {
+	switch e {
+	case fromNone:
+		return "fromNone"
+	case fromXen:
+		return "fromXen"
+	case fromXend:
+		return "fromXend"
+	case fromXenstore:
+		return "fromXenstore"
+	case fromSexpr:
+		return "fromSexpr"
+	case fromXML:
+		return "fromXML"
+	case fromDom:
+		return "fromDom"
+	case fromRPC:
+		return "fromRPC"
+	case fromProxy:
+		return "fromProxy"
+	case fromConf:
+		return "fromConf"
+	case fromQemu:
+		return "fromQemu"
+	case fromNet:
+		return "fromNet"
+	case fromTest:
+		return "fromTest"
+	case fromRemote:
+		return "fromRemote"
+	case fromOpenvz:
+		return "fromOpenvz"
+	case fromXenxm:
+		return "fromXenxm"
+	case fromStatsLinux:
+		return "fromStatsLinux"
+	case fromLxc:
+		return "fromLxc"
+	case fromStorage:
+		return "fromStorage"
+	case fromNetwork:
+		return "fromNetwork"
+	case fromDomain:
+		return "fromDomain"
+	case fromUml:
+		return "fromUml"
+	case fromNodedev:
+		return "fromNodedev"
+	case fromXenInotify:
+		return "fromXenInotify"
+	case fromSecurity:
+		return "fromSecurity"
+	case fromVbox:
+		return "fromVbox"
+	case fromInterface:
+		return "fromInterface"
+	case fromOne:
+		return "fromOne"
+	case fromEsx:
+		return "fromEsx"
+	case fromPhyp:
+		return "fromPhyp"
+	case fromSecret:
+		return "fromSecret"
+	case fromCPU:
+		return "fromCPU"
+	case fromXenapi:
+		return "fromXenapi"
+	case fromNwfilter:
+		return "fromNwfilter"
+	case fromHook:
+		return "fromHook"
+	case fromDomainSnapshot:
+		return "fromDomainSnapshot"
+	case fromAudit:
+		return "fromAudit"
+	case fromSysinfo:
+		return "fromSysinfo"
+	case fromStreams:
+		return "fromStreams"
+	case fromVmware:
+		return "fromVmware"
+	case fromEvent:
+		return "fromEvent"
+	case fromLibxl:
+		return "fromLibxl"
+	case fromLocking:
+		return "fromLocking"
+	case fromHyperv:
+		return "fromHyperv"
+	case fromCapabilities:
+		return "fromCapabilities"
+	case fromURI:
+		return "fromURI"
+	case fromAuth:
+		return "fromAuth"
+	case fromDbus:
+		return "fromDbus"
+	case fromParallels:
+		return "fromParallels"
+	case fromDevice:
+		return "fromDevice"
+	case fromSSH:
+		return "fromSSH"
+	case fromLockspace:
+		return "fromLockspace"
+	case fromInitctl:
+		return "fromInitctl"
+	case fromIdentity:
+		return "fromIdentity"
+	case fromCgroup:
+		return "fromCgroup"
+	case fromAccess:
+		return "fromAccess"
+	case fromSystemd:
+		return "fromSystemd"
+	case fromBhyve:
+		return "fromBhyve"
+	case fromCrypto:
+		return "fromCrypto"
+	case fromFirewall:
+		return "fromFirewall"
+	case fromPolkit:
+		return "fromPolkit"
+	case fromThread:
+		return "fromThread"
+	case fromAdmin:
+		return "fromAdmin"
+	case fromLogging:
+		return "fromLogging"
+	case fromXenxl:
+		return "fromXenxl"
+	case fromPerf:
+		return "fromPerf"
+	case fromLibssh:
+		return "fromLibssh"
+	case fromResctrl:
+		return "fromResctrl"
+	case fromFirewalld:
+		return "fromFirewalld"
+	case fromDomainCheckpoint:
+		return "fromDomainCheckpoint"
+	case fromTpm:
+		return "fromTpm"
+	case fromBpf:
+		return "fromBpf"
+	}
+	return fmt.Sprintf("ErrorDomain(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ErrorDomain values.
+func (e ErrorDomain) IsValid() bool {
+	switch e {
+	case fromNone,
+		fromXen,
+		fromXend,
+		fromXenstore,
+		fromSexpr,
+		fromXML,
+		fromDom,
+		fromRPC,
+		fromProxy,
+		fromConf,
+		fromQemu,
+		fromNet,
+		fromTest,
+		fromRemote,
+		fromOpenvz,
+		fromXenxm,
+		fromStatsLinux,
+		fromLxc,
+		fromStorage,
+		fromNetwork,
+		fromDomain,
+		fromUml,
+		fromNodedev,
+		fromXenInotify,
+		fromSecurity,
+		fromVbox,
+		fromInterface,
+		fromOne,
+		fromEsx,
+		fromPhyp,
+		fromSecret,
+		fromCPU,
+		fromXenapi,
+		fromNwfilter,
+		fromHook,
+		fromDomainSnapshot,
+		fromAudit,
+		fromSysinfo,
+		fromStreams,
+		fromVmware,
+		fromEvent,
+		fromLibxl,
+		fromLocking,
+		fromHyperv,
+		fromCapabilities,
+		fromURI,
+		fromAuth,
+		fromDbus,
+		fromParallels,
+		fromDevice,
+		fromSSH,
+		fromLockspace,
+		fromInitctl,
+		fromIdentity,
+		fromCgroup,
+		fromAccess,
+		fromSystemd,
+		fromBhyve,
+		fromCrypto,
+		fromFirewall,
+		fromPolkit,
+		fromThread,
+		fromAdmin,
+		fromLogging,
+		fromXenxl,
+		fromPerf,
+		fromLibssh,
+		fromResctrl,
+		fromFirewalld,
+		fromDomainCheckpoint,
+		fromTpm,
+		fromBpf:
+		return true
+	}
+	return false
+}
+
+// ParseErrorDomain returns the ErrorDomain value with the given name, as returned
+// by String.
+func ParseErrorDomain(s string) (ErrorDomain, error) {
+	switch s {
+	case "fromNone":
+		return fromNone, nil
+	case "fromXen":
+		return fromXen, nil
+	case "fromXend":
+		return fromXend, nil
+	case "fromXenstore":
+		return fromXenstore, nil
+	case "fromSexpr":
+		return fromSexpr, nil
+	case "fromXML":
+		return fromXML, nil
+	case "fromDom":
+		return fromDom, nil
+	case "fromRPC":
+		return fromRPC, nil
+	case "fromProxy":
+		return fromProxy, nil
+	case "fromConf":
+		return fromConf, nil
+	case "fromQemu":
+		return fromQemu, nil
+	case "fromNet":
+		return fromNet, nil
+	case "fromTest":
+		return fromTest, nil
+	case "fromRemote":
+		return fromRemote, nil
+	case "fromOpenvz":
+		return fromOpenvz, nil
+	case "fromXenxm":
+		return fromXenxm, nil
+	case "fromStatsLinux":
+		return fromStatsLinux, nil
+	case "fromLxc":
+		return fromLxc, nil
+	case "fromStorage":
+		return fromStorage, nil
+	case "fromNetwork":
+		return fromNetwork, nil
+	case "fromDomain":
+		return fromDomain, nil
+	case "fromUml":
+		return fromUml, nil
+	case "fromNodedev":
+		return fromNodedev, nil
+	case "fromXenInotify":
+		return fromXenInotify, nil
+	case "fromSecurity":
+		return fromSecurity, nil
+	case "fromVbox":
+		return fromVbox, nil
+	case "fromInterface":
+		return fromInterface, nil
+	case "fromOne":
+		return fromOne, nil
+	case "fromEsx":
+		return fromEsx, nil
+	case "fromPhyp":
+		return fromPhyp, nil
+	case "fromSecret":
+		return fromSecret, nil
+	case "fromCPU":
+		return fromCPU, nil
+	case "fromXenapi":
+		return fromXenapi, nil
+	case "fromNwfilter":
+		return fromNwfilter, nil
+	case "fromHook":
+		return fromHook, nil
+	case "fromDomainSnapshot":
+		return fromDomainSnapshot, nil
+	case "fromAudit":
+		return fromAudit, nil
+	case "fromSysinfo":
+		return fromSysinfo, nil
+	case "fromStreams":
+		return fromStreams, nil
+	case "fromVmware":
+		return fromVmware, nil
+	case "fromEvent":
+		return fromEvent, nil
+	case "fromLibxl":
+		return fromLibxl, nil
+	case "fromLocking":
+		return fromLocking, nil
+	case "fromHyperv":
+		return fromHyperv, nil
+	case "fromCapabilities":
+		return fromCapabilities, nil
+	case "fromURI":
+		return fromURI, nil
+	case "fromAuth":
+		return fromAuth, nil
+	case "fromDbus":
+		return fromDbus, nil
+	case "fromParallels":
+		return fromParallels, nil
+	case "fromDevice":
+		return fromDevice, nil
+	case "fromSSH":
+		return fromSSH, nil
+	case "fromLockspace":
+		return fromLockspace, nil
+	case "fromInitctl":
+		return fromInitctl, nil
+	case "fromIdentity":
+		return fromIdentity, nil
+	case "fromCgroup":
+		return fromCgroup, nil
+	case "fromAccess":
+		return fromAccess, nil
+	case "fromSystemd":
+		return fromSystemd, nil
+	case "fromBhyve":
+		return fromBhyve, nil
+	case "fromCrypto":
+		return fromCrypto, nil
+	case "fromFirewall":
+		return fromFirewall, nil
+	case "fromPolkit":
+		return fromPolkit, nil
+	case "fromThread":
+		return fromThread, nil
+	case "fromAdmin":
+		return fromAdmin, nil
+	case "fromLogging":
+		return fromLogging, nil
+	case "fromXenxl":
+		return fromXenxl, nil
+	case "fromPerf":
+		return fromPerf, nil
+	case "fromLibssh":
+		return fromLibssh, nil
+	case "fromResctrl":
+		return fromResctrl, nil
+	case "fromFirewalld":
+		return fromFirewalld, nil
+	case "fromDomainCheckpoint":
+		return fromDomainCheckpoint, nil
+	case "fromTpm":
+		return fromTpm, nil
+	case "fromBpf":
+		return fromBpf, nil
+	}
+	return 0, fmt.Errorf("invalid ErrorDomain %q", s)
+}
+
+// String returns the name of the ErrorNumber value.
+func (e ErrorNumber) String() string {
+	switch e {
+	case ErrOk:
+		return "ErrOk"
+	case ErrInternalError:
+		return "ErrInternalError"
+	case ErrNoMemory:
+		return "ErrNoMemory"
+	case ErrNoSupport:
+		return "ErrNoSupport"
+	case ErrUnknownHost:
+		return "ErrUnknownHost"
+	case ErrNoConnect:
+		return "ErrNoConnect"
+	case ErrInvalidConn:
+		return "ErrInvalidConn"
+	case ErrInvalidDomain:
+		return "ErrInvalidDomain"
+	case ErrInvalidArg:
+		return "ErrInvalidArg"
+	case ErrOperationFailed:
+		return "ErrOperationFailed"
+	case ErrGetFailed:
+		return "ErrGetFailed"
+	case ErrPostFailed:
+		return "ErrPostFailed"
+	case ErrHTTPError:
+		return "ErrHTTPError"
+	case ErrSexprSerial:
+		return "ErrSexprSerial"
+	case ErrNoXen:
+		return "ErrNoXen"
+	case ErrXenCall:
+		return "ErrXenCall"
+	case ErrOsType:
+		return "ErrOsType"
+	case ErrNoKernel:
+		return "ErrNoKernel"
+	case ErrNoRoot:
+		return "ErrNoRoot"
+	case ErrNoSource:
+		return "ErrNoSource"
+	case ErrNoTarget:
+		return "ErrNoTarget"
+	case ErrNoName:
+		return "ErrNoName"
+	case ErrNoOs:
+		return "ErrNoOs"
+	case ErrNoDevice:
+		return "ErrNoDevice"
+	case ErrNoXenstore:
+		return "ErrNoXenstore"
+	case ErrDriverFull:
+		return "ErrDriverFull"
+	case ErrCallFailed:
+		return "ErrCallFailed"
+	case ErrXMLError:
+		return "ErrXMLError"
+	case ErrDomExist:
+		return "ErrDomExist"
+	case ErrOperationDenied:
+		return "ErrOperationDenied"
+	case ErrOpenFailed:
+		return "ErrOpenFailed"
+	case ErrReadFailed:
+		return "ErrReadFailed"
+	case ErrParseFailed:
+		return "ErrParseFailed"
+	case ErrConfSyntax:
+		return "ErrConfSyntax"
+	case ErrWriteFailed:
+		return "ErrWriteFailed"
+	case ErrXMLDetail:
+		return "ErrXMLDetail"
+	case ErrInvalidNetwork:
+		return "ErrInvalidNetwork"
+	case ErrNetworkExist:
+		return "ErrNetworkExist"
+	case ErrSystemError:
+		return "ErrSystemError"
+	case ErrRPC:
+		return "ErrRPC"
+	case ErrGnutlsError:
+		return "ErrGnutlsError"
+	case WarNoNetwork:
+		return "WarNoNetwork"
+	case ErrNoDomain:
+		return "ErrNoDomain"
+	case ErrNoNetwork:
+		return "ErrNoNetwork"
+	case ErrInvalidMac:
+		return "ErrInvalidMac"
+	case ErrAuthFailed:
+		return "ErrAuthFailed"
+	case ErrInvalidStoragePool:
+		return "ErrInvalidStoragePool"
+	case ErrInvalidStorageVol:
+		return "ErrInvalidStorageVol"
+	case WarNoStorage:
+		return "WarNoStorage"
+	case ErrNoStoragePool:
+		return "ErrNoStoragePool"
+	case ErrNoStorageVol:
+		return "ErrNoStorageVol"
+	case WarNoNode:
+		return "WarNoNode"
+	case ErrInvalidNodeDevice:
+		return "ErrInvalidNodeDevice"
+	case ErrNoNodeDevice:
+		return "ErrNoNodeDevice"
+	case ErrNoSecurityModel:
+		return "ErrNoSecurityModel"
+	case ErrOperationInvalid:
+		return "ErrOperationInvalid"
+	case WarNoInterface:
+		return "WarNoInterface"
+	case ErrNoInterface:
+		return "ErrNoInterface"
+	case ErrInvalidInterface:
+		return "ErrInvalidInterface"
+	case ErrMultipleInterfaces:
+		return "ErrMultipleInterfaces"
+	case WarNoNwfilter:
+		return "WarNoNwfilter"
+	case ErrInvalidNwfilter:
+		return "ErrInvalidNwfilter"
+	case ErrNoNwfilter:
+		return "ErrNoNwfilter"
+	case ErrBuildFirewall:
+		return "ErrBuildFirewall"
+	case WarNoSecret:
+		return "WarNoSecret"
+	case ErrInvalidSecret:
+		return "ErrInvalidSecret"
+	case ErrNoSecret:
+		return "ErrNoSecret"
+	case ErrConfigUnsupported:
+		return "ErrConfigUnsupported"
+	case ErrOperationTimeout:
+		return "ErrOperationTimeout"
+	case ErrMigratePersistFailed:
+		return "ErrMigratePersistFailed"
+	case ErrHookScriptFailed:
+		return "ErrHookScriptFailed"
+	case ErrInvalidDomainSnapshot:
+		return "ErrInvalidDomainSnapshot"
+	case ErrNoDomainSnapshot:
+		return "ErrNoDomainSnapshot"
+	case ErrInvalidStream:
+		return "ErrInvalidStream"
+	case ErrArgumentUnsupported:
+		return "ErrArgumentUnsupported"
+	case ErrStorageProbeFailed:
+		return "ErrStorageProbeFailed"
+	case ErrStoragePoolBuilt:
+		return "ErrStoragePoolBuilt"
+	case ErrSnapshotRevertRisky:
+		return "ErrSnapshotRevertRisky"
+	case ErrOperationAborted:
+		return "ErrOperationAborted"
+	case ErrAuthCancelled:
+		return "ErrAuthCancelled"
+	case ErrNoDomainMetadata:
+		return "ErrNoDomainMetadata"
+	case ErrMigrateUnsafe:
+		return "ErrMigrateUnsafe"
+	case ErrOverflow:
+		return "ErrOverflow"
+	case ErrBlockCopyActive:
+		return "ErrBlockCopyActive"
+	case ErrOperationUnsupported:
+		return "ErrOperationUnsupported"
+	case ErrSSH:
+		return "ErrSSH"
+	case ErrAgentUnresponsive:
+		return "ErrAgentUnresponsive"
+	case ErrResourceBusy:
+		return "ErrResourceBusy"
+	case ErrAccessDenied:
+		return "ErrAccessDenied"
+	case ErrDbusService:
+		return "ErrDbusService"
+	case ErrStorageVolExist:
+		return "ErrStorageVolExist"
+	case ErrCPUIncompatible:
+		return "ErrCPUIncompatible"
+	case ErrXMLInvalidSchema:
+		return "ErrXMLInvalidSchema"
+	case ErrMigrateFinishOk:
+		return "ErrMigrateFinishOk"
+	case ErrAuthUnavailable:
+		return "ErrAuthUnavailable"
+	case ErrNoServer:
+		return "ErrNoServer"
+	case ErrNoClient:
+		return "ErrNoClient"
+	case ErrAgentUnsynced:
+		return "ErrAgentUnsynced"
+	case ErrLibssh:
+		return "ErrLibssh"
+	case ErrDeviceMissing:
+		return "ErrDeviceMissing"
+	case ErrInvalidNwfilterBinding:
+		return "ErrInvalidNwfilterBinding"
+	case ErrNoNwfilterBinding:
+		return "ErrNoNwfilterBinding"
+	case ErrInvalidDomainCheckpoint:
+		return "ErrInvalidDomainCheckpoint"
+	case ErrNoDomainCheckpoint:
+		return "ErrNoDomainCheckpoint"
+	case ErrNoDomainBackup:
+		return "ErrNoDomainBackup"
+	case ErrInvalidNetworkPort:
+		return "ErrInvalidNetworkPort"
+	case ErrNetworkPortExist:
+		return "ErrNetworkPortExist"
+	case ErrNoNetworkPort:
+		return "ErrNoNetworkPort"
+	case ErrNoHostname:
+		return "ErrNoHostname"
+	case ErrCheckpointInconsistent:
+		return "ErrCheckpointInconsistent"
+	case ErrMultipleDomains:
+		return "ErrMultipleDomains"
+	}
+	return fmt.Sprintf("ErrorNumber(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the ErrorNumber values.
+func (e ErrorNumber) IsValid() bool {
+	switch e {
+	case ErrOk,
+		ErrInternalError,
+		ErrNoMemory,
+		ErrNoSupport,
+		ErrUnknownHost,
+		ErrNoConnect,
+		ErrInvalidConn,
+		ErrInvalidDomain,
+		ErrInvalidArg,
+		ErrOperationFailed,
+		ErrGetFailed,
+		ErrPostFailed,
+		ErrHTTPError,
+		ErrSexprSerial,
+		ErrNoXen,
+		ErrXenCall,
+		ErrOsType,
+		ErrNoKernel,
+		ErrNoRoot,
+		ErrNoSource,
+		ErrNoTarget,
+		ErrNoName,
+		ErrNoOs,
+		ErrNoDevice,
+		ErrNoXenstore,
+		ErrDriverFull,
+		ErrCallFailed,
+		ErrXMLError,
+		ErrDomExist,
+		ErrOperationDenied,
+		ErrOpenFailed,
+		ErrReadFailed,
+		ErrParseFailed,
+		ErrConfSyntax,
+		ErrWriteFailed,
+		ErrXMLDetail,
+		ErrInvalidNetwork,
+		ErrNetworkExist,
+		ErrSystemError,
+		ErrRPC,
+		ErrGnutlsError,
+		WarNoNetwork,
+		ErrNoDomain,
+		ErrNoNetwork,
+		ErrInvalidMac,
+		ErrAuthFailed,
+		ErrInvalidStoragePool,
+		ErrInvalidStorageVol,
+		WarNoStorage,
+		ErrNoStoragePool,
+		ErrNoStorageVol,
+		WarNoNode,
+		ErrInvalidNodeDevice,
+		ErrNoNodeDevice,
+		ErrNoSecurityModel,
+		ErrOperationInvalid,
+		WarNoInterface,
+		ErrNoInterface,
+		ErrInvalidInterface,
+		ErrMultipleInterfaces,
+		WarNoNwfilter,
+		ErrInvalidNwfilter,
+		ErrNoNwfilter,
+		ErrBuildFirewall,
+		WarNoSecret,
+		ErrInvalidSecret,
+		ErrNoSecret,
+		ErrConfigUnsupported,
+		ErrOperationTimeout,
+		ErrMigratePersistFailed,
+		ErrHookScriptFailed,
+		ErrInvalidDomainSnapshot,
+		ErrNoDomainSnapshot,
+		ErrInvalidStream,
+		ErrArgumentUnsupported,
+		ErrStorageProbeFailed,
+		ErrStoragePoolBuilt,
+		ErrSnapshotRevertRisky,
+		ErrOperationAborted,
+		ErrAuthCancelled,
+		ErrNoDomainMetadata,
+		ErrMigrateUnsafe,
+		ErrOverflow,
+		ErrBlockCopyActive,
+		ErrOperationUnsupported,
+		ErrSSH,
+		ErrAgentUnresponsive,
+		ErrResourceBusy,
+		ErrAccessDenied,
+		ErrDbusService,
+		ErrStorageVolExist,
+		ErrCPUIncompatible,
+		ErrXMLInvalidSchema,
+		ErrMigrateFinishOk,
+		ErrAuthUnavailable,
+		ErrNoServer,
+		ErrNoClient,
+		ErrAgentUnsynced,
+		ErrLibssh,
+		ErrDeviceMissing,
+		ErrInvalidNwfilterBinding,
+		ErrNoNwfilterBinding,
+		ErrInvalidDomainCheckpoint,
+		ErrNoDomainCheckpoint,
+		ErrNoDomainBackup,
+		ErrInvalidNetworkPort,
+		ErrNetworkPortExist,
+		ErrNoNetworkPort,
+		ErrNoHostname,
+		ErrCheckpointInconsistent,
+		ErrMultipleDomains:
+		return true
+	}
+	return false
+}
+
+// ParseErrorNumber returns the ErrorNumber value with the given name, as returned
+// by String.
+func ParseErrorNumber(s string) (ErrorNumber, error) {
+	switch s {
+	case "ErrOk":
+		return ErrOk, nil
+	case "ErrInternalError":
+		return ErrInternalError, nil
+	case "ErrNoMemory":
+		return ErrNoMemory, nil
+	case "ErrNoSupport":
+		return ErrNoSupport, nil
+	case "ErrUnknownHost":
+		return ErrUnknownHost, nil
+	case "ErrNoConnect":
+		return ErrNoConnect, nil
+	case "ErrInvalidConn":
+		return ErrInvalidConn, nil
+	case "ErrInvalidDomain":
+		return ErrInvalidDomain, nil
+	case "ErrInvalidArg":
+		return ErrInvalidArg, nil
+	case "ErrOperationFailed":
+		return ErrOperationFailed, nil
+	case "ErrGetFailed":
+		return ErrGetFailed, nil
+	case "ErrPostFailed":
+		return ErrPostFailed, nil
+	case "ErrHTTPError":
+		return ErrHTTPError, nil
+	case "ErrSexprSerial":
+		return ErrSexprSerial, nil
+	case "ErrNoXen":
+		return ErrNoXen, nil
+	case "ErrXenCall":
+		return ErrXenCall, nil
+	case "ErrOsType":
+		return ErrOsType, nil
+	case "ErrNoKernel":
+		return ErrNoKernel, nil
+	case "ErrNoRoot":
+		return ErrNoRoot, nil
+	case "ErrNoSource":
+		return ErrNoSource, nil
+	case "ErrNoTarget":
+		return ErrNoTarget, nil
+	case "ErrNoName":
+		return ErrNoName, nil
+	case "ErrNoOs":
+		return ErrNoOs, nil
+	case "ErrNoDevice":
+		return ErrNoDevice, nil
+	case "ErrNoXenstore":
+		return ErrNoXenstore, nil
+	case "ErrDriverFull":
+		return ErrDriverFull, nil
+	case "ErrCallFailed":
+		return ErrCallFailed, nil
+	case "ErrXMLError":
+		return ErrXMLError, nil
+	case "ErrDomExist":
+		return ErrDomExist, nil
+	case "ErrOperationDenied":
+		return ErrOperationDenied, nil
+	case "ErrOpenFailed":
+		return ErrOpenFailed, nil
+	case "ErrReadFailed":
+		return ErrReadFailed, nil
+	case "ErrParseFailed":
+		return ErrParseFailed, nil
+	case "ErrConfSyntax":
+		return ErrConfSyntax, nil
+	case "ErrWriteFailed":
+		return ErrWriteFailed, nil
+	case "ErrXMLDetail":
+		return ErrXMLDetail, nil
+	case "ErrInvalidNetwork":
+		return ErrInvalidNetwork, nil
+	case "ErrNetworkExist":
+		return ErrNetworkExist, nil
+	case "ErrSystemError":
+		return ErrSystemError, nil
+	case "ErrRPC":
+		return ErrRPC, nil
+	case "ErrGnutlsError":
+		return ErrGnutlsError, nil
+	case "WarNoNetwork":
+		return WarNoNetwork, nil
+	case "ErrNoDomain":
+		return ErrNoDomain, nil
+	case "ErrNoNetwork":
+		return ErrNoNetwork, nil
+	case "ErrInvalidMac":
+		return ErrInvalidMac, nil
+	case "ErrAuthFailed":
+		return ErrAuthFailed, nil
+	case "ErrInvalidStoragePool":
+		return ErrInvalidStoragePool, nil
+	case "ErrInvalidStorageVol":
+		return ErrInvalidStorageVol, nil
+	case "WarNoStorage":
+		return WarNoStorage, nil
+	case "ErrNoStoragePool":
+		return ErrNoStoragePool, nil
+	case "ErrNoStorageVol":
+		return ErrNoStorageVol, nil
+	case "WarNoNode":
+		return WarNoNode, nil
+	case "ErrInvalidNodeDevice":
+		return ErrInvalidNodeDevice, nil
+	case "ErrNoNodeDevice":
+		return ErrNoNodeDevice, nil
+	case "ErrNoSecurityModel":
+		return ErrNoSecurityModel, nil
+	case "ErrOperationInvalid":
+		return ErrOperationInvalid, nil
+	case "WarNoInterface":
+		return WarNoInterface, nil
+	case "ErrNoInterface":
+		return ErrNoInterface, nil
+	case "ErrInvalidInterface":
+		return ErrInvalidInterface, nil
+	case "ErrMultipleInterfaces":
+		return ErrMultipleInterfaces, nil
+	case "WarNoNwfilter":
+		return WarNoNwfilter, nil
+	case "ErrInvalidNwfilter":
+		return ErrInvalidNwfilter, nil
+	case "ErrNoNwfilter":
+		return ErrNoNwfilter, nil
+	case "ErrBuildFirewall":
+		return ErrBuildFirewall, nil
+	case "WarNoSecret":
+		return WarNoSecret, nil
+	case "ErrInvalidSecret":
+		return ErrInvalidSecret, nil
+	case "ErrNoSecret":
+		return ErrNoSecret, nil
+	case "ErrConfigUnsupported":
+		return ErrConfigUnsupported, nil
+	case "ErrOperationTimeout":
+		return ErrOperationTimeout, nil
+	case "ErrMigratePersistFailed":
+		return ErrMigratePersistFailed, nil
+	case "ErrHookScriptFailed":
+		return ErrHookScriptFailed, nil
+	case "ErrInvalidDomainSnapshot":
+		return ErrInvalidDomainSnapshot, nil
+	case "ErrNoDomainSnapshot":
+		return ErrNoDomainSnapshot, nil
+	case "ErrInvalidStream":
+		return ErrInvalidStream, nil
+	case "ErrArgumentUnsupported":
+		return ErrArgumentUnsupported, nil
+	case "ErrStorageProbeFailed":
+		return ErrStorageProbeFailed, nil
+	case "ErrStoragePoolBuilt":
+		return ErrStoragePoolBuilt, nil
+	case "ErrSnapshotRevertRisky":
+		return ErrSnapshotRevertRisky, nil
+	case "ErrOperationAborted":
+		return ErrOperationAborted, nil
+	case "ErrAuthCancelled":
+		return ErrAuthCancelled, nil
+	case "ErrNoDomainMetadata":
+		return ErrNoDomainMetadata, nil
+	case "ErrMigrateUnsafe":
+		return ErrMigrateUnsafe, nil
+	case "ErrOverflow":
+		return ErrOverflow, nil
+	case "ErrBlockCopyActive":
+		return ErrBlockCopyActive, nil
+	case "ErrOperationUnsupported":
+		return ErrOperationUnsupported, nil
+	case "ErrSSH":
+		return ErrSSH, nil
+	case "ErrAgentUnresponsive":
+		return ErrAgentUnresponsive, nil
+	case "ErrResourceBusy":
+		return ErrResourceBusy, nil
+	case "ErrAccessDenied":
+		return ErrAccessDenied, nil
+	case "ErrDbusService":
+		return ErrDbusService, nil
+	case "ErrStorageVolExist":
+		return ErrStorageVolExist, nil
+	case "ErrCPUIncompatible":
+		return ErrCPUIncompatible, nil
+	case "ErrXMLInvalidSchema":
+		return ErrXMLInvalidSchema, nil
+	case "ErrMigrateFinishOk":
+		return ErrMigrateFinishOk, nil
+	case "ErrAuthUnavailable":
+		return ErrAuthUnavailable, nil
+	case "ErrNoServer":
+		return ErrNoServer, nil
+	case "ErrNoClient":
+		return ErrNoClient, nil
+	case "ErrAgentUnsynced":
+		return ErrAgentUnsynced, nil
+	case "ErrLibssh":
+		return ErrLibssh, nil
+	case "ErrDeviceMissing":
+		return ErrDeviceMissing, nil
+	case "ErrInvalidNwfilterBinding":
+		return ErrInvalidNwfilterBinding, nil
+	case "ErrNoNwfilterBinding":
+		return ErrNoNwfilterBinding, nil
+	case "ErrInvalidDomainCheckpoint":
+		return ErrInvalidDomainCheckpoint, nil
+	case "ErrNoDomainCheckpoint":
+		return ErrNoDomainCheckpoint, nil
+	case "ErrNoDomainBackup":
+		return ErrNoDomainBackup, nil
+	case "ErrInvalidNetworkPort":
+		return ErrInvalidNetworkPort, nil
+	case "ErrNetworkPortExist":
+		return ErrNetworkPortExist, nil
+	case "ErrNoNetworkPort":
+		return ErrNoNetworkPort, nil
+	case "ErrNoHostname":
+		return ErrNoHostname, nil
+	case "ErrCheckpointInconsistent":
+		return ErrCheckpointInconsistent, nil
+	case "ErrMultipleDomains":
+		return ErrMultipleDomains, nil
+	}
+	return 0, fmt.Errorf("invalid ErrorNumber %q", s)
+}
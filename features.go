@@ -0,0 +1,218 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+)
+
+// Feature is a driver feature which can be checked with SupportsFeature;
+// libvirt's virDrvFeature.
+type Feature int32
+
+// Features known to libvirt.
+const (
+	FeatureMigrationV1                  Feature = 1
+	FeatureRemote                       Feature = 2
+	FeatureMigrationV2                  Feature = 3
+	FeatureMigrationP2P                 Feature = 4
+	FeatureMigrationDirect              Feature = 5
+	FeatureMigrationV3                  Feature = 6
+	FeatureMigrateChangeProtection      Feature = 7
+	FeatureFDPassing                    Feature = 8
+	FeatureTypedParamString             Feature = 9
+	FeatureProgramKeepAlive             Feature = constants.FeatureProgramKeepAlive
+	FeatureXMLMigratable                Feature = 11
+	FeatureMigrationOffline             Feature = 12
+	FeatureMigrationParams              Feature = 13
+	FeatureRemoteEventCallback          Feature = 14
+	FeatureRemoteCloseCallback          Feature = 15
+	FeatureNetworkUpdateHasCorrectOrder Feature = 16
+)
+
+// procKey identifies a procedure of one of libvirt's programs.
+type procKey struct {
+	program, proc uint32
+}
+
+// SupportsFeature reports whether the connected driver supports a feature.
+// The answer is cached until the client next connects.
+func (l *Libvirt) SupportsFeature(f Feature) (bool, error) {
+	r := l.root()
+	r.fmux.Lock()
+	supported, ok := r.features[f]
+	r.fmux.Unlock()
+	if ok {
+		return supported, nil
+	}
+
+	res, err := l.ConnectSupportsFeature(int32(f))
+	if err != nil {
+		return false, err
+	}
+	supported = res != 0
+
+	r.fmux.Lock()
+	if r.features == nil {
+		r.features = make(map[Feature]bool)
+	}
+	r.features[f] = supported
+	r.fmux.Unlock()
+
+	return supported, nil
+}
+
+// SupportsProc reports whether the connected daemon supports the procedure
+// called by a Libvirt method, given by name, e.g. "DomainGetGuestInfo" or
+// "QEMUDomainAgentCommand". libvirt has no way to ask which procedures it
+// supports, so for the remote program the answer is worked out from the
+// daemon's version: procedures are numbered in the order they were added, and
+// procVersions records the release which added some of them. A procedure
+// added in a release the daemon may or may not have is reported as supported
+// until a call to it fails with ErrUnsupported, which older daemons return
+// for procedures they don't know. The answer is forgotten when the client
+// next connects.
+func (l *Libvirt) SupportsProc(method string) (bool, error) {
+	key, ok := methodProc(method)
+	if !ok {
+		return false, fmt.Errorf("unknown method %q", method)
+	}
+
+	r := l.root()
+	r.fmux.Lock()
+	unsupported := r.unsupported[key]
+	r.fmux.Unlock()
+	if unsupported || key.program != constants.Program {
+		return !unsupported, nil
+	}
+
+	v, err := l.libVersion()
+	if err != nil {
+		return false, err
+	}
+	return v >= procMinVersion(key.proc), nil
+}
+
+// methodProc returns the procedure called by a Libvirt method.
+func methodProc(method string) (procKey, bool) {
+	for _, p := range []struct {
+		program uint32
+		methods map[string]uint32
+	}{
+		{constants.Program, constants.ProcedureMethods},
+		{constants.QEMUProgram, constants.QEMUProcedureMethods},
+		{constants.LXCProgram, constants.LXCProcedureMethods},
+	} {
+		if proc, ok := p.methods[method]; ok {
+			return procKey{p.program, proc}, true
+		}
+	}
+	return procKey{}, false
+}
+
+// procVersions lists the libvirt release which added some of the remote
+// program's procedures, in procedure order.
+var procVersions = []struct {
+	proc    uint32
+	version VersionNumber
+}{
+	{constants.ProcConnectGetAllDomainStats, NewVersionNumber(1, 2, 8)},
+	{constants.ProcDomainGetFsinfo, NewVersionNumber(1, 2, 11)},
+	{constants.ProcDomainRename, NewVersionNumber(1, 2, 19)},
+	{constants.ProcDomainMigrateStartPostCopy, NewVersionNumber(1, 3, 3)},
+	{constants.ProcDomainSetPerfEvents, NewVersionNumber(1, 3, 3)},
+	{constants.ProcConnectStoragePoolEventRegisterAny, NewVersionNumber(2, 0, 0)},
+	{constants.ProcDomainGetGuestVcpus, NewVersionNumber(2, 0, 0)},
+	{constants.ProcConnectSecretEventRegisterAny, NewVersionNumber(3, 0, 0)},
+	{constants.ProcDomainSetVcpu, NewVersionNumber(3, 1, 0)},
+	{constants.ProcDomainSetBlockThreshold, NewVersionNumber(3, 2, 0)},
+	{constants.ProcDomainSetLifecycleAction, NewVersionNumber(3, 9, 0)},
+	{constants.ProcDomainDetachDeviceAlias, NewVersionNumber(4, 4, 0)},
+	{constants.ProcConnectCompareHypervisorCPU, NewVersionNumber(4, 4, 0)},
+	{constants.ProcNodeGetSevInfo, NewVersionNumber(4, 5, 0)},
+	{constants.ProcConnectListAllNwfilterBindings, NewVersionNumber(4, 5, 0)},
+	{constants.ProcDomainSetIothreadParams, NewVersionNumber(4, 10, 0)},
+	{constants.ProcNetworkPortCreateXML, NewVersionNumber(5, 5, 0)},
+	{constants.ProcDomainCheckpointCreateXML, NewVersionNumber(5, 6, 0)},
+	{constants.ProcDomainGetGuestInfo, NewVersionNumber(5, 7, 0)},
+	{constants.ProcConnectSetIdentity, NewVersionNumber(5, 8, 0)},
+	{constants.ProcDomainAgentSetResponseTimeout, NewVersionNumber(5, 10, 0)},
+	{constants.ProcDomainBackupBegin, NewVersionNumber(6, 0, 0)},
+	{constants.ProcDomainEventMemoryFailure, NewVersionNumber(6, 9, 0)},
+	{constants.ProcDomainAuthorizedSshKeysGet, NewVersionNumber(6, 10, 0)},
+	{constants.ProcDomainGetMessages, NewVersionNumber(7, 1, 0)},
+}
+
+// procMinVersion returns the earliest libvirt release which can have added a
+// remote procedure: the release which added the closest procedure at or below
+// it in procVersions, or 0 if there's none.
+func procMinVersion(proc uint32) VersionNumber {
+	var v VersionNumber
+	for _, pv := range procVersions {
+		if pv.proc > proc {
+			break
+		}
+		v = pv.version
+	}
+	return v
+}
+
+// libVersion returns the daemon's libvirt version, which is cached until the
+// client next connects.
+func (l *Libvirt) libVersion() (VersionNumber, error) {
+	r := l.root()
+	r.fmux.Lock()
+	v := r.version
+	r.fmux.Unlock()
+	if v != 0 {
+		return v, nil
+	}
+
+	ver, err := l.ConnectGetLibVersion()
+	if err != nil {
+		return 0, err
+	}
+	v = VersionNumber(ver)
+
+	r.fmux.Lock()
+	r.version = v
+	r.fmux.Unlock()
+
+	return v, nil
+}
+
+// markUnsupported records that the daemon rejected a procedure as unknown.
+func (l *Libvirt) markUnsupported(program, proc uint32) {
+	l.fmux.Lock()
+	defer l.fmux.Unlock()
+
+	if l.unsupported == nil {
+		l.unsupported = make(map[procKey]bool)
+	}
+	l.unsupported[procKey{program, proc}] = true
+}
+
+// resetSupport forgets what's known about the daemon's features and
+// procedures, before connecting to a daemon which may be a different version.
+func (l *Libvirt) resetSupport() {
+	l.fmux.Lock()
+	defer l.fmux.Unlock()
+
+	l.features = nil
+	l.unsupported = nil
+	l.version = 0
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestSupportsFeature(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&ConnectSupportsFeatureRet{Supported: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectSupportsFeature, payload)
+
+	for i := 0; i < 2; i++ {
+		ok, err := l.SupportsFeature(FeatureMigrationParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Errorf("expected feature to be supported")
+		}
+	}
+
+	var calls int
+	for _, req := range dialer.Requests() {
+		if req.Header.Procedure == constants.ProcConnectSupportsFeature {
+			calls++
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call to ConnectSupportsFeature, got %d", calls)
+	}
+}
+
+func TestSupportsProc(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	// The mock daemon is libvirt 1.3.4.
+	tests := []struct {
+		method    string
+		supported bool
+	}{
+		{"DomainGetInfo", true},
+		{"ConnectGetAllDomainStats", true}, // 1.2.8
+		{"DomainSetPerfEvents", true},      // 1.3.3
+		// Between procedures added in 1.3.3 and 2.0.0, so it may be there.
+		{"DomainEventCallbackDeviceRemovalFailed", true},
+		// After a procedure added in 2.0.0.
+		{"ConnectStoragePoolEventDeregisterAny", false},
+		{"DomainGetGuestInfo", false}, // 5.7.0
+		{"QEMUDomainAgentCommand", true},
+	}
+	for _, tt := range tests {
+		ok, err := l.SupportsProc(tt.method)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.method, err)
+		}
+		if ok != tt.supported {
+			t.Errorf("%s: expected supported %v, got %v", tt.method, tt.supported, ok)
+		}
+	}
+
+	var calls int
+	for _, req := range dialer.Requests() {
+		if req.Header.Procedure == constants.ProcConnectGetLibVersion {
+			calls++
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call to ConnectGetLibVersion, got %d", calls)
+	}
+
+	if _, err := l.SupportsProc("NoSuchMethod"); err == nil {
+		t.Error("expected an error for an unknown method")
+	}
+}
+
+func TestSupportsProcUnsupported(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	proc := uint32(constants.ProcDomainGetPerfEvents)
+	payload, err := encode(&struct {
+		Code     uint32
+		DomainID uint32
+		Padding  uint8
+		Message  string
+		Level    uint32
+	}{uint32(ErrCallFailed), uint32(fromRPC), 1, "unknown procedure: 365", 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueError(constants.Program, proc, payload)
+
+	if _, err := l.DomainGetPerfEvents(Domain{Name: "test"}, 0); err != ErrUnsupported {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+	if ok, err := l.SupportsProc("DomainGetPerfEvents"); err != nil || ok {
+		t.Errorf("expected procedure to be unsupported once libvirt rejected it, got %v, error %v", ok, err)
+	}
+	if ok, err := l.SupportsProc("DomainGetInfo"); err != nil || !ok {
+		t.Errorf("expected other procedures to be unaffected, got %v, error %v", ok, err)
+	}
+}
@@ -0,0 +1,1135 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// Code generated by internal/lvgen/generate.go. DO NOT EDIT.
+//
+// To regenerate, run 'go generate' in internal/lvgen.
+//
+
+package libvirt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// flagName is the name of one bit of a flag type.
+type flagName struct {
+	bit  uint32
+	name string
+}
+
+// flagString names the bits set in v, joined by "|". Bits without a name are
+// shown in hex, and 0 is shown as zero.
+func flagString(v uint32, zero string, names []flagName) string {
+	if v == 0 {
+		return zero
+	}
+	var parts []string
+	for _, n := range names {
+		if v&n.bit != 0 {
+			parts = append(parts, n.name)
+			v &^= n.bit
+		}
+	}
+	if v != 0 {
+		parts = append(parts, fmt.Sprintf("%#x", v))
+	}
+	return strings.Join(parts, "|")
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f TypedParameterFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(TypedParamStringOkay), "TypedParamStringOkay"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f TypedParameterFlags) Has(flag TypedParameterFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectRo), "ConnectRo"},
+		{uint32(ConnectNoAliases), "ConnectNoAliases"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectFlags) Has(flag ConnectFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectCompareCPUFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectCompareCPUFailIncompatible), "ConnectCompareCPUFailIncompatible"},
+		{uint32(ConnectCompareCPUValidateXML), "ConnectCompareCPUValidateXML"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectCompareCPUFlags) Has(flag ConnectCompareCPUFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectBaselineCPUFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectBaselineCPUExpandFeatures), "ConnectBaselineCPUExpandFeatures"},
+		{uint32(ConnectBaselineCPUMigratable), "ConnectBaselineCPUMigratable"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectBaselineCPUFlags) Has(flag ConnectBaselineCPUFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f NodeAllocPagesFlags) String() string {
+	return flagString(uint32(f), "NodeAllocPagesAdd", []flagName{
+		{uint32(NodeAllocPagesSet), "NodeAllocPagesSet"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f NodeAllocPagesFlags) Has(flag NodeAllocPagesFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCreateFlags) String() string {
+	return flagString(uint32(f), "DomainNone", []flagName{
+		{uint32(DomainStartPaused), "DomainStartPaused"},
+		{uint32(DomainStartAutodestroy), "DomainStartAutodestroy"},
+		{uint32(DomainStartBypassCache), "DomainStartBypassCache"},
+		{uint32(DomainStartForceBoot), "DomainStartForceBoot"},
+		{uint32(DomainStartValidate), "DomainStartValidate"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCreateFlags) Has(flag DomainCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCoreDumpFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DumpCrash), "DumpCrash"},
+		{uint32(DumpLive), "DumpLive"},
+		{uint32(DumpBypassCache), "DumpBypassCache"},
+		{uint32(DumpReset), "DumpReset"},
+		{uint32(DumpMemoryOnly), "DumpMemoryOnly"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCoreDumpFlags) Has(flag DomainCoreDumpFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainMigrateFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(MigrateLive), "MigrateLive"},
+		{uint32(MigratePeer2peer), "MigratePeer2peer"},
+		{uint32(MigrateTunnelled), "MigrateTunnelled"},
+		{uint32(MigratePersistDest), "MigratePersistDest"},
+		{uint32(MigrateUndefineSource), "MigrateUndefineSource"},
+		{uint32(MigratePaused), "MigratePaused"},
+		{uint32(MigrateNonSharedDisk), "MigrateNonSharedDisk"},
+		{uint32(MigrateNonSharedInc), "MigrateNonSharedInc"},
+		{uint32(MigrateChangeProtection), "MigrateChangeProtection"},
+		{uint32(MigrateUnsafe), "MigrateUnsafe"},
+		{uint32(MigrateOffline), "MigrateOffline"},
+		{uint32(MigrateCompressed), "MigrateCompressed"},
+		{uint32(MigrateAbortOnError), "MigrateAbortOnError"},
+		{uint32(MigrateAutoConverge), "MigrateAutoConverge"},
+		{uint32(MigrateRdmaPinAll), "MigrateRdmaPinAll"},
+		{uint32(MigratePostcopy), "MigratePostcopy"},
+		{uint32(MigrateTLS), "MigrateTLS"},
+		{uint32(MigrateParallel), "MigrateParallel"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainMigrateFlags) Has(flag DomainMigrateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainMigrateMaxSpeedFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainMigrateMaxSpeedPostcopy), "DomainMigrateMaxSpeedPostcopy"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainMigrateMaxSpeedFlags) Has(flag DomainMigrateMaxSpeedFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainShutdownFlagValues) String() string {
+	return flagString(uint32(f), "DomainShutdownDefault", []flagName{
+		{uint32(DomainShutdownAcpiPowerBtn), "DomainShutdownAcpiPowerBtn"},
+		{uint32(DomainShutdownGuestAgent), "DomainShutdownGuestAgent"},
+		{uint32(DomainShutdownInitctl), "DomainShutdownInitctl"},
+		{uint32(DomainShutdownSignal), "DomainShutdownSignal"},
+		{uint32(DomainShutdownParavirt), "DomainShutdownParavirt"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainShutdownFlagValues) Has(flag DomainShutdownFlagValues) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainRebootFlagValues) String() string {
+	return flagString(uint32(f), "DomainRebootDefault", []flagName{
+		{uint32(DomainRebootAcpiPowerBtn), "DomainRebootAcpiPowerBtn"},
+		{uint32(DomainRebootGuestAgent), "DomainRebootGuestAgent"},
+		{uint32(DomainRebootInitctl), "DomainRebootInitctl"},
+		{uint32(DomainRebootSignal), "DomainRebootSignal"},
+		{uint32(DomainRebootParavirt), "DomainRebootParavirt"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainRebootFlagValues) Has(flag DomainRebootFlagValues) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainDestroyFlagsValues) String() string {
+	return flagString(uint32(f), "DomainDestroyDefault", []flagName{
+		{uint32(DomainDestroyGraceful), "DomainDestroyGraceful"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainDestroyFlagsValues) Has(flag DomainDestroyFlagsValues) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSaveRestoreFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSaveBypassCache), "DomainSaveBypassCache"},
+		{uint32(DomainSaveRunning), "DomainSaveRunning"},
+		{uint32(DomainSavePaused), "DomainSavePaused"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSaveRestoreFlags) Has(flag DomainSaveRestoreFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainMemoryModFlags) String() string {
+	return flagString(uint32(f), "DomainMemCurrent", []flagName{
+		{uint32(DomainMemLive), "DomainMemLive"},
+		{uint32(DomainMemConfig), "DomainMemConfig"},
+		{uint32(DomainMemMaximum), "DomainMemMaximum"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainMemoryModFlags) Has(flag DomainMemoryModFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainGetHostnameFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainGetHostnameLease), "DomainGetHostnameLease"},
+		{uint32(DomainGetHostnameAgent), "DomainGetHostnameAgent"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainGetHostnameFlags) Has(flag DomainGetHostnameFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainXMLSecure), "DomainXMLSecure"},
+		{uint32(DomainXMLInactive), "DomainXMLInactive"},
+		{uint32(DomainXMLUpdateCPU), "DomainXMLUpdateCPU"},
+		{uint32(DomainXMLMigratable), "DomainXMLMigratable"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainXMLFlags) Has(flag DomainXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSaveImageXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSaveImageXMLSecure), "DomainSaveImageXMLSecure"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSaveImageXMLFlags) Has(flag DomainSaveImageXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockResizeFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockResizeBytes), "DomainBlockResizeBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockResizeFlags) Has(flag DomainBlockResizeFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainMemoryFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(MemoryVirtual), "MemoryVirtual"},
+		{uint32(MemoryPhysical), "MemoryPhysical"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainMemoryFlags) Has(flag DomainMemoryFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainDefineFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainDefineValidate), "DomainDefineValidate"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainDefineFlags) Has(flag DomainDefineFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainUndefineFlagsValues) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainUndefineManagedSave), "DomainUndefineManagedSave"},
+		{uint32(DomainUndefineSnapshotsMetadata), "DomainUndefineSnapshotsMetadata"},
+		{uint32(DomainUndefineNvram), "DomainUndefineNvram"},
+		{uint32(DomainUndefineKeepNvram), "DomainUndefineKeepNvram"},
+		{uint32(DomainUndefineCheckpointsMetadata), "DomainUndefineCheckpointsMetadata"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainUndefineFlagsValues) Has(flag DomainUndefineFlagsValues) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllDomainsFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListDomainsActive), "ConnectListDomainsActive"},
+		{uint32(ConnectListDomainsInactive), "ConnectListDomainsInactive"},
+		{uint32(ConnectListDomainsPersistent), "ConnectListDomainsPersistent"},
+		{uint32(ConnectListDomainsTransient), "ConnectListDomainsTransient"},
+		{uint32(ConnectListDomainsRunning), "ConnectListDomainsRunning"},
+		{uint32(ConnectListDomainsPaused), "ConnectListDomainsPaused"},
+		{uint32(ConnectListDomainsShutoff), "ConnectListDomainsShutoff"},
+		{uint32(ConnectListDomainsOther), "ConnectListDomainsOther"},
+		{uint32(ConnectListDomainsManagedsave), "ConnectListDomainsManagedsave"},
+		{uint32(ConnectListDomainsNoManagedsave), "ConnectListDomainsNoManagedsave"},
+		{uint32(ConnectListDomainsAutostart), "ConnectListDomainsAutostart"},
+		{uint32(ConnectListDomainsNoAutostart), "ConnectListDomainsNoAutostart"},
+		{uint32(ConnectListDomainsHasSnapshot), "ConnectListDomainsHasSnapshot"},
+		{uint32(ConnectListDomainsNoSnapshot), "ConnectListDomainsNoSnapshot"},
+		{uint32(ConnectListDomainsHasCheckpoint), "ConnectListDomainsHasCheckpoint"},
+		{uint32(ConnectListDomainsNoCheckpoint), "ConnectListDomainsNoCheckpoint"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllDomainsFlags) Has(flag ConnectListAllDomainsFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainVCPUFlags) String() string {
+	return flagString(uint32(f), "DomainVCPUCurrent", []flagName{
+		{uint32(DomainVCPULive), "DomainVCPULive"},
+		{uint32(DomainVCPUConfig), "DomainVCPUConfig"},
+		{uint32(DomainVCPUMaximum), "DomainVCPUMaximum"},
+		{uint32(DomainVCPUGuest), "DomainVCPUGuest"},
+		{uint32(DomainVCPUHotpluggable), "DomainVCPUHotpluggable"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainVCPUFlags) Has(flag DomainVCPUFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainDeviceModifyFlags) String() string {
+	return flagString(uint32(f), "DomainDeviceModifyCurrent", []flagName{
+		{uint32(DomainDeviceModifyLive), "DomainDeviceModifyLive"},
+		{uint32(DomainDeviceModifyConfig), "DomainDeviceModifyConfig"},
+		{uint32(DomainDeviceModifyForce), "DomainDeviceModifyForce"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainDeviceModifyFlags) Has(flag DomainDeviceModifyFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainStatsTypes) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainStatsState), "DomainStatsState"},
+		{uint32(DomainStatsCPUTotal), "DomainStatsCPUTotal"},
+		{uint32(DomainStatsBalloon), "DomainStatsBalloon"},
+		{uint32(DomainStatsVCPU), "DomainStatsVCPU"},
+		{uint32(DomainStatsInterface), "DomainStatsInterface"},
+		{uint32(DomainStatsBlock), "DomainStatsBlock"},
+		{uint32(DomainStatsPerf), "DomainStatsPerf"},
+		{uint32(DomainStatsIothread), "DomainStatsIothread"},
+		{uint32(DomainStatsMemory), "DomainStatsMemory"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainStatsTypes) Has(flag DomainStatsTypes) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockJobAbortFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockJobAbortAsync), "DomainBlockJobAbortAsync"},
+		{uint32(DomainBlockJobAbortPivot), "DomainBlockJobAbortPivot"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockJobAbortFlags) Has(flag DomainBlockJobAbortFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockJobInfoFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockJobInfoBandwidthBytes), "DomainBlockJobInfoBandwidthBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockJobInfoFlags) Has(flag DomainBlockJobInfoFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockJobSetSpeedFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockJobSpeedBandwidthBytes), "DomainBlockJobSpeedBandwidthBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockJobSetSpeedFlags) Has(flag DomainBlockJobSetSpeedFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockPullFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockPullBandwidthBytes), "DomainBlockPullBandwidthBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockPullFlags) Has(flag DomainBlockPullFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockRebaseFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockRebaseShallow), "DomainBlockRebaseShallow"},
+		{uint32(DomainBlockRebaseReuseExt), "DomainBlockRebaseReuseExt"},
+		{uint32(DomainBlockRebaseCopyRaw), "DomainBlockRebaseCopyRaw"},
+		{uint32(DomainBlockRebaseCopy), "DomainBlockRebaseCopy"},
+		{uint32(DomainBlockRebaseRelative), "DomainBlockRebaseRelative"},
+		{uint32(DomainBlockRebaseCopyDev), "DomainBlockRebaseCopyDev"},
+		{uint32(DomainBlockRebaseBandwidthBytes), "DomainBlockRebaseBandwidthBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockRebaseFlags) Has(flag DomainBlockRebaseFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockCopyFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockCopyShallow), "DomainBlockCopyShallow"},
+		{uint32(DomainBlockCopyReuseExt), "DomainBlockCopyReuseExt"},
+		{uint32(DomainBlockCopyTransientJob), "DomainBlockCopyTransientJob"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockCopyFlags) Has(flag DomainBlockCopyFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBlockCommitFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBlockCommitShallow), "DomainBlockCommitShallow"},
+		{uint32(DomainBlockCommitDelete), "DomainBlockCommitDelete"},
+		{uint32(DomainBlockCommitActive), "DomainBlockCommitActive"},
+		{uint32(DomainBlockCommitRelative), "DomainBlockCommitRelative"},
+		{uint32(DomainBlockCommitBandwidthBytes), "DomainBlockCommitBandwidthBytes"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBlockCommitFlags) Has(flag DomainBlockCommitFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainMemoryFailureFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainMemoryFailureActionRequired), "DomainMemoryFailureActionRequired"},
+		{uint32(DomainMemoryFailureRecursive), "DomainMemoryFailureRecursive"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainMemoryFailureFlags) Has(flag DomainMemoryFailureFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainGetJobStatsFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainJobStatsCompleted), "DomainJobStatsCompleted"},
+		{uint32(DomainJobStatsKeepCompleted), "DomainJobStatsKeepCompleted"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainGetJobStatsFlags) Has(flag DomainGetJobStatsFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainConsoleFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainConsoleForce), "DomainConsoleForce"},
+		{uint32(DomainConsoleSafe), "DomainConsoleSafe"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainConsoleFlags) Has(flag DomainConsoleFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainChannelFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainChannelForce), "DomainChannelForce"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainChannelFlags) Has(flag DomainChannelFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainOpenGraphicsFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainOpenGraphicsSkipauth), "DomainOpenGraphicsSkipauth"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainOpenGraphicsFlags) Has(flag DomainOpenGraphicsFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSetTimeFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainTimeSync), "DomainTimeSync"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSetTimeFlags) Has(flag DomainSetTimeFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSetUserPasswordFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainPasswordEncrypted), "DomainPasswordEncrypted"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSetUserPasswordFlags) Has(flag DomainSetUserPasswordFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainGuestInfoTypes) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainGuestInfoUsers), "DomainGuestInfoUsers"},
+		{uint32(DomainGuestInfoOs), "DomainGuestInfoOs"},
+		{uint32(DomainGuestInfoTimezone), "DomainGuestInfoTimezone"},
+		{uint32(DomainGuestInfoHostname), "DomainGuestInfoHostname"},
+		{uint32(DomainGuestInfoFilesystem), "DomainGuestInfoFilesystem"},
+		{uint32(DomainGuestInfoDisks), "DomainGuestInfoDisks"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainGuestInfoTypes) Has(flag DomainGuestInfoTypes) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainBackupBeginFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainBackupBeginReuseExternal), "DomainBackupBeginReuseExternal"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainBackupBeginFlags) Has(flag DomainBackupBeginFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainAuthorizedSSHKeysSetFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainAuthorizedSSHKeysSetAppend), "DomainAuthorizedSSHKeysSetAppend"},
+		{uint32(DomainAuthorizedSSHKeysSetRemove), "DomainAuthorizedSSHKeysSetRemove"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainAuthorizedSSHKeysSetFlags) Has(flag DomainAuthorizedSSHKeysSetFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCheckpointCreateFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainCheckpointCreateRedefine), "DomainCheckpointCreateRedefine"},
+		{uint32(DomainCheckpointCreateQuiesce), "DomainCheckpointCreateQuiesce"},
+		{uint32(DomainCheckpointCreateRedefineValidate), "DomainCheckpointCreateRedefineValidate"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCheckpointCreateFlags) Has(flag DomainCheckpointCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCheckpointXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainCheckpointXMLSecure), "DomainCheckpointXMLSecure"},
+		{uint32(DomainCheckpointXMLNoDomain), "DomainCheckpointXMLNoDomain"},
+		{uint32(DomainCheckpointXMLSize), "DomainCheckpointXMLSize"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCheckpointXMLFlags) Has(flag DomainCheckpointXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCheckpointListFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainCheckpointListRoots), "DomainCheckpointListRoots"},
+		{uint32(DomainCheckpointListTopological), "DomainCheckpointListTopological"},
+		{uint32(DomainCheckpointListLeaves), "DomainCheckpointListLeaves"},
+		{uint32(DomainCheckpointListNoLeaves), "DomainCheckpointListNoLeaves"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCheckpointListFlags) Has(flag DomainCheckpointListFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainCheckpointDeleteFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainCheckpointDeleteChildren), "DomainCheckpointDeleteChildren"},
+		{uint32(DomainCheckpointDeleteMetadataOnly), "DomainCheckpointDeleteMetadataOnly"},
+		{uint32(DomainCheckpointDeleteChildrenOnly), "DomainCheckpointDeleteChildrenOnly"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainCheckpointDeleteFlags) Has(flag DomainCheckpointDeleteFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSnapshotCreateFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSnapshotCreateRedefine), "DomainSnapshotCreateRedefine"},
+		{uint32(DomainSnapshotCreateCurrent), "DomainSnapshotCreateCurrent"},
+		{uint32(DomainSnapshotCreateNoMetadata), "DomainSnapshotCreateNoMetadata"},
+		{uint32(DomainSnapshotCreateHalt), "DomainSnapshotCreateHalt"},
+		{uint32(DomainSnapshotCreateDiskOnly), "DomainSnapshotCreateDiskOnly"},
+		{uint32(DomainSnapshotCreateReuseExt), "DomainSnapshotCreateReuseExt"},
+		{uint32(DomainSnapshotCreateQuiesce), "DomainSnapshotCreateQuiesce"},
+		{uint32(DomainSnapshotCreateAtomic), "DomainSnapshotCreateAtomic"},
+		{uint32(DomainSnapshotCreateLive), "DomainSnapshotCreateLive"},
+		{uint32(DomainSnapshotCreateValidate), "DomainSnapshotCreateValidate"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSnapshotCreateFlags) Has(flag DomainSnapshotCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSnapshotXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSnapshotXMLSecure), "DomainSnapshotXMLSecure"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSnapshotXMLFlags) Has(flag DomainSnapshotXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSnapshotListFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSnapshotListRoots), "DomainSnapshotListRoots"},
+		{uint32(DomainSnapshotListMetadata), "DomainSnapshotListMetadata"},
+		{uint32(DomainSnapshotListLeaves), "DomainSnapshotListLeaves"},
+		{uint32(DomainSnapshotListNoLeaves), "DomainSnapshotListNoLeaves"},
+		{uint32(DomainSnapshotListNoMetadata), "DomainSnapshotListNoMetadata"},
+		{uint32(DomainSnapshotListInactive), "DomainSnapshotListInactive"},
+		{uint32(DomainSnapshotListActive), "DomainSnapshotListActive"},
+		{uint32(DomainSnapshotListDiskOnly), "DomainSnapshotListDiskOnly"},
+		{uint32(DomainSnapshotListInternal), "DomainSnapshotListInternal"},
+		{uint32(DomainSnapshotListExternal), "DomainSnapshotListExternal"},
+		{uint32(DomainSnapshotListTopological), "DomainSnapshotListTopological"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSnapshotListFlags) Has(flag DomainSnapshotListFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSnapshotRevertFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSnapshotRevertRunning), "DomainSnapshotRevertRunning"},
+		{uint32(DomainSnapshotRevertPaused), "DomainSnapshotRevertPaused"},
+		{uint32(DomainSnapshotRevertForce), "DomainSnapshotRevertForce"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSnapshotRevertFlags) Has(flag DomainSnapshotRevertFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f DomainSnapshotDeleteFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(DomainSnapshotDeleteChildren), "DomainSnapshotDeleteChildren"},
+		{uint32(DomainSnapshotDeleteMetadataOnly), "DomainSnapshotDeleteMetadataOnly"},
+		{uint32(DomainSnapshotDeleteChildrenOnly), "DomainSnapshotDeleteChildrenOnly"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f DomainSnapshotDeleteFlags) Has(flag DomainSnapshotDeleteFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f EventHandleType) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(EventHandleReadable), "EventHandleReadable"},
+		{uint32(EventHandleWritable), "EventHandleWritable"},
+		{uint32(EventHandleError), "EventHandleError"},
+		{uint32(EventHandleHangup), "EventHandleHangup"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f EventHandleType) Has(flag EventHandleType) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllInterfacesFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListInterfacesInactive), "ConnectListInterfacesInactive"},
+		{uint32(ConnectListInterfacesActive), "ConnectListInterfacesActive"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllInterfacesFlags) Has(flag ConnectListAllInterfacesFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f InterfaceXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(InterfaceXMLInactive), "InterfaceXMLInactive"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f InterfaceXMLFlags) Has(flag InterfaceXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f NetworkXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(NetworkXMLInactive), "NetworkXMLInactive"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f NetworkXMLFlags) Has(flag NetworkXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllNetworksFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListNetworksInactive), "ConnectListNetworksInactive"},
+		{uint32(ConnectListNetworksActive), "ConnectListNetworksActive"},
+		{uint32(ConnectListNetworksPersistent), "ConnectListNetworksPersistent"},
+		{uint32(ConnectListNetworksTransient), "ConnectListNetworksTransient"},
+		{uint32(ConnectListNetworksAutostart), "ConnectListNetworksAutostart"},
+		{uint32(ConnectListNetworksNoAutostart), "ConnectListNetworksNoAutostart"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllNetworksFlags) Has(flag ConnectListAllNetworksFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f NetworkUpdateFlags) String() string {
+	return flagString(uint32(f), "NetworkUpdateAffectCurrent", []flagName{
+		{uint32(NetworkUpdateAffectLive), "NetworkUpdateAffectLive"},
+		{uint32(NetworkUpdateAffectConfig), "NetworkUpdateAffectConfig"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f NetworkUpdateFlags) Has(flag NetworkUpdateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f NetworkPortCreateFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(NetworkPortCreateReclaim), "NetworkPortCreateReclaim"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f NetworkPortCreateFlags) Has(flag NetworkPortCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllNodeDeviceFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListNodeDevicesCapSystem), "ConnectListNodeDevicesCapSystem"},
+		{uint32(ConnectListNodeDevicesCapPciDev), "ConnectListNodeDevicesCapPciDev"},
+		{uint32(ConnectListNodeDevicesCapUsbDev), "ConnectListNodeDevicesCapUsbDev"},
+		{uint32(ConnectListNodeDevicesCapUsbInterface), "ConnectListNodeDevicesCapUsbInterface"},
+		{uint32(ConnectListNodeDevicesCapNet), "ConnectListNodeDevicesCapNet"},
+		{uint32(ConnectListNodeDevicesCapScsiHost), "ConnectListNodeDevicesCapScsiHost"},
+		{uint32(ConnectListNodeDevicesCapScsiTarget), "ConnectListNodeDevicesCapScsiTarget"},
+		{uint32(ConnectListNodeDevicesCapScsi), "ConnectListNodeDevicesCapScsi"},
+		{uint32(ConnectListNodeDevicesCapStorage), "ConnectListNodeDevicesCapStorage"},
+		{uint32(ConnectListNodeDevicesCapFcHost), "ConnectListNodeDevicesCapFcHost"},
+		{uint32(ConnectListNodeDevicesCapVports), "ConnectListNodeDevicesCapVports"},
+		{uint32(ConnectListNodeDevicesCapScsiGeneric), "ConnectListNodeDevicesCapScsiGeneric"},
+		{uint32(ConnectListNodeDevicesCapDrm), "ConnectListNodeDevicesCapDrm"},
+		{uint32(ConnectListNodeDevicesCapMdevTypes), "ConnectListNodeDevicesCapMdevTypes"},
+		{uint32(ConnectListNodeDevicesCapMdev), "ConnectListNodeDevicesCapMdev"},
+		{uint32(ConnectListNodeDevicesCapCcwDev), "ConnectListNodeDevicesCapCcwDev"},
+		{uint32(ConnectListNodeDevicesCapCssDev), "ConnectListNodeDevicesCapCssDev"},
+		{uint32(ConnectListNodeDevicesCapVdpa), "ConnectListNodeDevicesCapVdpa"},
+		{uint32(ConnectListNodeDevicesCapApCard), "ConnectListNodeDevicesCapApCard"},
+		{uint32(ConnectListNodeDevicesCapApQueue), "ConnectListNodeDevicesCapApQueue"},
+		{uint32(ConnectListNodeDevicesCapApMatrix), "ConnectListNodeDevicesCapApMatrix"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllNodeDeviceFlags) Has(flag ConnectListAllNodeDeviceFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllSecretsFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListSecretsEphemeral), "ConnectListSecretsEphemeral"},
+		{uint32(ConnectListSecretsNoEphemeral), "ConnectListSecretsNoEphemeral"},
+		{uint32(ConnectListSecretsPrivate), "ConnectListSecretsPrivate"},
+		{uint32(ConnectListSecretsNoPrivate), "ConnectListSecretsNoPrivate"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllSecretsFlags) Has(flag ConnectListAllSecretsFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StoragePoolBuildFlags) String() string {
+	return flagString(uint32(f), "StoragePoolBuildNew", []flagName{
+		{uint32(StoragePoolBuildRepair), "StoragePoolBuildRepair"},
+		{uint32(StoragePoolBuildResize), "StoragePoolBuildResize"},
+		{uint32(StoragePoolBuildNoOverwrite), "StoragePoolBuildNoOverwrite"},
+		{uint32(StoragePoolBuildOverwrite), "StoragePoolBuildOverwrite"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StoragePoolBuildFlags) Has(flag StoragePoolBuildFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StoragePoolDeleteFlags) String() string {
+	return flagString(uint32(f), "StoragePoolDeleteNormal", []flagName{
+		{uint32(StoragePoolDeleteZeroed), "StoragePoolDeleteZeroed"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StoragePoolDeleteFlags) Has(flag StoragePoolDeleteFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StoragePoolCreateFlags) String() string {
+	return flagString(uint32(f), "StoragePoolCreateNormal", []flagName{
+		{uint32(StoragePoolCreateWithBuild), "StoragePoolCreateWithBuild"},
+		{uint32(StoragePoolCreateWithBuildOverwrite), "StoragePoolCreateWithBuildOverwrite"},
+		{uint32(StoragePoolCreateWithBuildNoOverwrite), "StoragePoolCreateWithBuildNoOverwrite"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StoragePoolCreateFlags) Has(flag StoragePoolCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolDeleteFlags) String() string {
+	return flagString(uint32(f), "StorageVolDeleteNormal", []flagName{
+		{uint32(StorageVolDeleteZeroed), "StorageVolDeleteZeroed"},
+		{uint32(StorageVolDeleteWithSnapshots), "StorageVolDeleteWithSnapshots"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolDeleteFlags) Has(flag StorageVolDeleteFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolInfoFlags) String() string {
+	return flagString(uint32(f), "StorageVolUseAllocation", []flagName{
+		{uint32(StorageVolGetPhysical), "StorageVolGetPhysical"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolInfoFlags) Has(flag StorageVolInfoFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageXMLFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StorageXMLInactive), "StorageXMLInactive"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageXMLFlags) Has(flag StorageXMLFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f ConnectListAllStoragePoolsFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(ConnectListStoragePoolsInactive), "ConnectListStoragePoolsInactive"},
+		{uint32(ConnectListStoragePoolsActive), "ConnectListStoragePoolsActive"},
+		{uint32(ConnectListStoragePoolsPersistent), "ConnectListStoragePoolsPersistent"},
+		{uint32(ConnectListStoragePoolsTransient), "ConnectListStoragePoolsTransient"},
+		{uint32(ConnectListStoragePoolsAutostart), "ConnectListStoragePoolsAutostart"},
+		{uint32(ConnectListStoragePoolsNoAutostart), "ConnectListStoragePoolsNoAutostart"},
+		{uint32(ConnectListStoragePoolsDir), "ConnectListStoragePoolsDir"},
+		{uint32(ConnectListStoragePoolsFs), "ConnectListStoragePoolsFs"},
+		{uint32(ConnectListStoragePoolsNetfs), "ConnectListStoragePoolsNetfs"},
+		{uint32(ConnectListStoragePoolsLogical), "ConnectListStoragePoolsLogical"},
+		{uint32(ConnectListStoragePoolsDisk), "ConnectListStoragePoolsDisk"},
+		{uint32(ConnectListStoragePoolsIscsi), "ConnectListStoragePoolsIscsi"},
+		{uint32(ConnectListStoragePoolsScsi), "ConnectListStoragePoolsScsi"},
+		{uint32(ConnectListStoragePoolsMpath), "ConnectListStoragePoolsMpath"},
+		{uint32(ConnectListStoragePoolsRbd), "ConnectListStoragePoolsRbd"},
+		{uint32(ConnectListStoragePoolsSheepdog), "ConnectListStoragePoolsSheepdog"},
+		{uint32(ConnectListStoragePoolsGluster), "ConnectListStoragePoolsGluster"},
+		{uint32(ConnectListStoragePoolsZfs), "ConnectListStoragePoolsZfs"},
+		{uint32(ConnectListStoragePoolsVstorage), "ConnectListStoragePoolsVstorage"},
+		{uint32(ConnectListStoragePoolsIscsiDirect), "ConnectListStoragePoolsIscsiDirect"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f ConnectListAllStoragePoolsFlags) Has(flag ConnectListAllStoragePoolsFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolCreateFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StorageVolCreatePreallocMetadata), "StorageVolCreatePreallocMetadata"},
+		{uint32(StorageVolCreateReflink), "StorageVolCreateReflink"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolCreateFlags) Has(flag StorageVolCreateFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolDownloadFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StorageVolDownloadSparseStream), "StorageVolDownloadSparseStream"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolDownloadFlags) Has(flag StorageVolDownloadFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolUploadFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StorageVolUploadSparseStream), "StorageVolUploadSparseStream"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolUploadFlags) Has(flag StorageVolUploadFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StorageVolResizeFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StorageVolResizeAllocate), "StorageVolResizeAllocate"},
+		{uint32(StorageVolResizeDelta), "StorageVolResizeDelta"},
+		{uint32(StorageVolResizeShrink), "StorageVolResizeShrink"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StorageVolResizeFlags) Has(flag StorageVolResizeFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StreamFlags) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StreamNonblock), "StreamNonblock"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StreamFlags) Has(flag StreamFlags) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StreamRecvFlagsValues) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StreamRecvStopAtHole), "StreamRecvStopAtHole"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StreamRecvFlagsValues) Has(flag StreamRecvFlagsValues) bool {
+	return f&flag == flag
+}
+
+// String returns the names of the flags set in f, joined by "|".
+func (f StreamEventType) String() string {
+	return flagString(uint32(f), "0", []flagName{
+		{uint32(StreamEventReadable), "StreamEventReadable"},
+		{uint32(StreamEventWritable), "StreamEventWritable"},
+		{uint32(StreamEventError), "StreamEventError"},
+		{uint32(StreamEventHangup), "StreamEventHangup"},
+	})
+}
+
+// Has reports whether all of the flags set in flag are also set in f.
+func (f StreamEventType) Has(flag StreamEventType) bool {
+	return f&flag == flag
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFlagString(t *testing.T) {
+	tests := []struct {
+		val  fmt.Stringer
+		want string
+	}{
+		{DomainXMLSecure | DomainXMLInactive, "DomainXMLSecure|DomainXMLInactive"},
+		{DomainXMLMigratable, "DomainXMLMigratable"},
+		{DomainXMLFlags(0), "0"},
+		{DomainXMLInactive | DomainXMLFlags(0x40), "DomainXMLInactive|0x40"},
+		{DomainNone, "DomainNone"},
+		{DomainStartPaused | DomainStartAutodestroy, "DomainStartPaused|DomainStartAutodestroy"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.val.String(); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestFlagHas(t *testing.T) {
+	f := DomainXMLSecure | DomainXMLUpdateCPU
+
+	if !f.Has(DomainXMLSecure) {
+		t.Error("expected DomainXMLSecure to be set")
+	}
+	if !f.Has(DomainXMLSecure | DomainXMLUpdateCPU) {
+		t.Error("expected DomainXMLSecure and DomainXMLUpdateCPU to be set")
+	}
+	if f.Has(DomainXMLSecure | DomainXMLInactive) {
+		t.Error("expected DomainXMLInactive not to be set")
+	}
+}
@@ -1,8 +1,9 @@
 module github.com/digitalocean/go-libvirt
 
-go 1.15
+go 1.16
 
 require (
 	github.com/stretchr/testify v1.6.1
+	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a
 	golang.org/x/tools v0.1.1
 )
@@ -0,0 +1,275 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "strconv"
+
+// DomainGuestInfoReport holds the information a domain's guest agent reports
+// about the guest, decoded into typed fields, returned by DomainGuestInfo.
+// Information in categories not requested, or which the agent doesn't report,
+// is zero.
+type DomainGuestInfoReport struct {
+	Users       []DomainGuestUser
+	OS          DomainGuestOS
+	Timezone    DomainGuestTimezone
+	Hostname    string
+	Filesystems []DomainGuestFilesystem
+	Disks       []DomainGuestDisk
+}
+
+// DomainGuestUser holds the "user.<num>.*" information for one user logged
+// in to the guest.
+type DomainGuestUser struct {
+	Name   string
+	Domain string
+	// LoginTime is when the user logged in, in milliseconds since the epoch.
+	LoginTime uint64
+}
+
+// DomainGuestOS holds the "os.*" information, as found in the guest's
+// os-release file.
+type DomainGuestOS struct {
+	ID            string
+	Name          string
+	PrettyName    string
+	Version       string
+	VersionID     string
+	KernelRelease string
+	KernelVersion string
+	Machine       string
+	Variant       string
+	VariantID     string
+}
+
+// DomainGuestTimezone holds the "timezone.*" information.
+type DomainGuestTimezone struct {
+	Name string
+	// Offset is the offset from UTC, in seconds.
+	Offset int32
+}
+
+// DomainGuestFilesystem holds the "fs.<num>.*" information for one filesystem
+// mounted in the guest. Sizes are in bytes.
+type DomainGuestFilesystem struct {
+	Mountpoint string
+	Name       string
+	FSType     string
+	TotalBytes uint64
+	UsedBytes  uint64
+	Disks      []DomainGuestFSDisk
+}
+
+// DomainGuestFSDisk holds the "fs.<num>.disk.<num>.*" information for one disk
+// a filesystem is on.
+type DomainGuestFSDisk struct {
+	// Alias is the name of the domain's disk device, e.g. "vda".
+	Alias  string
+	Serial string
+	// Device is the name of the disk device in the guest, e.g. "/dev/sda".
+	Device string
+}
+
+// DomainGuestDisk holds the "disk.<num>.*" information for one disk or
+// partition in the guest.
+type DomainGuestDisk struct {
+	// Name is the name of the disk in the guest, e.g. "/dev/sda1".
+	Name      string
+	Partition bool
+	// Dependencies holds the names of the disks this one is on, such as the
+	// disk a partition belongs to.
+	Dependencies []string
+	Serial       string
+	// Alias is the name of the domain's disk device, e.g. "vda", and
+	// GuestAlias the name the guest knows the disk by.
+	Alias      string
+	GuestAlias string
+}
+
+// DomainGuestInfo returns the information the guest agent of dom reports about
+// the guest, decoded into a DomainGuestInfoReport. The types argument selects
+// the categories of information to return, such as DomainGuestInfoOs, and 0
+// returns every category the agent supports. Use DomainGetGuestInfo for the
+// raw typed parameters.
+func (l *Libvirt) DomainGuestInfo(dom Domain, types DomainGuestInfoTypes, flags uint32) (DomainGuestInfoReport, error) {
+	params, err := l.DomainGetGuestInfo(dom, types, flags)
+	if err != nil {
+		return DomainGuestInfoReport{}, err
+	}
+	return parseGuestInfo(TypedParams(params))
+}
+
+// parseGuestInfo decodes the guest information in p, ignoring any it doesn't
+// know or that has an unexpected type. It returns an error if the index of a
+// user, filesystem, disk or dependency is out of range, rather than growing
+// the slices to whatever index the daemon sends.
+func parseGuestInfo(p TypedParams) (DomainGuestInfoReport, error) {
+	// Each entry has at least one parameter, so a valid reply never needs
+	// more entries, across all the slices, than it has parameters.
+	left := len(p)
+	reserve := func(name string, have, n int) error {
+		if n < 0 || n-have >= left {
+			return statIndexError(name, n, have+left)
+		}
+		if n >= have {
+			left -= n + 1 - have
+		}
+		return nil
+	}
+
+	var r DomainGuestInfoReport
+	for _, param := range p {
+		v := paramValue(param)
+		vs, _ := v.(string)
+		group, rest := splitStat(param.Field)
+		idx, key := splitStat(rest)
+		n, err := strconv.Atoi(idx)
+		indexed := err == nil
+		if !indexed {
+			key = rest
+		}
+
+		switch {
+		case group == "hostname" && rest == "":
+			r.Hostname = vs
+
+		case group == "os" && !indexed:
+			switch key {
+			case "id":
+				r.OS.ID = vs
+			case "name":
+				r.OS.Name = vs
+			case "pretty-name":
+				r.OS.PrettyName = vs
+			case "version":
+				r.OS.Version = vs
+			case "version-id":
+				r.OS.VersionID = vs
+			case "kernel-release":
+				r.OS.KernelRelease = vs
+			case "kernel-version":
+				r.OS.KernelVersion = vs
+			case "machine":
+				r.OS.Machine = vs
+			case "variant":
+				r.OS.Variant = vs
+			case "variant-id":
+				r.OS.VariantID = vs
+			}
+
+		case group == "timezone" && !indexed:
+			switch key {
+			case "name":
+				r.Timezone.Name = vs
+			case "offset":
+				r.Timezone.Offset, _ = v.(int32)
+			}
+
+		case group == "user" && indexed:
+			if err := reserve(param.Field, len(r.Users), n); err != nil {
+				return DomainGuestInfoReport{}, err
+			}
+			for len(r.Users) <= n {
+				r.Users = append(r.Users, DomainGuestUser{})
+			}
+			u := &r.Users[n]
+			switch key {
+			case "name":
+				u.Name = vs
+			case "domain":
+				u.Domain = vs
+			case "login-time":
+				u.LoginTime, _ = v.(uint64)
+			}
+
+		case group == "fs" && indexed:
+			if err := reserve(param.Field, len(r.Filesystems), n); err != nil {
+				return DomainGuestInfoReport{}, err
+			}
+			for len(r.Filesystems) <= n {
+				r.Filesystems = append(r.Filesystems, DomainGuestFilesystem{})
+			}
+			fs := &r.Filesystems[n]
+			switch key {
+			case "mountpoint":
+				fs.Mountpoint = vs
+			case "name":
+				fs.Name = vs
+			case "fstype":
+				fs.FSType = vs
+			case "total-bytes":
+				fs.TotalBytes, _ = v.(uint64)
+			case "used-bytes":
+				fs.UsedBytes, _ = v.(uint64)
+			default:
+				sub, rest := splitStat(key)
+				idx, key := splitStat(rest)
+				d, err := strconv.Atoi(idx)
+				if sub != "disk" || err != nil {
+					break
+				}
+				if err := reserve(param.Field, len(fs.Disks), d); err != nil {
+					return DomainGuestInfoReport{}, err
+				}
+				for len(fs.Disks) <= d {
+					fs.Disks = append(fs.Disks, DomainGuestFSDisk{})
+				}
+				switch key {
+				case "alias":
+					fs.Disks[d].Alias = vs
+				case "serial":
+					fs.Disks[d].Serial = vs
+				case "device":
+					fs.Disks[d].Device = vs
+				}
+			}
+
+		case group == "disk" && indexed:
+			if err := reserve(param.Field, len(r.Disks), n); err != nil {
+				return DomainGuestInfoReport{}, err
+			}
+			for len(r.Disks) <= n {
+				r.Disks = append(r.Disks, DomainGuestDisk{})
+			}
+			d := &r.Disks[n]
+			switch key {
+			case "name":
+				d.Name = vs
+			case "partition":
+				d.Partition, _ = v.(bool)
+			case "serial":
+				d.Serial = vs
+			case "alias":
+				d.Alias = vs
+			case "guest_alias":
+				d.GuestAlias = vs
+			default:
+				sub, rest := splitStat(key)
+				idx, key := splitStat(rest)
+				dep, err := strconv.Atoi(idx)
+				if sub != "dependency" || key != "name" || err != nil {
+					break
+				}
+				if err := reserve(param.Field, len(d.Dependencies), dep); err != nil {
+					return DomainGuestInfoReport{}, err
+				}
+				for len(d.Dependencies) <= dep {
+					d.Dependencies = append(d.Dependencies, "")
+				}
+				d.Dependencies[dep] = vs
+			}
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainGuestInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var p TypedParams
+	p.SetUint("user.count", 1)
+	p.SetString("user.0.name", "root")
+	p.SetUllong("user.0.login-time", 1700000000000)
+	p.SetString("os.id", "fedora")
+	p.SetString("os.pretty-name", "Fedora Linux 40")
+	p.SetString("timezone.name", "CET")
+	p.SetInt("timezone.offset", 3600)
+	p.SetString("hostname", "guest")
+	p.SetUint("fs.count", 1)
+	p.SetString("fs.0.mountpoint", "/")
+	p.SetString("fs.0.fstype", "xfs")
+	p.SetUllong("fs.0.total-bytes", 1<<30)
+	p.SetUint("fs.0.disk.count", 1)
+	p.SetString("fs.0.disk.0.alias", "vda")
+	p.SetString("fs.0.disk.0.device", "/dev/vda1")
+	p.SetUint("disk.count", 2)
+	p.SetString("disk.0.name", "/dev/vda")
+	p.SetBool("disk.0.partition", false)
+	p.SetString("disk.0.alias", "vda")
+	p.SetString("disk.1.name", "/dev/vda1")
+	p.SetBool("disk.1.partition", true)
+	p.SetUint("disk.1.dependency.count", 1)
+	p.SetString("disk.1.dependency.0.name", "/dev/vda")
+	p.SetString("unknown.key", "ignored")
+
+	payload, err := encode(&DomainGetGuestInfoRet{Params: p})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetGuestInfo, payload)
+
+	types := DomainGuestInfoUsers | DomainGuestInfoOs | DomainGuestInfoTimezone |
+		DomainGuestInfoHostname | DomainGuestInfoFilesystem | DomainGuestInfoDisks
+	got, err := l.DomainGuestInfo(Domain{Name: "test"}, types, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DomainGuestInfoReport{
+		Users:    []DomainGuestUser{{Name: "root", LoginTime: 1700000000000}},
+		OS:       DomainGuestOS{ID: "fedora", PrettyName: "Fedora Linux 40"},
+		Timezone: DomainGuestTimezone{Name: "CET", Offset: 3600},
+		Hostname: "guest",
+		Filesystems: []DomainGuestFilesystem{{
+			Mountpoint: "/",
+			FSType:     "xfs",
+			TotalBytes: 1 << 30,
+			Disks:      []DomainGuestFSDisk{{Alias: "vda", Device: "/dev/vda1"}},
+		}},
+		Disks: []DomainGuestDisk{
+			{Name: "/dev/vda", Alias: "vda"},
+			{Name: "/dev/vda1", Partition: true, Dependencies: []string{"/dev/vda"}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainGetGuestInfo {
+			continue
+		}
+		var args DomainGetGuestInfoArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if args.Types != types {
+			t.Errorf("expected types %v, got %v", types, args.Types)
+		}
+	}
+}
+
+func TestParseGuestInfoIndexes(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   func(p *TypedParams)
+		valid bool
+	}{
+		{"in range", func(p *TypedParams) {
+			p.SetString("fs.0.name", "vda1")
+			p.SetString("fs.0.disk.0.alias", "vda")
+		}, true},
+		{"negative", func(p *TypedParams) { p.SetString("user.-1.name", "root") }, false},
+		{"negative disk", func(p *TypedParams) {
+			p.SetString("fs.0.name", "vda1")
+			p.SetString("fs.0.disk.-1.alias", "vda")
+		}, false},
+		{"negative dependency", func(p *TypedParams) {
+			p.SetString("disk.0.name", "/dev/vda1")
+			p.SetString("disk.0.dependency.-1.name", "/dev/vda")
+		}, false},
+		{"beyond params", func(p *TypedParams) { p.SetString("fs.2000000000.name", "vda1") }, false},
+		{"nested beyond params", func(p *TypedParams) {
+			p.SetString("disk.0.name", "/dev/vda1")
+			p.SetString("disk.0.dependency.2.name", "/dev/vda")
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p TypedParams
+			tt.set(&p)
+			_, err := parseGuestInfo(p)
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected an error for an out of range index")
+			}
+		})
+	}
+}
+
+func TestDomainFSFreezeThaw(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test"}
+	mountpoints := []string{"/", "/var/lib/data"}
+
+	payload, err := encode(&DomainFsfreezeRet{Filesystems: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainFsfreeze, payload)
+	if n, err := l.DomainFSFreeze(dom, mountpoints, 0); err != nil || n != 2 {
+		t.Fatalf("expected 2 filesystems frozen, got %d, error %v", n, err)
+	}
+
+	payload, err = encode(&DomainFsthawRet{Filesystems: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainFsthaw, payload)
+	if n, err := l.DomainFSThaw(dom, nil, 0); err != nil || n != 3 {
+		t.Fatalf("expected 3 filesystems thawed, got %d, error %v", n, err)
+	}
+
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcDomainFsfreeze:
+			var args DomainFsfreezeArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(args.Mountpoints, mountpoints) {
+				t.Errorf("expected mountpoints %q, got %q", mountpoints, args.Mountpoints)
+			}
+		case constants.ProcDomainFsthaw:
+			var args DomainFsthawArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if len(args.Mountpoints) != 0 {
+				t.Errorf("expected no mountpoints, got %q", args.Mountpoints)
+			}
+		}
+	}
+}
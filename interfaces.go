@@ -0,0 +1,73 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "net"
+
+// GuestInterface is a domain's network interface and its addresses, as
+// returned by GuestInterfaces.
+type GuestInterface struct {
+	Name string
+	// MAC is the interface's hardware address, which may be empty when the
+	// addresses came from the guest agent.
+	MAC   string
+	Addrs []GuestIPAddr
+}
+
+// GuestIPAddr is an address assigned to a domain's network interface.
+type GuestIPAddr struct {
+	Type   IPAddrType
+	Addr   net.IP
+	Prefix uint32
+}
+
+// IPNet returns the address along with the network mask given by its prefix.
+func (a GuestIPAddr) IPNet() *net.IPNet {
+	bits := 8 * net.IPv4len
+	if a.Type == IPAddrTypeIpv6 {
+		bits = 8 * net.IPv6len
+	}
+	return &net.IPNet{IP: a.Addr, Mask: net.CIDRMask(int(a.Prefix), bits)}
+}
+
+// GuestInterfaces returns a domain's network interfaces and their IP
+// addresses. source selects where libvirt looks for them: the DHCP leases of
+// libvirt's own networks, the guest agent, or the host's ARP table. flags is
+// currently unused by libvirt and should be 0.
+func (l *Libvirt) GuestInterfaces(dom Domain, source DomainInterfaceAddressesSource,
+	flags uint32) ([]GuestInterface, error) {
+	ifaces, err := l.DomainInterfaceAddresses(dom, uint32(source), flags)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]GuestInterface, 0, len(ifaces))
+	for _, iface := range ifaces {
+		gi := GuestInterface{Name: iface.Name}
+		if len(iface.Hwaddr) > 0 {
+			gi.MAC = iface.Hwaddr[0]
+		}
+		for _, a := range iface.Addrs {
+			gi.Addrs = append(gi.Addrs, GuestIPAddr{
+				Type:   IPAddrType(a.Type),
+				Addr:   net.ParseIP(a.Addr),
+				Prefix: a.Prefix,
+			})
+		}
+		res = append(res, gi)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestGuestInterfaces(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&DomainInterfaceAddressesRet{Ifaces: []DomainInterface{
+		{Name: "lo", Addrs: []DomainIPAddr{
+			{Type: int32(IPAddrTypeIpv4), Addr: "127.0.0.1", Prefix: 8},
+		}},
+		{Name: "eth0", Hwaddr: OptString{"52:54:00:12:34:56"}, Addrs: []DomainIPAddr{
+			{Type: int32(IPAddrTypeIpv4), Addr: "192.168.122.10", Prefix: 24},
+			{Type: int32(IPAddrTypeIpv6), Addr: "fe80::5054:ff:fe12:3456", Prefix: 64},
+		}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainInterfaceAddresses, payload)
+
+	ifaces, err := l.GuestInterfaces(Domain{Name: "test"}, DomainInterfaceAddressesSrcAgent, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []GuestInterface{
+		{Name: "lo", Addrs: []GuestIPAddr{
+			{Type: IPAddrTypeIpv4, Addr: net.ParseIP("127.0.0.1"), Prefix: 8},
+		}},
+		{Name: "eth0", MAC: "52:54:00:12:34:56", Addrs: []GuestIPAddr{
+			{Type: IPAddrTypeIpv4, Addr: net.ParseIP("192.168.122.10"), Prefix: 24},
+			{Type: IPAddrTypeIpv6, Addr: net.ParseIP("fe80::5054:ff:fe12:3456"), Prefix: 64},
+		}},
+	}
+	if !reflect.DeepEqual(ifaces, want) {
+		t.Fatalf("expected %+v, got %+v", want, ifaces)
+	}
+
+	if n := ifaces[1].Addrs[0].IPNet().String(); n != "192.168.122.10/24" {
+		t.Errorf("expected 192.168.122.10/24, got %v", n)
+	}
+	if n := ifaces[1].Addrs[1].IPNet().String(); n != "fe80::5054:ff:fe12:3456/64" {
+		t.Errorf("expected fe80::5054:ff:fe12:3456/64, got %v", n)
+	}
+}
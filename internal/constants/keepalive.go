@@ -0,0 +1,35 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constants
+
+// These come from libvirt's src/rpc/virkeepaliveprotocol.x, which is small and
+// stable enough that it isn't worth generating. Keepalive messages have no
+// payload, and are sent with the Message type and a serial of 0.
+const (
+	// KeepAliveProgram is libvirt's KEEPALIVE_PROGRAM
+	KeepAliveProgram = 0x6b656570
+	// KeepAliveProtocolVersion is libvirt's KEEPALIVE_PROTOCOL_VERSION
+	KeepAliveProtocolVersion = 1
+
+	// KeepAliveProcPing is libvirt's KEEPALIVE_PROC_PING
+	KeepAliveProcPing = 1
+	// KeepAliveProcPong is libvirt's KEEPALIVE_PROC_PONG
+	KeepAliveProcPong = 2
+
+	// FeatureProgramKeepAlive is libvirt's VIR_DRV_FEATURE_PROGRAM_KEEPALIVE,
+	// which is passed to ConnectSupportsFeature to find out whether the server
+	// supports the keepalive program.
+	FeatureProgramKeepAlive = 10
+)
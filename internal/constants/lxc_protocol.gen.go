@@ -0,0 +1,50 @@
+// Copyright 2018 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// Code generated by internal/lvgen/generate.go. DO NOT EDIT.
+//
+// To regenerate, run 'go generate' in internal/lvgen.
+//
+
+package constants
+
+// These are libvirt procedure numbers which correspond to each respective
+// API call between remote_internal driver and libvirtd. Each procedure is
+// identified by a unique number.
+const (
+	// From enums:
+	// LXCProcDomainOpenNamespace is libvirt's LXC_PROC_DOMAIN_OPEN_NAMESPACE
+	LXCProcDomainOpenNamespace = 1
+
+	// From consts:
+	// LXCProgram is libvirt's LXC_PROGRAM
+	//
+	// Define the program number, protocol version and procedure numbers here.
+	LXCProgram = 0x00068000
+	// LXCProtocolVersion is libvirt's LXC_PROTOCOL_VERSION
+	LXCProtocolVersion = 1
+)
+
+// LXCProcedureNames maps each lxc_procedure value to libvirt's name for it, for
+// use when debugging.
+var LXCProcedureNames = map[uint32]string{
+	LXCProcDomainOpenNamespace: "LXC_PROC_DOMAIN_OPEN_NAMESPACE",
+}
+
+// LXCProcedureMethods maps the name of each go-libvirt method to the
+// lxc_procedure value it calls.
+var LXCProcedureMethods = map[string]uint32{
+	"LXCDomainOpenNamespace": LXCProcDomainOpenNamespace,
+}
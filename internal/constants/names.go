@@ -0,0 +1,59 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constants
+
+import "fmt"
+
+// KeepAliveProcedureNames maps each keepalive procedure to libvirt's name for
+// it, like the generated maps for the other programs.
+var KeepAliveProcedureNames = map[uint32]string{
+	KeepAliveProcPing: "KEEPALIVE_PROC_PING",
+	KeepAliveProcPong: "KEEPALIVE_PROC_PONG",
+}
+
+// programInfo describes one of the RPC programs spoken over a libvirt
+// connection.
+type programInfo struct {
+	name    string
+	version uint32
+	procs   map[uint32]string
+}
+
+var programs = map[uint32]programInfo{
+	Program:          {"REMOTE_PROGRAM", ProtocolVersion, ProcedureNames},
+	QEMUProgram:      {"QEMU_PROGRAM", QEMUProtocolVersion, QEMUProcedureNames},
+	LXCProgram:       {"LXC_PROGRAM", LXCProtocolVersion, LXCProcedureNames},
+	KeepAliveProgram: {"KEEPALIVE_PROGRAM", KeepAliveProtocolVersion, KeepAliveProcedureNames},
+}
+
+// ProgramName returns libvirt's name for a program number along with its
+// protocol version, e.g. "REMOTE_PROGRAM v1", or the number in hex if the
+// program isn't known.
+func ProgramName(program uint32) string {
+	p, ok := programs[program]
+	if !ok {
+		return fmt.Sprintf("program %#x", program)
+	}
+	return fmt.Sprintf("%v v%d", p.name, p.version)
+}
+
+// ProcName returns libvirt's name for a procedure of the given program, e.g.
+// "REMOTE_PROC_CONNECT_OPEN". Unknown procedures are described by number.
+func ProcName(program, proc uint32) string {
+	if name, ok := programs[program].procs[proc]; ok {
+		return name
+	}
+	return fmt.Sprintf("%v procedure %d", ProgramName(program), proc)
+}
@@ -38,10 +38,31 @@ const (
 	// QEMUProcDomainMonitorEvent is libvirt's QEMU_PROC_DOMAIN_MONITOR_EVENT
 	QEMUProcDomainMonitorEvent = 6
 
-
 	// From consts:
 	// QEMUProgram is libvirt's QEMU_PROGRAM
 	QEMUProgram = 0x20008087
 	// QEMUProtocolVersion is libvirt's QEMU_PROTOCOL_VERSION
 	QEMUProtocolVersion = 1
 )
+
+// QEMUProcedureNames maps each qemu_procedure value to libvirt's name for it, for
+// use when debugging.
+var QEMUProcedureNames = map[uint32]string{
+	QEMUProcDomainMonitorCommand:                "QEMU_PROC_DOMAIN_MONITOR_COMMAND",
+	QEMUProcDomainAttach:                        "QEMU_PROC_DOMAIN_ATTACH",
+	QEMUProcDomainAgentCommand:                  "QEMU_PROC_DOMAIN_AGENT_COMMAND",
+	QEMUProcConnectDomainMonitorEventRegister:   "QEMU_PROC_CONNECT_DOMAIN_MONITOR_EVENT_REGISTER",
+	QEMUProcConnectDomainMonitorEventDeregister: "QEMU_PROC_CONNECT_DOMAIN_MONITOR_EVENT_DEREGISTER",
+	QEMUProcDomainMonitorEvent:                  "QEMU_PROC_DOMAIN_MONITOR_EVENT",
+}
+
+// QEMUProcedureMethods maps the name of each go-libvirt method to the
+// qemu_procedure value it calls.
+var QEMUProcedureMethods = map[string]uint32{
+	"QEMUDomainMonitorCommand":                QEMUProcDomainMonitorCommand,
+	"QEMUDomainAttach":                        QEMUProcDomainAttach,
+	"QEMUDomainAgentCommand":                  QEMUProcDomainAgentCommand,
+	"QEMUConnectDomainMonitorEventRegister":   QEMUProcConnectDomainMonitorEventRegister,
+	"QEMUConnectDomainMonitorEventDeregister": QEMUProcConnectDomainMonitorEventDeregister,
+	"QEMUDomainMonitorEvent":                  QEMUProcDomainMonitorEvent,
+}
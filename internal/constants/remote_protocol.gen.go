@@ -884,140 +884,1005 @@ const (
 	// ProcDomainGetMessages is libvirt's REMOTE_PROC_DOMAIN_GET_MESSAGES
 	ProcDomainGetMessages = 426
 
-
 	// From consts:
 	// StringMax is libvirt's REMOTE_STRING_MAX
-	StringMax = 4194304
+	StringMax uint32 = 4194304
 	// ConnectIdentityParamsMax is libvirt's REMOTE_CONNECT_IDENTITY_PARAMS_MAX
-	ConnectIdentityParamsMax = 20
+	ConnectIdentityParamsMax uint32 = 20
 	// DomainListMax is libvirt's REMOTE_DOMAIN_LIST_MAX
-	DomainListMax = 16384
+	DomainListMax uint32 = 16384
 	// CpumapMax is libvirt's REMOTE_CPUMAP_MAX
-	CpumapMax = 2048
+	CpumapMax uint32 = 2048
 	// VcpuinfoMax is libvirt's REMOTE_VCPUINFO_MAX
-	VcpuinfoMax = 16384
+	VcpuinfoMax uint32 = 16384
 	// CpumapsMax is libvirt's REMOTE_CPUMAPS_MAX
-	CpumapsMax = 8388608
+	CpumapsMax uint32 = 8388608
 	// IothreadInfoMax is libvirt's REMOTE_IOTHREAD_INFO_MAX
-	IothreadInfoMax = 16384
+	IothreadInfoMax uint32 = 16384
 	// MigrateCookieMax is libvirt's REMOTE_MIGRATE_COOKIE_MAX
-	MigrateCookieMax = 4194304
+	MigrateCookieMax uint32 = 4194304
 	// NetworkListMax is libvirt's REMOTE_NETWORK_LIST_MAX
-	NetworkListMax = 16384
+	NetworkListMax uint32 = 16384
 	// NetworkPortListMax is libvirt's REMOTE_NETWORK_PORT_LIST_MAX
-	NetworkPortListMax = 16384
+	NetworkPortListMax uint32 = 16384
 	// InterfaceListMax is libvirt's REMOTE_INTERFACE_LIST_MAX
-	InterfaceListMax = 16384
+	InterfaceListMax uint32 = 16384
 	// StoragePoolListMax is libvirt's REMOTE_STORAGE_POOL_LIST_MAX
-	StoragePoolListMax = 16384
+	StoragePoolListMax uint32 = 16384
 	// StorageVolListMax is libvirt's REMOTE_STORAGE_VOL_LIST_MAX
-	StorageVolListMax = 16384
+	StorageVolListMax uint32 = 16384
 	// NodeDeviceListMax is libvirt's REMOTE_NODE_DEVICE_LIST_MAX
-	NodeDeviceListMax = 65536
+	NodeDeviceListMax uint32 = 65536
 	// NodeDeviceCapsListMax is libvirt's REMOTE_NODE_DEVICE_CAPS_LIST_MAX
-	NodeDeviceCapsListMax = 65536
+	NodeDeviceCapsListMax uint32 = 65536
 	// NwfilterListMax is libvirt's REMOTE_NWFILTER_LIST_MAX
-	NwfilterListMax = 16384
+	NwfilterListMax uint32 = 16384
 	// NwfilterBindingListMax is libvirt's REMOTE_NWFILTER_BINDING_LIST_MAX
-	NwfilterBindingListMax = 16384
+	NwfilterBindingListMax uint32 = 16384
 	// DomainSchedulerParametersMax is libvirt's REMOTE_DOMAIN_SCHEDULER_PARAMETERS_MAX
-	DomainSchedulerParametersMax = 16
+	DomainSchedulerParametersMax uint32 = 16
 	// DomainBlkioParametersMax is libvirt's REMOTE_DOMAIN_BLKIO_PARAMETERS_MAX
-	DomainBlkioParametersMax = 16
+	DomainBlkioParametersMax uint32 = 16
 	// DomainMemoryParametersMax is libvirt's REMOTE_DOMAIN_MEMORY_PARAMETERS_MAX
-	DomainMemoryParametersMax = 16
+	DomainMemoryParametersMax uint32 = 16
 	// DomainBlockIOTuneParametersMax is libvirt's REMOTE_DOMAIN_BLOCK_IO_TUNE_PARAMETERS_MAX
-	DomainBlockIOTuneParametersMax = 32
+	DomainBlockIOTuneParametersMax uint32 = 32
 	// DomainNumaParametersMax is libvirt's REMOTE_DOMAIN_NUMA_PARAMETERS_MAX
-	DomainNumaParametersMax = 16
+	DomainNumaParametersMax uint32 = 16
 	// DomainPerfEventsMax is libvirt's REMOTE_DOMAIN_PERF_EVENTS_MAX
-	DomainPerfEventsMax = 64
+	DomainPerfEventsMax uint32 = 64
 	// DomainBlockCopyParametersMax is libvirt's REMOTE_DOMAIN_BLOCK_COPY_PARAMETERS_MAX
-	DomainBlockCopyParametersMax = 16
+	DomainBlockCopyParametersMax uint32 = 16
 	// NodeCPUStatsMax is libvirt's REMOTE_NODE_CPU_STATS_MAX
-	NodeCPUStatsMax = 16
+	NodeCPUStatsMax uint32 = 16
 	// NodeMemoryStatsMax is libvirt's REMOTE_NODE_MEMORY_STATS_MAX
-	NodeMemoryStatsMax = 16
+	NodeMemoryStatsMax uint32 = 16
 	// DomainBlockStatsParametersMax is libvirt's REMOTE_DOMAIN_BLOCK_STATS_PARAMETERS_MAX
-	DomainBlockStatsParametersMax = 16
+	DomainBlockStatsParametersMax uint32 = 16
 	// NodeMaxCells is libvirt's REMOTE_NODE_MAX_CELLS
-	NodeMaxCells = 1024
+	NodeMaxCells uint32 = 1024
 	// AuthSaslDataMax is libvirt's REMOTE_AUTH_SASL_DATA_MAX
-	AuthSaslDataMax = 65536
+	AuthSaslDataMax uint32 = 65536
 	// AuthTypeListMax is libvirt's REMOTE_AUTH_TYPE_LIST_MAX
-	AuthTypeListMax = 20
+	AuthTypeListMax uint32 = 20
 	// DomainMemoryStatsMax is libvirt's REMOTE_DOMAIN_MEMORY_STATS_MAX
-	DomainMemoryStatsMax = 1024
+	DomainMemoryStatsMax uint32 = 1024
 	// DomainCheckpointListMax is libvirt's REMOTE_DOMAIN_CHECKPOINT_LIST_MAX
-	DomainCheckpointListMax = 16384
+	DomainCheckpointListMax uint32 = 16384
 	// DomainSnapshotListMax is libvirt's REMOTE_DOMAIN_SNAPSHOT_LIST_MAX
-	DomainSnapshotListMax = 16384
+	DomainSnapshotListMax uint32 = 16384
 	// DomainBlockPeekBufferMax is libvirt's REMOTE_DOMAIN_BLOCK_PEEK_BUFFER_MAX
-	DomainBlockPeekBufferMax = 4194304
+	DomainBlockPeekBufferMax uint32 = 4194304
 	// DomainMemoryPeekBufferMax is libvirt's REMOTE_DOMAIN_MEMORY_PEEK_BUFFER_MAX
-	DomainMemoryPeekBufferMax = 4194304
+	DomainMemoryPeekBufferMax uint32 = 4194304
 	// SecurityLabelListMax is libvirt's REMOTE_SECURITY_LABEL_LIST_MAX
-	SecurityLabelListMax = 64
+	SecurityLabelListMax uint32 = 64
 	// SecretValueMax is libvirt's REMOTE_SECRET_VALUE_MAX
-	SecretValueMax = 65536
+	SecretValueMax uint32 = 65536
 	// SecretListMax is libvirt's REMOTE_SECRET_LIST_MAX
-	SecretListMax = 16384
+	SecretListMax uint32 = 16384
 	// CPUBaselineMax is libvirt's REMOTE_CPU_BASELINE_MAX
-	CPUBaselineMax = 256
+	CPUBaselineMax uint32 = 256
 	// DomainSendKeyMax is libvirt's REMOTE_DOMAIN_SEND_KEY_MAX
-	DomainSendKeyMax = 16
+	DomainSendKeyMax uint32 = 16
 	// DomainInterfaceParametersMax is libvirt's REMOTE_DOMAIN_INTERFACE_PARAMETERS_MAX
-	DomainInterfaceParametersMax = 16
+	DomainInterfaceParametersMax uint32 = 16
 	// DomainGetCPUStatsNcpusMax is libvirt's REMOTE_DOMAIN_GET_CPU_STATS_NCPUS_MAX
-	DomainGetCPUStatsNcpusMax = 128
+	DomainGetCPUStatsNcpusMax uint32 = 128
 	// DomainGetCPUStatsMax is libvirt's REMOTE_DOMAIN_GET_CPU_STATS_MAX
-	DomainGetCPUStatsMax = 2048
+	DomainGetCPUStatsMax uint32 = 2048
 	// DomainDiskErrorsMax is libvirt's REMOTE_DOMAIN_DISK_ERRORS_MAX
-	DomainDiskErrorsMax = 256
+	DomainDiskErrorsMax uint32 = 256
 	// NodeMemoryParametersMax is libvirt's REMOTE_NODE_MEMORY_PARAMETERS_MAX
-	NodeMemoryParametersMax = 64
+	NodeMemoryParametersMax uint32 = 64
 	// DomainMigrateParamListMax is libvirt's REMOTE_DOMAIN_MIGRATE_PARAM_LIST_MAX
-	DomainMigrateParamListMax = 64
+	DomainMigrateParamListMax uint32 = 64
 	// DomainJobStatsMax is libvirt's REMOTE_DOMAIN_JOB_STATS_MAX
-	DomainJobStatsMax = 64
+	DomainJobStatsMax uint32 = 64
 	// ConnectCPUModelsMax is libvirt's REMOTE_CONNECT_CPU_MODELS_MAX
-	ConnectCPUModelsMax = 8192
+	ConnectCPUModelsMax uint32 = 8192
 	// DomainFsfreezeMountpointsMax is libvirt's REMOTE_DOMAIN_FSFREEZE_MOUNTPOINTS_MAX
-	DomainFsfreezeMountpointsMax = 256
+	DomainFsfreezeMountpointsMax uint32 = 256
 	// NetworkDhcpLeasesMax is libvirt's REMOTE_NETWORK_DHCP_LEASES_MAX
-	NetworkDhcpLeasesMax = 65536
+	NetworkDhcpLeasesMax uint32 = 65536
 	// ConnectGetAllDomainStatsMax is libvirt's REMOTE_CONNECT_GET_ALL_DOMAIN_STATS_MAX
-	ConnectGetAllDomainStatsMax = 262144
+	ConnectGetAllDomainStatsMax uint32 = 262144
 	// DomainEventTunableMax is libvirt's REMOTE_DOMAIN_EVENT_TUNABLE_MAX
-	DomainEventTunableMax = 2048
+	DomainEventTunableMax uint32 = 2048
 	// DomainFsinfoMax is libvirt's REMOTE_DOMAIN_FSINFO_MAX
-	DomainFsinfoMax = 256
+	DomainFsinfoMax uint32 = 256
 	// DomainFsinfoDisksMax is libvirt's REMOTE_DOMAIN_FSINFO_DISKS_MAX
-	DomainFsinfoDisksMax = 256
+	DomainFsinfoDisksMax uint32 = 256
 	// DomainInterfaceMax is libvirt's REMOTE_DOMAIN_INTERFACE_MAX
-	DomainInterfaceMax = 2048
+	DomainInterfaceMax uint32 = 2048
 	// DomainIPAddrMax is libvirt's REMOTE_DOMAIN_IP_ADDR_MAX
-	DomainIPAddrMax = 2048
+	DomainIPAddrMax uint32 = 2048
 	// DomainGuestVcpuParamsMax is libvirt's REMOTE_DOMAIN_GUEST_VCPU_PARAMS_MAX
-	DomainGuestVcpuParamsMax = 64
+	DomainGuestVcpuParamsMax uint32 = 64
 	// DomainIothreadParamsMax is libvirt's REMOTE_DOMAIN_IOTHREAD_PARAMS_MAX
-	DomainIothreadParamsMax = 64
+	DomainIothreadParamsMax uint32 = 64
 	// NodeSevInfoMax is libvirt's REMOTE_NODE_SEV_INFO_MAX
-	NodeSevInfoMax = 64
+	NodeSevInfoMax uint32 = 64
 	// DomainLaunchSecurityInfoParamsMax is libvirt's REMOTE_DOMAIN_LAUNCH_SECURITY_INFO_PARAMS_MAX
-	DomainLaunchSecurityInfoParamsMax = 64
+	DomainLaunchSecurityInfoParamsMax uint32 = 64
 	// DomainGuestInfoParamsMax is libvirt's REMOTE_DOMAIN_GUEST_INFO_PARAMS_MAX
-	DomainGuestInfoParamsMax = 2048
+	DomainGuestInfoParamsMax uint32 = 2048
 	// NetworkPortParametersMax is libvirt's REMOTE_NETWORK_PORT_PARAMETERS_MAX
-	NetworkPortParametersMax = 16
+	NetworkPortParametersMax uint32 = 16
 	// DomainAuthorizedSshKeysMax is libvirt's REMOTE_DOMAIN_AUTHORIZED_SSH_KEYS_MAX
-	DomainAuthorizedSshKeysMax = 2048
+	DomainAuthorizedSshKeysMax uint32 = 2048
 	// DomainMessagesMax is libvirt's REMOTE_DOMAIN_MESSAGES_MAX
-	DomainMessagesMax = 2048
+	DomainMessagesMax uint32 = 2048
 	// DomainEventGraphicsIdentityMax is libvirt's REMOTE_DOMAIN_EVENT_GRAPHICS_IDENTITY_MAX
-	DomainEventGraphicsIdentityMax = 20
+	DomainEventGraphicsIdentityMax uint32 = 20
 	// Program is libvirt's REMOTE_PROGRAM
 	Program = 0x20008086
 	// ProtocolVersion is libvirt's REMOTE_PROTOCOL_VERSION
 	ProtocolVersion = 1
 )
+
+// MaxLimit is the largest of the protocol's limits on the length of strings
+// and arrays, libvirt's REMOTE_CPUMAPS_MAX.
+const MaxLimit = CpumapsMax
+
+// ProcedureNames maps each remote_procedure value to libvirt's name for it, for
+// use when debugging.
+var ProcedureNames = map[uint32]string{
+	ProcConnectOpen:                             "REMOTE_PROC_CONNECT_OPEN",
+	ProcConnectClose:                            "REMOTE_PROC_CONNECT_CLOSE",
+	ProcConnectGetType:                          "REMOTE_PROC_CONNECT_GET_TYPE",
+	ProcConnectGetVersion:                       "REMOTE_PROC_CONNECT_GET_VERSION",
+	ProcConnectGetMaxVcpus:                      "REMOTE_PROC_CONNECT_GET_MAX_VCPUS",
+	ProcNodeGetInfo:                             "REMOTE_PROC_NODE_GET_INFO",
+	ProcConnectGetCapabilities:                  "REMOTE_PROC_CONNECT_GET_CAPABILITIES",
+	ProcDomainAttachDevice:                      "REMOTE_PROC_DOMAIN_ATTACH_DEVICE",
+	ProcDomainCreate:                            "REMOTE_PROC_DOMAIN_CREATE",
+	ProcDomainCreateXML:                         "REMOTE_PROC_DOMAIN_CREATE_XML",
+	ProcDomainDefineXML:                         "REMOTE_PROC_DOMAIN_DEFINE_XML",
+	ProcDomainDestroy:                           "REMOTE_PROC_DOMAIN_DESTROY",
+	ProcDomainDetachDevice:                      "REMOTE_PROC_DOMAIN_DETACH_DEVICE",
+	ProcDomainGetXMLDesc:                        "REMOTE_PROC_DOMAIN_GET_XML_DESC",
+	ProcDomainGetAutostart:                      "REMOTE_PROC_DOMAIN_GET_AUTOSTART",
+	ProcDomainGetInfo:                           "REMOTE_PROC_DOMAIN_GET_INFO",
+	ProcDomainGetMaxMemory:                      "REMOTE_PROC_DOMAIN_GET_MAX_MEMORY",
+	ProcDomainGetMaxVcpus:                       "REMOTE_PROC_DOMAIN_GET_MAX_VCPUS",
+	ProcDomainGetOsType:                         "REMOTE_PROC_DOMAIN_GET_OS_TYPE",
+	ProcDomainGetVcpus:                          "REMOTE_PROC_DOMAIN_GET_VCPUS",
+	ProcConnectListDefinedDomains:               "REMOTE_PROC_CONNECT_LIST_DEFINED_DOMAINS",
+	ProcDomainLookupByID:                        "REMOTE_PROC_DOMAIN_LOOKUP_BY_ID",
+	ProcDomainLookupByName:                      "REMOTE_PROC_DOMAIN_LOOKUP_BY_NAME",
+	ProcDomainLookupByUUID:                      "REMOTE_PROC_DOMAIN_LOOKUP_BY_UUID",
+	ProcConnectNumOfDefinedDomains:              "REMOTE_PROC_CONNECT_NUM_OF_DEFINED_DOMAINS",
+	ProcDomainPinVcpu:                           "REMOTE_PROC_DOMAIN_PIN_VCPU",
+	ProcDomainReboot:                            "REMOTE_PROC_DOMAIN_REBOOT",
+	ProcDomainResume:                            "REMOTE_PROC_DOMAIN_RESUME",
+	ProcDomainSetAutostart:                      "REMOTE_PROC_DOMAIN_SET_AUTOSTART",
+	ProcDomainSetMaxMemory:                      "REMOTE_PROC_DOMAIN_SET_MAX_MEMORY",
+	ProcDomainSetMemory:                         "REMOTE_PROC_DOMAIN_SET_MEMORY",
+	ProcDomainSetVcpus:                          "REMOTE_PROC_DOMAIN_SET_VCPUS",
+	ProcDomainShutdown:                          "REMOTE_PROC_DOMAIN_SHUTDOWN",
+	ProcDomainSuspend:                           "REMOTE_PROC_DOMAIN_SUSPEND",
+	ProcDomainUndefine:                          "REMOTE_PROC_DOMAIN_UNDEFINE",
+	ProcConnectListDefinedNetworks:              "REMOTE_PROC_CONNECT_LIST_DEFINED_NETWORKS",
+	ProcConnectListDomains:                      "REMOTE_PROC_CONNECT_LIST_DOMAINS",
+	ProcConnectListNetworks:                     "REMOTE_PROC_CONNECT_LIST_NETWORKS",
+	ProcNetworkCreate:                           "REMOTE_PROC_NETWORK_CREATE",
+	ProcNetworkCreateXML:                        "REMOTE_PROC_NETWORK_CREATE_XML",
+	ProcNetworkDefineXML:                        "REMOTE_PROC_NETWORK_DEFINE_XML",
+	ProcNetworkDestroy:                          "REMOTE_PROC_NETWORK_DESTROY",
+	ProcNetworkGetXMLDesc:                       "REMOTE_PROC_NETWORK_GET_XML_DESC",
+	ProcNetworkGetAutostart:                     "REMOTE_PROC_NETWORK_GET_AUTOSTART",
+	ProcNetworkGetBridgeName:                    "REMOTE_PROC_NETWORK_GET_BRIDGE_NAME",
+	ProcNetworkLookupByName:                     "REMOTE_PROC_NETWORK_LOOKUP_BY_NAME",
+	ProcNetworkLookupByUUID:                     "REMOTE_PROC_NETWORK_LOOKUP_BY_UUID",
+	ProcNetworkSetAutostart:                     "REMOTE_PROC_NETWORK_SET_AUTOSTART",
+	ProcNetworkUndefine:                         "REMOTE_PROC_NETWORK_UNDEFINE",
+	ProcConnectNumOfDefinedNetworks:             "REMOTE_PROC_CONNECT_NUM_OF_DEFINED_NETWORKS",
+	ProcConnectNumOfDomains:                     "REMOTE_PROC_CONNECT_NUM_OF_DOMAINS",
+	ProcConnectNumOfNetworks:                    "REMOTE_PROC_CONNECT_NUM_OF_NETWORKS",
+	ProcDomainCoreDump:                          "REMOTE_PROC_DOMAIN_CORE_DUMP",
+	ProcDomainRestore:                           "REMOTE_PROC_DOMAIN_RESTORE",
+	ProcDomainSave:                              "REMOTE_PROC_DOMAIN_SAVE",
+	ProcDomainGetSchedulerType:                  "REMOTE_PROC_DOMAIN_GET_SCHEDULER_TYPE",
+	ProcDomainGetSchedulerParameters:            "REMOTE_PROC_DOMAIN_GET_SCHEDULER_PARAMETERS",
+	ProcDomainSetSchedulerParameters:            "REMOTE_PROC_DOMAIN_SET_SCHEDULER_PARAMETERS",
+	ProcConnectGetHostname:                      "REMOTE_PROC_CONNECT_GET_HOSTNAME",
+	ProcConnectSupportsFeature:                  "REMOTE_PROC_CONNECT_SUPPORTS_FEATURE",
+	ProcDomainMigratePrepare:                    "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE",
+	ProcDomainMigratePerform:                    "REMOTE_PROC_DOMAIN_MIGRATE_PERFORM",
+	ProcDomainMigrateFinish:                     "REMOTE_PROC_DOMAIN_MIGRATE_FINISH",
+	ProcDomainBlockStats:                        "REMOTE_PROC_DOMAIN_BLOCK_STATS",
+	ProcDomainInterfaceStats:                    "REMOTE_PROC_DOMAIN_INTERFACE_STATS",
+	ProcAuthList:                                "REMOTE_PROC_AUTH_LIST",
+	ProcAuthSaslInit:                            "REMOTE_PROC_AUTH_SASL_INIT",
+	ProcAuthSaslStart:                           "REMOTE_PROC_AUTH_SASL_START",
+	ProcAuthSaslStep:                            "REMOTE_PROC_AUTH_SASL_STEP",
+	ProcAuthPolkit:                              "REMOTE_PROC_AUTH_POLKIT",
+	ProcConnectNumOfStoragePools:                "REMOTE_PROC_CONNECT_NUM_OF_STORAGE_POOLS",
+	ProcConnectListStoragePools:                 "REMOTE_PROC_CONNECT_LIST_STORAGE_POOLS",
+	ProcConnectNumOfDefinedStoragePools:         "REMOTE_PROC_CONNECT_NUM_OF_DEFINED_STORAGE_POOLS",
+	ProcConnectListDefinedStoragePools:          "REMOTE_PROC_CONNECT_LIST_DEFINED_STORAGE_POOLS",
+	ProcConnectFindStoragePoolSources:           "REMOTE_PROC_CONNECT_FIND_STORAGE_POOL_SOURCES",
+	ProcStoragePoolCreateXML:                    "REMOTE_PROC_STORAGE_POOL_CREATE_XML",
+	ProcStoragePoolDefineXML:                    "REMOTE_PROC_STORAGE_POOL_DEFINE_XML",
+	ProcStoragePoolCreate:                       "REMOTE_PROC_STORAGE_POOL_CREATE",
+	ProcStoragePoolBuild:                        "REMOTE_PROC_STORAGE_POOL_BUILD",
+	ProcStoragePoolDestroy:                      "REMOTE_PROC_STORAGE_POOL_DESTROY",
+	ProcStoragePoolDelete:                       "REMOTE_PROC_STORAGE_POOL_DELETE",
+	ProcStoragePoolUndefine:                     "REMOTE_PROC_STORAGE_POOL_UNDEFINE",
+	ProcStoragePoolRefresh:                      "REMOTE_PROC_STORAGE_POOL_REFRESH",
+	ProcStoragePoolLookupByName:                 "REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_NAME",
+	ProcStoragePoolLookupByUUID:                 "REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_UUID",
+	ProcStoragePoolLookupByVolume:               "REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_VOLUME",
+	ProcStoragePoolGetInfo:                      "REMOTE_PROC_STORAGE_POOL_GET_INFO",
+	ProcStoragePoolGetXMLDesc:                   "REMOTE_PROC_STORAGE_POOL_GET_XML_DESC",
+	ProcStoragePoolGetAutostart:                 "REMOTE_PROC_STORAGE_POOL_GET_AUTOSTART",
+	ProcStoragePoolSetAutostart:                 "REMOTE_PROC_STORAGE_POOL_SET_AUTOSTART",
+	ProcStoragePoolNumOfVolumes:                 "REMOTE_PROC_STORAGE_POOL_NUM_OF_VOLUMES",
+	ProcStoragePoolListVolumes:                  "REMOTE_PROC_STORAGE_POOL_LIST_VOLUMES",
+	ProcStorageVolCreateXML:                     "REMOTE_PROC_STORAGE_VOL_CREATE_XML",
+	ProcStorageVolDelete:                        "REMOTE_PROC_STORAGE_VOL_DELETE",
+	ProcStorageVolLookupByName:                  "REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_NAME",
+	ProcStorageVolLookupByKey:                   "REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_KEY",
+	ProcStorageVolLookupByPath:                  "REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_PATH",
+	ProcStorageVolGetInfo:                       "REMOTE_PROC_STORAGE_VOL_GET_INFO",
+	ProcStorageVolGetXMLDesc:                    "REMOTE_PROC_STORAGE_VOL_GET_XML_DESC",
+	ProcStorageVolGetPath:                       "REMOTE_PROC_STORAGE_VOL_GET_PATH",
+	ProcNodeGetCellsFreeMemory:                  "REMOTE_PROC_NODE_GET_CELLS_FREE_MEMORY",
+	ProcNodeGetFreeMemory:                       "REMOTE_PROC_NODE_GET_FREE_MEMORY",
+	ProcDomainBlockPeek:                         "REMOTE_PROC_DOMAIN_BLOCK_PEEK",
+	ProcDomainMemoryPeek:                        "REMOTE_PROC_DOMAIN_MEMORY_PEEK",
+	ProcConnectDomainEventRegister:              "REMOTE_PROC_CONNECT_DOMAIN_EVENT_REGISTER",
+	ProcConnectDomainEventDeregister:            "REMOTE_PROC_CONNECT_DOMAIN_EVENT_DEREGISTER",
+	ProcDomainEventLifecycle:                    "REMOTE_PROC_DOMAIN_EVENT_LIFECYCLE",
+	ProcDomainMigratePrepare2:                   "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE2",
+	ProcDomainMigrateFinish2:                    "REMOTE_PROC_DOMAIN_MIGRATE_FINISH2",
+	ProcConnectGetUri:                           "REMOTE_PROC_CONNECT_GET_URI",
+	ProcNodeNumOfDevices:                        "REMOTE_PROC_NODE_NUM_OF_DEVICES",
+	ProcNodeListDevices:                         "REMOTE_PROC_NODE_LIST_DEVICES",
+	ProcNodeDeviceLookupByName:                  "REMOTE_PROC_NODE_DEVICE_LOOKUP_BY_NAME",
+	ProcNodeDeviceGetXMLDesc:                    "REMOTE_PROC_NODE_DEVICE_GET_XML_DESC",
+	ProcNodeDeviceGetParent:                     "REMOTE_PROC_NODE_DEVICE_GET_PARENT",
+	ProcNodeDeviceNumOfCaps:                     "REMOTE_PROC_NODE_DEVICE_NUM_OF_CAPS",
+	ProcNodeDeviceListCaps:                      "REMOTE_PROC_NODE_DEVICE_LIST_CAPS",
+	ProcNodeDeviceDettach:                       "REMOTE_PROC_NODE_DEVICE_DETTACH",
+	ProcNodeDeviceReAttach:                      "REMOTE_PROC_NODE_DEVICE_RE_ATTACH",
+	ProcNodeDeviceReset:                         "REMOTE_PROC_NODE_DEVICE_RESET",
+	ProcDomainGetSecurityLabel:                  "REMOTE_PROC_DOMAIN_GET_SECURITY_LABEL",
+	ProcNodeGetSecurityModel:                    "REMOTE_PROC_NODE_GET_SECURITY_MODEL",
+	ProcNodeDeviceCreateXML:                     "REMOTE_PROC_NODE_DEVICE_CREATE_XML",
+	ProcNodeDeviceDestroy:                       "REMOTE_PROC_NODE_DEVICE_DESTROY",
+	ProcStorageVolCreateXMLFrom:                 "REMOTE_PROC_STORAGE_VOL_CREATE_XML_FROM",
+	ProcConnectNumOfInterfaces:                  "REMOTE_PROC_CONNECT_NUM_OF_INTERFACES",
+	ProcConnectListInterfaces:                   "REMOTE_PROC_CONNECT_LIST_INTERFACES",
+	ProcInterfaceLookupByName:                   "REMOTE_PROC_INTERFACE_LOOKUP_BY_NAME",
+	ProcInterfaceLookupByMacString:              "REMOTE_PROC_INTERFACE_LOOKUP_BY_MAC_STRING",
+	ProcInterfaceGetXMLDesc:                     "REMOTE_PROC_INTERFACE_GET_XML_DESC",
+	ProcInterfaceDefineXML:                      "REMOTE_PROC_INTERFACE_DEFINE_XML",
+	ProcInterfaceUndefine:                       "REMOTE_PROC_INTERFACE_UNDEFINE",
+	ProcInterfaceCreate:                         "REMOTE_PROC_INTERFACE_CREATE",
+	ProcInterfaceDestroy:                        "REMOTE_PROC_INTERFACE_DESTROY",
+	ProcConnectDomainXMLFromNative:              "REMOTE_PROC_CONNECT_DOMAIN_XML_FROM_NATIVE",
+	ProcConnectDomainXMLToNative:                "REMOTE_PROC_CONNECT_DOMAIN_XML_TO_NATIVE",
+	ProcConnectNumOfDefinedInterfaces:           "REMOTE_PROC_CONNECT_NUM_OF_DEFINED_INTERFACES",
+	ProcConnectListDefinedInterfaces:            "REMOTE_PROC_CONNECT_LIST_DEFINED_INTERFACES",
+	ProcConnectNumOfSecrets:                     "REMOTE_PROC_CONNECT_NUM_OF_SECRETS",
+	ProcConnectListSecrets:                      "REMOTE_PROC_CONNECT_LIST_SECRETS",
+	ProcSecretLookupByUUID:                      "REMOTE_PROC_SECRET_LOOKUP_BY_UUID",
+	ProcSecretDefineXML:                         "REMOTE_PROC_SECRET_DEFINE_XML",
+	ProcSecretGetXMLDesc:                        "REMOTE_PROC_SECRET_GET_XML_DESC",
+	ProcSecretSetValue:                          "REMOTE_PROC_SECRET_SET_VALUE",
+	ProcSecretGetValue:                          "REMOTE_PROC_SECRET_GET_VALUE",
+	ProcSecretUndefine:                          "REMOTE_PROC_SECRET_UNDEFINE",
+	ProcSecretLookupByUsage:                     "REMOTE_PROC_SECRET_LOOKUP_BY_USAGE",
+	ProcDomainMigratePrepareTunnel:              "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL",
+	ProcConnectIsSecure:                         "REMOTE_PROC_CONNECT_IS_SECURE",
+	ProcDomainIsActive:                          "REMOTE_PROC_DOMAIN_IS_ACTIVE",
+	ProcDomainIsPersistent:                      "REMOTE_PROC_DOMAIN_IS_PERSISTENT",
+	ProcNetworkIsActive:                         "REMOTE_PROC_NETWORK_IS_ACTIVE",
+	ProcNetworkIsPersistent:                     "REMOTE_PROC_NETWORK_IS_PERSISTENT",
+	ProcStoragePoolIsActive:                     "REMOTE_PROC_STORAGE_POOL_IS_ACTIVE",
+	ProcStoragePoolIsPersistent:                 "REMOTE_PROC_STORAGE_POOL_IS_PERSISTENT",
+	ProcInterfaceIsActive:                       "REMOTE_PROC_INTERFACE_IS_ACTIVE",
+	ProcConnectGetLibVersion:                    "REMOTE_PROC_CONNECT_GET_LIB_VERSION",
+	ProcConnectCompareCPU:                       "REMOTE_PROC_CONNECT_COMPARE_CPU",
+	ProcDomainMemoryStats:                       "REMOTE_PROC_DOMAIN_MEMORY_STATS",
+	ProcDomainAttachDeviceFlags:                 "REMOTE_PROC_DOMAIN_ATTACH_DEVICE_FLAGS",
+	ProcDomainDetachDeviceFlags:                 "REMOTE_PROC_DOMAIN_DETACH_DEVICE_FLAGS",
+	ProcConnectBaselineCPU:                      "REMOTE_PROC_CONNECT_BASELINE_CPU",
+	ProcDomainGetJobInfo:                        "REMOTE_PROC_DOMAIN_GET_JOB_INFO",
+	ProcDomainAbortJob:                          "REMOTE_PROC_DOMAIN_ABORT_JOB",
+	ProcStorageVolWipe:                          "REMOTE_PROC_STORAGE_VOL_WIPE",
+	ProcDomainMigrateSetMaxDowntime:             "REMOTE_PROC_DOMAIN_MIGRATE_SET_MAX_DOWNTIME",
+	ProcConnectDomainEventRegisterAny:           "REMOTE_PROC_CONNECT_DOMAIN_EVENT_REGISTER_ANY",
+	ProcConnectDomainEventDeregisterAny:         "REMOTE_PROC_CONNECT_DOMAIN_EVENT_DEREGISTER_ANY",
+	ProcDomainEventReboot:                       "REMOTE_PROC_DOMAIN_EVENT_REBOOT",
+	ProcDomainEventRtcChange:                    "REMOTE_PROC_DOMAIN_EVENT_RTC_CHANGE",
+	ProcDomainEventWatchdog:                     "REMOTE_PROC_DOMAIN_EVENT_WATCHDOG",
+	ProcDomainEventIOError:                      "REMOTE_PROC_DOMAIN_EVENT_IO_ERROR",
+	ProcDomainEventGraphics:                     "REMOTE_PROC_DOMAIN_EVENT_GRAPHICS",
+	ProcDomainUpdateDeviceFlags:                 "REMOTE_PROC_DOMAIN_UPDATE_DEVICE_FLAGS",
+	ProcNwfilterLookupByName:                    "REMOTE_PROC_NWFILTER_LOOKUP_BY_NAME",
+	ProcNwfilterLookupByUUID:                    "REMOTE_PROC_NWFILTER_LOOKUP_BY_UUID",
+	ProcNwfilterGetXMLDesc:                      "REMOTE_PROC_NWFILTER_GET_XML_DESC",
+	ProcConnectNumOfNwfilters:                   "REMOTE_PROC_CONNECT_NUM_OF_NWFILTERS",
+	ProcConnectListNwfilters:                    "REMOTE_PROC_CONNECT_LIST_NWFILTERS",
+	ProcNwfilterDefineXML:                       "REMOTE_PROC_NWFILTER_DEFINE_XML",
+	ProcNwfilterUndefine:                        "REMOTE_PROC_NWFILTER_UNDEFINE",
+	ProcDomainManagedSave:                       "REMOTE_PROC_DOMAIN_MANAGED_SAVE",
+	ProcDomainHasManagedSaveImage:               "REMOTE_PROC_DOMAIN_HAS_MANAGED_SAVE_IMAGE",
+	ProcDomainManagedSaveRemove:                 "REMOTE_PROC_DOMAIN_MANAGED_SAVE_REMOVE",
+	ProcDomainSnapshotCreateXML:                 "REMOTE_PROC_DOMAIN_SNAPSHOT_CREATE_XML",
+	ProcDomainSnapshotGetXMLDesc:                "REMOTE_PROC_DOMAIN_SNAPSHOT_GET_XML_DESC",
+	ProcDomainSnapshotNum:                       "REMOTE_PROC_DOMAIN_SNAPSHOT_NUM",
+	ProcDomainSnapshotListNames:                 "REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_NAMES",
+	ProcDomainSnapshotLookupByName:              "REMOTE_PROC_DOMAIN_SNAPSHOT_LOOKUP_BY_NAME",
+	ProcDomainHasCurrentSnapshot:                "REMOTE_PROC_DOMAIN_HAS_CURRENT_SNAPSHOT",
+	ProcDomainSnapshotCurrent:                   "REMOTE_PROC_DOMAIN_SNAPSHOT_CURRENT",
+	ProcDomainRevertToSnapshot:                  "REMOTE_PROC_DOMAIN_REVERT_TO_SNAPSHOT",
+	ProcDomainSnapshotDelete:                    "REMOTE_PROC_DOMAIN_SNAPSHOT_DELETE",
+	ProcDomainGetBlockInfo:                      "REMOTE_PROC_DOMAIN_GET_BLOCK_INFO",
+	ProcDomainEventIOErrorReason:                "REMOTE_PROC_DOMAIN_EVENT_IO_ERROR_REASON",
+	ProcDomainCreateWithFlags:                   "REMOTE_PROC_DOMAIN_CREATE_WITH_FLAGS",
+	ProcDomainSetMemoryParameters:               "REMOTE_PROC_DOMAIN_SET_MEMORY_PARAMETERS",
+	ProcDomainGetMemoryParameters:               "REMOTE_PROC_DOMAIN_GET_MEMORY_PARAMETERS",
+	ProcDomainSetVcpusFlags:                     "REMOTE_PROC_DOMAIN_SET_VCPUS_FLAGS",
+	ProcDomainGetVcpusFlags:                     "REMOTE_PROC_DOMAIN_GET_VCPUS_FLAGS",
+	ProcDomainOpenConsole:                       "REMOTE_PROC_DOMAIN_OPEN_CONSOLE",
+	ProcDomainIsUpdated:                         "REMOTE_PROC_DOMAIN_IS_UPDATED",
+	ProcConnectGetSysinfo:                       "REMOTE_PROC_CONNECT_GET_SYSINFO",
+	ProcDomainSetMemoryFlags:                    "REMOTE_PROC_DOMAIN_SET_MEMORY_FLAGS",
+	ProcDomainSetBlkioParameters:                "REMOTE_PROC_DOMAIN_SET_BLKIO_PARAMETERS",
+	ProcDomainGetBlkioParameters:                "REMOTE_PROC_DOMAIN_GET_BLKIO_PARAMETERS",
+	ProcDomainMigrateSetMaxSpeed:                "REMOTE_PROC_DOMAIN_MIGRATE_SET_MAX_SPEED",
+	ProcStorageVolUpload:                        "REMOTE_PROC_STORAGE_VOL_UPLOAD",
+	ProcStorageVolDownload:                      "REMOTE_PROC_STORAGE_VOL_DOWNLOAD",
+	ProcDomainInjectNmi:                         "REMOTE_PROC_DOMAIN_INJECT_NMI",
+	ProcDomainScreenshot:                        "REMOTE_PROC_DOMAIN_SCREENSHOT",
+	ProcDomainGetState:                          "REMOTE_PROC_DOMAIN_GET_STATE",
+	ProcDomainMigrateBegin3:                     "REMOTE_PROC_DOMAIN_MIGRATE_BEGIN3",
+	ProcDomainMigratePrepare3:                   "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE3",
+	ProcDomainMigratePrepareTunnel3:             "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL3",
+	ProcDomainMigratePerform3:                   "REMOTE_PROC_DOMAIN_MIGRATE_PERFORM3",
+	ProcDomainMigrateFinish3:                    "REMOTE_PROC_DOMAIN_MIGRATE_FINISH3",
+	ProcDomainMigrateConfirm3:                   "REMOTE_PROC_DOMAIN_MIGRATE_CONFIRM3",
+	ProcDomainSetSchedulerParametersFlags:       "REMOTE_PROC_DOMAIN_SET_SCHEDULER_PARAMETERS_FLAGS",
+	ProcInterfaceChangeBegin:                    "REMOTE_PROC_INTERFACE_CHANGE_BEGIN",
+	ProcInterfaceChangeCommit:                   "REMOTE_PROC_INTERFACE_CHANGE_COMMIT",
+	ProcInterfaceChangeRollback:                 "REMOTE_PROC_INTERFACE_CHANGE_ROLLBACK",
+	ProcDomainGetSchedulerParametersFlags:       "REMOTE_PROC_DOMAIN_GET_SCHEDULER_PARAMETERS_FLAGS",
+	ProcDomainEventControlError:                 "REMOTE_PROC_DOMAIN_EVENT_CONTROL_ERROR",
+	ProcDomainPinVcpuFlags:                      "REMOTE_PROC_DOMAIN_PIN_VCPU_FLAGS",
+	ProcDomainSendKey:                           "REMOTE_PROC_DOMAIN_SEND_KEY",
+	ProcNodeGetCPUStats:                         "REMOTE_PROC_NODE_GET_CPU_STATS",
+	ProcNodeGetMemoryStats:                      "REMOTE_PROC_NODE_GET_MEMORY_STATS",
+	ProcDomainGetControlInfo:                    "REMOTE_PROC_DOMAIN_GET_CONTROL_INFO",
+	ProcDomainGetVcpuPinInfo:                    "REMOTE_PROC_DOMAIN_GET_VCPU_PIN_INFO",
+	ProcDomainUndefineFlags:                     "REMOTE_PROC_DOMAIN_UNDEFINE_FLAGS",
+	ProcDomainSaveFlags:                         "REMOTE_PROC_DOMAIN_SAVE_FLAGS",
+	ProcDomainRestoreFlags:                      "REMOTE_PROC_DOMAIN_RESTORE_FLAGS",
+	ProcDomainDestroyFlags:                      "REMOTE_PROC_DOMAIN_DESTROY_FLAGS",
+	ProcDomainSaveImageGetXMLDesc:               "REMOTE_PROC_DOMAIN_SAVE_IMAGE_GET_XML_DESC",
+	ProcDomainSaveImageDefineXML:                "REMOTE_PROC_DOMAIN_SAVE_IMAGE_DEFINE_XML",
+	ProcDomainBlockJobAbort:                     "REMOTE_PROC_DOMAIN_BLOCK_JOB_ABORT",
+	ProcDomainGetBlockJobInfo:                   "REMOTE_PROC_DOMAIN_GET_BLOCK_JOB_INFO",
+	ProcDomainBlockJobSetSpeed:                  "REMOTE_PROC_DOMAIN_BLOCK_JOB_SET_SPEED",
+	ProcDomainBlockPull:                         "REMOTE_PROC_DOMAIN_BLOCK_PULL",
+	ProcDomainEventBlockJob:                     "REMOTE_PROC_DOMAIN_EVENT_BLOCK_JOB",
+	ProcDomainMigrateGetMaxSpeed:                "REMOTE_PROC_DOMAIN_MIGRATE_GET_MAX_SPEED",
+	ProcDomainBlockStatsFlags:                   "REMOTE_PROC_DOMAIN_BLOCK_STATS_FLAGS",
+	ProcDomainSnapshotGetParent:                 "REMOTE_PROC_DOMAIN_SNAPSHOT_GET_PARENT",
+	ProcDomainReset:                             "REMOTE_PROC_DOMAIN_RESET",
+	ProcDomainSnapshotNumChildren:               "REMOTE_PROC_DOMAIN_SNAPSHOT_NUM_CHILDREN",
+	ProcDomainSnapshotListChildrenNames:         "REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_CHILDREN_NAMES",
+	ProcDomainEventDiskChange:                   "REMOTE_PROC_DOMAIN_EVENT_DISK_CHANGE",
+	ProcDomainOpenGraphics:                      "REMOTE_PROC_DOMAIN_OPEN_GRAPHICS",
+	ProcNodeSuspendForDuration:                  "REMOTE_PROC_NODE_SUSPEND_FOR_DURATION",
+	ProcDomainBlockResize:                       "REMOTE_PROC_DOMAIN_BLOCK_RESIZE",
+	ProcDomainSetBlockIOTune:                    "REMOTE_PROC_DOMAIN_SET_BLOCK_IO_TUNE",
+	ProcDomainGetBlockIOTune:                    "REMOTE_PROC_DOMAIN_GET_BLOCK_IO_TUNE",
+	ProcDomainSetNumaParameters:                 "REMOTE_PROC_DOMAIN_SET_NUMA_PARAMETERS",
+	ProcDomainGetNumaParameters:                 "REMOTE_PROC_DOMAIN_GET_NUMA_PARAMETERS",
+	ProcDomainSetInterfaceParameters:            "REMOTE_PROC_DOMAIN_SET_INTERFACE_PARAMETERS",
+	ProcDomainGetInterfaceParameters:            "REMOTE_PROC_DOMAIN_GET_INTERFACE_PARAMETERS",
+	ProcDomainShutdownFlags:                     "REMOTE_PROC_DOMAIN_SHUTDOWN_FLAGS",
+	ProcStorageVolWipePattern:                   "REMOTE_PROC_STORAGE_VOL_WIPE_PATTERN",
+	ProcStorageVolResize:                        "REMOTE_PROC_STORAGE_VOL_RESIZE",
+	ProcDomainPmSuspendForDuration:              "REMOTE_PROC_DOMAIN_PM_SUSPEND_FOR_DURATION",
+	ProcDomainGetCPUStats:                       "REMOTE_PROC_DOMAIN_GET_CPU_STATS",
+	ProcDomainGetDiskErrors:                     "REMOTE_PROC_DOMAIN_GET_DISK_ERRORS",
+	ProcDomainSetMetadata:                       "REMOTE_PROC_DOMAIN_SET_METADATA",
+	ProcDomainGetMetadata:                       "REMOTE_PROC_DOMAIN_GET_METADATA",
+	ProcDomainBlockRebase:                       "REMOTE_PROC_DOMAIN_BLOCK_REBASE",
+	ProcDomainPmWakeup:                          "REMOTE_PROC_DOMAIN_PM_WAKEUP",
+	ProcDomainEventTrayChange:                   "REMOTE_PROC_DOMAIN_EVENT_TRAY_CHANGE",
+	ProcDomainEventPmwakeup:                     "REMOTE_PROC_DOMAIN_EVENT_PMWAKEUP",
+	ProcDomainEventPmsuspend:                    "REMOTE_PROC_DOMAIN_EVENT_PMSUSPEND",
+	ProcDomainSnapshotIsCurrent:                 "REMOTE_PROC_DOMAIN_SNAPSHOT_IS_CURRENT",
+	ProcDomainSnapshotHasMetadata:               "REMOTE_PROC_DOMAIN_SNAPSHOT_HAS_METADATA",
+	ProcConnectListAllDomains:                   "REMOTE_PROC_CONNECT_LIST_ALL_DOMAINS",
+	ProcDomainListAllSnapshots:                  "REMOTE_PROC_DOMAIN_LIST_ALL_SNAPSHOTS",
+	ProcDomainSnapshotListAllChildren:           "REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_ALL_CHILDREN",
+	ProcDomainEventBalloonChange:                "REMOTE_PROC_DOMAIN_EVENT_BALLOON_CHANGE",
+	ProcDomainGetHostname:                       "REMOTE_PROC_DOMAIN_GET_HOSTNAME",
+	ProcDomainGetSecurityLabelList:              "REMOTE_PROC_DOMAIN_GET_SECURITY_LABEL_LIST",
+	ProcDomainPinEmulator:                       "REMOTE_PROC_DOMAIN_PIN_EMULATOR",
+	ProcDomainGetEmulatorPinInfo:                "REMOTE_PROC_DOMAIN_GET_EMULATOR_PIN_INFO",
+	ProcConnectListAllStoragePools:              "REMOTE_PROC_CONNECT_LIST_ALL_STORAGE_POOLS",
+	ProcStoragePoolListAllVolumes:               "REMOTE_PROC_STORAGE_POOL_LIST_ALL_VOLUMES",
+	ProcConnectListAllNetworks:                  "REMOTE_PROC_CONNECT_LIST_ALL_NETWORKS",
+	ProcConnectListAllInterfaces:                "REMOTE_PROC_CONNECT_LIST_ALL_INTERFACES",
+	ProcConnectListAllNodeDevices:               "REMOTE_PROC_CONNECT_LIST_ALL_NODE_DEVICES",
+	ProcConnectListAllNwfilters:                 "REMOTE_PROC_CONNECT_LIST_ALL_NWFILTERS",
+	ProcConnectListAllSecrets:                   "REMOTE_PROC_CONNECT_LIST_ALL_SECRETS",
+	ProcNodeSetMemoryParameters:                 "REMOTE_PROC_NODE_SET_MEMORY_PARAMETERS",
+	ProcNodeGetMemoryParameters:                 "REMOTE_PROC_NODE_GET_MEMORY_PARAMETERS",
+	ProcDomainBlockCommit:                       "REMOTE_PROC_DOMAIN_BLOCK_COMMIT",
+	ProcNetworkUpdate:                           "REMOTE_PROC_NETWORK_UPDATE",
+	ProcDomainEventPmsuspendDisk:                "REMOTE_PROC_DOMAIN_EVENT_PMSUSPEND_DISK",
+	ProcNodeGetCPUMap:                           "REMOTE_PROC_NODE_GET_CPU_MAP",
+	ProcDomainFstrim:                            "REMOTE_PROC_DOMAIN_FSTRIM",
+	ProcDomainSendProcessSignal:                 "REMOTE_PROC_DOMAIN_SEND_PROCESS_SIGNAL",
+	ProcDomainOpenChannel:                       "REMOTE_PROC_DOMAIN_OPEN_CHANNEL",
+	ProcNodeDeviceLookupScsiHostByWwn:           "REMOTE_PROC_NODE_DEVICE_LOOKUP_SCSI_HOST_BY_WWN",
+	ProcDomainGetJobStats:                       "REMOTE_PROC_DOMAIN_GET_JOB_STATS",
+	ProcDomainMigrateGetCompressionCache:        "REMOTE_PROC_DOMAIN_MIGRATE_GET_COMPRESSION_CACHE",
+	ProcDomainMigrateSetCompressionCache:        "REMOTE_PROC_DOMAIN_MIGRATE_SET_COMPRESSION_CACHE",
+	ProcNodeDeviceDetachFlags:                   "REMOTE_PROC_NODE_DEVICE_DETACH_FLAGS",
+	ProcDomainMigrateBegin3Params:               "REMOTE_PROC_DOMAIN_MIGRATE_BEGIN3_PARAMS",
+	ProcDomainMigratePrepare3Params:             "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE3_PARAMS",
+	ProcDomainMigratePrepareTunnel3Params:       "REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL3_PARAMS",
+	ProcDomainMigratePerform3Params:             "REMOTE_PROC_DOMAIN_MIGRATE_PERFORM3_PARAMS",
+	ProcDomainMigrateFinish3Params:              "REMOTE_PROC_DOMAIN_MIGRATE_FINISH3_PARAMS",
+	ProcDomainMigrateConfirm3Params:             "REMOTE_PROC_DOMAIN_MIGRATE_CONFIRM3_PARAMS",
+	ProcDomainSetMemoryStatsPeriod:              "REMOTE_PROC_DOMAIN_SET_MEMORY_STATS_PERIOD",
+	ProcDomainCreateXMLWithFiles:                "REMOTE_PROC_DOMAIN_CREATE_XML_WITH_FILES",
+	ProcDomainCreateWithFiles:                   "REMOTE_PROC_DOMAIN_CREATE_WITH_FILES",
+	ProcDomainEventDeviceRemoved:                "REMOTE_PROC_DOMAIN_EVENT_DEVICE_REMOVED",
+	ProcConnectGetCPUModelNames:                 "REMOTE_PROC_CONNECT_GET_CPU_MODEL_NAMES",
+	ProcConnectNetworkEventRegisterAny:          "REMOTE_PROC_CONNECT_NETWORK_EVENT_REGISTER_ANY",
+	ProcConnectNetworkEventDeregisterAny:        "REMOTE_PROC_CONNECT_NETWORK_EVENT_DEREGISTER_ANY",
+	ProcNetworkEventLifecycle:                   "REMOTE_PROC_NETWORK_EVENT_LIFECYCLE",
+	ProcConnectDomainEventCallbackRegisterAny:   "REMOTE_PROC_CONNECT_DOMAIN_EVENT_CALLBACK_REGISTER_ANY",
+	ProcConnectDomainEventCallbackDeregisterAny: "REMOTE_PROC_CONNECT_DOMAIN_EVENT_CALLBACK_DEREGISTER_ANY",
+	ProcDomainEventCallbackLifecycle:            "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_LIFECYCLE",
+	ProcDomainEventCallbackReboot:               "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_REBOOT",
+	ProcDomainEventCallbackRtcChange:            "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_RTC_CHANGE",
+	ProcDomainEventCallbackWatchdog:             "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_WATCHDOG",
+	ProcDomainEventCallbackIOError:              "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_IO_ERROR",
+	ProcDomainEventCallbackGraphics:             "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_GRAPHICS",
+	ProcDomainEventCallbackIOErrorReason:        "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_IO_ERROR_REASON",
+	ProcDomainEventCallbackControlError:         "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_CONTROL_ERROR",
+	ProcDomainEventCallbackBlockJob:             "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_BLOCK_JOB",
+	ProcDomainEventCallbackDiskChange:           "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DISK_CHANGE",
+	ProcDomainEventCallbackTrayChange:           "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_TRAY_CHANGE",
+	ProcDomainEventCallbackPmwakeup:             "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMWAKEUP",
+	ProcDomainEventCallbackPmsuspend:            "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMSUSPEND",
+	ProcDomainEventCallbackBalloonChange:        "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_BALLOON_CHANGE",
+	ProcDomainEventCallbackPmsuspendDisk:        "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMSUSPEND_DISK",
+	ProcDomainEventCallbackDeviceRemoved:        "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_REMOVED",
+	ProcDomainCoreDumpWithFormat:                "REMOTE_PROC_DOMAIN_CORE_DUMP_WITH_FORMAT",
+	ProcDomainFsfreeze:                          "REMOTE_PROC_DOMAIN_FSFREEZE",
+	ProcDomainFsthaw:                            "REMOTE_PROC_DOMAIN_FSTHAW",
+	ProcDomainGetTime:                           "REMOTE_PROC_DOMAIN_GET_TIME",
+	ProcDomainSetTime:                           "REMOTE_PROC_DOMAIN_SET_TIME",
+	ProcDomainEventBlockJob2:                    "REMOTE_PROC_DOMAIN_EVENT_BLOCK_JOB_2",
+	ProcNodeGetFreePages:                        "REMOTE_PROC_NODE_GET_FREE_PAGES",
+	ProcNetworkGetDhcpLeases:                    "REMOTE_PROC_NETWORK_GET_DHCP_LEASES",
+	ProcConnectGetDomainCapabilities:            "REMOTE_PROC_CONNECT_GET_DOMAIN_CAPABILITIES",
+	ProcDomainOpenGraphicsFd:                    "REMOTE_PROC_DOMAIN_OPEN_GRAPHICS_FD",
+	ProcConnectGetAllDomainStats:                "REMOTE_PROC_CONNECT_GET_ALL_DOMAIN_STATS",
+	ProcDomainBlockCopy:                         "REMOTE_PROC_DOMAIN_BLOCK_COPY",
+	ProcDomainEventCallbackTunable:              "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_TUNABLE",
+	ProcNodeAllocPages:                          "REMOTE_PROC_NODE_ALLOC_PAGES",
+	ProcDomainEventCallbackAgentLifecycle:       "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_AGENT_LIFECYCLE",
+	ProcDomainGetFsinfo:                         "REMOTE_PROC_DOMAIN_GET_FSINFO",
+	ProcDomainDefineXMLFlags:                    "REMOTE_PROC_DOMAIN_DEFINE_XML_FLAGS",
+	ProcDomainGetIothreadInfo:                   "REMOTE_PROC_DOMAIN_GET_IOTHREAD_INFO",
+	ProcDomainPinIothread:                       "REMOTE_PROC_DOMAIN_PIN_IOTHREAD",
+	ProcDomainInterfaceAddresses:                "REMOTE_PROC_DOMAIN_INTERFACE_ADDRESSES",
+	ProcDomainEventCallbackDeviceAdded:          "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_ADDED",
+	ProcDomainAddIothread:                       "REMOTE_PROC_DOMAIN_ADD_IOTHREAD",
+	ProcDomainDelIothread:                       "REMOTE_PROC_DOMAIN_DEL_IOTHREAD",
+	ProcDomainSetUserPassword:                   "REMOTE_PROC_DOMAIN_SET_USER_PASSWORD",
+	ProcDomainRename:                            "REMOTE_PROC_DOMAIN_RENAME",
+	ProcDomainEventCallbackMigrationIteration:   "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_MIGRATION_ITERATION",
+	ProcConnectRegisterCloseCallback:            "REMOTE_PROC_CONNECT_REGISTER_CLOSE_CALLBACK",
+	ProcConnectUnregisterCloseCallback:          "REMOTE_PROC_CONNECT_UNREGISTER_CLOSE_CALLBACK",
+	ProcConnectEventConnectionClosed:            "REMOTE_PROC_CONNECT_EVENT_CONNECTION_CLOSED",
+	ProcDomainEventCallbackJobCompleted:         "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_JOB_COMPLETED",
+	ProcDomainMigrateStartPostCopy:              "REMOTE_PROC_DOMAIN_MIGRATE_START_POST_COPY",
+	ProcDomainGetPerfEvents:                     "REMOTE_PROC_DOMAIN_GET_PERF_EVENTS",
+	ProcDomainSetPerfEvents:                     "REMOTE_PROC_DOMAIN_SET_PERF_EVENTS",
+	ProcDomainEventCallbackDeviceRemovalFailed:  "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_REMOVAL_FAILED",
+	ProcConnectStoragePoolEventRegisterAny:      "REMOTE_PROC_CONNECT_STORAGE_POOL_EVENT_REGISTER_ANY",
+	ProcConnectStoragePoolEventDeregisterAny:    "REMOTE_PROC_CONNECT_STORAGE_POOL_EVENT_DEREGISTER_ANY",
+	ProcStoragePoolEventLifecycle:               "REMOTE_PROC_STORAGE_POOL_EVENT_LIFECYCLE",
+	ProcDomainGetGuestVcpus:                     "REMOTE_PROC_DOMAIN_GET_GUEST_VCPUS",
+	ProcDomainSetGuestVcpus:                     "REMOTE_PROC_DOMAIN_SET_GUEST_VCPUS",
+	ProcStoragePoolEventRefresh:                 "REMOTE_PROC_STORAGE_POOL_EVENT_REFRESH",
+	ProcConnectNodeDeviceEventRegisterAny:       "REMOTE_PROC_CONNECT_NODE_DEVICE_EVENT_REGISTER_ANY",
+	ProcConnectNodeDeviceEventDeregisterAny:     "REMOTE_PROC_CONNECT_NODE_DEVICE_EVENT_DEREGISTER_ANY",
+	ProcNodeDeviceEventLifecycle:                "REMOTE_PROC_NODE_DEVICE_EVENT_LIFECYCLE",
+	ProcNodeDeviceEventUpdate:                   "REMOTE_PROC_NODE_DEVICE_EVENT_UPDATE",
+	ProcStorageVolGetInfoFlags:                  "REMOTE_PROC_STORAGE_VOL_GET_INFO_FLAGS",
+	ProcDomainEventCallbackMetadataChange:       "REMOTE_PROC_DOMAIN_EVENT_CALLBACK_METADATA_CHANGE",
+	ProcConnectSecretEventRegisterAny:           "REMOTE_PROC_CONNECT_SECRET_EVENT_REGISTER_ANY",
+	ProcConnectSecretEventDeregisterAny:         "REMOTE_PROC_CONNECT_SECRET_EVENT_DEREGISTER_ANY",
+	ProcSecretEventLifecycle:                    "REMOTE_PROC_SECRET_EVENT_LIFECYCLE",
+	ProcSecretEventValueChanged:                 "REMOTE_PROC_SECRET_EVENT_VALUE_CHANGED",
+	ProcDomainSetVcpu:                           "REMOTE_PROC_DOMAIN_SET_VCPU",
+	ProcDomainEventBlockThreshold:               "REMOTE_PROC_DOMAIN_EVENT_BLOCK_THRESHOLD",
+	ProcDomainSetBlockThreshold:                 "REMOTE_PROC_DOMAIN_SET_BLOCK_THRESHOLD",
+	ProcDomainMigrateGetMaxDowntime:             "REMOTE_PROC_DOMAIN_MIGRATE_GET_MAX_DOWNTIME",
+	ProcDomainManagedSaveGetXMLDesc:             "REMOTE_PROC_DOMAIN_MANAGED_SAVE_GET_XML_DESC",
+	ProcDomainManagedSaveDefineXML:              "REMOTE_PROC_DOMAIN_MANAGED_SAVE_DEFINE_XML",
+	ProcDomainSetLifecycleAction:                "REMOTE_PROC_DOMAIN_SET_LIFECYCLE_ACTION",
+	ProcStoragePoolLookupByTargetPath:           "REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_TARGET_PATH",
+	ProcDomainDetachDeviceAlias:                 "REMOTE_PROC_DOMAIN_DETACH_DEVICE_ALIAS",
+	ProcConnectCompareHypervisorCPU:             "REMOTE_PROC_CONNECT_COMPARE_HYPERVISOR_CPU",
+	ProcConnectBaselineHypervisorCPU:            "REMOTE_PROC_CONNECT_BASELINE_HYPERVISOR_CPU",
+	ProcNodeGetSevInfo:                          "REMOTE_PROC_NODE_GET_SEV_INFO",
+	ProcDomainGetLaunchSecurityInfo:             "REMOTE_PROC_DOMAIN_GET_LAUNCH_SECURITY_INFO",
+	ProcNwfilterBindingLookupByPortDev:          "REMOTE_PROC_NWFILTER_BINDING_LOOKUP_BY_PORT_DEV",
+	ProcNwfilterBindingGetXMLDesc:               "REMOTE_PROC_NWFILTER_BINDING_GET_XML_DESC",
+	ProcNwfilterBindingCreateXML:                "REMOTE_PROC_NWFILTER_BINDING_CREATE_XML",
+	ProcNwfilterBindingDelete:                   "REMOTE_PROC_NWFILTER_BINDING_DELETE",
+	ProcConnectListAllNwfilterBindings:          "REMOTE_PROC_CONNECT_LIST_ALL_NWFILTER_BINDINGS",
+	ProcDomainSetIothreadParams:                 "REMOTE_PROC_DOMAIN_SET_IOTHREAD_PARAMS",
+	ProcConnectGetStoragePoolCapabilities:       "REMOTE_PROC_CONNECT_GET_STORAGE_POOL_CAPABILITIES",
+	ProcNetworkListAllPorts:                     "REMOTE_PROC_NETWORK_LIST_ALL_PORTS",
+	ProcNetworkPortLookupByUUID:                 "REMOTE_PROC_NETWORK_PORT_LOOKUP_BY_UUID",
+	ProcNetworkPortCreateXML:                    "REMOTE_PROC_NETWORK_PORT_CREATE_XML",
+	ProcNetworkPortGetParameters:                "REMOTE_PROC_NETWORK_PORT_GET_PARAMETERS",
+	ProcNetworkPortSetParameters:                "REMOTE_PROC_NETWORK_PORT_SET_PARAMETERS",
+	ProcNetworkPortGetXMLDesc:                   "REMOTE_PROC_NETWORK_PORT_GET_XML_DESC",
+	ProcNetworkPortDelete:                       "REMOTE_PROC_NETWORK_PORT_DELETE",
+	ProcDomainCheckpointCreateXML:               "REMOTE_PROC_DOMAIN_CHECKPOINT_CREATE_XML",
+	ProcDomainCheckpointGetXMLDesc:              "REMOTE_PROC_DOMAIN_CHECKPOINT_GET_XML_DESC",
+	ProcDomainListAllCheckpoints:                "REMOTE_PROC_DOMAIN_LIST_ALL_CHECKPOINTS",
+	ProcDomainCheckpointListAllChildren:         "REMOTE_PROC_DOMAIN_CHECKPOINT_LIST_ALL_CHILDREN",
+	ProcDomainCheckpointLookupByName:            "REMOTE_PROC_DOMAIN_CHECKPOINT_LOOKUP_BY_NAME",
+	ProcDomainCheckpointGetParent:               "REMOTE_PROC_DOMAIN_CHECKPOINT_GET_PARENT",
+	ProcDomainCheckpointDelete:                  "REMOTE_PROC_DOMAIN_CHECKPOINT_DELETE",
+	ProcDomainGetGuestInfo:                      "REMOTE_PROC_DOMAIN_GET_GUEST_INFO",
+	ProcConnectSetIdentity:                      "REMOTE_PROC_CONNECT_SET_IDENTITY",
+	ProcDomainAgentSetResponseTimeout:           "REMOTE_PROC_DOMAIN_AGENT_SET_RESPONSE_TIMEOUT",
+	ProcDomainBackupBegin:                       "REMOTE_PROC_DOMAIN_BACKUP_BEGIN",
+	ProcDomainBackupGetXMLDesc:                  "REMOTE_PROC_DOMAIN_BACKUP_GET_XML_DESC",
+	ProcDomainEventMemoryFailure:                "REMOTE_PROC_DOMAIN_EVENT_MEMORY_FAILURE",
+	ProcDomainAuthorizedSshKeysGet:              "REMOTE_PROC_DOMAIN_AUTHORIZED_SSH_KEYS_GET",
+	ProcDomainAuthorizedSshKeysSet:              "REMOTE_PROC_DOMAIN_AUTHORIZED_SSH_KEYS_SET",
+	ProcDomainGetMessages:                       "REMOTE_PROC_DOMAIN_GET_MESSAGES",
+}
+
+// ProcedureMethods maps the name of each go-libvirt method to the
+// remote_procedure value it calls.
+var ProcedureMethods = map[string]uint32{
+	"ConnectOpen":                             ProcConnectOpen,
+	"ConnectClose":                            ProcConnectClose,
+	"ConnectGetType":                          ProcConnectGetType,
+	"ConnectGetVersion":                       ProcConnectGetVersion,
+	"ConnectGetMaxVcpus":                      ProcConnectGetMaxVcpus,
+	"NodeGetInfo":                             ProcNodeGetInfo,
+	"ConnectGetCapabilities":                  ProcConnectGetCapabilities,
+	"DomainAttachDevice":                      ProcDomainAttachDevice,
+	"DomainCreate":                            ProcDomainCreate,
+	"DomainCreateXML":                         ProcDomainCreateXML,
+	"DomainDefineXML":                         ProcDomainDefineXML,
+	"DomainDestroy":                           ProcDomainDestroy,
+	"DomainDetachDevice":                      ProcDomainDetachDevice,
+	"DomainGetXMLDesc":                        ProcDomainGetXMLDesc,
+	"DomainGetAutostart":                      ProcDomainGetAutostart,
+	"DomainGetInfo":                           ProcDomainGetInfo,
+	"DomainGetMaxMemory":                      ProcDomainGetMaxMemory,
+	"DomainGetMaxVcpus":                       ProcDomainGetMaxVcpus,
+	"DomainGetOsType":                         ProcDomainGetOsType,
+	"DomainGetVcpus":                          ProcDomainGetVcpus,
+	"ConnectListDefinedDomains":               ProcConnectListDefinedDomains,
+	"DomainLookupByID":                        ProcDomainLookupByID,
+	"DomainLookupByName":                      ProcDomainLookupByName,
+	"DomainLookupByUUID":                      ProcDomainLookupByUUID,
+	"ConnectNumOfDefinedDomains":              ProcConnectNumOfDefinedDomains,
+	"DomainPinVcpu":                           ProcDomainPinVcpu,
+	"DomainReboot":                            ProcDomainReboot,
+	"DomainResume":                            ProcDomainResume,
+	"DomainSetAutostart":                      ProcDomainSetAutostart,
+	"DomainSetMaxMemory":                      ProcDomainSetMaxMemory,
+	"DomainSetMemory":                         ProcDomainSetMemory,
+	"DomainSetVcpus":                          ProcDomainSetVcpus,
+	"DomainShutdown":                          ProcDomainShutdown,
+	"DomainSuspend":                           ProcDomainSuspend,
+	"DomainUndefine":                          ProcDomainUndefine,
+	"ConnectListDefinedNetworks":              ProcConnectListDefinedNetworks,
+	"ConnectListDomains":                      ProcConnectListDomains,
+	"ConnectListNetworks":                     ProcConnectListNetworks,
+	"NetworkCreate":                           ProcNetworkCreate,
+	"NetworkCreateXML":                        ProcNetworkCreateXML,
+	"NetworkDefineXML":                        ProcNetworkDefineXML,
+	"NetworkDestroy":                          ProcNetworkDestroy,
+	"NetworkGetXMLDesc":                       ProcNetworkGetXMLDesc,
+	"NetworkGetAutostart":                     ProcNetworkGetAutostart,
+	"NetworkGetBridgeName":                    ProcNetworkGetBridgeName,
+	"NetworkLookupByName":                     ProcNetworkLookupByName,
+	"NetworkLookupByUUID":                     ProcNetworkLookupByUUID,
+	"NetworkSetAutostart":                     ProcNetworkSetAutostart,
+	"NetworkUndefine":                         ProcNetworkUndefine,
+	"ConnectNumOfDefinedNetworks":             ProcConnectNumOfDefinedNetworks,
+	"ConnectNumOfDomains":                     ProcConnectNumOfDomains,
+	"ConnectNumOfNetworks":                    ProcConnectNumOfNetworks,
+	"DomainCoreDump":                          ProcDomainCoreDump,
+	"DomainRestore":                           ProcDomainRestore,
+	"DomainSave":                              ProcDomainSave,
+	"DomainGetSchedulerType":                  ProcDomainGetSchedulerType,
+	"DomainGetSchedulerParameters":            ProcDomainGetSchedulerParameters,
+	"DomainSetSchedulerParameters":            ProcDomainSetSchedulerParameters,
+	"ConnectGetHostname":                      ProcConnectGetHostname,
+	"ConnectSupportsFeature":                  ProcConnectSupportsFeature,
+	"DomainMigratePrepare":                    ProcDomainMigratePrepare,
+	"DomainMigratePerform":                    ProcDomainMigratePerform,
+	"DomainMigrateFinish":                     ProcDomainMigrateFinish,
+	"DomainBlockStats":                        ProcDomainBlockStats,
+	"DomainInterfaceStats":                    ProcDomainInterfaceStats,
+	"AuthList":                                ProcAuthList,
+	"AuthSaslInit":                            ProcAuthSaslInit,
+	"AuthSaslStart":                           ProcAuthSaslStart,
+	"AuthSaslStep":                            ProcAuthSaslStep,
+	"AuthPolkit":                              ProcAuthPolkit,
+	"ConnectNumOfStoragePools":                ProcConnectNumOfStoragePools,
+	"ConnectListStoragePools":                 ProcConnectListStoragePools,
+	"ConnectNumOfDefinedStoragePools":         ProcConnectNumOfDefinedStoragePools,
+	"ConnectListDefinedStoragePools":          ProcConnectListDefinedStoragePools,
+	"ConnectFindStoragePoolSources":           ProcConnectFindStoragePoolSources,
+	"StoragePoolCreateXML":                    ProcStoragePoolCreateXML,
+	"StoragePoolDefineXML":                    ProcStoragePoolDefineXML,
+	"StoragePoolCreate":                       ProcStoragePoolCreate,
+	"StoragePoolBuild":                        ProcStoragePoolBuild,
+	"StoragePoolDestroy":                      ProcStoragePoolDestroy,
+	"StoragePoolDelete":                       ProcStoragePoolDelete,
+	"StoragePoolUndefine":                     ProcStoragePoolUndefine,
+	"StoragePoolRefresh":                      ProcStoragePoolRefresh,
+	"StoragePoolLookupByName":                 ProcStoragePoolLookupByName,
+	"StoragePoolLookupByUUID":                 ProcStoragePoolLookupByUUID,
+	"StoragePoolLookupByVolume":               ProcStoragePoolLookupByVolume,
+	"StoragePoolGetInfo":                      ProcStoragePoolGetInfo,
+	"StoragePoolGetXMLDesc":                   ProcStoragePoolGetXMLDesc,
+	"StoragePoolGetAutostart":                 ProcStoragePoolGetAutostart,
+	"StoragePoolSetAutostart":                 ProcStoragePoolSetAutostart,
+	"StoragePoolNumOfVolumes":                 ProcStoragePoolNumOfVolumes,
+	"StoragePoolListVolumes":                  ProcStoragePoolListVolumes,
+	"StorageVolCreateXML":                     ProcStorageVolCreateXML,
+	"StorageVolDelete":                        ProcStorageVolDelete,
+	"StorageVolLookupByName":                  ProcStorageVolLookupByName,
+	"StorageVolLookupByKey":                   ProcStorageVolLookupByKey,
+	"StorageVolLookupByPath":                  ProcStorageVolLookupByPath,
+	"StorageVolGetInfo":                       ProcStorageVolGetInfo,
+	"StorageVolGetXMLDesc":                    ProcStorageVolGetXMLDesc,
+	"StorageVolGetPath":                       ProcStorageVolGetPath,
+	"NodeGetCellsFreeMemory":                  ProcNodeGetCellsFreeMemory,
+	"NodeGetFreeMemory":                       ProcNodeGetFreeMemory,
+	"DomainBlockPeek":                         ProcDomainBlockPeek,
+	"DomainMemoryPeek":                        ProcDomainMemoryPeek,
+	"ConnectDomainEventRegister":              ProcConnectDomainEventRegister,
+	"ConnectDomainEventDeregister":            ProcConnectDomainEventDeregister,
+	"DomainEventLifecycle":                    ProcDomainEventLifecycle,
+	"DomainMigratePrepare2":                   ProcDomainMigratePrepare2,
+	"DomainMigrateFinish2":                    ProcDomainMigrateFinish2,
+	"ConnectGetUri":                           ProcConnectGetUri,
+	"NodeNumOfDevices":                        ProcNodeNumOfDevices,
+	"NodeListDevices":                         ProcNodeListDevices,
+	"NodeDeviceLookupByName":                  ProcNodeDeviceLookupByName,
+	"NodeDeviceGetXMLDesc":                    ProcNodeDeviceGetXMLDesc,
+	"NodeDeviceGetParent":                     ProcNodeDeviceGetParent,
+	"NodeDeviceNumOfCaps":                     ProcNodeDeviceNumOfCaps,
+	"NodeDeviceListCaps":                      ProcNodeDeviceListCaps,
+	"NodeDeviceDettach":                       ProcNodeDeviceDettach,
+	"NodeDeviceReAttach":                      ProcNodeDeviceReAttach,
+	"NodeDeviceReset":                         ProcNodeDeviceReset,
+	"DomainGetSecurityLabel":                  ProcDomainGetSecurityLabel,
+	"NodeGetSecurityModel":                    ProcNodeGetSecurityModel,
+	"NodeDeviceCreateXML":                     ProcNodeDeviceCreateXML,
+	"NodeDeviceDestroy":                       ProcNodeDeviceDestroy,
+	"StorageVolCreateXMLFrom":                 ProcStorageVolCreateXMLFrom,
+	"ConnectNumOfInterfaces":                  ProcConnectNumOfInterfaces,
+	"ConnectListInterfaces":                   ProcConnectListInterfaces,
+	"InterfaceLookupByName":                   ProcInterfaceLookupByName,
+	"InterfaceLookupByMacString":              ProcInterfaceLookupByMacString,
+	"InterfaceGetXMLDesc":                     ProcInterfaceGetXMLDesc,
+	"InterfaceDefineXML":                      ProcInterfaceDefineXML,
+	"InterfaceUndefine":                       ProcInterfaceUndefine,
+	"InterfaceCreate":                         ProcInterfaceCreate,
+	"InterfaceDestroy":                        ProcInterfaceDestroy,
+	"ConnectDomainXMLFromNative":              ProcConnectDomainXMLFromNative,
+	"ConnectDomainXMLToNative":                ProcConnectDomainXMLToNative,
+	"ConnectNumOfDefinedInterfaces":           ProcConnectNumOfDefinedInterfaces,
+	"ConnectListDefinedInterfaces":            ProcConnectListDefinedInterfaces,
+	"ConnectNumOfSecrets":                     ProcConnectNumOfSecrets,
+	"ConnectListSecrets":                      ProcConnectListSecrets,
+	"SecretLookupByUUID":                      ProcSecretLookupByUUID,
+	"SecretDefineXML":                         ProcSecretDefineXML,
+	"SecretGetXMLDesc":                        ProcSecretGetXMLDesc,
+	"SecretSetValue":                          ProcSecretSetValue,
+	"SecretGetValue":                          ProcSecretGetValue,
+	"SecretUndefine":                          ProcSecretUndefine,
+	"SecretLookupByUsage":                     ProcSecretLookupByUsage,
+	"DomainMigratePrepareTunnel":              ProcDomainMigratePrepareTunnel,
+	"ConnectIsSecure":                         ProcConnectIsSecure,
+	"DomainIsActive":                          ProcDomainIsActive,
+	"DomainIsPersistent":                      ProcDomainIsPersistent,
+	"NetworkIsActive":                         ProcNetworkIsActive,
+	"NetworkIsPersistent":                     ProcNetworkIsPersistent,
+	"StoragePoolIsActive":                     ProcStoragePoolIsActive,
+	"StoragePoolIsPersistent":                 ProcStoragePoolIsPersistent,
+	"InterfaceIsActive":                       ProcInterfaceIsActive,
+	"ConnectGetLibVersion":                    ProcConnectGetLibVersion,
+	"ConnectCompareCPU":                       ProcConnectCompareCPU,
+	"DomainMemoryStats":                       ProcDomainMemoryStats,
+	"DomainAttachDeviceFlags":                 ProcDomainAttachDeviceFlags,
+	"DomainDetachDeviceFlags":                 ProcDomainDetachDeviceFlags,
+	"ConnectBaselineCPU":                      ProcConnectBaselineCPU,
+	"DomainGetJobInfo":                        ProcDomainGetJobInfo,
+	"DomainAbortJob":                          ProcDomainAbortJob,
+	"StorageVolWipe":                          ProcStorageVolWipe,
+	"DomainMigrateSetMaxDowntime":             ProcDomainMigrateSetMaxDowntime,
+	"ConnectDomainEventRegisterAny":           ProcConnectDomainEventRegisterAny,
+	"ConnectDomainEventDeregisterAny":         ProcConnectDomainEventDeregisterAny,
+	"DomainEventReboot":                       ProcDomainEventReboot,
+	"DomainEventRtcChange":                    ProcDomainEventRtcChange,
+	"DomainEventWatchdog":                     ProcDomainEventWatchdog,
+	"DomainEventIOError":                      ProcDomainEventIOError,
+	"DomainEventGraphics":                     ProcDomainEventGraphics,
+	"DomainUpdateDeviceFlags":                 ProcDomainUpdateDeviceFlags,
+	"NwfilterLookupByName":                    ProcNwfilterLookupByName,
+	"NwfilterLookupByUUID":                    ProcNwfilterLookupByUUID,
+	"NwfilterGetXMLDesc":                      ProcNwfilterGetXMLDesc,
+	"ConnectNumOfNwfilters":                   ProcConnectNumOfNwfilters,
+	"ConnectListNwfilters":                    ProcConnectListNwfilters,
+	"NwfilterDefineXML":                       ProcNwfilterDefineXML,
+	"NwfilterUndefine":                        ProcNwfilterUndefine,
+	"DomainManagedSave":                       ProcDomainManagedSave,
+	"DomainHasManagedSaveImage":               ProcDomainHasManagedSaveImage,
+	"DomainManagedSaveRemove":                 ProcDomainManagedSaveRemove,
+	"DomainSnapshotCreateXML":                 ProcDomainSnapshotCreateXML,
+	"DomainSnapshotGetXMLDesc":                ProcDomainSnapshotGetXMLDesc,
+	"DomainSnapshotNum":                       ProcDomainSnapshotNum,
+	"DomainSnapshotListNames":                 ProcDomainSnapshotListNames,
+	"DomainSnapshotLookupByName":              ProcDomainSnapshotLookupByName,
+	"DomainHasCurrentSnapshot":                ProcDomainHasCurrentSnapshot,
+	"DomainSnapshotCurrent":                   ProcDomainSnapshotCurrent,
+	"DomainRevertToSnapshot":                  ProcDomainRevertToSnapshot,
+	"DomainSnapshotDelete":                    ProcDomainSnapshotDelete,
+	"DomainGetBlockInfo":                      ProcDomainGetBlockInfo,
+	"DomainEventIOErrorReason":                ProcDomainEventIOErrorReason,
+	"DomainCreateWithFlags":                   ProcDomainCreateWithFlags,
+	"DomainSetMemoryParameters":               ProcDomainSetMemoryParameters,
+	"DomainGetMemoryParameters":               ProcDomainGetMemoryParameters,
+	"DomainSetVcpusFlags":                     ProcDomainSetVcpusFlags,
+	"DomainGetVcpusFlags":                     ProcDomainGetVcpusFlags,
+	"DomainOpenConsole":                       ProcDomainOpenConsole,
+	"DomainIsUpdated":                         ProcDomainIsUpdated,
+	"ConnectGetSysinfo":                       ProcConnectGetSysinfo,
+	"DomainSetMemoryFlags":                    ProcDomainSetMemoryFlags,
+	"DomainSetBlkioParameters":                ProcDomainSetBlkioParameters,
+	"DomainGetBlkioParameters":                ProcDomainGetBlkioParameters,
+	"DomainMigrateSetMaxSpeed":                ProcDomainMigrateSetMaxSpeed,
+	"StorageVolUpload":                        ProcStorageVolUpload,
+	"StorageVolDownload":                      ProcStorageVolDownload,
+	"DomainInjectNmi":                         ProcDomainInjectNmi,
+	"DomainScreenshot":                        ProcDomainScreenshot,
+	"DomainGetState":                          ProcDomainGetState,
+	"DomainMigrateBegin3":                     ProcDomainMigrateBegin3,
+	"DomainMigratePrepare3":                   ProcDomainMigratePrepare3,
+	"DomainMigratePrepareTunnel3":             ProcDomainMigratePrepareTunnel3,
+	"DomainMigratePerform3":                   ProcDomainMigratePerform3,
+	"DomainMigrateFinish3":                    ProcDomainMigrateFinish3,
+	"DomainMigrateConfirm3":                   ProcDomainMigrateConfirm3,
+	"DomainSetSchedulerParametersFlags":       ProcDomainSetSchedulerParametersFlags,
+	"InterfaceChangeBegin":                    ProcInterfaceChangeBegin,
+	"InterfaceChangeCommit":                   ProcInterfaceChangeCommit,
+	"InterfaceChangeRollback":                 ProcInterfaceChangeRollback,
+	"DomainGetSchedulerParametersFlags":       ProcDomainGetSchedulerParametersFlags,
+	"DomainEventControlError":                 ProcDomainEventControlError,
+	"DomainPinVcpuFlags":                      ProcDomainPinVcpuFlags,
+	"DomainSendKey":                           ProcDomainSendKey,
+	"NodeGetCPUStats":                         ProcNodeGetCPUStats,
+	"NodeGetMemoryStats":                      ProcNodeGetMemoryStats,
+	"DomainGetControlInfo":                    ProcDomainGetControlInfo,
+	"DomainGetVcpuPinInfo":                    ProcDomainGetVcpuPinInfo,
+	"DomainUndefineFlags":                     ProcDomainUndefineFlags,
+	"DomainSaveFlags":                         ProcDomainSaveFlags,
+	"DomainRestoreFlags":                      ProcDomainRestoreFlags,
+	"DomainDestroyFlags":                      ProcDomainDestroyFlags,
+	"DomainSaveImageGetXMLDesc":               ProcDomainSaveImageGetXMLDesc,
+	"DomainSaveImageDefineXML":                ProcDomainSaveImageDefineXML,
+	"DomainBlockJobAbort":                     ProcDomainBlockJobAbort,
+	"DomainGetBlockJobInfo":                   ProcDomainGetBlockJobInfo,
+	"DomainBlockJobSetSpeed":                  ProcDomainBlockJobSetSpeed,
+	"DomainBlockPull":                         ProcDomainBlockPull,
+	"DomainEventBlockJob":                     ProcDomainEventBlockJob,
+	"DomainMigrateGetMaxSpeed":                ProcDomainMigrateGetMaxSpeed,
+	"DomainBlockStatsFlags":                   ProcDomainBlockStatsFlags,
+	"DomainSnapshotGetParent":                 ProcDomainSnapshotGetParent,
+	"DomainReset":                             ProcDomainReset,
+	"DomainSnapshotNumChildren":               ProcDomainSnapshotNumChildren,
+	"DomainSnapshotListChildrenNames":         ProcDomainSnapshotListChildrenNames,
+	"DomainEventDiskChange":                   ProcDomainEventDiskChange,
+	"DomainOpenGraphics":                      ProcDomainOpenGraphics,
+	"NodeSuspendForDuration":                  ProcNodeSuspendForDuration,
+	"DomainBlockResize":                       ProcDomainBlockResize,
+	"DomainSetBlockIOTune":                    ProcDomainSetBlockIOTune,
+	"DomainGetBlockIOTune":                    ProcDomainGetBlockIOTune,
+	"DomainSetNumaParameters":                 ProcDomainSetNumaParameters,
+	"DomainGetNumaParameters":                 ProcDomainGetNumaParameters,
+	"DomainSetInterfaceParameters":            ProcDomainSetInterfaceParameters,
+	"DomainGetInterfaceParameters":            ProcDomainGetInterfaceParameters,
+	"DomainShutdownFlags":                     ProcDomainShutdownFlags,
+	"StorageVolWipePattern":                   ProcStorageVolWipePattern,
+	"StorageVolResize":                        ProcStorageVolResize,
+	"DomainPmSuspendForDuration":              ProcDomainPmSuspendForDuration,
+	"DomainGetCPUStats":                       ProcDomainGetCPUStats,
+	"DomainGetDiskErrors":                     ProcDomainGetDiskErrors,
+	"DomainSetMetadata":                       ProcDomainSetMetadata,
+	"DomainGetMetadata":                       ProcDomainGetMetadata,
+	"DomainBlockRebase":                       ProcDomainBlockRebase,
+	"DomainPmWakeup":                          ProcDomainPmWakeup,
+	"DomainEventTrayChange":                   ProcDomainEventTrayChange,
+	"DomainEventPmwakeup":                     ProcDomainEventPmwakeup,
+	"DomainEventPmsuspend":                    ProcDomainEventPmsuspend,
+	"DomainSnapshotIsCurrent":                 ProcDomainSnapshotIsCurrent,
+	"DomainSnapshotHasMetadata":               ProcDomainSnapshotHasMetadata,
+	"ConnectListAllDomains":                   ProcConnectListAllDomains,
+	"DomainListAllSnapshots":                  ProcDomainListAllSnapshots,
+	"DomainSnapshotListAllChildren":           ProcDomainSnapshotListAllChildren,
+	"DomainEventBalloonChange":                ProcDomainEventBalloonChange,
+	"DomainGetHostname":                       ProcDomainGetHostname,
+	"DomainGetSecurityLabelList":              ProcDomainGetSecurityLabelList,
+	"DomainPinEmulator":                       ProcDomainPinEmulator,
+	"DomainGetEmulatorPinInfo":                ProcDomainGetEmulatorPinInfo,
+	"ConnectListAllStoragePools":              ProcConnectListAllStoragePools,
+	"StoragePoolListAllVolumes":               ProcStoragePoolListAllVolumes,
+	"ConnectListAllNetworks":                  ProcConnectListAllNetworks,
+	"ConnectListAllInterfaces":                ProcConnectListAllInterfaces,
+	"ConnectListAllNodeDevices":               ProcConnectListAllNodeDevices,
+	"ConnectListAllNwfilters":                 ProcConnectListAllNwfilters,
+	"ConnectListAllSecrets":                   ProcConnectListAllSecrets,
+	"NodeSetMemoryParameters":                 ProcNodeSetMemoryParameters,
+	"NodeGetMemoryParameters":                 ProcNodeGetMemoryParameters,
+	"DomainBlockCommit":                       ProcDomainBlockCommit,
+	"NetworkUpdate":                           ProcNetworkUpdate,
+	"DomainEventPmsuspendDisk":                ProcDomainEventPmsuspendDisk,
+	"NodeGetCPUMap":                           ProcNodeGetCPUMap,
+	"DomainFstrim":                            ProcDomainFstrim,
+	"DomainSendProcessSignal":                 ProcDomainSendProcessSignal,
+	"DomainOpenChannel":                       ProcDomainOpenChannel,
+	"NodeDeviceLookupScsiHostByWwn":           ProcNodeDeviceLookupScsiHostByWwn,
+	"DomainGetJobStats":                       ProcDomainGetJobStats,
+	"DomainMigrateGetCompressionCache":        ProcDomainMigrateGetCompressionCache,
+	"DomainMigrateSetCompressionCache":        ProcDomainMigrateSetCompressionCache,
+	"NodeDeviceDetachFlags":                   ProcNodeDeviceDetachFlags,
+	"DomainMigrateBegin3Params":               ProcDomainMigrateBegin3Params,
+	"DomainMigratePrepare3Params":             ProcDomainMigratePrepare3Params,
+	"DomainMigratePrepareTunnel3Params":       ProcDomainMigratePrepareTunnel3Params,
+	"DomainMigratePerform3Params":             ProcDomainMigratePerform3Params,
+	"DomainMigrateFinish3Params":              ProcDomainMigrateFinish3Params,
+	"DomainMigrateConfirm3Params":             ProcDomainMigrateConfirm3Params,
+	"DomainSetMemoryStatsPeriod":              ProcDomainSetMemoryStatsPeriod,
+	"DomainCreateXMLWithFiles":                ProcDomainCreateXMLWithFiles,
+	"DomainCreateWithFiles":                   ProcDomainCreateWithFiles,
+	"DomainEventDeviceRemoved":                ProcDomainEventDeviceRemoved,
+	"ConnectGetCPUModelNames":                 ProcConnectGetCPUModelNames,
+	"ConnectNetworkEventRegisterAny":          ProcConnectNetworkEventRegisterAny,
+	"ConnectNetworkEventDeregisterAny":        ProcConnectNetworkEventDeregisterAny,
+	"NetworkEventLifecycle":                   ProcNetworkEventLifecycle,
+	"ConnectDomainEventCallbackRegisterAny":   ProcConnectDomainEventCallbackRegisterAny,
+	"ConnectDomainEventCallbackDeregisterAny": ProcConnectDomainEventCallbackDeregisterAny,
+	"DomainEventCallbackLifecycle":            ProcDomainEventCallbackLifecycle,
+	"DomainEventCallbackReboot":               ProcDomainEventCallbackReboot,
+	"DomainEventCallbackRtcChange":            ProcDomainEventCallbackRtcChange,
+	"DomainEventCallbackWatchdog":             ProcDomainEventCallbackWatchdog,
+	"DomainEventCallbackIOError":              ProcDomainEventCallbackIOError,
+	"DomainEventCallbackGraphics":             ProcDomainEventCallbackGraphics,
+	"DomainEventCallbackIOErrorReason":        ProcDomainEventCallbackIOErrorReason,
+	"DomainEventCallbackControlError":         ProcDomainEventCallbackControlError,
+	"DomainEventCallbackBlockJob":             ProcDomainEventCallbackBlockJob,
+	"DomainEventCallbackDiskChange":           ProcDomainEventCallbackDiskChange,
+	"DomainEventCallbackTrayChange":           ProcDomainEventCallbackTrayChange,
+	"DomainEventCallbackPmwakeup":             ProcDomainEventCallbackPmwakeup,
+	"DomainEventCallbackPmsuspend":            ProcDomainEventCallbackPmsuspend,
+	"DomainEventCallbackBalloonChange":        ProcDomainEventCallbackBalloonChange,
+	"DomainEventCallbackPmsuspendDisk":        ProcDomainEventCallbackPmsuspendDisk,
+	"DomainEventCallbackDeviceRemoved":        ProcDomainEventCallbackDeviceRemoved,
+	"DomainCoreDumpWithFormat":                ProcDomainCoreDumpWithFormat,
+	"DomainFsfreeze":                          ProcDomainFsfreeze,
+	"DomainFsthaw":                            ProcDomainFsthaw,
+	"DomainGetTime":                           ProcDomainGetTime,
+	"DomainSetTime":                           ProcDomainSetTime,
+	"DomainEventBlockJob2":                    ProcDomainEventBlockJob2,
+	"NodeGetFreePages":                        ProcNodeGetFreePages,
+	"NetworkGetDhcpLeases":                    ProcNetworkGetDhcpLeases,
+	"ConnectGetDomainCapabilities":            ProcConnectGetDomainCapabilities,
+	"DomainOpenGraphicsFd":                    ProcDomainOpenGraphicsFd,
+	"ConnectGetAllDomainStats":                ProcConnectGetAllDomainStats,
+	"DomainBlockCopy":                         ProcDomainBlockCopy,
+	"DomainEventCallbackTunable":              ProcDomainEventCallbackTunable,
+	"NodeAllocPages":                          ProcNodeAllocPages,
+	"DomainEventCallbackAgentLifecycle":       ProcDomainEventCallbackAgentLifecycle,
+	"DomainGetFsinfo":                         ProcDomainGetFsinfo,
+	"DomainDefineXMLFlags":                    ProcDomainDefineXMLFlags,
+	"DomainGetIothreadInfo":                   ProcDomainGetIothreadInfo,
+	"DomainPinIothread":                       ProcDomainPinIothread,
+	"DomainInterfaceAddresses":                ProcDomainInterfaceAddresses,
+	"DomainEventCallbackDeviceAdded":          ProcDomainEventCallbackDeviceAdded,
+	"DomainAddIothread":                       ProcDomainAddIothread,
+	"DomainDelIothread":                       ProcDomainDelIothread,
+	"DomainSetUserPassword":                   ProcDomainSetUserPassword,
+	"DomainRename":                            ProcDomainRename,
+	"DomainEventCallbackMigrationIteration":   ProcDomainEventCallbackMigrationIteration,
+	"ConnectRegisterCloseCallback":            ProcConnectRegisterCloseCallback,
+	"ConnectUnregisterCloseCallback":          ProcConnectUnregisterCloseCallback,
+	"ConnectEventConnectionClosed":            ProcConnectEventConnectionClosed,
+	"DomainEventCallbackJobCompleted":         ProcDomainEventCallbackJobCompleted,
+	"DomainMigrateStartPostCopy":              ProcDomainMigrateStartPostCopy,
+	"DomainGetPerfEvents":                     ProcDomainGetPerfEvents,
+	"DomainSetPerfEvents":                     ProcDomainSetPerfEvents,
+	"DomainEventCallbackDeviceRemovalFailed":  ProcDomainEventCallbackDeviceRemovalFailed,
+	"ConnectStoragePoolEventRegisterAny":      ProcConnectStoragePoolEventRegisterAny,
+	"ConnectStoragePoolEventDeregisterAny":    ProcConnectStoragePoolEventDeregisterAny,
+	"StoragePoolEventLifecycle":               ProcStoragePoolEventLifecycle,
+	"DomainGetGuestVcpus":                     ProcDomainGetGuestVcpus,
+	"DomainSetGuestVcpus":                     ProcDomainSetGuestVcpus,
+	"StoragePoolEventRefresh":                 ProcStoragePoolEventRefresh,
+	"ConnectNodeDeviceEventRegisterAny":       ProcConnectNodeDeviceEventRegisterAny,
+	"ConnectNodeDeviceEventDeregisterAny":     ProcConnectNodeDeviceEventDeregisterAny,
+	"NodeDeviceEventLifecycle":                ProcNodeDeviceEventLifecycle,
+	"NodeDeviceEventUpdate":                   ProcNodeDeviceEventUpdate,
+	"StorageVolGetInfoFlags":                  ProcStorageVolGetInfoFlags,
+	"DomainEventCallbackMetadataChange":       ProcDomainEventCallbackMetadataChange,
+	"ConnectSecretEventRegisterAny":           ProcConnectSecretEventRegisterAny,
+	"ConnectSecretEventDeregisterAny":         ProcConnectSecretEventDeregisterAny,
+	"SecretEventLifecycle":                    ProcSecretEventLifecycle,
+	"SecretEventValueChanged":                 ProcSecretEventValueChanged,
+	"DomainSetVcpu":                           ProcDomainSetVcpu,
+	"DomainEventBlockThreshold":               ProcDomainEventBlockThreshold,
+	"DomainSetBlockThreshold":                 ProcDomainSetBlockThreshold,
+	"DomainMigrateGetMaxDowntime":             ProcDomainMigrateGetMaxDowntime,
+	"DomainManagedSaveGetXMLDesc":             ProcDomainManagedSaveGetXMLDesc,
+	"DomainManagedSaveDefineXML":              ProcDomainManagedSaveDefineXML,
+	"DomainSetLifecycleAction":                ProcDomainSetLifecycleAction,
+	"StoragePoolLookupByTargetPath":           ProcStoragePoolLookupByTargetPath,
+	"DomainDetachDeviceAlias":                 ProcDomainDetachDeviceAlias,
+	"ConnectCompareHypervisorCPU":             ProcConnectCompareHypervisorCPU,
+	"ConnectBaselineHypervisorCPU":            ProcConnectBaselineHypervisorCPU,
+	"NodeGetSevInfo":                          ProcNodeGetSevInfo,
+	"DomainGetLaunchSecurityInfo":             ProcDomainGetLaunchSecurityInfo,
+	"NwfilterBindingLookupByPortDev":          ProcNwfilterBindingLookupByPortDev,
+	"NwfilterBindingGetXMLDesc":               ProcNwfilterBindingGetXMLDesc,
+	"NwfilterBindingCreateXML":                ProcNwfilterBindingCreateXML,
+	"NwfilterBindingDelete":                   ProcNwfilterBindingDelete,
+	"ConnectListAllNwfilterBindings":          ProcConnectListAllNwfilterBindings,
+	"DomainSetIothreadParams":                 ProcDomainSetIothreadParams,
+	"ConnectGetStoragePoolCapabilities":       ProcConnectGetStoragePoolCapabilities,
+	"NetworkListAllPorts":                     ProcNetworkListAllPorts,
+	"NetworkPortLookupByUUID":                 ProcNetworkPortLookupByUUID,
+	"NetworkPortCreateXML":                    ProcNetworkPortCreateXML,
+	"NetworkPortGetParameters":                ProcNetworkPortGetParameters,
+	"NetworkPortSetParameters":                ProcNetworkPortSetParameters,
+	"NetworkPortGetXMLDesc":                   ProcNetworkPortGetXMLDesc,
+	"NetworkPortDelete":                       ProcNetworkPortDelete,
+	"DomainCheckpointCreateXML":               ProcDomainCheckpointCreateXML,
+	"DomainCheckpointGetXMLDesc":              ProcDomainCheckpointGetXMLDesc,
+	"DomainListAllCheckpoints":                ProcDomainListAllCheckpoints,
+	"DomainCheckpointListAllChildren":         ProcDomainCheckpointListAllChildren,
+	"DomainCheckpointLookupByName":            ProcDomainCheckpointLookupByName,
+	"DomainCheckpointGetParent":               ProcDomainCheckpointGetParent,
+	"DomainCheckpointDelete":                  ProcDomainCheckpointDelete,
+	"DomainGetGuestInfo":                      ProcDomainGetGuestInfo,
+	"ConnectSetIdentity":                      ProcConnectSetIdentity,
+	"DomainAgentSetResponseTimeout":           ProcDomainAgentSetResponseTimeout,
+	"DomainBackupBegin":                       ProcDomainBackupBegin,
+	"DomainBackupGetXMLDesc":                  ProcDomainBackupGetXMLDesc,
+	"DomainEventMemoryFailure":                ProcDomainEventMemoryFailure,
+	"DomainAuthorizedSshKeysGet":              ProcDomainAuthorizedSshKeysGet,
+	"DomainAuthorizedSshKeysSet":              ProcDomainAuthorizedSshKeysSet,
+	"DomainGetMessages":                       ProcDomainGetMessages,
+}
@@ -16,6 +16,7 @@ package event
 
 import (
 	"context"
+	"time"
 )
 
 // emptyEvent is used as a zero-value. Clients will never receive one of these;
@@ -25,6 +26,13 @@ type emptyEvent struct{}
 
 func (emptyEvent) GetCallbackID() int32 { return 0 }
 
+// Received is an event along with the time its stream received it. Streams
+// with Timestamp set relay their events as Received.
+type Received struct {
+	Event
+	Time time.Time
+}
+
 // Stream is an unbounded buffered event channel. The implementation
 // consists of a pair of unbuffered channels and a goroutine to manage them.
 // Client behavior will not cause incoming events to block.
@@ -35,13 +43,19 @@ type Stream struct {
 	// CallbackID is returned by the event registration call.
 	CallbackID int32
 
+	// Timestamp causes events to be relayed as Received, stamped with the time
+	// they were pushed. It must be set before the first event is pushed.
+	Timestamp bool
+
 	// manage unbounded channel behavior.
 	queue   []Event
 	qlen    chan (chan int)
 	in, out chan Event
 
-	// terminates processing
+	// terminates processing. done is closed once processing has stopped, after
+	// which pushed events are dropped.
 	shutdown context.CancelFunc
+	done     <-chan struct{}
 }
 
 // NewStream configures a new Event Stream. Incoming events are appended to a
@@ -81,9 +95,16 @@ func (s *Stream) Recv() chan Event {
 	return s.out
 }
 
-// Push appends a new event to the queue.
+// Push appends a new event to the queue. Events pushed once the stream has
+// been shut down are dropped.
 func (s *Stream) Push(e Event) {
-	s.in <- e
+	if s.Timestamp {
+		e = Received{Event: e, Time: time.Now()}
+	}
+	select {
+	case s.in <- e:
+	case <-s.done:
+	}
 }
 
 // Shutdown gracefully terminates Stream processing, releasing all internal
@@ -99,6 +120,7 @@ func (s *Stream) Shutdown() {
 // terminated by the returned context.CancelFunc.
 func (s *Stream) start() context.CancelFunc {
 	ctx, cancel := context.WithCancel(context.Background())
+	s.done = ctx.Done()
 
 	go s.process(ctx)
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -83,3 +84,43 @@ func TestStreamParallel(t *testing.T) {
 	wg.Wait()
 	assert.Zero(t, s.Len())
 }
+
+// TestStreamPushAfterShutdown makes sure pushing to a stream which has been
+// shut down doesn't block.
+func TestStreamPushAfterShutdown(t *testing.T) {
+	s := NewStream(1, 2)
+	s.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Push(testEvent{1})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("push blocked after shutdown")
+	}
+}
+
+func TestStreamTimestamp(t *testing.T) {
+	s := NewStream(1, 2)
+	s.Timestamp = true
+	defer s.Shutdown()
+
+	before := time.Now()
+	s.Push(testEvent{7})
+	after := time.Now()
+
+	e := <-s.Recv()
+	r, ok := e.(Received)
+	if !ok {
+		t.Fatalf("expected a Received event, got %T", e)
+	}
+	assert.Equal(t, testEvent{7}, r.Event)
+	assert.Equal(t, int32(7), r.GetCallbackID())
+	if r.Time.Before(before) || r.Time.After(after) {
+		t.Errorf("expected a time between %v and %v, got %v", before, after, r.Time)
+	}
+}
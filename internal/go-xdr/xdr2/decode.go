@@ -91,6 +91,12 @@ type TypeDecoder interface {
 	Decode(*Decoder, reflect.Value) (int, error)
 }
 
+// Unmarshaler is implemented by types that decode themselves, such as
+// discriminated unions, whose encoding depends on their value.
+type Unmarshaler interface {
+	UnmarshalXDR(*Decoder) (int, error)
+}
+
 // A Decoder wraps an io.Reader that is expected to provide an XDR-encoded byte
 // stream and provides several exposed methods to manually decode various XDR
 // primitives without relying on reflection.  The NewDecoder function can be
@@ -333,8 +339,13 @@ func (d *Decoder) DecodeFixedOpaque(size int32) ([]byte, int, error) {
 		return nil, 0, err
 	}
 
-	buf := make([]byte, paddedSize)
+	// Only allocate as much as the reader holds, so a corrupt length can't
+	// exhaust memory. Reading then fails as it would have anyway.
+	buf := make([]byte, d.available(int(paddedSize)))
 	n, err := io.ReadFull(d.r, buf)
+	if err == nil && n < int(paddedSize) {
+		err = io.ErrUnexpectedEOF
+	}
 	if err != nil {
 		msg := fmt.Sprintf(errIODecode, err.Error(), paddedSize)
 		err := unmarshalError("DecodeFixedOpaque", ErrIO, msg, buf[:n],
@@ -471,6 +482,15 @@ func (d *Decoder) decodeArray(v reflect.Value, ignoreOpaque bool) (int, error) {
 		return n, err
 	}
 
+	// Every element takes at least one byte, so don't allocate storage for
+	// more elements than there are bytes left.
+	if d.available(int(dataLen)) < int(dataLen) {
+		msg := fmt.Sprintf(errIODecode, io.ErrUnexpectedEOF.Error(), dataLen)
+		err := unmarshalError("decodeArray", ErrIO, msg, dataLen,
+			io.ErrUnexpectedEOF)
+		return n, err
+	}
+
 	// Allocate storage for the slice elements (the underlying array) if
 	// existing slice does not have enough capacity.
 	sliceLen := int(dataLen)
@@ -700,6 +720,11 @@ func (d *Decoder) decode(v reflect.Value) (int, error) {
 	if dt, ok := d.customTypes[ve.Type().String()]; ok {
 		return dt.Decode(d, v)
 	}
+	if ve.CanAddr() {
+		if u, ok := ve.Addr().Interface().(Unmarshaler); ok {
+			return u.UnmarshalXDR(d)
+		}
+	}
 
 	// Handle native Go types.
 	switch ve.Kind() {
@@ -874,6 +899,17 @@ func (d *Decoder) Decode(v interface{}) (int, error) {
 	return d.decode(vv)
 }
 
+// available returns n, or the number of unread bytes held by the Decoder's
+// reader if that is smaller. Readers which report the number of unread bytes,
+// such as bytes.Reader, let the decoder reject lengths which are larger than
+// the remaining data before allocating space for it.
+func (d *Decoder) available(n int) int {
+	if l, ok := d.r.(interface{ Len() int }); ok && l.Len() < n {
+		return l.Len()
+	}
+	return n
+}
+
 // NewDecoder returns a Decoder that can be used to manually decode XDR data
 // from a provided reader.  Typically, Unmarshal should be used instead of
 // manually creating a Decoder.
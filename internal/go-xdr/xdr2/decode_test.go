@@ -310,6 +310,8 @@ func TestUnmarshal(t *testing.T) {
 		{[]byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00}, []bool{true, false}, 12, nil},
 		// Expected Failure -- 2 entries in array - not enough bytes
 		{[]byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x01}, []bool{}, 8, &UnmarshalError{ErrorCode: ErrIO}},
+		// Expected Failure -- huge entry count, rejected before allocating
+		{[]byte{0x7F, 0xFF, 0xFF, 0xFF, 0x00, 0x00, 0x00, 0x01}, []bool{}, 4, &UnmarshalError{ErrorCode: ErrIO}},
 
 		// [#]<type> - XDR Fixed-Length Array
 		{[]byte{0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x04, 0x00}, [2]uint32{512, 1024}, 8, nil},
@@ -520,6 +522,8 @@ func TestDecoder(t *testing.T) {
 		{fDecodeOpaque, []byte{0xFF, 0xFF, 0xFF, 0xFF}, []byte{}, 4, 0, &UnmarshalError{ErrorCode: ErrOverflow}},
 		{fDecodeOpaque, []byte{0x7F, 0xFF, 0xFF, 0xFD}, []byte{}, 4, 0, &UnmarshalError{ErrorCode: ErrOverflow}},
 		{fDecodeOpaque, []byte{0x00, 0x00, 0x00, 0xFF}, []byte{}, 4, 0, &UnmarshalError{ErrorCode: ErrIO}},
+		// Huge length, rejected before allocating
+		{fDecodeOpaque, []byte{0x7F, 0xFF, 0xFF, 0xF0, 0x01, 0x02, 0x03, 0x04}, []byte{}, 8, 0, &UnmarshalError{ErrorCode: ErrIO}},
 		// Hit maxReadSize in opaque
 		{fDecodeOpaque, []byte{0x00, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0x00}, []byte{0x01}, 8, 4, nil},
 		{fDecodeOpaque, []byte{0x00, 0x00, 0x00, 0x08, 0x01, 0x00, 0x00, 0x00}, []byte{}, 4, 4, &UnmarshalError{ErrorCode: ErrOverflow}},
@@ -791,3 +795,33 @@ func TestUnmarshalCorners(t *testing.T) {
 	n, err = TstDecode(bytes.NewReader(buf))(reflect.ValueOf(upstruct))
 	testExpectedURet(t, testName, n, expectedN, err, expectedErr)
 }
+
+// TestUnmarshaler ensures types implementing Unmarshaler decode themselves.
+func TestUnmarshaler(t *testing.T) {
+	type follows struct {
+		A optUint
+		B uint32
+	}
+
+	tests := []struct {
+		in   []byte
+		want follows
+	}{
+		{[]byte{0, 0, 0, 1, 0, 0, 0, 7, 0, 0, 0, 3},
+			follows{optUint{D: 1, I: uint32(7)}, 3}},
+		{[]byte{0, 0, 0, 0, 0, 0, 0, 9}, follows{optUint{D: 0}, 9}},
+	}
+
+	for i, test := range tests {
+		var got follows
+		n, err := Unmarshal(bytes.NewReader(test.in), &got)
+		if err != nil {
+			t.Errorf("Unmarshal #%d failed: %v", i, err)
+			continue
+		}
+		if n != len(test.in) || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Unmarshal #%d got: %v (%d bytes) want: %v", i, got,
+				n, test.want)
+		}
+	}
+}
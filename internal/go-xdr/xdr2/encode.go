@@ -77,6 +77,14 @@ func Marshal(w io.Writer, v interface{}) (int, error) {
 	return enc.Encode(v)
 }
 
+// Marshaler is implemented by types that encode themselves, such as
+// discriminated unions, whose encoding depends on their value.
+type Marshaler interface {
+	MarshalXDR(*Encoder) (int, error)
+}
+
+var marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+
 // An Encoder wraps an io.Writer that will receive the XDR encoded byte stream.
 // See NewEncoder.
 type Encoder struct {
@@ -571,6 +579,18 @@ func (enc *Encoder) encode(v reflect.Value) (int, error) {
 		}
 	}
 
+	// Types which encode themselves have pointer receivers, so take a copy
+	// of values we can't address.
+	if reflect.PtrTo(ve.Type()).Implements(marshalerType) && ve.CanInterface() {
+		p := reflect.New(ve.Type())
+		if ve.CanAddr() {
+			p = ve.Addr()
+		} else {
+			p.Elem().Set(ve)
+		}
+		return p.Interface().(Marshaler).MarshalXDR(enc)
+	}
+
 	// Handle native Go types.
 	switch ve.Kind() {
 	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int:
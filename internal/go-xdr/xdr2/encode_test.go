@@ -17,6 +17,7 @@
 package xdr_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"reflect"
@@ -592,3 +593,131 @@ func TestMarshalCorners(t *testing.T) {
 	}
 
 }
+
+// optUint is a discriminated union implementing Marshaler and Unmarshaler. A
+// discriminant of 1 is followed by a uint32; 0 is followed by nothing.
+type optUint struct {
+	D uint32
+	I interface{}
+}
+
+func (u *optUint) MarshalXDR(enc *Encoder) (int, error) {
+	n, err := enc.EncodeUint(u.D)
+	if err != nil || u.D == 0 {
+		return n, err
+	}
+	n2, err := enc.EncodeUint(u.I.(uint32))
+	return n + n2, err
+}
+
+func (u *optUint) UnmarshalXDR(d *Decoder) (int, error) {
+	disc, n, err := d.DecodeUint()
+	if err != nil {
+		return n, err
+	}
+	u.D, u.I = disc, nil
+	if disc == 0 {
+		return n, nil
+	}
+	v, n2, err := d.DecodeUint()
+	u.I = v
+	return n + n2, err
+}
+
+// TestMarshaler ensures types implementing Marshaler encode themselves,
+// whether or not they're addressable.
+func TestMarshaler(t *testing.T) {
+	type follows struct {
+		A optUint
+		B uint32
+	}
+
+	tests := []struct {
+		in   interface{}
+		want []byte
+	}{
+		{optUint{D: 1, I: uint32(7)}, []byte{0, 0, 0, 1, 0, 0, 0, 7}},
+		{&optUint{D: 0}, []byte{0, 0, 0, 0}},
+		{follows{optUint{D: 0}, 9}, []byte{0, 0, 0, 0, 0, 0, 0, 9}},
+		{&follows{optUint{D: 1, I: uint32(2)}, 3},
+			[]byte{0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 3}},
+	}
+
+	for i, test := range tests {
+		var buf bytes.Buffer
+		n, err := Marshal(&buf, test.in)
+		if err != nil {
+			t.Errorf("Marshal #%d failed: %v", i, err)
+			continue
+		}
+		if n != len(test.want) || !reflect.DeepEqual(buf.Bytes(), test.want) {
+			t.Errorf("Marshal #%d got: %v (%d bytes) want: %v", i,
+				buf.Bytes(), n, test.want)
+		}
+	}
+}
+
+// TestFloatRoundTrip ensures floating point values, including NaN, the
+// infinities and negative zero, are encoded as big-endian IEEE 754 and decode
+// to the same bits.
+func TestFloatRoundTrip(t *testing.T) {
+	floats := []struct {
+		in   float32
+		want []byte
+	}{
+		{3.14, []byte{0x40, 0x48, 0xF5, 0xC3}},
+		{float32(math.Copysign(0, -1)), []byte{0x80, 0x00, 0x00, 0x00}},
+		{float32(math.Inf(1)), []byte{0x7F, 0x80, 0x00, 0x00}},
+		{float32(math.Inf(-1)), []byte{0xFF, 0x80, 0x00, 0x00}},
+		{math.Float32frombits(0x7FC00001), []byte{0x7F, 0xC0, 0x00, 0x01}},
+		{math.SmallestNonzeroFloat32, []byte{0x00, 0x00, 0x00, 0x01}},
+	}
+	for i, test := range floats {
+		var buf bytes.Buffer
+		if _, err := Marshal(&buf, test.in); err != nil {
+			t.Errorf("Marshal float #%d failed: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.want) {
+			t.Errorf("Marshal float #%d got: %x want: %x", i, buf.Bytes(), test.want)
+		}
+		var got float32
+		if _, err := Unmarshal(&buf, &got); err != nil {
+			t.Errorf("Unmarshal float #%d failed: %v", i, err)
+			continue
+		}
+		if math.Float32bits(got) != math.Float32bits(test.in) {
+			t.Errorf("Unmarshal float #%d got: %v want: %v", i, got, test.in)
+		}
+	}
+
+	doubles := []struct {
+		in   float64
+		want []byte
+	}{
+		{math.Pi, []byte{0x40, 0x09, 0x21, 0xFB, 0x54, 0x44, 0x2D, 0x18}},
+		{math.Copysign(0, -1), []byte{0x80, 0, 0, 0, 0, 0, 0, 0}},
+		{math.Inf(1), []byte{0x7F, 0xF0, 0, 0, 0, 0, 0, 0}},
+		{math.Inf(-1), []byte{0xFF, 0xF0, 0, 0, 0, 0, 0, 0}},
+		{math.NaN(), []byte{0x7F, 0xF8, 0, 0, 0, 0, 0, 0x01}},
+		{math.SmallestNonzeroFloat64, []byte{0, 0, 0, 0, 0, 0, 0, 0x01}},
+	}
+	for i, test := range doubles {
+		var buf bytes.Buffer
+		if _, err := Marshal(&buf, test.in); err != nil {
+			t.Errorf("Marshal double #%d failed: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), test.want) {
+			t.Errorf("Marshal double #%d got: %x want: %x", i, buf.Bytes(), test.want)
+		}
+		var got float64
+		if _, err := Unmarshal(&buf, &got); err != nil {
+			t.Errorf("Unmarshal double #%d failed: %v", i, err)
+			continue
+		}
+		if math.Float64bits(got) != math.Float64bits(test.in) {
+			t.Errorf("Unmarshal double #%d got: %v want: %v", i, got, test.in)
+		}
+	}
+}
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -26,9 +27,32 @@ import (
 var protoPaths = [...]string{
 	"src/remote/remote_protocol.x",
 	"src/remote/qemu_protocol.x",
+	"src/remote/lxc_protocol.x",
 }
 
+// outDir is the root of the go-libvirt tree the generated files are written to.
+// The default is correct when the generator is run by 'go generate' in
+// internal/lvgen.
+var outDir = flag.String("output", "../..", "path to the root of the go-libvirt source tree")
+
+// abbrevs is a comma-separated list of extra abbreviations to upper-case in
+// generated names.
+var abbrevs = flag.String("abbrevs", "", "comma-separated list of additional abbreviations, e.g. Numa,Pci")
+
+// keepAbbrevs leaves abbreviations camel-cased in generated names.
+var keepAbbrevs = flag.Bool("keep-abbrevs", false, "don't upper-case abbreviations such as Xml and Uuid in generated names")
+
+// splitConsts also writes the generated constants to a package per libvirt
+// subsystem, such as go-libvirt/domain.
+var splitConsts = flag.Bool("split-consts", false, "also write generated constants to a package per libvirt subsystem")
+
 func main() {
+	flag.Parse()
+	lvgen.SplitConsts = *splitConsts
+	lvgen.KeepAbbrevs = *keepAbbrevs
+	for _, a := range strings.Split(*abbrevs, ",") {
+		lvgen.AddAbbreviation(strings.TrimSpace(a))
+	}
 	lvPath := os.Getenv("LIBVIRT_SOURCE")
 	if lvPath == "" {
 		fmt.Println("set $LIBVIRT_SOURCE to point to the root of the libvirt sources and retry")
@@ -44,6 +68,16 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	fmt.Println("flag type processing")
+	if err := lvgen.GenerateFlags(*outDir); err != nil {
+		fmt.Println("go-libvirt code generator failed:", err)
+		os.Exit(1)
+	}
+	fmt.Println("enum processing")
+	if err := lvgen.GenerateEnums(*outDir); err != nil {
+		fmt.Println("go-libvirt code generator failed:", err)
+		os.Exit(1)
+	}
 }
 
 func processProto(lvFile string) error {
@@ -57,5 +91,5 @@ func processProto(lvFile string) error {
 	// extract the base filename, without extension, for the generator to use.
 	name := strings.TrimSuffix(filepath.Base(lvFile), filepath.Ext(lvFile))
 
-	return lvgen.Generate(name, rdr)
+	return lvgen.Generate(name, rdr, *outDir)
 }
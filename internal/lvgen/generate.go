@@ -15,10 +15,17 @@
 package lvgen
 
 import (
+	"bytes"
+	"embed"
 	"fmt"
 	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/types"
 	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -38,12 +45,47 @@ type ConstItem struct {
 	Name   string
 	LVName string
 	Val    string
+	// Type is the Go type of a const, if it's typed. Protocol limits such as
+	// REMOTE_STRING_MAX are uint32, matching the length prefixes they bound.
+	Type string
+	// EnumName is the Go name of the enum this value belongs to. It's empty
+	// for consts.
+	EnumName string
+	// Comment holds the lines of the comment preceding the item in the
+	// protocol file, if any.
+	Comment []string
 }
 
-// Generator holds all the information parsed out of the protocol file.
+// Enum holds an enum declaration along with the values that belong to it.
+type Enum struct {
+	Decl
+	Vals []ConstItem
+}
+
+// UniqueVals returns the enum's values, omitting any whose numeric value has
+// already been seen. The first name given to a value wins, so these can be
+// used as case labels in a switch.
+func (e Enum) UniqueVals() []ConstItem {
+	seen := make(map[string]bool)
+	var vals []ConstItem
+	for _, v := range e.Vals {
+		if seen[v.Val] {
+			continue
+		}
+		seen[v.Val] = true
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+// Generator holds all the information parsed out of the protocol file. Each
+// slice holds items in the order they appear in the file. The templates range
+// only over these slices, never over the maps, which are for lookups, so that
+// generating from the same file always produces the same output.
 type Generator struct {
-	// Enums holds the enum declarations. The type of enums is always int32.
-	Enums []Decl
+	// Enums holds the enum declarations, along with the values of each. The
+	// type of enums is always int32.
+	Enums []Enum
 	// EnumVals holds the list of enum values found by the parser. In sunrpc as
 	// in go, these are not separately namespaced.
 	EnumVals []ConstItem
@@ -63,6 +105,46 @@ type Generator struct {
 	Procs []Proc
 }
 
+// ProcEnum returns the protocol's procedure enum, or nil if it doesn't have
+// one.
+func (g Generator) ProcEnum() *Enum {
+	for ix := range g.Enums {
+		if strings.HasSuffix(g.Enums[ix].LVName, "_procedure") {
+			return &g.Enums[ix]
+		}
+	}
+	return nil
+}
+
+// MaxLimit returns a const aliasing the largest of the protocol's limits on
+// the length of strings and arrays, or nil if it has none. The const is named
+// MaxLimit, with the same prefix as the protocol's procedure enum.
+func (g Generator) MaxLimit() *ConstItem {
+	var max *ConstItem
+	var maxVal uint64
+	for ix, c := range g.Consts {
+		if c.Type != "uint32" {
+			continue
+		}
+		_, digits, base := splitNumber(c.Val)
+		v, err := strconv.ParseUint(digits, base, 32)
+		if err != nil || (max != nil && v <= maxVal) {
+			continue
+		}
+		max, maxVal = &g.Consts[ix], v
+	}
+	if max == nil {
+		return nil
+	}
+
+	var prefix string
+	if pe := g.ProcEnum(); pe != nil {
+		prefix = strings.TrimSuffix(pe.Name, "Procedure")
+	}
+	return &ConstItem{Name: prefix + "MaxLimit", LVName: max.LVName,
+		Val: max.Name}
+}
+
 func newGenerator() Generator {
 	return Generator{
 		StructMap: make(map[string]int),
@@ -165,7 +247,18 @@ type Union struct {
 type Case struct {
 	CaseName        string
 	DiscriminantVal string
-	Decl
+	IsDefault       bool // true for the union's default arm
+	Decl                 // Empty for a void arm
+}
+
+// HasDefault reports whether the union has a default arm.
+func (u Union) HasDefault() bool {
+	for _, c := range u.Cases {
+		if c.IsDefault {
+			return true
+		}
+	}
+	return false
 }
 
 // Proc holds information about a libvirt procedure the parser has found.
@@ -173,6 +266,7 @@ type Proc struct {
 	Program        string // The program name. Blank for REMOTE_ procs.
 	Num            int64  // The libvirt procedure number.
 	Name           string // The name of the go func.
+	ConstName      string // The name of the procedure's constant.
 	LVName         string // The name of the libvirt proc this wraps.
 	Args           []Decl // The contents of the args struct for this procedure.
 	Ret            []Decl // The contents of the ret struct for this procedure.
@@ -231,10 +325,18 @@ var CurrentUnion *Union
 // a case statement.
 var CurrentCase *Case
 
-// Generate will output go bindings for libvirt. The lvPath parameter should be
-// the path to the root of the libvirt source directory to use for the
-// generation.
-func Generate(name string, proto io.Reader) error {
+// templates holds the text templates used to render the generated files. They
+// are embedded so the generator doesn't depend on the working directory.
+//go:embed constants.tmpl procedures.tmpl category.tmpl flags.tmpl enums.tmpl enummethods.tmpl
+var templates embed.FS
+
+// Generate will output go bindings for libvirt. The name parameter is the base
+// name of the protocol file being processed, and is used to name the output
+// files. The outDir parameter should be the path to the root of the go-libvirt
+// source tree; the generated procedures are written there, and the generated
+// constants are written to outDir/internal/constants. The c-for-go constants
+// file, const.gen.go, is also read from outDir.
+func Generate(name string, proto io.Reader, outDir string) error {
 	// Start with a clean state
 	Gen = newGenerator()
 
@@ -249,46 +351,269 @@ func Generate(name string, proto io.Reader) error {
 	// yyDebug = 3
 	rv := parser.Parse(lexer)
 	if rv != 0 {
+		if err := lexer.Err(); err != nil {
+			return fmt.Errorf("failed to parse libvirt protocol: %v", err)
+		}
 		return fmt.Errorf("failed to parse libvirt protocol: %v", rv)
 	}
 
 	// When parsing is done, we can link the procedures we've found to their
 	// argument types.
-	procLink()
+	procLink(filepath.Join(outDir, "const.gen.go"))
 
 	// Generate and write the output.
-	constsName := fmt.Sprintf("../constants/%v.gen.go", name)
+	constsName := filepath.Join(outDir, "internal", "constants", name+".gen.go")
 	constFile, err := os.Create(constsName)
 	if err != nil {
 		return err
 	}
 	defer constFile.Close()
-	procName := fmt.Sprintf("../../%v.gen.go", name)
+	procName := filepath.Join(outDir, name+".gen.go")
 	procFile, err := os.Create(procName)
 	if err != nil {
 		return err
 	}
 	defer procFile.Close()
 
-	return genGo(constFile, procFile)
+	if err := genGo(constFile, procFile); err != nil {
+		return err
+	}
+	if SplitConsts {
+		return genCategories(outDir, name)
+	}
+	return nil
+}
+
+// GenerateFlags writes String and Has methods for the flag types among the
+// enums in the c-for-go constants file, const.gen.go, to flags.gen.go. Both
+// files are in outDir, the root of the go-libvirt source tree. Unlike
+// Generate, it doesn't depend on a protocol file, so it's called once.
+func GenerateFlags(outDir string) error {
+	flags, err := flagEnums(filepath.Join(outDir, "const.gen.go"))
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(outDir, "flags.gen.go"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return genFlags(f, flags)
+}
+
+// GenerateEnums writes String and IsValid methods and Parse functions for the
+// enums in the c-for-go constants file, const.gen.go, other than the flag
+// types, to enums.gen.go. Like GenerateFlags, it's called once, and both files
+// are in outDir.
+func GenerateEnums(outDir string) error {
+	enums, err := valueEnums(filepath.Join(outDir, "const.gen.go"))
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(outDir, "enums.gen.go"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return genEnums(f, enums)
 }
 
 // genGo is called when the parsing is done; it generates the golang output
 // files using templates.
 func genGo(constFile, procFile io.Writer) error {
-	t, err := template.ParseFiles("constants.tmpl")
-	if err != nil {
+	if err := genConsts(constFile, Gen); err != nil {
 		return err
 	}
-	if err = t.Execute(constFile, Gen); err != nil {
-		return err
+	return genProcs(procFile)
+}
+
+// genCategories is called after genGo when SplitConsts is set. The constants
+// belonging to each category are written to a package named after the
+// category, underneath outDir.
+func genCategories(outDir, name string) error {
+	for _, c := range splitConsts(Gen) {
+		dir := filepath.Join(outDir, c.Package)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		f, err := os.Create(filepath.Join(dir, name+".gen.go"))
+		if err != nil {
+			return err
+		}
+		err = genCategory(f, c)
+		f.Close()
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	t, err = template.ParseFiles("procedures.tmpl")
+// genConsts writes the enum values and consts held in g to w.
+func genConsts(w io.Writer, g Generator) error {
+	return execute(w, "constants.tmpl", g)
+}
+
+// genCategory writes the package of constants for category c to w.
+func genCategory(w io.Writer, c Category) error {
+	return execute(w, "category.tmpl", c)
+}
+
+// genFlags writes the methods of the flag types to w.
+func genFlags(w io.Writer, flags []FlagEnum) error {
+	return execute(w, "flags.tmpl", flags)
+}
+
+// genEnums writes the methods of the c-for-go enums in enums to w.
+func genEnums(w io.Writer, enums []Enum) error {
+	return execute(w, "enums.tmpl", enums)
+}
+
+// execute runs the named template with data, and writes its output to w once
+// gofmt has formatted it. Output which doesn't parse as go is a bug in the
+// template, so it fails without writing anything. The templates defined in
+// enummethods.tmpl are available to all of them.
+func execute(w io.Writer, name string, data interface{}) error {
+	t, err := template.ParseFS(templates, name, "enummethods.tmpl")
 	if err != nil {
 		return err
 	}
-	return t.Execute(procFile, Gen)
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s produced invalid go: %v", name, err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// genProcs writes the go types and procedure wrappers to w.
+func genProcs(w io.Writer) error {
+	return execute(w, "procedures.tmpl", Gen)
+}
+
+// SplitConsts causes the generated constants to also be written to a package
+// per libvirt subsystem (domain, network, storage and so on), such as
+// go-libvirt/domain, with the subsystem's prefix removed from their names:
+// libvirt.ProcDomainCreate is domain.ProcCreate, and constants.DomainListMax
+// is domain.ListMax. The internal constants package still holds everything.
+// This must be set before Generate is called.
+var SplitConsts bool
+
+// constCategories maps name prefixes to the libvirt subsystem, or category,
+// that constants with that prefix belong to, and the package its constants are
+// written to. Prefixes are checked in order, so more specific prefixes must
+// come first.
+var constCategories = []struct {
+	prefix, category string
+}{
+	{"Connect", "connect"},
+	{"Domain", "domain"},
+	// interface is a Go keyword, so can't be used as a package name.
+	{"Interface", "iface"},
+	{"Network", "network"},
+	{"NodeDevice", "nodedev"},
+	{"Node", "node"},
+	{"Nwfilter", "nwfilter"},
+	{"Secret", "secret"},
+	{"Storage", "storage"},
+}
+
+// procPrefixes are the procedure name prefixes of each protocol, which come
+// before the category prefix.
+var procPrefixes = []string{"QEMUProc", "LXCProc", "Proc"}
+
+// CategoryItem is a constant written to a category's package.
+type CategoryItem struct {
+	ConstItem
+	// Short is the name of the constant in the category's package.
+	Short string
+}
+
+// Category holds the constants belonging to one libvirt subsystem.
+type Category struct {
+	// Package is the name of the package for the category.
+	Package string
+	// EnumVals are typed enum values, declared in the go-libvirt package.
+	EnumVals []CategoryItem
+	// Consts are the consts, such as limits, declared in the internal
+	// constants package.
+	Consts []CategoryItem
+}
+
+// constCategory returns the category for a generated constant name, and its
+// name with the category prefix removed, or an empty category if it doesn't
+// belong to one. Procedure prefixes are kept, so ProcDomainCreate belongs to
+// the domain category, as ProcCreate.
+func constCategory(name string) (category, short string) {
+	proc := ""
+	for _, p := range procPrefixes {
+		if strings.HasPrefix(name, p) {
+			proc = p
+			break
+		}
+	}
+	rest := name[len(proc):]
+	for _, c := range constCategories {
+		if !strings.HasPrefix(rest, c.prefix) {
+			continue
+		}
+		// Only match whole words: Domain, but not Domainx. A name which is
+		// only the prefix, like Domain, can't be shortened.
+		short := rest[len(c.prefix):]
+		if r, _ := utf8.DecodeRuneInString(short); !unicode.IsUpper(r) {
+			if short == "" {
+				return c.category, name
+			}
+			continue
+		}
+		return c.category, proc + short
+	}
+	return "", name
+}
+
+// splitConsts partitions the enum values and consts in g by category,
+// returning the categories which have any, in the order of constCategories.
+// Uncategorized items are left out.
+func splitConsts(g Generator) []Category {
+	groups := make(map[string]*Category)
+	add := func(ci ConstItem, typed bool) {
+		cat, short := constCategory(ci.Name)
+		if cat == "" {
+			return
+		}
+		c, ok := groups[cat]
+		if !ok {
+			c = &Category{Package: cat}
+			groups[cat] = c
+		}
+		item := CategoryItem{ci, short}
+		if typed {
+			c.EnumVals = append(c.EnumVals, item)
+		} else {
+			c.Consts = append(c.Consts, item)
+		}
+	}
+	for _, ev := range g.EnumVals {
+		add(ev, true)
+	}
+	for _, c := range g.Consts {
+		add(c, false)
+	}
+
+	var cats []Category
+	for _, c := range constCategories {
+		if g, ok := groups[c.category]; ok {
+			cats = append(cats, *g)
+			delete(groups, c.category)
+		}
+	}
+	return cats
 }
 
 // constNameTransform changes an upcased, snake-style name like
@@ -385,27 +710,59 @@ func fromSnakeToCamel(s string) string {
 
 // abbrevs is a list of abbreviations which should be all upper-case in a name.
 // (This is really just to keep the go linters happy and to produce names that
-// are intuitive to a go developer.)
-var abbrevs = []string{"Xml", "Io", "Uuid", "Cpu", "Id", "Ip", "Qemu"}
+// are intuitive to a go developer.) More can be added with AddAbbreviation.
+var abbrevs = []string{"Xml", "Io", "Uuid", "Cpu", "Id", "Ip", "Qemu", "Lxc"}
+
+// AddAbbreviation adds an abbreviation, such as "Numa", to the list of those
+// which are upper-cased in generated names. The abbreviation may be given in
+// any case. Abbreviations must be added before Generate is called.
+func AddAbbreviation(a string) {
+	if a == "" {
+		return
+	}
+	a = strings.ToUpper(a[:1]) + strings.ToLower(a[1:])
+	for _, existing := range abbrevs {
+		if existing == a {
+			return
+		}
+	}
+	abbrevs = append(abbrevs, a)
+}
+
+// KeepAbbrevs leaves abbreviations in generated names as libvirt's names are
+// camel-cased, so that DOMAIN_GET_XML_DESC becomes DomainGetXmlDesc rather
+// than DomainGetXMLDesc. Flag types and the manual maps are still looked up by
+// the up-cased names, since the c-for-go constants use them. This must be set
+// before Generate is called.
+var KeepAbbrevs bool
 
-// fixAbbrevs up-cases all instances of anything in the 'abbrevs' array. This
+// fixAbbrevs up-cases the abbreviations in s, unless KeepAbbrevs is set.
+func fixAbbrevs(s string) string {
+	if KeepAbbrevs {
+		return s
+	}
+	return upcaseAbbrevs(s)
+}
+
+// upcaseAbbrevs up-cases all instances of anything in the 'abbrevs' array. This
 // would be a simple matter, but we don't want to upcase an abbreviation if it's
 // actually part of a larger word, so it's not so simple.
-func fixAbbrevs(s string) string {
+func upcaseAbbrevs(s string) string {
 	for _, a := range abbrevs {
-		for loc := 0; ; {
-			loc = strings.Index(s[loc:], a)
-			if loc == -1 {
+		for loc := 0; loc < len(s); {
+			ix := strings.Index(s[loc:], a)
+			if ix == -1 {
 				break
 			}
+			loc += ix
 			r := 'A'
 			if len(a) < len(s[loc:]) {
-				r, _ = utf8.DecodeRune([]byte(s[loc+len(a):]))
+				r, _ = utf8.DecodeRuneInString(s[loc+len(a):])
 			}
-			if unicode.IsLower(r) == false {
-				s = s[:loc] + strings.Replace(s[loc:], a, strings.ToUpper(a), 1)
+			if !unicode.IsLower(r) {
+				s = s[:loc] + strings.ToUpper(a) + s[loc+len(a):]
 			}
-			loc++
+			loc += len(a)
 		}
 	}
 	return s
@@ -416,8 +773,8 @@ func fixAbbrevs(s string) string {
 // types are extracted by iterating through the argument and return structures
 // defined in the protocol file. If one or both of these structs is not defined
 // then either the args or return values are empty.
-func procLink() {
-	flagTypes := mapFlagTypes()
+func procLink(constsPath string) {
+	flagTypes := mapFlagTypes(constsPath)
 
 	for ix, proc := range Gen.Procs {
 		argsName := proc.Name + "Args"
@@ -427,7 +784,10 @@ func procLink() {
 		if hasArgs {
 			argsStruct := Gen.Structs[argsIx]
 			Gen.Procs[ix].ArgsStruct = argsStruct.Name
-			changeFlagType(proc.Name, &argsStruct, flagTypes)
+			// The flag types and manual maps use the up-cased names.
+			name := upcaseAbbrevs(proc.Name)
+			changeFlagType(name, &argsStruct, flagTypes)
+			changeArgTypes(name, &argsStruct, flagTypes)
 			Gen.Procs[ix].Args = argsStruct.Members
 		}
 		if hasRet {
@@ -435,9 +795,19 @@ func procLink() {
 			Gen.Procs[ix].RetStruct = retStruct.Name
 			Gen.Procs[ix].Ret = retStruct.Members
 		}
+		if name, ok := procNameMap[upcaseAbbrevs(proc.Name)]; ok {
+			Gen.Procs[ix].Name = name
+		}
 	}
 }
 
+// procNameMap renames the go funcs of procedures whose names, transformed from
+// libvirt's, read badly. The argument and return types keep their names.
+var procNameMap = map[string]string{
+	"DomainFsfreeze": "DomainFSFreeze",
+	"DomainFsthaw":   "DomainFSThaw",
+}
+
 // mapFlagTypes builds a map of the C types which appear to correspond to the
 // various flags fields in libvirt calls. Determining whether a type actually
 // corresponds to a set of flags is done by pattern matching the type name;
@@ -447,9 +817,9 @@ func procLink() {
 // This code uses the loader package to load the constants file generated by
 // c-for-go, which runs against libvirt's C sources. This file is generated by
 // 'go generate ./...' prior to the lvgen/ generator being run.
-func mapFlagTypes() map[string]ast.Expr {
+func mapFlagTypes(constsPath string) map[string]ast.Expr {
 	pconf := loader.Config{}
-	f, err := pconf.ParseFile("../../const.gen.go", nil)
+	f, err := pconf.ParseFile(constsPath, nil)
 	if err != nil {
 		panic(fmt.Sprintln("failed to read constants file: ", err))
 	}
@@ -481,42 +851,61 @@ func mapFlagTypes() map[string]ast.Expr {
 // .c files in libvirt, which contain doxygen-style parameter comments that
 // specify the valid value types for flags.
 var flagMap = map[string]string{
-	"ConnectOpen":                  "ConnectFlags",
-	"DomainAddIothread":            "DomainModificationImpact",
-	"DomainCoreDumpWithFormat":     "DomainCoreDumpFlags",
-	"DomainCreateXML":              "DomainCreateFlags",
-	"DomainCreateWithFiles":        "DomainCreateFlags",
-	"DomainCreateXMLWithFiles":     "DomainCreateFlags",
-	"DomainDefineXMLFlags":         "DomainDefineFlags",
-	"DomainDelIothread":            "DomainModificationImpact",
-	"DomainDestroyFlags":           "DomainDestroyFlagsValues",
-	"DomainGetCPUStats":            "TypedParameterFlags",
-	"DomainGetEmulatorPinInfo":     "DomainModificationImpact",
-	"DomainGetInterfaceParameters": "DomainModificationImpact",
-	"DomainGetIothreadInfo":        "DomainModificationImpact",
-	"DomainGetMetadata":            "DomainModificationImpact",
-	"DomainGetPerfEvents":          "DomainModificationImpact",
-	"DomainGetXMLDesc":             "DomainXMLFlags",
-	"DomainManagedSaveDefineXML":   "DomainSaveRestoreFlags",
-	"DomainManagedSaveGetXMLDesc":  "DomainXMLFlags",
-	"DomainMemoryPeek":             "DomainMemoryFlags",
-	"DomainMigratePerform3Params":  "DomainMigrateFlags",
-	"DomainOpenChannel":            "DomainChannelFlags",
-	"DomainOpenGraphicsFd":         "DomainOpenGraphicsFlags",
-	"DomainPinEmulator":            "DomainModificationImpact",
-	"DomainPinIothread":            "DomainModificationImpact",
-	"DomainSetLifecycleAction":     "DomainModificationImpact",
-	"DomainSetMemoryStatsPeriod":   "DomainMemoryModFlags",
-	"DomainSetMetadata":            "DomainModificationImpact",
-	"DomainSetPerfEvents":          "DomainModificationImpact",
-	"DomainSetVcpu":                "DomainModificationImpact",
-	"DomainShutdownFlags":          "DomainShutdownFlagValues",
-	"DomainUndefineFlags":          "DomainUndefineFlagsValues",
-	"DomainUpdateDeviceFlags":      "DomainDeviceModifyFlags",
-	"StoragePoolCreateXML":         "StoragePoolCreateFlags",
-	"StoragePoolGetXMLDesc":        "StorageXMLFlags",
-	"StorageVolCreateXML":          "StorageVolCreateFlags",
-	"StorageVolCreateXMLFrom":      "StorageVolCreateFlags",
+	"ConnectListAllNodeDevices":         "ConnectListAllNodeDeviceFlags",
+	"ConnectOpen":                       "ConnectFlags",
+	"DomainAddIothread":                 "DomainModificationImpact",
+	"DomainAttachDeviceFlags":           "DomainDeviceModifyFlags",
+	"DomainCoreDumpWithFormat":          "DomainCoreDumpFlags",
+	"DomainCreateWithFlags":             "DomainCreateFlags",
+	"DomainCreateXML":                   "DomainCreateFlags",
+	"DomainCreateWithFiles":             "DomainCreateFlags",
+	"DomainCreateXMLWithFiles":          "DomainCreateFlags",
+	"DomainDefineXMLFlags":              "DomainDefineFlags",
+	"DomainDelIothread":                 "DomainModificationImpact",
+	"DomainDestroyFlags":                "DomainDestroyFlagsValues",
+	"DomainDetachDeviceAlias":           "DomainDeviceModifyFlags",
+	"DomainDetachDeviceFlags":           "DomainDeviceModifyFlags",
+	"DomainGetCPUStats":                 "TypedParameterFlags",
+	"DomainGetEmulatorPinInfo":          "DomainModificationImpact",
+	"DomainGetInterfaceParameters":      "DomainModificationImpact",
+	"DomainGetIothreadInfo":             "DomainModificationImpact",
+	"DomainGetMetadata":                 "DomainModificationImpact",
+	"DomainGetPerfEvents":               "DomainModificationImpact",
+	"DomainGetSchedulerParametersFlags": "DomainModificationImpact",
+	"DomainGetVcpusFlags":               "DomainVCPUFlags",
+	"DomainGetXMLDesc":                  "DomainXMLFlags",
+	"DomainListAllSnapshots":            "DomainSnapshotListFlags",
+	"DomainManagedSave":                 "DomainSaveRestoreFlags",
+	"DomainManagedSaveDefineXML":        "DomainSaveRestoreFlags",
+	"DomainManagedSaveGetXMLDesc":       "DomainXMLFlags",
+	"DomainMemoryPeek":                  "DomainMemoryFlags",
+	"DomainMigrateGetMaxSpeed":          "DomainMigrateMaxSpeedFlags",
+	"DomainMigratePerform3Params":       "DomainMigrateFlags",
+	"DomainMigrateSetMaxSpeed":          "DomainMigrateMaxSpeedFlags",
+	"DomainOpenChannel":                 "DomainChannelFlags",
+	"DomainOpenGraphicsFd":              "DomainOpenGraphicsFlags",
+	"DomainPinEmulator":                 "DomainModificationImpact",
+	"DomainPinIothread":                 "DomainModificationImpact",
+	"DomainRestoreFlags":                "DomainSaveRestoreFlags",
+	"DomainRevertToSnapshot":            "DomainSnapshotRevertFlags",
+	"DomainSaveFlags":                   "DomainSaveRestoreFlags",
+	"DomainSetLifecycleAction":          "DomainModificationImpact",
+	"DomainSetMemoryStatsPeriod":        "DomainMemoryModFlags",
+	"DomainSetMetadata":                 "DomainModificationImpact",
+	"DomainSetPerfEvents":               "DomainModificationImpact",
+	"DomainSetSchedulerParametersFlags": "DomainModificationImpact",
+	"DomainSetVcpu":                     "DomainModificationImpact",
+	"DomainSetVcpusFlags":               "DomainVCPUFlags",
+	"DomainShutdownFlags":               "DomainShutdownFlagValues",
+	"DomainSnapshotCreateXML":           "DomainSnapshotCreateFlags",
+	"DomainSnapshotGetXMLDesc":          "DomainSnapshotXMLFlags",
+	"DomainUndefineFlags":               "DomainUndefineFlagsValues",
+	"DomainUpdateDeviceFlags":           "DomainDeviceModifyFlags",
+	"InterfaceGetXMLDesc":               "InterfaceXMLFlags",
+	"StoragePoolCreateXML":              "StoragePoolCreateFlags",
+	"StoragePoolGetXMLDesc":             "StorageXMLFlags",
+	"StorageVolCreateXML":               "StorageVolCreateFlags",
+	"StorageVolCreateXMLFrom":           "StorageVolCreateFlags",
 }
 
 // findFlagType attempts to find a real type for the flags passed to a given
@@ -571,6 +960,170 @@ func changeFlagType(procName string, s *Structure, flagTypes map[string]ast.Expr
 	}
 }
 
+// argTypeMap gives the go types of arguments other than flags which take the
+// values of an enum, by procedure and then argument name. As with flags, the
+// protocol declares them as plain integers.
+var argTypeMap = map[string]map[string]string{
+	"DomainCoreDumpWithFormat": {"Dumpformat": "DomainCoreDumpFormat"},
+	"DomainGetGuestInfo":       {"Types": "DomainGuestInfoTypes"},
+}
+
+// changeArgTypes sets the types of a libvirt call's arguments listed in
+// argTypeMap. Like changeFlagType, it leaves an argument's type alone if the
+// enum isn't in the constants file.
+func changeArgTypes(procName string, s *Structure, enumTypes map[string]ast.Expr) {
+	types, ok := argTypeMap[procName]
+	if !ok {
+		return
+	}
+	for ix, d := range s.Members {
+		tname, ok := types[d.Name]
+		if !ok {
+			continue
+		}
+		if _, ok := enumTypes[tname]; !ok {
+			fmt.Printf("manual argument type %v for %v not found, continuing", tname, procName)
+			continue
+		}
+		s.Members[ix].Type = tname
+	}
+}
+
+// FlagEnum is an enum from the c-for-go constants file whose values are bit
+// flags, which are combined rather than used one at a time.
+type FlagEnum struct {
+	Name string
+	// Zero is the name of the enum's 0 value, if it has one.
+	Zero string
+	// Bits holds the name of each flag, in order of value. Where several
+	// names share a value, the first declared is used.
+	Bits []ConstItem
+}
+
+// ownStringer lists the enums in the c-for-go constants file which have a
+// String method written by hand, so GenerateEnums leaves them alone.
+var ownStringer = map[string]bool{
+	// Named for the statistics' libvirt names, such as "swap_in".
+	"DomainMemoryStatTags": true,
+}
+
+// constEnums loads the constants file generated by c-for-go and returns its
+// enums, and the values of each, in the order they're declared.
+func constEnums(constsPath string) ([]Enum, error) {
+	pconf := loader.Config{}
+	f, err := pconf.ParseFile(constsPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read constants file: %v", err)
+	}
+	pconf.CreateFromFiles("const", f)
+	prog, err := pconf.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load package: %v", err)
+	}
+	cpkg := prog.Package("const")
+
+	var enums []Enum
+	index := make(map[string]int)
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for _, id := range vs.Names {
+				c, ok := cpkg.Defs[id].(*types.Const)
+				if !ok {
+					continue
+				}
+				named, ok := c.Type().(*types.Named)
+				if !ok {
+					continue
+				}
+				tname := named.Obj().Name()
+				ix, ok := index[tname]
+				if !ok {
+					ix = len(enums)
+					index[tname] = ix
+					enums = append(enums, Enum{Decl: Decl{Name: tname}})
+				}
+				v, _ := constant.Int64Val(c.Val())
+				enums[ix].Vals = append(enums[ix].Vals, ConstItem{
+					Name:     id.Name,
+					Val:      strconv.FormatInt(v, 10),
+					EnumName: tname,
+				})
+			}
+		}
+	}
+	return enums, nil
+}
+
+// flagEnum returns e as a FlagEnum if it appears to be flags: if its nonzero
+// values are all single bits. Enums with only one or two such values, like
+// ErrorLevel, are as likely to be a plain list of values, so they're only
+// taken as flags if their name says so.
+func flagEnum(e Enum) (FlagEnum, bool) {
+	fe := FlagEnum{Name: e.Name}
+	for _, v := range e.UniqueVals() {
+		n, err := strconv.ParseInt(v.Val, 10, 64)
+		if err != nil || n < 0 || n&(n-1) != 0 {
+			return FlagEnum{}, false
+		}
+		if n == 0 {
+			fe.Zero = v.Name
+			continue
+		}
+		fe.Bits = append(fe.Bits, v)
+	}
+	if len(fe.Bits) == 0 || len(fe.Bits) < 3 && !strings.Contains(e.Name, "Flag") {
+		return FlagEnum{}, false
+	}
+	sort.SliceStable(fe.Bits, func(i, j int) bool {
+		a, _ := strconv.ParseInt(fe.Bits[i].Val, 10, 64)
+		b, _ := strconv.ParseInt(fe.Bits[j].Val, 10, 64)
+		return a < b
+	})
+	return fe, true
+}
+
+// flagEnums returns the enums in the constants file at constsPath which
+// appear to be flags, as decided by flagEnum.
+func flagEnums(constsPath string) ([]FlagEnum, error) {
+	enums, err := constEnums(constsPath)
+	if err != nil {
+		return nil, err
+	}
+	var flags []FlagEnum
+	for _, e := range enums {
+		if fe, ok := flagEnum(e); ok {
+			flags = append(flags, fe)
+		}
+	}
+	return flags, nil
+}
+
+// valueEnums returns the enums in the constants file at constsPath which
+// aren't flags, and so take one of their values at a time, leaving out those
+// in ownStringer.
+func valueEnums(constsPath string) ([]Enum, error) {
+	enums, err := constEnums(constsPath)
+	if err != nil {
+		return nil, err
+	}
+	var vals []Enum
+	for _, e := range enums {
+		if _, ok := flagEnum(e); ok || ownStringer[e.Name] {
+			continue
+		}
+		vals = append(vals, e)
+	}
+	return vals, nil
+}
+
 //---------------------------------------------------------------------------
 // Routines called by the parser's actions.
 //---------------------------------------------------------------------------
@@ -579,19 +1132,30 @@ func changeFlagType(procName string, s *Structure, flagTypes map[string]ast.Expr
 func StartEnum(name string) {
 	// Enums are always signed 32-bit integers.
 	goname := identifierTransform(name)
-	Gen.Enums = append(Gen.Enums, Decl{goname, name, "int32"})
+	// The parser calls StartEnum once it has seen the whole enum, so the values
+	// that haven't yet been claimed by an enum belong to this one.
+	first := len(Gen.EnumVals)
+	for first > 0 && Gen.EnumVals[first-1].EnumName == "" {
+		first--
+	}
+	for ix := first; ix < len(Gen.EnumVals); ix++ {
+		Gen.EnumVals[ix].EnumName = goname
+	}
+	vals := make([]ConstItem, len(Gen.EnumVals)-first)
+	copy(vals, Gen.EnumVals[first:])
+	Gen.Enums = append(Gen.Enums, Enum{Decl{goname, name, "int32"}, vals})
 	// Set the automatic value var to -1; it will be incremented before being
 	// assigned to an enum value.
 	CurrentEnumVal = -1
 }
 
 // AddEnumVal will add a new enum value to the list.
-func AddEnumVal(name, val string) error {
+func AddEnumVal(name, val, comment string) error {
 	ev, err := parseNumber(val)
 	if err != nil {
 		return fmt.Errorf("invalid enum value %v = %v", name, val)
 	}
-	return addEnumVal(name, ev, nil)
+	return addEnumVal(name, ev, nil, comment)
 }
 
 // AddProcEnumVal adds a procedure enum to our list of remote procedures which
@@ -626,11 +1190,13 @@ func AddProcEnumVal(name, val string, meta string) error {
 	program := procProgramName(name)
 	procName := procNameTransform(name)
 	enumName := constNameTransform(name)
-	Gen.EnumVals = append(Gen.EnumVals, ConstItem{enumName, name, strconv.FormatInt(ev, 10)})
+	Gen.EnumVals = append(Gen.EnumVals, ConstItem{Name: enumName, LVName: name,
+		Val: strconv.FormatInt(ev, 10)})
 	CurrentEnumVal = ev
 
 	proc := &Proc{Program: program, Num: ev, Name: procName,
-		LVName: name, ReadStreamIdx: -1, WriteStreamIdx: -1}
+		ConstName: enumName, LVName: name, ReadStreamIdx: -1,
+		WriteStreamIdx: -1}
 	if metaObj != nil {
 		proc.ReadStreamIdx = metaObj.ReadStream
 		proc.WriteStreamIdx = metaObj.WriteStream
@@ -642,39 +1208,111 @@ func AddProcEnumVal(name, val string, meta string) error {
 // AddEnumAutoVal adds an enum to the list, using the automatically-incremented
 // value. This is called when the parser finds an enum definition without an
 // explicit value.
-func AddEnumAutoVal(name string) error {
+func AddEnumAutoVal(name, comment string) error {
 	CurrentEnumVal++
-	return addEnumVal(name, CurrentEnumVal, nil)
+	return addEnumVal(name, CurrentEnumVal, nil, comment)
 }
 
-func addEnumVal(name string, val int64, meta *ProcMeta) error {
+func addEnumVal(name string, val int64, meta *ProcMeta, comment string) error {
 	goname := constNameTransform(name)
-	Gen.EnumVals = append(Gen.EnumVals, ConstItem{goname, name, fmt.Sprintf("%d", val)})
+	Gen.EnumVals = append(Gen.EnumVals, ConstItem{Name: goname, LVName: name,
+		Val: fmt.Sprintf("%d", val), Comment: commentLines(comment)})
 	CurrentEnumVal = val
 	return nil
 }
 
 // AddConst adds a new constant to the parser's list.
-func AddConst(name, val string) error {
-	_, err := parseNumber(val)
+func AddConst(name, val, comment string) error {
+	lit, err := goLiteral(val)
 	if err != nil {
 		return fmt.Errorf("invalid const value %v = %v", name, val)
 	}
 	goname := constNameTransform(name)
-	Gen.Consts = append(Gen.Consts, ConstItem{goname, name, val})
+	var typ string
+	if isLimit(name, val) {
+		typ = "uint32"
+	}
+	Gen.Consts = append(Gen.Consts, ConstItem{Name: goname, LVName: name, Val: lit,
+		Type: typ, Comment: commentLines(comment)})
 	return nil
 }
 
+// isLimit reports whether a const is one of the protocol's limits on the
+// length of strings and arrays, like REMOTE_STRING_MAX or
+// REMOTE_NODE_MAX_CELLS, whose value fits in the uint32 used for XDR lengths.
+func isLimit(name, val string) bool {
+	if !strings.HasSuffix(name, "_MAX") && !strings.Contains(name, "_MAX_") {
+		return false
+	}
+	neg, digits, base := splitNumber(val)
+	if neg {
+		return false
+	}
+	_, err := strconv.ParseUint(digits, base, 32)
+	return err == nil
+}
+
+// commentLines splits a comment collected by the lexer into lines.
+func commentLines(comment string) []string {
+	if comment == "" {
+		return nil
+	}
+	return strings.Split(comment, "\n")
+}
+
 // parseNumber makes sure that a parsed numerical value can be parsed to a 64-
 // bit integer.
 func parseNumber(val string) (int64, error) {
-	base := 10
-	if strings.HasPrefix(val, "0x") {
-		base = 16
-		val = val[2:]
+	neg, digits, base := splitNumber(val)
+	if neg {
+		digits = "-" + digits
 	}
-	n, err := strconv.ParseInt(val, base, 64)
-	return n, err
+	return strconv.ParseInt(digits, base, 64)
+}
+
+// goLiteral converts a numeric value from the protocol file to a Go literal
+// with the same value. Values too large for an int64 are given an explicit
+// uint64 type, so they can still be used without a conversion.
+func goLiteral(val string) (string, error) {
+	neg, digits, base := splitNumber(val)
+	lit := digits
+	switch base {
+	case 16:
+		lit = "0x" + digits
+	case 8:
+		lit = "0" + digits
+	}
+	if neg {
+		lit = "-" + lit
+	}
+
+	if _, err := parseNumber(val); err == nil {
+		return lit, nil
+	}
+	if neg {
+		return "", fmt.Errorf("%v is out of range", val)
+	}
+	if _, err := strconv.ParseUint(digits, base, 64); err != nil {
+		return "", err
+	}
+	return "uint64(" + lit + ")", nil
+}
+
+// splitNumber breaks a C integer literal into its sign, digits and base. Any
+// integer suffix (U, L, UL, ULL...) is discarded, since it isn't needed in Go.
+func splitNumber(val string) (neg bool, digits string, base int) {
+	val = strings.TrimRight(val, "uUlL")
+	if strings.HasPrefix(val, "-") {
+		neg = true
+		val = val[1:]
+	}
+	switch {
+	case strings.HasPrefix(val, "0x"), strings.HasPrefix(val, "0X"):
+		return neg, val[2:], 16
+	case len(val) > 1 && val[0] == '0':
+		return neg, val[1:], 8
+	}
+	return neg, val, 10
 }
 
 // parseMeta parses procedure metadata to simple string mapping
@@ -761,11 +1399,24 @@ func StartCase(dvalue string) {
 		caseName = caseName[ix+1:]
 	}
 	caseName = fromSnakeToCamel(caseName)
-	dv, ok := lvTypedParams[dvalue]
-	if ok {
+	if dv, ok := lvTypedParams[dvalue]; ok {
 		dvalue = strconv.FormatUint(uint64(dv), 10)
+	} else if v, ok := lvValue(dvalue); ok {
+		dvalue = v
+	}
+	CurrentCase = &Case{CaseName: caseName, DiscriminantVal: dvalue,
+		IsDefault: dvalue == "default"}
+}
+
+// lvValue returns the value of an enum value or const the parser has already
+// seen, looked up by its libvirt name.
+func lvValue(name string) (string, bool) {
+	for _, v := range append(Gen.EnumVals, Gen.Consts...) {
+		if v.LVName == name {
+			return v.Val, true
+		}
 	}
-	CurrentCase = &Case{CaseName: caseName, DiscriminantVal: dvalue}
+	return "", false
 }
 
 // AddCase is called when the parser finishes parsing a case.
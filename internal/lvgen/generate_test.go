@@ -0,0 +1,687 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lvgen
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const constsProto = `
+const TEST_STRING_MAX = 4194304;
+const TEST_NODE_MAX_CELLS = 1024;
+const TEST_MIGRATE_COOKIE_MAX = 4194304;
+const TEST_PROGRAM = 0x20008086;
+const TEST_NEGATIVE_MAX = -1;
+
+enum test_procedure {
+    /**
+     * @generate: both
+     */
+    TEST_PROC_CONNECT_OPEN = 1,
+
+    /**
+     * @generate: both
+     */
+    TEST_PROC_CONNECT_CLOSE = 2
+};
+`
+
+func genTestConsts(t *testing.T) string {
+	parse(t, constsProto)
+	var buf bytes.Buffer
+	if err := genConsts(&buf, Gen); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestGenConstsLimits(t *testing.T) {
+	out := genTestConsts(t)
+
+	for _, want := range []string{
+		"\tTestStringMax uint32 = 4194304\n",
+		"\tTestNodeMaxCells uint32 = 1024\n",
+		"\tTestMigrateCookieMax uint32 = 4194304\n",
+		"\tTestProgram = 0x20008086\n",
+		"\tTestNegativeMax = -1\n",
+		"const TestMaxLimit = TestStringMax\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated consts to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenConstsProcNames(t *testing.T) {
+	out := genTestConsts(t)
+
+	for _, want := range []string{
+		"var TestProcedureNames = map[uint32]string{\n",
+		"\tTestProcConnectOpen:  \"TEST_PROC_CONNECT_OPEN\",\n",
+		"\tTestProcConnectClose: \"TEST_PROC_CONNECT_CLOSE\",\n",
+		"var TestProcedureMethods = map[string]uint32{\n",
+		"\t\"TestConnectOpen\":  TestProcConnectOpen,\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated consts to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenConstsFormatted(t *testing.T) {
+	out := genTestConsts(t)
+
+	src, err := format.Source([]byte(out))
+	if err != nil {
+		t.Fatalf("generated consts don't parse: %v", err)
+	}
+	if string(src) != out {
+		t.Errorf("expected generated consts to be gofmt clean, got:\n%s", out)
+	}
+}
+
+func TestGenConstsLibvirtNames(t *testing.T) {
+	out := genTestConsts(t)
+
+	for _, want := range []string{
+		"\t// TestProcConnectOpen is libvirt's TEST_PROC_CONNECT_OPEN\n\tTestProcConnectOpen = 1\n",
+		"\t// TestStringMax is libvirt's TEST_STRING_MAX\n\tTestStringMax uint32 = 4194304\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated consts to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+const unionProto = `
+enum test_kind {
+    TEST_KIND_NONE = 0,
+    TEST_KIND_COUNT = 1
+};
+
+union test_value switch (int kind) {
+ case TEST_KIND_NONE:
+     void;
+ case TEST_KIND_COUNT:
+     int count;
+ default:
+     unsigned int other;
+};
+`
+
+func TestGenUnion(t *testing.T) {
+	parse(t, unionProto)
+	var buf bytes.Buffer
+	if err := genProcs(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, out)
+	}
+
+	for _, want := range []string{
+		// void arm
+		"func NewTestValueNone() *TestValue {\n\treturn &TestValue{D: 0, I: struct{}{}}\n}",
+		"\tcase 0:\n\tcase 1:\n\t\tv, ok := u.I.(int32)",
+		"\tcase 0:\n\t\tu.I = struct{}{}\n",
+		// default arm
+		"func NewTestValueDefault(d uint32, v uint32) *TestValue {\n\treturn &TestValue{D: d, I: v}\n}",
+		"\tdefault:\n\t\tv, ok := u.I.(uint32)",
+		"\tdefault:\n\t\tvar v uint32\n\t\tn2, err = d.Decode(&v)\n\t\tu.I = v\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "invalid TestValue discriminant") {
+		t.Error("a union with a default arm shouldn't reject any discriminant")
+	}
+}
+
+const floatProto = `
+struct test_usage {
+    float load;
+    double percent;
+    double history<16>;
+};
+`
+
+func TestGenFloats(t *testing.T) {
+	parse(t, floatProto)
+	var buf bytes.Buffer
+	if err := genProcs(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"\tLoad    float32\n",
+		"\tPercent float64\n",
+		"\tHistory []float64\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+const optProto = `
+const REMOTE_STRING_MAX = 4194304;
+typedef string remote_nonnull_string<REMOTE_STRING_MAX>;
+typedef remote_nonnull_string *remote_string;
+struct remote_test_ret {
+    remote_string hostname;
+    remote_nonnull_string name;
+};
+`
+
+// TestGenOptional ensures optional data, declared like a pointer, becomes a
+// slice holding at most one value, which XDR encodes the same way: a 0 or 1
+// presence flag, followed by the value if present.
+func TestGenOptional(t *testing.T) {
+	parse(t, optProto)
+	var buf bytes.Buffer
+	if err := genProcs(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"// OptString is libvirt's remote_string\ntype OptString []string\n",
+		"type TestRet struct {\n\tHostname OptString\n\tName     string\n}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+const enumProto = `
+enum test_color {
+    TEST_COLOR_RED = 1,
+    TEST_COLOR_GREEN = 2,
+    TEST_COLOR_SCARLET = 1
+};
+`
+
+func TestGenEnum(t *testing.T) {
+	parse(t, enumProto)
+	var buf bytes.Buffer
+	if err := genProcs(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, out)
+	}
+
+	for _, want := range []string{
+		"func (e TestColor) IsValid() bool {\n\tswitch e {\n\tcase TestColorRed,\n\t\tTestColorGreen:\n\t\treturn true\n",
+		"func ParseTestColor(s string) (TestColor, error) {\n",
+		// Every name parses, including those sharing a value.
+		"\tcase \"TestColorScarlet\":\n\t\treturn TestColorScarlet, nil\n",
+		"\treturn 0, fmt.Errorf(\"invalid TestColor %q\", s)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+const procTypesProto = `
+struct test_connect_open_args {
+    unsigned int flags;
+};
+
+struct test_connect_get_type_ret {
+    string type<>;
+};
+
+enum test_procedure {
+    /**
+     * @generate: both
+     */
+    TEST_PROC_CONNECT_OPEN = 1,
+
+    /**
+     * @generate: both
+     */
+    TEST_PROC_CONNECT_GET_TYPE = 2
+};
+`
+
+func TestGenProcTypes(t *testing.T) {
+	parse(t, procTypesProto)
+	// Link the procedures to their structs as procLink does, without the flag
+	// types it reads from const.gen.go.
+	for ix, proc := range Gen.Procs {
+		if _, ok := Gen.StructMap[proc.Name+"Args"]; ok {
+			Gen.Procs[ix].ArgsStruct = proc.Name + "Args"
+		}
+		if _, ok := Gen.StructMap[proc.Name+"Ret"]; ok {
+			Gen.Procs[ix].RetStruct = proc.Name + "Ret"
+		}
+	}
+	var buf bytes.Buffer
+	if err := genProcs(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"var TestProcedureTypes = map[uint32]ProcInfo{\n",
+		"\tconstants.TestProcConnectOpen:    {Method: \"TestConnectOpen\", Args: reflect.TypeOf(TestConnectOpenArgs{})},\n",
+		"\tconstants.TestProcConnectGetType: {Method: \"TestConnectGetType\", Ret: reflect.TypeOf(TestConnectGetTypeRet{})},\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestConstCategory(t *testing.T) {
+	tests := []struct {
+		name, category, short string
+	}{
+		{"ProcDomainCreate", "domain", "ProcCreate"},
+		{"QEMUProcDomainMonitorCommand", "domain", "QEMUProcMonitorCommand"},
+		{"DomainListMax", "domain", "ListMax"},
+		{"ProcNodeDeviceLookupByName", "nodedev", "ProcLookupByName"},
+		{"ProcNodeGetInfo", "node", "ProcGetInfo"},
+		{"ProcInterfaceCreate", "iface", "ProcCreate"},
+		{"Domain", "domain", "Domain"},
+		{"Domainx", "", "Domainx"},
+		{"ProcAuthList", "", "ProcAuthList"},
+		{"StringMax", "", "StringMax"},
+	}
+
+	for _, tt := range tests {
+		cat, short := constCategory(tt.name)
+		if cat != tt.category || short != tt.short {
+			t.Errorf("constCategory(%v): expected %q, %q, got %q, %q",
+				tt.name, tt.category, tt.short, cat, short)
+		}
+	}
+}
+
+const categoryProto = `
+const REMOTE_STRING_MAX = 4194304;
+const REMOTE_DOMAIN_LIST_MAX = 16384;
+
+enum remote_procedure {
+    /**
+     * @generate: both
+     */
+    REMOTE_PROC_CONNECT_OPEN = 1,
+
+    /**
+     * @generate: both
+     */
+    REMOTE_PROC_DOMAIN_CREATE = 2,
+
+    /**
+     * @generate: both
+     */
+    REMOTE_PROC_NETWORK_CREATE = 3
+};
+`
+
+func TestGenCategory(t *testing.T) {
+	parse(t, categoryProto)
+	cats := splitConsts(Gen)
+
+	var pkgs []string
+	for _, c := range cats {
+		pkgs = append(pkgs, c.Package)
+	}
+	if got := strings.Join(pkgs, ","); got != "connect,domain,network" {
+		t.Fatalf("expected connect, domain and network categories, got %v", got)
+	}
+
+	var buf bytes.Buffer
+	if err := genCategory(&buf, cats[1]); err != nil {
+		t.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, buf.Bytes())
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"package domain\n",
+		"\tlibvirt \"github.com/digitalocean/go-libvirt\"\n",
+		"\t\"github.com/digitalocean/go-libvirt/internal/constants\"\n",
+		"\tProcCreate = libvirt.ProcDomainCreate\n",
+		"\tListMax = constants.DomainListMax\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated package to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "StringMax") || strings.Contains(out, "ConnectOpen") {
+		t.Errorf("expected only domain constants, got:\n%s", out)
+	}
+
+	// A package with no consts doesn't import the constants package.
+	buf.Reset()
+	if err := genCategory(&buf, cats[2]); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "internal/constants") {
+		t.Errorf("expected no constants import, got:\n%s", buf.String())
+	}
+}
+
+const flagsConsts = `package libvirt
+
+type TestCreateFlags int32
+
+const (
+	TestCreateNone   TestCreateFlags = iota
+	TestCreatePaused TestCreateFlags = 1
+	TestCreateKeep   TestCreateFlags = 4
+	TestCreateHold   TestCreateFlags = 1
+	TestCreateForce  TestCreateFlags = 2
+)
+
+type TestStatsTypes int32
+
+const (
+	TestStatsCPU   TestStatsTypes = 1
+	TestStatsDisk  TestStatsTypes = 2
+	TestStatsNet   TestStatsTypes = 4
+)
+
+type TestLevel int32
+
+const (
+	TestLevelNone  TestLevel = iota
+	TestLevelWarn  TestLevel = 1
+	TestLevelError TestLevel = 2
+)
+
+type TestState int32
+
+const (
+	TestStateOff     TestState = iota
+	TestStateOn      TestState = 1
+	TestStatePaused  TestState = 2
+	TestStateCrashed TestState = 3
+)
+`
+
+func TestFlagEnums(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lvgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "const.gen.go")
+	if err := ioutil.WriteFile(path, []byte(flagsConsts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	flags, err := flagEnums(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range flags {
+		names = append(names, f.Name)
+	}
+	// TestLevel has too few bits to be taken as flags without the name, and
+	// TestState isn't made of bits.
+	if got := strings.Join(names, ","); got != "TestCreateFlags,TestStatsTypes" {
+		t.Fatalf("expected TestCreateFlags and TestStatsTypes, got %v", got)
+	}
+
+	create := flags[0]
+	if create.Zero != "TestCreateNone" {
+		t.Errorf("expected zero value TestCreateNone, got %q", create.Zero)
+	}
+	var bits []string
+	for _, b := range create.Bits {
+		bits = append(bits, b.Name)
+	}
+	if got := strings.Join(bits, ","); got != "TestCreatePaused,TestCreateForce,TestCreateKeep" {
+		t.Errorf("expected bits in order of value, first name winning, got %v", got)
+	}
+
+	var buf bytes.Buffer
+	if err := genFlags(&buf, flags); err != nil {
+		t.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, buf.Bytes())
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"func (f TestCreateFlags) String() string {\n\treturn flagString(uint32(f), \"TestCreateNone\", []flagName{\n",
+		"\t\t{uint32(TestCreateForce), \"TestCreateForce\"},\n",
+		"func (f TestCreateFlags) Has(flag TestCreateFlags) bool {\n",
+		"\treturn flagString(uint32(f), \"0\", []flagName{\n\t\t{uint32(TestStatsCPU), \"TestStatsCPU\"},\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "TestCreateHold") {
+		t.Errorf("expected aliased flags to be left out, got:\n%s", out)
+	}
+}
+
+func TestGenEnums(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lvgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "const.gen.go")
+	if err := ioutil.WriteFile(path, []byte(flagsConsts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	enums, err := valueEnums(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range enums {
+		names = append(names, e.Name)
+	}
+	if got := strings.Join(names, ","); got != "TestLevel,TestState" {
+		t.Fatalf("expected TestLevel and TestState, got %v", got)
+	}
+
+	var buf bytes.Buffer
+	if err := genEnums(&buf, enums); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"func (e TestState) String() string {\n\tswitch e {\n\tcase TestStateOff:\n\t\treturn \"TestStateOff\"\n",
+		"\treturn fmt.Sprintf(\"TestLevel(%d)\", int32(e))\n",
+		"func (e TestState) IsValid() bool {\n",
+		"func ParseTestLevel(s string) (TestLevel, error) {\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "TestCreateFlags") {
+		t.Errorf("expected flag types to be left out, got:\n%s", out)
+	}
+}
+
+const generateProto = `
+const TEST_STRING_MAX = 4194304;
+const TEST_NAME_MAX = 256;
+
+typedef string test_nonnull_string<TEST_STRING_MAX>;
+
+enum test_color {
+    TEST_COLOR_RED = 1,
+    TEST_COLOR_GREEN = 2,
+    TEST_COLOR_BLUE = 4
+};
+
+struct test_thing {
+    test_nonnull_string name;
+    unsigned hyper size;
+};
+
+struct test_create_args {
+    test_thing thing;
+    unsigned int flags;
+};
+
+struct test_create_ret {
+    int id;
+};
+
+union test_value switch (int kind) {
+ case TEST_COLOR_RED:
+     int red;
+ case TEST_COLOR_GREEN:
+     unsigned int green;
+};
+
+enum test_procedure {
+    /**
+     * @generate: both
+     */
+    TEST_PROC_CREATE = 1,
+
+    /**
+     * @generate: both
+     */
+    TEST_PROC_DESTROY = 2
+};
+`
+
+// generateFiles runs Generate, GenerateFlags and GenerateEnums into a new
+// directory, and returns the contents of the files they write.
+func generateFiles(t *testing.T) map[string][]byte {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "lvgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "internal", "constants"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "const.gen.go"), []byte(flagsConsts), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Generate("test", strings.NewReader(generateProto), dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateFlags(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateEnums(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string][]byte)
+	for _, name := range []string{
+		"test.gen.go",
+		filepath.Join("internal", "constants", "test.gen.go"),
+		"flags.gen.go",
+		"enums.gen.go",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[name] = b
+	}
+	return files
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	first := generateFiles(t)
+	if !bytes.Contains(first["test.gen.go"], []byte("func (l *Libvirt) TestCreate(Thing TestThing, Flags TestCreateFlags)")) {
+		t.Fatalf("expected generated procedures, got:\n%s", first["test.gen.go"])
+	}
+
+	for i := 0; i < 5; i++ {
+		again := generateFiles(t)
+		for name, want := range first {
+			if !bytes.Equal(again[name], want) {
+				t.Fatalf("regenerating %v produced different output:\n%s\nthen:\n%s", name, want, again[name])
+			}
+		}
+	}
+}
+
+func TestKeepAbbrevs(t *testing.T) {
+	tests := []struct {
+		name, want, keep string
+		transform        func(string) string
+	}{
+		{"DOMAIN_GET_XML_DESC", "DomainGetXMLDesc", "DomainGetXmlDesc", constNameTransform},
+		{"REMOTE_PROC_DOMAIN_GET_CPU_STATS", "DomainGetCPUStats", "DomainGetCpuStats", procNameTransform},
+		{"remote_domain_lookup_by_uuid_args", "DomainLookupByUUIDArgs", "DomainLookupByUuidArgs", identifierTransform},
+		// Abbreviations inside a larger word are never up-cased.
+		{"REMOTE_NODE_DEVICE_GET_IDENTITY", "NodeDeviceGetIdentity", "NodeDeviceGetIdentity", constNameTransform},
+	}
+
+	defer func() { KeepAbbrevs = false }()
+	for _, keep := range []bool{false, true} {
+		KeepAbbrevs = keep
+		for _, tt := range tests {
+			want := tt.want
+			if keep {
+				want = tt.keep
+			}
+			if got := tt.transform(tt.name); got != want {
+				t.Errorf("KeepAbbrevs %v: expected %v to become %v, got %v", keep, tt.name, want, got)
+			}
+		}
+	}
+}
+
+func TestProcNameMap(t *testing.T) {
+	procNameMap["TestCreate"] = "TestMake"
+	defer delete(procNameMap, "TestCreate")
+
+	out := generateFiles(t)["test.gen.go"]
+	for _, want := range []string{
+		"// TestMake is the go wrapper for TEST_PROC_CREATE.\n",
+		"func (l *Libvirt) TestMake(Thing TestThing, Flags TestCreateFlags) (rID int32, err error) {\n",
+		// The types keep their names.
+		"\targs := TestCreateArgs{\n",
+	} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("expected generated code to contain %q, got:\n%s", want, out)
+		}
+	}
+	if bytes.Contains(out, []byte("func (l *Libvirt) TestCreate(")) {
+		t.Errorf("expected TestCreate to be renamed, got:\n%s", out)
+	}
+}
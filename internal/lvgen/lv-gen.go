@@ -24,6 +24,12 @@ package lvgen
 // 2) Set the environment variable LIBVIRT_SOURCE to point to the top level
 //    directory containing the version of libvirt for which you want to generate
 //    bindings.
+//
+// The generator in gen/ accepts flags to write its output somewhere other than
+// this tree (-output), to upper-case additional abbreviations in generated
+// names (-abbrevs), and to also write the generated constants to a package per
+// libvirt subsystem, such as go-libvirt/domain (-split-consts). Run
+// `go run gen/main.go -h` for details.
 
 //go:generate goyacc sunrpc.y
 //go:generate go run gen/main.go
@@ -60,6 +60,7 @@ type item struct {
 	typ          int
 	val          string
 	line, column int
+	comment      string // the doc comment preceding the item, if any
 }
 
 // String will display lexer items for humans to debug. There are some
@@ -81,14 +82,23 @@ func (i item) String() string {
 
 // Lexer stores the state of this lexer.
 type Lexer struct {
-	input    string    // the string we're scanning.
-	start    int       // start position of the item.
-	pos      int       // current position in the input.
-	line     int       // the current line (for error reporting).
-	column   int       // current position within the current line.
-	width    int       // width of the last rune scanned.
-	items    chan item // channel of scanned lexer items (lexemes).
-	lastItem item      // The last item the lexer handed the parser
+	input       string    // the string we're scanning.
+	start       int       // start position of the item.
+	pos         int       // current position in the input.
+	line        int       // the current line (for error reporting).
+	column      int       // current position within the current line.
+	width       int       // width of the last rune scanned.
+	startLine   int       // the line at the start of the current item.
+	startColumn int       // the column at the start of the current item.
+	err         error     // the first error reported to the parser.
+	items       chan item // channel of scanned lexer items (lexemes).
+	lastItem    item      // The last item the lexer handed the parser
+
+	// comment holds the most recent block comment, which documents the first
+	// item on the next line, unless a blank line comes between them.
+	comment     string
+	commentLine int // the last line of the comment
+	emitLine    int // the line of the last emitted item
 }
 
 // NewLexer will return a new lexer for the passed-in reader.
@@ -101,6 +111,7 @@ func NewLexer(rdr io.Reader) (*Lexer, error) {
 	}
 	l.input = string(b)
 	l.items = make(chan item)
+	l.emitLine = -1
 
 	return l, nil
 }
@@ -115,29 +126,98 @@ func (l *Lexer) Run() {
 
 // emit returns a token to the parser.
 func (l *Lexer) emit(t int) {
-	l.items <- item{t, l.input[l.start:l.pos], l.line, l.column}
-	l.start = l.pos
+	l.items <- item{t, l.input[l.start:l.pos], l.startLine, l.startColumn, l.docComment()}
+	l.ignore()
+}
+
+// docComment returns the comment documenting the item being emitted. Only the
+// first item on a line is documented, by the comment preceding it and by any
+// comment following it on the same line; the grammar takes a definition's
+// comment from its first token.
+func (l *Lexer) docComment() string {
+	if l.startLine == l.emitLine {
+		return ""
+	}
+	l.emitLine = l.startLine
+
+	c := l.comment
+	if l.startLine > l.commentLine+1 {
+		// A blank line separates the comment from the item.
+		c = ""
+	}
+	l.comment = ""
+
+	if t := l.trailingComment(); t != "" {
+		if c != "" {
+			c += "\n"
+		}
+		c += t
+	}
+	return c
+}
+
+// trailingComment returns the comment following the item being emitted on the
+// same line, if any. lexBlockComment discards it once it's reached.
+func (l *Lexer) trailingComment() string {
+	rest := l.input[l.pos:]
+	if nl := strings.IndexByte(rest, '\n'); nl >= 0 {
+		rest = rest[:nl]
+	}
+	start := strings.Index(rest, "/*")
+	if start < 0 {
+		return ""
+	}
+	body := l.input[l.pos+start:]
+	end := strings.Index(body, "*/")
+	if end < 0 {
+		return ""
+	}
+	body = body[:end+2]
+	if strings.HasPrefix(body, "/**") && strings.Contains(body, "@") {
+		// a metadata comment, which isn't documentation
+		return ""
+	}
+	return normalizeComment(body)
 }
 
 // Lex gets the next token.
 func (l *Lexer) Lex(st *yySymType) int {
-	s := <-l.items
-	l.lastItem = s
+	s, ok := <-l.items
+	if ok {
+		l.lastItem = s
+	}
 	st.val = s.val
+	st.comment = s.comment
 	return int(s.typ)
 }
 
-// Error is called by the parser when it finds a problem.
+// Error is called by the parser when it finds a problem. The first error is
+// saved, along with the location of the last token the parser saw, and can be
+// retrieved by calling Err.
 func (l *Lexer) Error(s string) {
-	fmt.Printf("parse error at %d:%d: %v\n", l.lastItem.line+1, l.lastItem.column+1, s)
-	fmt.Printf("error at %q\n", l.lastItem.val)
+	if l.err != nil {
+		return
+	}
+	if l.lastItem.typ == ERROR {
+		// The lexer has already described the problem.
+		l.err = fmt.Errorf("parse error at line %d, col %d: %v",
+			l.lastItem.line+1, l.lastItem.column+1, l.lastItem.val)
+		return
+	}
+	l.err = fmt.Errorf("parse error at line %d, col %d: %v (at %q)",
+		l.lastItem.line+1, l.lastItem.column+1, s, l.lastItem.val)
+}
+
+// Err returns the first error reported by the parser, or nil.
+func (l *Lexer) Err() error {
+	return l.err
 }
 
 // errorf is used by the lexer to report errors. It inserts an ERROR token into
 // the items channel, and sets the state to nil, which stops the lexer's state
 // machine.
 func (l *Lexer) errorf(format string, args ...interface{}) stateFn {
-	l.items <- item{ERROR, fmt.Sprintf(format, args...), l.line, l.column}
+	l.items <- item{ERROR, fmt.Sprintf(format, args...), l.startLine, l.startColumn, ""}
 	return nil
 }
 
@@ -161,6 +241,8 @@ func (l *Lexer) next() (r rune) {
 // ignore discards the current text from start to pos.
 func (l *Lexer) ignore() {
 	l.start = l.pos
+	l.startLine = l.line
+	l.startColumn = l.column
 }
 
 // backup moves back one character, but can only be called once per next() call.
@@ -253,10 +335,16 @@ func lexText(l *Lexer) stateFn {
 			l.ignore()
 			return lexText
 		}
-		if l.column == 1 && r == '%' {
+		if l.column == 1 && (r == '%' || r == '#') {
 			l.backup()
 			return lexDirective
 		}
+		// A backslash at the end of a line joins it to the next, as in C.
+		if r == '\\' && l.peek() == '\n' {
+			l.next()
+			l.ignore()
+			return lexText
+		}
 		if unicode.IsLetter(r) {
 			l.backup()
 			return lexIdent
@@ -275,19 +363,30 @@ func lexText(l *Lexer) stateFn {
 
 // lexBlockComment is used when we find a comment marker '/*' in the input.
 func lexBlockComment(l *Lexer) stateFn {
-	// Double star is used only at the start of metadata comments
-	metadataComment := strings.HasPrefix(l.input[l.pos:], "/**")
+	// Double star is used at the start of metadata comments, but libvirt also
+	// uses it for ordinary comments. Only those with annotations are metadata.
+	doubleStar := strings.HasPrefix(l.input[l.pos:], "/**")
+	// A comment following an item on the same line has already been attached
+	// to that item by docComment.
+	trailing := l.emitLine == l.line
 	for {
 		if strings.HasPrefix(l.input[l.pos:], "*/") {
-			// Found the end. Advance past the '*/' and discard the comment body
-			// unless it's a metadata comment
+			// Found the end. Advance past the '*/' and emit the comment body
+			// if it's a metadata comment, otherwise hold on to it so it can be
+			// attached to the items that follow.
 			l.next()
 			l.next()
-			if metadataComment {
+			body := l.input[l.start:l.pos]
+			if doubleStar && strings.Contains(body, "@") {
+				l.comment = ""
 				l.emit(METADATACOMMENT)
-			} else {
-				l.ignore()
+				return lexText
 			}
+			if !trailing {
+				l.comment = normalizeComment(body)
+				l.commentLine = l.line
+			}
+			l.ignore()
 			return lexText
 		}
 		if l.next() == eof {
@@ -296,6 +395,32 @@ func lexBlockComment(l *Lexer) stateFn {
 	}
 }
 
+// normalizeComment strips the comment markers and any leading '*' decorations
+// from a block comment, leaving lines of text suitable for a Go comment.
+func normalizeComment(c string) string {
+	c = strings.TrimPrefix(c, "/*")
+	c = strings.TrimPrefix(c, "*")
+	c = strings.TrimSuffix(c, "*/")
+
+	var lines []string
+	for _, line := range strings.Split(c, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "*") {
+			line = strings.TrimPrefix(line[1:], " ")
+		}
+		lines = append(lines, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+	// Drop any blank lines at the start and end.
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // lexIdent handles identifiers.
 func lexIdent(l *Lexer) stateFn {
 	for {
@@ -312,24 +437,21 @@ func lexIdent(l *Lexer) stateFn {
 	return lexText
 }
 
-// lexNumber handles decimal and hexadecimal numbers. Decimal numbers may begin
-// with a '-'; hex numbers begin with '0x' and do not accept leading '-'.
+// lexNumber handles decimal, octal and hexadecimal numbers. Numbers may begin
+// with a '-', octal numbers begin with '0', and hex numbers begin with '0x'. C
+// integer suffixes like 'U' and 'UL' are accepted.
 func lexNumber(l *Lexer) stateFn {
 	// Leading '-' is ok
 	digits := "0123456789"
-	neg := l.accept("-")
-	if !neg {
-		// allow '0x' for hex numbers, as long as there's not a leading '-'.
-		r := l.peek()
-		if r == '0' {
-			l.next()
-			if l.accept("x") {
-				digits = "0123456789ABCDEFabcdef"
-			}
-		}
+	l.accept("-")
+	// allow '0x' for hex numbers.
+	if l.accept("0") && l.accept("xX") {
+		digits = "0123456789ABCDEFabcdef"
 	}
 	// followed by any number of digits
 	l.acceptRun(digits)
+	// and an optional integer suffix.
+	l.acceptRun("uUlL")
 	r := l.peek()
 	if unicode.IsLetter(r) {
 		l.next()
@@ -339,19 +461,23 @@ func lexNumber(l *Lexer) stateFn {
 	return lexText
 }
 
-// lexDirective handles lines beginning with '%'. These are used to emit C code
-// directly to the output file. For now we're ignoring them, but some of the
-// constants in the protocol file do depend on values from #included header
-// files, so that may need to change.
+// lexDirective handles lines beginning with '%' or '#'. Lines beginning with
+// '%' are used to emit C code directly to the output file, and those beginning
+// with '#' are C preprocessor directives, such as #include, which rpcgen leaves
+// to cpp. For now we're ignoring both, but some of the constants in the
+// protocol file do depend on values from #included header files, so that may
+// need to change. A backslash at the end of the line continues the directive
+// onto the next.
 func lexDirective(l *Lexer) stateFn {
 	for {
 		r := l.next()
-		if r == '\n' {
+		if r == '\\' && l.peek() == '\n' {
+			l.next()
+			continue
+		}
+		if r == '\n' || r == eof {
 			l.ignore()
 			return lexText
 		}
-		if r == eof {
-			return l.errorf("unterminated directive")
-		}
 	}
 }
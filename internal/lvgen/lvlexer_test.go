@@ -0,0 +1,109 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lvgen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const commentProto = `
+/* The first constant. */
+const FIRST = 1;
+const SECOND = 2;
+
+/* Separated by a blank line, so not a doc comment. */
+
+const THIRD = 3; /* The third constant. */
+
+/* The fourth constant. */
+const FOURTH = 4; /* More about it. */
+
+enum colour {
+    /* Red. */
+    RED = 0,
+    GREEN = 1, /* Green. */
+    BLUE = 2
+};
+`
+
+// parse runs the parser over proto, leaving the results in Gen.
+func parse(t *testing.T, proto string) {
+	t.Helper()
+	Gen = newGenerator()
+	l, err := NewLexer(strings.NewReader(proto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go l.Run()
+	if rv := yyNewParser().Parse(l); rv != 0 {
+		t.Fatalf("parse failed: %v", l.Err())
+	}
+}
+
+func parseComments(t *testing.T, proto string) map[string][]string {
+	parse(t, proto)
+	comments := make(map[string][]string)
+	for _, c := range append(Gen.Consts, Gen.EnumVals...) {
+		comments[c.LVName] = c.Comment
+	}
+	return comments
+}
+
+func TestDocComments(t *testing.T) {
+	want := map[string][]string{
+		"FIRST":  {"The first constant."},
+		"SECOND": nil,
+		"THIRD":  {"The third constant."},
+		"FOURTH": {"The fourth constant.", "More about it."},
+		"RED":    {"Red."},
+		"GREEN":  {"Green."},
+		"BLUE":   nil,
+	}
+
+	got := parseComments(t, commentProto)
+	for name, w := range want {
+		if !reflect.DeepEqual(got[name], w) {
+			t.Errorf("%s: expected comment %q, got %q", name, w, got[name])
+		}
+	}
+}
+
+const directiveProto = `%#include <libvirt/libvirt.h>
+%#include "internal.h"
+#include "remote_protocol.h"
+#define REMOTE_LONG_MACRO(a, b) \
+    ((a) + \
+     (b))
+%#define REMOTE_PASSTHROUGH \
+%    1
+const FIRST = 1;
+const SECOND \
+    = 2;
+%/* trailing passthrough without a newline */`
+
+func TestDirectives(t *testing.T) {
+	parse(t, directiveProto)
+
+	var got []string
+	for _, c := range Gen.Consts {
+		got = append(got, c.LVName+"="+c.Val)
+	}
+	want := []string{"FIRST=1", "SECOND=2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected consts %v, got %v", want, got)
+	}
+}
@@ -48,6 +48,7 @@ import (
 // SymType
 %union{
     val string
+    comment string
 }
 
 // XDR tokens:
@@ -95,14 +96,14 @@ enum_value_list
 
 enum_value
     : enum_value_ident {
-        err := AddEnumAutoVal($1.val)
+        err := AddEnumAutoVal($1.val, $1.comment)
         if err != nil {
             yylex.Error(err.Error())
             return 1
         }
     }
     | enum_value_ident '=' value {
-        err := AddEnumVal($1.val, $3.val)
+        err := AddEnumVal($1.val, $3.val, $1.comment)
         if err != nil {
             yylex.Error(err.Error())
             return 1
@@ -142,7 +143,7 @@ enum_value_ident
 const_definition
     : CONST const_ident '=' IDENTIFIER
     | CONST const_ident '=' CONSTANT {
-        err := AddConst($2.val, $4.val)
+        err := AddConst($2.val, $4.val, $1.comment)
         if err != nil {
             yylex.Error(err.Error())
             return 1
@@ -172,6 +173,7 @@ simple_declaration
 type_specifier
     : int_spec
     | UNSIGNED int_spec {$$.val = "u"+$2.val}
+    | UNSIGNED          {$$.val = "uint32"}
     | FLOAT             {$$.val = "float32"}
     | DOUBLE            {$$.val = "float64"}
     | BOOL              {$$.val = "bool"}
@@ -238,8 +240,15 @@ case_list
     ;
 
 case
-    : CASE value {StartCase($2.val)} ':' declaration {AddCase()}
-    | DEFAULT {StartCase("default")} ':' declaration {AddCase()}
+    : CASE value {StartCase($2.val)} ':' case_body {AddCase()}
+    | DEFAULT {StartCase("default")} ':' case_body {AddCase()}
+    ;
+
+// A union arm may be declared void, in which case nothing follows the
+// discriminant on the wire.
+case_body
+    : declaration
+    | VOID
     ;
 
 program_definition
@@ -33,8 +33,9 @@ import (
 
 //line sunrpc.y:49
 type yySymType struct {
-	yys int
-	val string
+	yys     int
+	val     string
+	comment string
 }
 
 const BOOL = 57346
@@ -115,7 +116,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sunrpc.y:279
+//line sunrpc.y:288
 
 //line yacctab:1
 var yyExca = [...]int{
@@ -126,51 +127,53 @@ var yyExca = [...]int{
 
 const yyPrivate = 57344
 
-const yyLast = 157
+const yyLast = 174
 
 var yyAct = [...]int{
-	89, 82, 36, 119, 111, 81, 64, 70, 32, 55,
-	58, 137, 134, 136, 108, 125, 90, 91, 83, 66,
-	37, 106, 78, 31, 79, 105, 139, 61, 123, 101,
-	74, 96, 41, 93, 76, 65, 40, 10, 39, 43,
-	42, 13, 75, 30, 14, 38, 126, 48, 49, 50,
-	51, 47, 115, 97, 84, 73, 114, 103, 60, 67,
-	54, 52, 29, 59, 61, 118, 142, 135, 127, 116,
-	77, 98, 80, 85, 16, 72, 66, 92, 11, 94,
-	95, 10, 90, 91, 88, 13, 100, 12, 14, 62,
-	63, 87, 99, 102, 104, 69, 27, 25, 15, 23,
-	20, 18, 110, 2, 107, 117, 113, 109, 48, 49,
-	50, 51, 46, 8, 112, 45, 7, 44, 4, 113,
-	28, 124, 128, 121, 130, 122, 86, 71, 131, 8,
-	26, 132, 7, 129, 4, 133, 138, 120, 53, 140,
-	141, 24, 68, 22, 35, 34, 33, 21, 19, 57,
-	56, 17, 9, 6, 5, 3, 1,
+	89, 82, 138, 36, 119, 64, 111, 70, 32, 81,
+	55, 137, 58, 134, 136, 108, 125, 90, 91, 83,
+	66, 37, 106, 31, 78, 74, 79, 105, 144, 141,
+	123, 126, 96, 41, 101, 93, 65, 40, 10, 39,
+	43, 42, 13, 76, 75, 14, 38, 140, 48, 49,
+	50, 51, 47, 30, 115, 97, 84, 73, 114, 103,
+	67, 60, 54, 52, 29, 135, 59, 61, 72, 127,
+	116, 80, 77, 98, 85, 16, 61, 92, 118, 94,
+	95, 11, 90, 91, 10, 88, 66, 100, 13, 87,
+	12, 14, 99, 102, 104, 48, 49, 50, 51, 69,
+	27, 15, 110, 62, 63, 25, 109, 113, 107, 23,
+	20, 18, 117, 46, 8, 112, 45, 7, 44, 4,
+	113, 2, 128, 124, 130, 121, 86, 122, 71, 131,
+	8, 26, 132, 7, 129, 4, 139, 133, 28, 139,
+	142, 41, 143, 120, 53, 40, 10, 39, 43, 42,
+	13, 24, 68, 14, 38, 22, 48, 49, 50, 51,
+	47, 35, 34, 33, 21, 19, 57, 56, 17, 9,
+	6, 5, 3, 1,
 }
 
 var yyPact = [...]int{
-	72, -1000, -1000, 44, -1000, -1000, -1000, -1000, -1000, -1000,
-	78, 77, -1000, 76, 74, 73, 72, 31, -1000, 9,
-	-1000, 28, 30, -1000, -1000, -1000, 29, -1000, -1000, 35,
-	66, -1000, -1000, -1000, -1000, -1000, -4, -1000, 89, -1000,
+	75, -1000, -1000, 45, -1000, -1000, -1000, -1000, -1000, -1000,
+	88, 87, -1000, 86, 82, 77, 75, 33, -1000, 19,
+	-1000, 137, 32, -1000, -1000, -1000, 31, -1000, -1000, 38,
+	80, -1000, -1000, -1000, -1000, -1000, -3, -1000, 76, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 81, 48, 23, -3, 8, 0, -2,
-	-1000, -1000, -1000, -1000, -13, 53, -1000, -1000, 28, -22,
-	22, 43, 68, -1000, 35, 59, 59, -1, 59, -7,
-	-1000, 21, 41, 28, -5, 48, 26, -1000, -1000, -1000,
-	-1000, -1000, -1000, 59, -11, -17, -1000, -1000, 28, -27,
-	53, 59, -1000, 28, -1000, -1000, -1000, -1000, 25, -1000,
-	-1000, 20, 39, 42, 118, -6, 28, -25, -1000, 14,
-	38, 59, -1000, 59, -1000, 28, -1000, 118, -1000, -30,
-	37, -28, -1000, -31, 28, -1000, -8, 28, -1000, 59,
-	-1000, 36, -1000,
+	-1000, -1000, -1000, 85, 41, 25, -8, 10, 9, 47,
+	-1000, -1000, -1000, -1000, -11, 63, -1000, -1000, 137, -21,
+	24, 44, 66, -1000, 38, 59, 59, 1, 59, -6,
+	-1000, 23, 43, 137, 0, 41, 28, -1000, -1000, -1000,
+	-1000, -1000, -1000, 59, -9, -16, -1000, -1000, 137, -26,
+	63, 59, -1000, 137, -1000, -1000, -1000, -1000, 27, -1000,
+	-1000, 22, 40, 55, 120, -4, 137, -24, -1000, -1,
+	39, 59, -1000, 59, -1000, 137, -1000, 120, -1000, -29,
+	35, -27, -1000, -31, 29, -1000, -5, 29, -1000, -1000,
+	-1000, 59, -1000, -2, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 156, 103, 0, 155, 117, 154, 153, 115, 112,
-	152, 151, 9, 150, 149, 10, 148, 147, 1, 8,
-	146, 145, 144, 2, 6, 20, 143, 142, 5, 141,
-	138, 3, 137, 135, 133, 130, 7, 127, 126, 4,
-	114, 105,
+	0, 173, 121, 0, 172, 118, 171, 170, 116, 113,
+	169, 168, 10, 167, 166, 12, 165, 164, 1, 8,
+	163, 162, 161, 3, 5, 21, 155, 152, 9, 151,
+	144, 4, 143, 137, 2, 134, 131, 7, 128, 126,
+	6, 115, 112,
 }
 
 var yyR1 = [...]int{
@@ -178,10 +181,10 @@ var yyR1 = [...]int{
 	4, 4, 5, 12, 12, 13, 13, 13, 13, 15,
 	11, 14, 6, 6, 16, 17, 7, 18, 18, 18,
 	18, 19, 23, 23, 23, 23, 23, 23, 23, 23,
-	23, 23, 23, 25, 25, 25, 25, 24, 20, 21,
-	21, 22, 27, 8, 26, 28, 28, 30, 9, 29,
-	31, 31, 33, 32, 34, 32, 10, 35, 36, 36,
-	37, 38, 39, 39, 40, 41,
+	23, 23, 23, 23, 25, 25, 25, 25, 24, 20,
+	21, 21, 22, 27, 8, 26, 28, 28, 30, 9,
+	29, 31, 31, 33, 32, 35, 32, 34, 34, 10,
+	36, 37, 37, 38, 39, 40, 40, 41, 42,
 }
 
 var yyR2 = [...]int{
@@ -189,46 +192,46 @@ var yyR2 = [...]int{
 	1, 1, 5, 1, 3, 1, 3, 3, 4, 1,
 	1, 1, 4, 4, 1, 0, 3, 1, 1, 1,
 	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 5,
-	4, 3, 0, 6, 1, 2, 3, 0, 10, 1,
-	2, 3, 0, 5, 0, 4, 7, 1, 2, 3,
-	8, 1, 2, 3, 8, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 5,
+	5, 4, 3, 0, 6, 1, 2, 3, 0, 10,
+	1, 2, 3, 0, 5, 0, 4, 1, 1, 7,
+	1, 2, 3, 8, 1, 2, 3, 8, 1,
 }
 
 var yyChk = [...]int{
 	-1000, -1, -2, -4, -5, -6, -7, -8, -9, -10,
 	9, 6, 15, 13, 16, 26, 30, -11, 23, -16,
-	23, -17, -26, 23, -29, 23, -35, 23, -2, 31,
+	23, -17, -26, 23, -29, 23, -36, 23, -2, 31,
 	34, -18, -19, -20, -21, -22, -23, -25, 17, 10,
 	8, 4, 12, 11, -5, -8, -9, 23, 19, 20,
 	21, 22, 31, -30, 31, -12, -13, -14, -15, 28,
 	23, 29, 23, 24, -24, 39, 23, -25, -27, 14,
-	-36, -37, 27, 32, 33, 34, 34, -15, 35, 37,
-	-24, -28, -18, 40, 32, 30, -38, 23, -12, -3,
+	-37, -38, 27, 32, 33, 34, 34, -15, 35, 37,
+	-24, -28, -18, 40, 32, 30, -39, 23, -12, -3,
 	23, 24, -3, 34, -3, -3, 38, 32, 30, -19,
-	-23, 34, -36, 31, -3, 36, 38, -28, 41, -24,
-	-3, -39, -40, -23, 31, 32, 30, -41, 23, -31,
-	-32, 5, 7, 34, -39, 40, 32, 30, -3, -34,
-	-3, -23, -31, -33, 42, 30, 41, 42, -18, 34,
-	-18, -3, 30,
+	-23, 34, -37, 31, -3, 36, 38, -28, 41, -24,
+	-3, -40, -41, -23, 31, 32, 30, -42, 23, -31,
+	-32, 5, 7, 34, -40, 40, 32, 30, -3, -35,
+	-3, -23, -31, -33, 42, 30, 41, 42, -34, -18,
+	18, 34, -34, -3, 30,
 }
 
 var yyDef = [...]int{
 	0, -2, 1, 0, 6, 7, 8, 9, 10, 11,
 	0, 0, 25, 0, 0, 0, 4, 0, 20, 0,
-	24, 0, 0, 54, 57, 59, 0, 67, 5, 0,
-	0, 26, 27, 28, 29, 30, 0, 32, 0, 34,
-	35, 36, 37, 38, 39, 40, 41, 42, 43, 44,
-	45, 46, 52, 0, 0, 0, 13, 15, 0, 0,
-	21, 19, 22, 23, 31, 0, 47, 33, 0, 0,
+	24, 0, 0, 55, 58, 60, 0, 70, 5, 0,
+	0, 26, 27, 28, 29, 30, 0, 32, 34, 35,
+	36, 37, 38, 39, 40, 41, 42, 43, 44, 45,
+	46, 47, 53, 0, 0, 0, 13, 15, 0, 0,
+	21, 19, 22, 23, 31, 0, 48, 33, 0, 0,
 	0, 0, 0, 12, 0, 0, 0, 0, 0, 0,
-	51, 0, 0, 0, 0, 68, 0, 71, 14, 16,
-	2, 3, 17, 0, 0, 0, 50, 53, 55, 0,
-	0, 0, 69, 0, 18, 48, 49, 56, 0, 31,
-	66, 0, 0, 0, 0, 0, 72, 0, 75, 0,
-	0, 0, 64, 0, 73, 0, 58, 60, 62, 0,
-	0, 0, 61, 0, 0, 70, 0, 0, 65, 0,
-	63, 0, 74,
+	52, 0, 0, 0, 0, 71, 0, 74, 14, 16,
+	2, 3, 17, 0, 0, 0, 51, 54, 56, 0,
+	0, 0, 72, 0, 18, 49, 50, 57, 0, 31,
+	69, 0, 0, 0, 0, 0, 75, 0, 78, 0,
+	0, 0, 65, 0, 76, 0, 59, 61, 63, 0,
+	0, 0, 62, 0, 0, 73, 0, 0, 66, 67,
+	68, 0, 64, 0, 77,
 }
 
 var yyTok1 = [...]int{
@@ -596,15 +599,15 @@ yydefault:
 
 	case 12:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sunrpc.y:88
+//line sunrpc.y:89
 		{
 			StartEnum(yyDollar[2].val)
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:97
+//line sunrpc.y:98
 		{
-			err := AddEnumAutoVal(yyDollar[1].val)
+			err := AddEnumAutoVal(yyDollar[1].val, yyDollar[1].comment)
 			if err != nil {
 				yylex.Error(err.Error())
 				return 1
@@ -612,9 +615,9 @@ yydefault:
 		}
 	case 16:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sunrpc.y:104
+//line sunrpc.y:105
 		{
-			err := AddEnumVal(yyDollar[1].val, yyDollar[3].val)
+			err := AddEnumVal(yyDollar[1].val, yyDollar[3].val, yyDollar[1].comment)
 			if err != nil {
 				yylex.Error(err.Error())
 				return 1
@@ -622,7 +625,7 @@ yydefault:
 		}
 	case 17:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sunrpc.y:111
+//line sunrpc.y:112
 		{
 			err := AddProcEnumVal(yyDollar[1].val, yyDollar[3].val, "")
 			if err != nil {
@@ -632,7 +635,7 @@ yydefault:
 		}
 	case 18:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sunrpc.y:118
+//line sunrpc.y:119
 		{
 			err := AddProcEnumVal(yyDollar[2].val, yyDollar[4].val, yyDollar[1].val)
 			if err != nil {
@@ -642,9 +645,9 @@ yydefault:
 		}
 	case 23:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sunrpc.y:144
+//line sunrpc.y:145
 		{
-			err := AddConst(yyDollar[2].val, yyDollar[4].val)
+			err := AddConst(yyDollar[2].val, yyDollar[4].val, yyDollar[1].comment)
 			if err != nil {
 				yylex.Error(err.Error())
 				return 1
@@ -652,145 +655,151 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:158
+//line sunrpc.y:159
 		{
 			StartTypedef()
 		}
 	case 31:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sunrpc.y:169
+//line sunrpc.y:170
 		{
 			AddDeclaration(yyDollar[2].val, yyDollar[1].val)
 		}
 	case 33:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sunrpc.y:174
+//line sunrpc.y:175
 		{
 			yyVAL.val = "u" + yyDollar[2].val
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:175
+//line sunrpc.y:176
 		{
-			yyVAL.val = "float32"
+			yyVAL.val = "uint32"
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:176
+//line sunrpc.y:177
 		{
-			yyVAL.val = "float64"
+			yyVAL.val = "float32"
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:177
+//line sunrpc.y:178
 		{
-			yyVAL.val = "bool"
+			yyVAL.val = "float64"
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:178
+//line sunrpc.y:179
 		{
-			yyVAL.val = "string"
+			yyVAL.val = "bool"
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:179
+//line sunrpc.y:180
+		{
+			yyVAL.val = "string"
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sunrpc.y:181
 		{
 			yyVAL.val = "byte"
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:187
+//line sunrpc.y:189
 		{
 			yyVAL.val = "int64"
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:188
+//line sunrpc.y:190
 		{
 			yyVAL.val = "int32"
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:189
+//line sunrpc.y:191
 		{
 			yyVAL.val = "int16"
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:190
+//line sunrpc.y:192
 		{
 			yyVAL.val = "int8"
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sunrpc.y:198
+//line sunrpc.y:200
 		{
 			AddFixedArray(yyDollar[2].val, yyDollar[1].val, yyDollar[4].val)
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sunrpc.y:202
+//line sunrpc.y:204
 		{
 			AddVariableArray(yyDollar[2].val, yyDollar[1].val, yyDollar[4].val)
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sunrpc.y:203
+//line sunrpc.y:205
 		{
 			AddVariableArray(yyDollar[2].val, yyDollar[1].val, "")
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sunrpc.y:211
+//line sunrpc.y:213
 		{
 			AddOptValue(yyDollar[3].val, yyDollar[1].val)
 		}
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sunrpc.y:215
+//line sunrpc.y:217
 		{
 			StartStruct(yyDollar[2].val)
 		}
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sunrpc.y:215
+//line sunrpc.y:217
 		{
 			AddStruct()
 		}
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sunrpc.y:228
+//line sunrpc.y:230
 		{
 			StartUnion(yyDollar[2].val)
 		}
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sunrpc.y:228
+//line sunrpc.y:230
 		{
 			AddUnion()
 		}
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sunrpc.y:241
+//line sunrpc.y:243
 		{
 			StartCase(yyDollar[2].val)
 		}
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sunrpc.y:241
+//line sunrpc.y:243
 		{
 			AddCase()
 		}
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sunrpc.y:242
+//line sunrpc.y:244
 		{
 			StartCase("default")
 		}
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sunrpc.y:242
+//line sunrpc.y:244
 		{
 			AddCase()
 		}
@@ -0,0 +1,156 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"errors"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// ErrKeepAliveTimeout is returned by KeepAliveErr when the connection was
+// closed because libvirt stopped responding to keepalive messages.
+var ErrKeepAliveTimeout = errors.New("libvirt stopped responding to keepalive messages")
+
+// SetKeepAlive starts sending keepalive messages to libvirt every interval. If
+// count messages in a row go unanswered the connection is closed, and
+// KeepAliveErr will return ErrKeepAliveTimeout. libvirtd closes connections
+// which don't answer its own keepalive messages, so this is needed to keep
+// long-lived, mostly idle connections open.
+//
+// The connection must already be established. If the server doesn't support
+// keepalive messages SetKeepAlive does nothing. An interval of 0 stops sending
+// keepalive messages.
+func (l *Libvirt) SetKeepAlive(interval time.Duration, count int) error {
+	r := l.root()
+	if interval > 0 && count < 1 {
+		return errors.New("keepalive count must be at least 1")
+	}
+
+	// Check for support before taking kmux: routeKeepAlive runs on the
+	// goroutine reading replies, so it mustn't wait for a call to complete.
+	supported := false
+	if interval > 0 {
+		res, err := l.ConnectSupportsFeature(constants.FeatureProgramKeepAlive)
+		if err != nil {
+			return err
+		}
+		supported = res != 0
+	}
+
+	r.kmux.Lock()
+	defer r.kmux.Unlock()
+
+	if r.kaStop != nil {
+		close(r.kaStop)
+		r.kaStop = nil
+	}
+	r.kaInterval, r.kaCount = 0, 0
+	r.kaPong.Store((chan struct{})(nil))
+	if !supported {
+		return nil
+	}
+
+	pong := make(chan struct{}, 1)
+	r.kaInterval, r.kaCount = interval, count
+	r.kaStop = make(chan struct{})
+	r.kaPong.Store(pong)
+	r.kaErr = nil
+	go r.keepAlive(interval, count, r.kaStop, pong, r.socket.Disconnected())
+
+	return nil
+}
+
+// KeepAliveErr returns ErrKeepAliveTimeout if the connection was closed because
+// libvirt stopped responding to keepalive messages, and nil otherwise.
+func (l *Libvirt) KeepAliveErr() error {
+	r := l.root()
+	r.kmux.Lock()
+	defer r.kmux.Unlock()
+
+	return r.kaErr
+}
+
+// keepAlive sends a ping every interval until stop or disconnected is closed.
+// If count pings go unanswered, it closes the connection.
+func (l *Libvirt) keepAlive(interval time.Duration, count int,
+	stop <-chan struct{}, pong <-chan struct{}, disconnected <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	missed := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-disconnected:
+			return
+		case <-pong:
+			missed = 0
+		case <-t.C:
+			if missed >= count {
+				l.kmux.Lock()
+				l.kaErr = ErrKeepAliveTimeout
+				l.kmux.Unlock()
+				l.socket.Disconnect()
+				return
+			}
+			missed++
+			l.sendKeepAlive(constants.KeepAliveProcPing)
+		}
+	}
+}
+
+// routeKeepAlive handles a keepalive message from libvirt. Libvirt may send us
+// pings as well as answering ours.
+func (l *Libvirt) routeKeepAlive(h *socket.Header) {
+	switch h.Procedure {
+	case constants.KeepAliveProcPing:
+		l.sendKeepAlive(constants.KeepAliveProcPong)
+	case constants.KeepAliveProcPong:
+		if pong, _ := l.kaPong.Load().(chan struct{}); pong != nil {
+			select {
+			case pong <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// sendKeepAlive sends a keepalive message. Errors are ignored; if the
+// connection has failed it will be noticed elsewhere.
+func (l *Libvirt) sendKeepAlive(proc uint32) {
+	l.socket.SendPacket(0, proc, constants.KeepAliveProgram, nil, socket.Message,
+		socket.StatusOK)
+}
+
+// IsAlive checks the connection by making a cheap call to libvirt,
+// ConnectGetLibVersion, and reports whether libvirt answered. If the call
+// fails because libvirt returned an error, the daemon is still responsive, so
+// IsAlive returns true along with the Error. Any other failure, such as
+// ErrConnectionClosed or an error from the transport, means the connection is
+// dead, and IsAlive returns false with that error. Use WithContext or
+// SetTimeout to limit how long it waits for an answer, in which case a
+// timeout also counts as the connection being dead.
+func (l *Libvirt) IsAlive() (bool, error) {
+	_, err := l.ConnectGetLibVersion()
+	if err == nil {
+		return true, nil
+	}
+	var lerr Error
+	return errors.As(err, &lerr), err
+}
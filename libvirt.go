@@ -23,9 +23,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -40,6 +40,11 @@ import (
 // are unsupported by either QEMU or libvirt.
 var ErrEventsNotSupported = errors.New("event monitor is not supported")
 
+// ErrConnectionClosed is returned by calls made when the client isn't
+// connected, or which were waiting for a reply when the connection was closed
+// or lost.
+var ErrConnectionClosed = errors.New("connection to libvirt is closed")
+
 // ConnectURI defines a type for driver URIs for libvirt
 // the defined constants are *not* exhaustive as there are also options
 // e.g. to connect remote via SSH
@@ -60,7 +65,26 @@ const (
 	disconnectTimeout = 5 * time.Second
 )
 
+// drainTimeout is how long Disconnect waits for calls in flight to complete
+// before closing the connection. It's a variable so tests can shorten it.
+var drainTimeout = 5 * time.Second
+
+// connState is the state of the client's connection to libvirt.
+type connState int
+
+const (
+	stateClosed connState = iota
+	stateOpen
+	// stateClosing is set while Disconnect waits for calls in flight to
+	// complete.
+	stateClosing
+)
+
 // Libvirt implements libvirt's remote procedure call protocol.
+//
+// It is safe for concurrent use. Calls made from multiple goroutines share the
+// connection: each is sent as soon as it's made, and the replies, which libvirt
+// may send in any order, are matched to their calls by serial number.
 type Libvirt struct {
 	// socket connection
 	socket *socket.Socket
@@ -71,13 +95,78 @@ type Libvirt struct {
 	// method callbacks
 	cmux      sync.RWMutex
 	callbacks map[int32]chan response
+	// errHandler is told about error packets without a caller, see
+	// SetErrorHandler.
+	errHandler func(error)
+	// partial holds the packets received so far of replies split over
+	// several packets, by serial, see assemble.
+	partial map[int32][]byte
 
 	// event listeners
 	emux   sync.RWMutex
 	events map[int32]*event.Stream
+	// events received before their stream was added, see stream().
+	pending []event.Event
 
 	// next request serial number
 	s int32
+
+	// connection state. calls is the number of calls in flight, and drained is
+	// closed once it drops to zero while closing.
+	smux    sync.Mutex
+	state   connState
+	calls   int
+	drained chan struct{}
+
+	// timeout is how long calls wait for a reply, see SetTimeout. Accessed
+	// atomically.
+	timeout int64
+	// maxDecode caps the lengths decoded from replies, see SetMaxDecodeSize.
+	// On the handles returned by WithMaxDecodeSize it overrides the cap of
+	// the parent. Accessed atomically.
+	maxDecode uint32
+
+	// features and procedures the daemon is known to support or not, and its
+	// version, see SupportsFeature and SupportsProc.
+	fmux        sync.Mutex
+	features    map[Feature]bool
+	unsupported map[procKey]bool
+	version     VersionNumber
+
+	// sasl authenticates with libvirt when it requires SASL authentication.
+	sasl SASLClient
+
+	// event registrations, used to resume event streams after reconnecting.
+	registers map[int32]registerFunc
+
+	// keepalive state. kaPong holds the chan struct{} told about pongs; it's
+	// read without kmux by routeKeepAlive, which runs on the goroutine reading
+	// replies and mustn't block.
+	kmux       sync.Mutex
+	kaStop     chan struct{}
+	kaPong     atomic.Value
+	kaErr      error
+	kaInterval time.Duration
+	kaCount    int
+
+	// reconnect state, see EnableReconnect. next is closed once the connection
+	// following the current one is ready, or the client gives up reconnecting.
+	rmux         sync.Mutex
+	backoff      *Backoff
+	uri          ConnectURI
+	next         chan struct{}
+	abort        chan struct{}
+	closing      bool
+	reconnecting bool
+
+	// parent and ctx are set on the handles returned by WithContext, and
+	// parent on those returned by WithMaxDecodeSize. Requests made through
+	// such a handle use the parent's connection, and are bound to ctx.
+	parent *Libvirt
+	ctx    context.Context
+	// internal is set on the handle used to reconnect, whose requests mustn't
+	// wait for the reconnection to complete.
+	internal bool
 }
 
 // DomainEvent represents a libvirt domain event.
@@ -96,6 +185,12 @@ func (de DomainEvent) GetCallbackID() int32 {
 	return de.CallbackID
 }
 
+// Time returns when QEMU sent the event, from the timestamp it gives each
+// event. It's the time on the libvirt host.
+func (de DomainEvent) Time() time.Time {
+	return time.Unix(int64(de.Seconds), int64(de.Microseconds)*int64(time.Microsecond))
+}
+
 // GetCallbackID returns the callback ID of a libvirt lifecycle event.
 func (m DomainEventCallbackLifecycleMsg) GetCallbackID() int32 {
 	return m.CallbackID
@@ -132,6 +227,14 @@ func (l *Libvirt) authenticate() error {
 			if err != nil {
 				return err
 			}
+		case constants.AuthSasl:
+			c := l.root().sasl
+			if c == nil {
+				return ErrSASLClientRequired
+			}
+			if err := l.authenticateSASL(c); err != nil {
+				return err
+			}
 		default:
 			continue
 		}
@@ -156,6 +259,8 @@ func (l *Libvirt) initLibvirtComms(uri ConnectURI) error {
 		return err
 	}
 
+	l.root().resetSupport()
+
 	err = l.authenticate()
 	if err != nil {
 		return err
@@ -174,19 +279,31 @@ func (l *Libvirt) initLibvirtComms(uri ConnectURI) error {
 // Since the connection can be lost, the Disconnected function can be used
 // to monitor for a lost connection.
 func (l *Libvirt) ConnectToURI(uri ConnectURI) error {
-	err := l.socket.Connect()
+	r := l.root()
+	err := r.socket.Connect()
 	if err != nil {
 		return err
 	}
 
+	r.setState(stateOpen)
 	err = l.initLibvirtComms(uri)
 	if err != nil {
-		l.socket.Disconnect()
+		r.setState(stateClosed)
+		r.socket.Disconnect()
 		return err
 	}
 
-	l.disconnected = make(chan struct{})
-	go l.waitAndDisconnect()
+	r.rmux.Lock()
+	r.uri = uri
+	r.closing = false
+	r.abort = make(chan struct{})
+	if r.backoff != nil && r.next == nil {
+		r.next = make(chan struct{})
+	}
+	r.rmux.Unlock()
+
+	r.disconnected = make(chan struct{})
+	go r.waitAndDisconnect()
 
 	return nil
 }
@@ -199,20 +316,44 @@ func (l *Libvirt) Connect() error {
 	return l.ConnectToURI(QEMUSystem)
 }
 
+// Disconnected returns a channel which is closed once the connection to
+// libvirt has been lost or closed.
+func (l *Libvirt) Disconnected() <-chan struct{} {
+	return l.root().disconnected
+}
+
 // Disconnect shuts down communication with the libvirt server and closes the
 // underlying net.Conn.
+//
+// New calls fail with ErrConnectionClosed as soon as Disconnect is called.
+// Calls already in flight are given a few seconds to complete before the
+// connection is closed, after which any still waiting for a reply fail with
+// ErrConnectionClosed. If Disconnect is called concurrently, the later calls
+// wait for the first to finish.
 func (l *Libvirt) Disconnect() error {
+	r := l.root()
+
 	// Ordering is important here. We want to make sure the connection is closed
 	// before unsubscribing and deregistering the events and requests, to
 	// prevent new requests from racing.
-	_, err := l.request(constants.ProcConnectClose, constants.Program, nil)
+	r.stopReconnect()
+	if r.drainCalls(drainTimeout) == stateClosing {
+		select {
+		case <-r.disconnected:
+		case <-time.After(disconnectTimeout):
+		}
+		return nil
+	}
+
+	h := &Libvirt{parent: r, internal: true}
+	_, err := h.request(constants.ProcConnectClose, constants.Program, nil)
 
 	// syscall.EINVAL is returned by the socket pkg when things have already
 	// been disconnected.
-	if err != nil && err != syscall.EINVAL {
+	if err != nil && err != syscall.EINVAL && err != ErrConnectionClosed {
 		return err
 	}
-	err = l.socket.Disconnect()
+	err = r.socket.Disconnect()
 	if err != nil {
 		return err
 	}
@@ -221,7 +362,7 @@ func (l *Libvirt) Disconnect() error {
 	// to happen once it returns.  Safeguard with a timeout.
 	// Things not fully cleaned up is better than a deadlock.
 	select {
-	case <-l.disconnected:
+	case <-r.disconnected:
 	case <-time.After(disconnectTimeout):
 	}
 
@@ -261,13 +402,12 @@ func (l *Libvirt) SubscribeQEMUEvents(ctx context.Context, dom string) (<-chan D
 		return nil, err
 	}
 
-	callbackID, err := l.QEMUConnectDomainMonitorEventRegister([]Domain{d}, nil, 0)
+	stream, err := l.subscribe(constants.QEMUProgram, func(l *Libvirt) (int32, error) {
+		return l.QEMUConnectDomainMonitorEventRegister([]Domain{d}, nil, 0)
+	}, false)
 	if err != nil {
 		return nil, err
 	}
-
-	stream := event.NewStream(constants.QEMUProgram, callbackID)
-	l.addStream(stream)
 	ch := make(chan DomainEvent)
 	go func() {
 		ctx, cancel := context.WithCancel(ctx)
@@ -282,7 +422,11 @@ func (l *Libvirt) SubscribeQEMUEvents(ctx context.Context, dom string) (<-chan D
 				if !ok {
 					return
 				}
-				ch <- *ev.(*DomainEvent)
+				select {
+				case ch <- *ev.(*DomainEvent):
+				case <-ctx.Done():
+					return
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -294,8 +438,12 @@ func (l *Libvirt) SubscribeQEMUEvents(ctx context.Context, dom string) (<-chan D
 
 // unsubscribeQEMUEvents stops the flow of events from QEMU through libvirt.
 func (l *Libvirt) unsubscribeQEMUEvents(stream *event.Stream) error {
-	err := l.QEMUConnectDomainMonitorEventDeregister(stream.CallbackID)
-	l.removeStream(stream.CallbackID)
+	// Deregister using the root handle, since the handle the subscription was
+	// made with may be bound to the context which was just cancelled.
+	r := l.root()
+	id := r.streamID(stream)
+	err := r.QEMUConnectDomainMonitorEventDeregister(id)
+	r.removeStream(id)
 
 	return err
 }
@@ -304,18 +452,18 @@ func (l *Libvirt) unsubscribeQEMUEvents(stream *event.Stream) error {
 // supported by libvirt. The events will continue to be streamed until the
 // caller cancels the provided context. After canceling the context, callers
 // should wait until the channel is closed to be sure they're collected all the
-// events.
+// events. Events are sent as pointers to their message structs, as for
+// SubscribeDomainEventAny, which returns them typed.
 func (l *Libvirt) SubscribeEvents(ctx context.Context, eventID DomainEventID,
 	dom OptDomain) (<-chan interface{}, error) {
 
-	callbackID, err := l.ConnectDomainEventCallbackRegisterAny(int32(eventID), nil)
+	stream, err := l.subscribe(constants.Program, func(l *Libvirt) (int32, error) {
+		return l.ConnectDomainEventCallbackRegisterAny(int32(eventID), nil)
+	}, false)
 	if err != nil {
 		return nil, err
 	}
 
-	stream := event.NewStream(constants.QEMUProgram, callbackID)
-	l.addStream(stream)
-
 	ch := make(chan interface{})
 	go func() {
 		ctx, cancel := context.WithCancel(ctx)
@@ -330,7 +478,11 @@ func (l *Libvirt) SubscribeEvents(ctx context.Context, eventID DomainEventID,
 				if !ok {
 					return
 				}
-				ch <- ev
+				select {
+				case ch <- ev:
+				case <-ctx.Done():
+					return
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -345,27 +497,32 @@ func (l *Libvirt) SubscribeEvents(ctx context.Context, eventID DomainEventID,
 // and then removing the callback from the list used by the `Route` function. If
 // the deregister call fails, we'll return the error, but still remove the
 // callback from the list. That's ok; if any events arrive after this point, the
-// Route function will drop them when it finds no registered handler.
+// Route function will drop them when it finds no registered handler. As in
+// unsubscribeQEMUEvents, the root handle is used to deregister.
 func (l *Libvirt) unsubscribeEvents(stream *event.Stream) error {
-	err := l.ConnectDomainEventCallbackDeregisterAny(stream.CallbackID)
-	l.removeStream(stream.CallbackID)
+	r := l.root()
+	id := r.streamID(stream)
+	err := r.ConnectDomainEventCallbackDeregisterAny(id)
+	r.removeStream(id)
 
 	return err
 }
 
+// registerLifecycle registers for lifecycle events for all domains.
+func registerLifecycle(l *Libvirt) (int32, error) {
+	return l.ConnectDomainEventCallbackRegisterAny(int32(DomainEventIDLifecycle), nil)
+}
+
 // LifecycleEvents streams lifecycle events until the provided context is
 // cancelled. If a problem is encountered setting up the event monitor
 // connection, an error will be returned. Errors encountered during streaming
 // will cause the returned event channel to be closed.
 func (l *Libvirt) LifecycleEvents(ctx context.Context) (<-chan DomainEventLifecycleMsg, error) {
-	callbackID, err := l.ConnectDomainEventCallbackRegisterAny(int32(DomainEventIDLifecycle), nil)
+	stream, err := l.subscribe(constants.Program, registerLifecycle, false)
 	if err != nil {
 		return nil, err
 	}
 
-	stream := event.NewStream(constants.Program, callbackID)
-	l.addStream(stream)
-
 	ch := make(chan DomainEventLifecycleMsg)
 
 	go func() {
@@ -381,7 +538,86 @@ func (l *Libvirt) LifecycleEvents(ctx context.Context) (<-chan DomainEventLifecy
 				if !ok {
 					return
 				}
-				ch <- ev.(*DomainEventCallbackLifecycleMsg).Msg
+				select {
+				case ch <- ev.(*DomainEventCallbackLifecycleMsg).Msg:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// DomainLifecycleEvent is a domain lifecycle event, sent when a domain is
+// defined, started, stopped, suspended and so on.
+type DomainLifecycleEvent struct {
+	// Domain identifies the domain by name and UUID.
+	Domain Domain
+	// Event is what happened to the domain.
+	Event DomainEventType
+	// Detail gives the reason for the event. Its meaning depends on Event; for
+	// example a DomainEventStopped event has a DomainEventStoppedDetailType.
+	Detail int32
+	// Time is when the event happened, or when it was received; TimeSource
+	// says which.
+	Time time.Time
+	// TimeSource says where Time came from. libvirt's lifecycle events don't
+	// carry a time, so it's EventTimeReceived: when the client received the
+	// event.
+	TimeSource EventTimeSource
+}
+
+// EventTimeSource says where the time of an event came from.
+type EventTimeSource int
+
+const (
+	// EventTimeReceived is the time the client received the event, which is
+	// shortly after libvirt sent it.
+	EventTimeReceived EventTimeSource = iota
+	// EventTimeServer is the time the libvirt host gave the event.
+	EventTimeServer
+)
+
+// SubscribeDomainLifecycle streams lifecycle events for all domains until the
+// provided context is cancelled, after which libvirt is asked to stop sending
+// them and the returned channel is closed. If a problem is encountered
+// registering for events, an error will be returned.
+func (l *Libvirt) SubscribeDomainLifecycle(ctx context.Context) (<-chan DomainLifecycleEvent, error) {
+	stream, err := l.subscribe(constants.Program, registerLifecycle, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan DomainLifecycleEvent)
+
+	go func() {
+		defer l.unsubscribeEvents(stream)
+		defer stream.Shutdown()
+		defer close(ch)
+
+		for {
+			select {
+			case ev, ok := <-stream.Recv():
+				if !ok {
+					return
+				}
+				received := ev.(event.Received)
+				msg := received.Event.(*DomainEventCallbackLifecycleMsg).Msg
+				select {
+				case ch <- DomainLifecycleEvent{
+					Domain:     msg.Dom,
+					Event:      DomainEventType(msg.Event),
+					Detail:     msg.Detail,
+					Time:       received.Time,
+					TimeSource: EventTimeReceived,
+				}:
+				case <-ctx.Done():
+					return
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -516,26 +752,26 @@ func (l *Libvirt) DefineXML(x []byte, flags DomainDefineFlags) error {
 
 // Version returns the version of the libvirt daemon.
 //
-// Deprecated: use ConnectGetLibVersion instead.
+// Deprecated: use ConnectGetLibVersion instead, along with VersionNumber.
 func (l *Libvirt) Version() (string, error) {
 	ver, err := l.ConnectGetLibVersion()
 	if err != nil {
 		return "", err
 	}
 
-	// The version is provided as an int following this formula:
-	// version * 1,000,000 + minor * 1000 + micro
-	// See src/libvirt-host.c # virConnectGetLibVersion
-	major := ver / 1000000
-	ver %= 1000000
-	minor := ver / 1000
-	ver %= 1000
-	micro := ver
-
-	versionString := fmt.Sprintf("%d.%d.%d", major, minor, micro)
-	return versionString, nil
+	return VersionNumber(ver).String(), nil
 }
 
+// DomainShutdownFlags and DomainRebootFlags choose the mechanisms
+// DomainShutdownFlags and DomainReboot may use to ask the guest to shut down,
+// such as DomainShutdownAcpiPowerBtn or DomainRebootGuestAgent. The hypervisor
+// tries those given in an order of its choosing, and with none picks the one it
+// considers best.
+type (
+	DomainShutdownFlags = DomainShutdownFlagValues
+	DomainRebootFlags   = DomainRebootFlagValues
+)
+
 // Shutdown shuts down a domain. Note that the guest OS may ignore the request.
 // If flags is set to 0 then the hypervisor will choose the method of shutdown it considers best.
 //
@@ -676,6 +912,18 @@ func (l *Libvirt) waitAndDisconnect() {
 	// wait for the socket to indicate if/when it's been disconnected
 	<-l.socket.Disconnected()
 
+	// If reconnecting is enabled, calls waiting for a reply on the old
+	// connection fail, but event streams are kept.
+	for {
+		l.deregisterAll()
+		if !l.reconnect() {
+			break
+		}
+		<-l.socket.Disconnected()
+	}
+
+	l.setState(stateClosed)
+
 	// close event streams
 	l.removeAllStreams()
 
@@ -686,6 +934,163 @@ func (l *Libvirt) waitAndDisconnect() {
 	close(l.disconnected)
 }
 
+// WithContext returns a handle to the same libvirt connection as l whose RPC
+// calls are bound to ctx. If ctx is cancelled, or its deadline passes, while a
+// call is waiting for libvirt to reply, the call is abandoned and returns
+// ctx.Err(). The handle shares its connection, callbacks and event streams with
+// l, so closing either one closes both.
+func (l *Libvirt) WithContext(ctx context.Context) *Libvirt {
+	if ctx == nil {
+		panic("nil context")
+	}
+	return &Libvirt{parent: l.root(), ctx: ctx, maxDecode: l.decodeOverride()}
+}
+
+// SetTimeout sets how long RPC calls wait for libvirt to reply before giving
+// up and returning ErrTimeout. A timeout of 0, the default, waits indefinitely.
+// The timeout applies to every call made on the connection, including through
+// handles returned by WithContext, and covers the call's reply only; data sent
+// on a stream afterwards isn't subject to it.
+//
+// A call which times out is abandoned and its reply discarded if it arrives
+// later, but the connection is kept open. Use SetKeepAlive to detect a
+// connection which has stopped responding altogether.
+func (l *Libvirt) SetTimeout(d time.Duration) {
+	atomic.StoreInt64(&l.root().timeout, int64(d))
+}
+
+// SetMaxDecodeSize sets the largest string, opaque data or array length
+// accepted when decoding a reply. Replies with longer items fail to decode,
+// rather than the client trying to allocate space for them. The default, also
+// restored by passing 0, is the largest limit the remote protocol places on any
+// item, REMOTE_CPUMAPS_MAX; it only needs raising to talk to a libvirt built
+// with larger limits. The limit applies to every call made on the connection,
+// except through handles returned by WithMaxDecodeSize, which set their own.
+func (l *Libvirt) SetMaxDecodeSize(n uint32) {
+	atomic.StoreUint32(&l.root().maxDecode, n)
+}
+
+// WithMaxDecodeSize returns a handle to the same libvirt connection as l
+// whose RPC calls decode replies with a limit of n, in place of the one set by
+// SetMaxDecodeSize, for the few calls whose replies may be larger. Passing 0
+// uses the connection's limit. Like the handles returned by WithContext, it
+// shares everything else with l, including its context.
+func (l *Libvirt) WithMaxDecodeSize(n uint32) *Libvirt {
+	return &Libvirt{parent: l.root(), ctx: l.ctx, maxDecode: n}
+}
+
+// decodeOverride returns the limit set by WithMaxDecodeSize on a handle, or 0.
+func (l *Libvirt) decodeOverride() uint32 {
+	if l.parent == nil {
+		return 0
+	}
+	return l.maxDecode
+}
+
+// maxDecodeSize returns the limit set by WithMaxDecodeSize, or else by
+// SetMaxDecodeSize.
+func (l *Libvirt) maxDecodeSize() uint {
+	if n := l.decodeOverride(); n != 0 {
+		return uint(n)
+	}
+	if n := atomic.LoadUint32(&l.root().maxDecode); n != 0 {
+		return uint(n)
+	}
+	return uint(constants.MaxLimit)
+}
+
+// replyTimeout returns a channel which fires once the timeout set by
+// SetTimeout has passed, and a function to stop it. The channel is nil if no
+// timeout is set.
+func (l *Libvirt) replyTimeout() (<-chan time.Time, func()) {
+	d := time.Duration(atomic.LoadInt64(&l.root().timeout))
+	if d <= 0 {
+		return nil, func() {}
+	}
+	t := time.NewTimer(d)
+	return t.C, func() { t.Stop() }
+}
+
+// setState sets the state of the connection.
+func (l *Libvirt) setState(s connState) {
+	l.smux.Lock()
+	defer l.smux.Unlock()
+
+	l.state = s
+}
+
+// beginCall records the start of a call, failing if the connection isn't
+// open. Each successful call must be followed by a call to endCall.
+func (l *Libvirt) beginCall() error {
+	l.smux.Lock()
+	defer l.smux.Unlock()
+
+	if l.state != stateOpen {
+		return ErrConnectionClosed
+	}
+	l.calls++
+	return nil
+}
+
+// endCall records the end of a call.
+func (l *Libvirt) endCall() {
+	l.smux.Lock()
+	defer l.smux.Unlock()
+
+	l.calls--
+	if l.calls == 0 && l.drained != nil {
+		close(l.drained)
+		l.drained = nil
+	}
+}
+
+// drainCalls stops new calls being made, and waits up to timeout for the
+// calls in flight to complete. It returns the state of the connection before
+// it was called, and only waits if that was stateOpen.
+func (l *Libvirt) drainCalls(timeout time.Duration) connState {
+	l.smux.Lock()
+	prev := l.state
+	if prev != stateOpen {
+		l.smux.Unlock()
+		return prev
+	}
+	l.state = stateClosing
+	var drained <-chan struct{}
+	if l.calls > 0 {
+		l.drained = make(chan struct{})
+		drained = l.drained
+	}
+	l.smux.Unlock()
+
+	if drained != nil {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		select {
+		case <-drained:
+		case <-t.C:
+		}
+	}
+	return prev
+}
+
+// root returns the Libvirt which owns the connection state. For handles
+// returned by WithContext and WithMaxDecodeSize, this is the Libvirt they were
+// created from.
+func (l *Libvirt) root() *Libvirt {
+	if l.parent != nil {
+		return l.parent
+	}
+	return l
+}
+
+// requestContext returns the context RPC calls made through l are bound to.
+func (l *Libvirt) requestContext() context.Context {
+	if l.ctx == nil {
+		return context.Background()
+	}
+	return l.ctx
+}
+
 // NewWithDialer configures a new Libvirt object that can be used to perform
 // RPCs via libvirt's socket.  The actual connection will not be established
 // until Connect is called.  The same Libvirt object may be used to re-connect
@@ -696,6 +1101,7 @@ func NewWithDialer(dialer socket.Dialer) *Libvirt {
 		disconnected: make(chan struct{}),
 		callbacks:    make(map[int32]chan response),
 		events:       make(map[int32]*event.Stream),
+		registers:    make(map[int32]registerFunc),
 	}
 
 	l.socket = socket.New(dialer, l)
@@ -15,14 +15,22 @@
 package libvirt
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
 	"github.com/digitalocean/go-libvirt/libvirttest"
+	"github.com/digitalocean/go-libvirt/socket"
 )
 
 func TestDeprecatedConnectAndDisconnect(t *testing.T) {
@@ -59,6 +67,281 @@ func TestConnectAndDisconnect(t *testing.T) {
 	}
 }
 
+func TestWithContext(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The mock never replies to this procedure, so the call can only return
+	// once the context times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := l.WithContext(ctx).ConnectGetHostname()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	l.cmux.RLock()
+	pending := len(l.callbacks)
+	l.cmux.RUnlock()
+	if pending != 0 {
+		t.Errorf("expected no pending callbacks, got %d", pending)
+	}
+}
+
+func TestSetTimeout(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	l.SetTimeout(50 * time.Millisecond)
+
+	// The mock never replies to this procedure.
+	if _, err := l.ConnectGetHostname(); err != ErrTimeout {
+		t.Fatalf("expected %v, got %v", ErrTimeout, err)
+	}
+
+	// The connection is still usable once a call has timed out.
+	if _, err := l.ConnectGetLibVersion(); err != nil {
+		t.Fatalf("unexpected error after timeout: %v", err)
+	}
+
+	l.cmux.RLock()
+	pending := len(l.callbacks)
+	l.cmux.RUnlock()
+	if pending != 0 {
+		t.Errorf("expected no pending callbacks, got %d", pending)
+	}
+}
+
+func TestSetMaxDecodeSize(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&ConnectGetHostnameRet{Hostname: "hostname"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectGetHostname, payload)
+	dialer.QueueReply(constants.Program, constants.ProcConnectGetHostname, payload)
+
+	l.SetMaxDecodeSize(4)
+	if _, err := l.ConnectGetHostname(); err == nil {
+		t.Error("expected a hostname longer than the limit to fail to decode")
+	}
+
+	l.SetMaxDecodeSize(0)
+	if h, err := l.ConnectGetHostname(); err != nil || h != "hostname" {
+		t.Errorf("expected hostname, got %q, error %v", h, err)
+	}
+
+	// The default allows the largest item in the protocol, a cpumap list,
+	// which is longer than REMOTE_STRING_MAX.
+	cpumaps := make([]byte, constants.CpumapsMax)
+	payload, err = encode(&DomainGetVcpusRet{Cpumaps: cpumaps})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetVcpus, payload)
+	if _, rc, err := l.DomainGetVcpus(Domain{}, 0, 0); err != nil || len(rc) != len(cpumaps) {
+		t.Errorf("expected %d bytes of cpumaps, got %d, error %v", len(cpumaps), len(rc), err)
+	}
+}
+
+func TestWithMaxDecodeSize(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&ConnectGetHostnameRet{Hostname: "hostname"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		dialer.QueueReply(constants.Program, constants.ProcConnectGetHostname, payload)
+	}
+
+	// The handle's limit overrides the connection's in either direction,
+	// and is kept by the handles made from it.
+	l.SetMaxDecodeSize(4)
+	large := l.WithMaxDecodeSize(64)
+	if h, err := large.ConnectGetHostname(); err != nil || h != "hostname" {
+		t.Errorf("expected hostname, got %q, error %v", h, err)
+	}
+	if h, err := large.WithContext(context.Background()).ConnectGetHostname(); err != nil || h != "hostname" {
+		t.Errorf("expected hostname through a context handle, got %q, error %v", h, err)
+	}
+	if _, err := l.ConnectGetHostname(); err == nil {
+		t.Error("expected the connection's limit to still apply")
+	}
+
+	l.SetMaxDecodeSize(0)
+	if _, err := l.WithMaxDecodeSize(4).ConnectGetHostname(); err == nil {
+		t.Error("expected a hostname longer than the handle's limit to fail to decode")
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	if err := l.SetKeepAlive(10*time.Millisecond, 2); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if err := l.KeepAliveErr(); err != nil {
+		t.Fatalf("unexpected keepalive error: %v", err)
+	}
+	if _, err := l.Version(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetKeepAlive(0, 0); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetKeepAliveWhilePinging(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	// Pongs for pings already in flight arrive while SetKeepAlive is checking
+	// whether libvirt supports keepalives, and mustn't hold up its reply.
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 100; i++ {
+			if err := l.SetKeepAlive(time.Millisecond, 100); err != nil {
+				done <- err
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+		done <- l.SetKeepAlive(0, 0)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out setting keepalives")
+	}
+}
+
+func TestKeepAliveTimeout(t *testing.T) {
+	dialer := libvirttest.New()
+	dialer.IgnoreKeepAlive = true
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.SetKeepAlive(10*time.Millisecond, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-l.Disconnected():
+	case <-time.After(2 * time.Second):
+		t.Fatal("connection wasn't closed after missed keepalives")
+	}
+	if err := l.KeepAliveErr(); err != ErrKeepAliveTimeout {
+		t.Fatalf("expected %v, got %v", ErrKeepAliveTimeout, err)
+	}
+}
+
+func TestIsAlive(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+
+	if alive, err := l.IsAlive(); !alive || err != nil {
+		t.Errorf("expected a live connection, got %v, error %v", alive, err)
+	}
+
+	// An error from libvirt means the daemon is still answering.
+	dialer.QueueError(constants.Program, constants.ProcConnectGetLibVersion, testNoDomainError)
+	alive, err := l.IsAlive()
+	if !alive || !IsNotFound(err) {
+		t.Errorf("expected a live connection and libvirt's error, got %v, error %v", alive, err)
+	}
+
+	if err := l.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+	if alive, err := l.IsAlive(); alive || err != ErrConnectionClosed {
+		t.Errorf("expected a dead connection, got %v, error %v", alive, err)
+	}
+}
+
+func TestReconnect(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	l.EnableReconnect(dialer, Backoff{
+		Initial: 10 * time.Millisecond,
+		Max:     100 * time.Millisecond,
+		Wait:    5 * time.Second,
+	})
+	if err := l.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer l.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := l.SubscribeDomainLifecycle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// drop the connection, as happens when libvirtd restarts
+	dialer.Test.Close()
+
+	if _, err := l.Version(); err != nil {
+		t.Fatalf("call after connection loss failed: %v", err)
+	}
+	select {
+	case <-l.Disconnected():
+		t.Fatal("client disconnected instead of reconnecting")
+	default:
+	}
+
+	// the subscription should have been registered again
+	dialer.Test.Write(testLifecycleEvent)
+	select {
+	case e := <-stream:
+		if e.Domain.Name != "test" {
+			t.Errorf("expected domain %q, got %q", "test", e.Domain.Name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event after reconnecting")
+	}
+}
+
 func TestLibvirt_ConnectToURI(t *testing.T) {
 	type args struct {
 		uri ConnectURI
@@ -125,6 +408,51 @@ func TestDisconnectCleanup(t *testing.T) {
 	}
 }
 
+func TestDisconnectPendingCalls(t *testing.T) {
+	defer func(d time.Duration) { drainTimeout = d }(drainTimeout)
+	drainTimeout = 50 * time.Millisecond
+
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+
+	// The mock never replies to this procedure, so the call is still waiting
+	// when the connection is closed.
+	errs := make(chan error)
+	go func() {
+		_, err := l.ConnectGetHostname()
+		errs <- err
+	}()
+	for {
+		l.smux.Lock()
+		calls := l.calls
+		l.smux.Unlock()
+		if calls > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := l.Disconnect(); err != nil {
+		t.Fatalf("disconnect failed: %v", err)
+	}
+
+	select {
+	case err := <-errs:
+		if err != ErrConnectionClosed {
+			t.Errorf("expected %v for the pending call, got %v", ErrConnectionClosed, err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("pending call didn't return")
+	}
+
+	if _, err := l.ConnectGetLibVersion(); err != ErrConnectionClosed {
+		t.Errorf("expected %v for a call after disconnecting, got %v", ErrConnectionClosed, err)
+	}
+}
+
 func TestLostConnectionCleanup(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
@@ -183,6 +511,46 @@ func TestMigrate(t *testing.T) {
 	}
 }
 
+func TestDomainMigrate3(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom, err := l.DomainLookupByName("test")
+	if err != nil {
+		t.Fatalf("failed to lookup domain: %v", err)
+	}
+
+	var params TypedParams
+	params.SetString(MigrateParamURI, "tcp://dest")
+	params.SetUllong(MigrateParamBandwidth, 100)
+
+	err = l.DomainMigrate3(dom, "qemu+tcp://dest/system", params,
+		MigrateLive|MigratePeer2peer)
+	if err != nil {
+		t.Fatalf("unexpected migration error: %v", err)
+	}
+
+	want, err := encode(&DomainMigratePerform3ParamsArgs{
+		Dom:      dom,
+		Dconnuri: OptString{"qemu+tcp://dest/system"},
+		Params:   params,
+		Flags:    MigrateLive | MigratePeer2peer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := dialer.Requests()
+	if r := reqs[len(reqs)-1]; !bytes.Equal(r.Payload, want) {
+		t.Errorf("unexpected request payload:\n%x\nwant:\n%x", r.Payload, want)
+	}
+}
+
 func TestMigrateSetMaxSpeed(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
@@ -203,6 +571,57 @@ func TestMigrateSetMaxSpeed(t *testing.T) {
 	}
 }
 
+func TestMigrateTuning(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test"}
+	queue := func(proc uint32, ret interface{}) {
+		var payload []byte
+		if ret != nil {
+			var err error
+			if payload, err = encode(ret); err != nil {
+				t.Fatal(err)
+			}
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcDomainMigrateSetMaxSpeed, nil)
+	queue(constants.ProcDomainMigrateGetMaxSpeed, &DomainMigrateGetMaxSpeedRet{Bandwidth: 50})
+	queue(constants.ProcDomainMigrateSetMaxDowntime, nil)
+	queue(constants.ProcDomainMigrateGetCompressionCache, &DomainMigrateGetCompressionCacheRet{CacheSize: 64 << 20})
+
+	if err := l.DomainMigrateSetMaxSpeed(dom, 50, DomainMigrateMaxSpeedPostcopy); err != nil {
+		t.Fatal(err)
+	}
+	if bw, err := l.DomainMigrateGetMaxSpeed(dom, DomainMigrateMaxSpeedPostcopy); err != nil || bw != 50 {
+		t.Errorf("expected a bandwidth of 50 MiB/s, got %v, error %v", bw, err)
+	}
+	if err := l.DomainMigrateSetMaxDowntime(dom, 300, 0); err != nil {
+		t.Fatal(err)
+	}
+	if size, err := l.DomainMigrateGetCompressionCache(dom, 0); err != nil || size != 64<<20 {
+		t.Errorf("expected a 64MiB compression cache, got %v, error %v", size, err)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainMigrateSetMaxSpeed {
+			continue
+		}
+		var args DomainMigrateSetMaxSpeedArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if args.Bandwidth != 50 || args.Flags != DomainMigrateMaxSpeedPostcopy {
+			t.Errorf("expected a postcopy bandwidth of 50, got %+v", args)
+		}
+	}
+}
+
 func TestDomainsWithDeprecatedNew(t *testing.T) {
 	dialer := libvirttest.New()
 	conn, err := dialer.Dial()
@@ -291,12 +710,515 @@ func TestDomainState(t *testing.T) {
 		t.Error(err)
 	}
 
-	if gotState != wantState {
-		t.Errorf("expected domain state %d, got %d", wantState, gotState)
+	if gotState != wantState {
+		t.Errorf("expected domain state %d, got %d", wantState, gotState)
+	}
+}
+
+func TestDomainMemoryStats(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	wantDomainMemoryStats := []DomainMemoryStat{
+		{
+			Tag: 6,
+			Val: 1048576,
+		},
+		{
+			Tag: 7,
+			Val: 91272,
+		},
+	}
+
+	d, err := l.lookup("test")
+	if err != nil {
+		t.Error(err)
+	}
+
+	gotDomainMemoryStats, err := l.DomainMemoryStats(d, 8, 0)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(gotDomainMemoryStats) == 0 {
+		t.Error("No memory stats returned!")
+	}
+
+	for i := range wantDomainMemoryStats {
+		if wantDomainMemoryStats[i] != gotDomainMemoryStats[i] {
+			t.Errorf("expected domain memory stat %v, got %v", wantDomainMemoryStats[i], gotDomainMemoryStats[i])
+		}
+	}
+}
+
+func TestDomainMemoryStatMap(t *testing.T) {
+	stats := []DomainMemoryStat{
+		{Tag: int32(DomainMemoryStatActualBalloon), Val: 1048576},
+		{Tag: int32(DomainMemoryStatRss), Val: 91272},
+	}
+
+	m := DomainMemoryStatMap(stats)
+	if len(m) != 2 {
+		t.Fatalf("expected 2 stats, got %d", len(m))
+	}
+	if m[DomainMemoryStatRss] != 91272 {
+		t.Errorf("expected rss %d, got %d", 91272, m[DomainMemoryStatRss])
+	}
+
+	if s := stats[1].StatTag().String(); s != "rss" {
+		t.Errorf("expected tag name %q, got %q", "rss", s)
+	}
+	if s := DomainMemoryStatTags(99).String(); s != "DomainMemoryStatTags(99)" {
+		t.Errorf("unexpected name for unknown tag: %q", s)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	done := make(chan error)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := l.SubscribeQEMUEvents(ctx, "test")
+	if err != nil {
+		t.Error(err)
+	}
+
+	go func() {
+		var e DomainEvent
+
+		select {
+		case e = <-stream:
+		case <-time.After(time.Second * 5):
+			done <- errors.New("expected event, received timeout")
+		}
+
+		result := struct {
+			Device string `json:"device"`
+			Len    int    `json:"len"`
+			Offset int    `json:"offset"`
+			Speed  int    `json:"speed"`
+			Type   string `json:"type"`
+		}{}
+
+		if err := json.Unmarshal(e.Details, &result); err != nil {
+			t.Error(err)
+		}
+
+		expected := "drive-ide0-0-0"
+		if result.Device != expected {
+			t.Errorf("expected device %q, got %q", expected, result.Device)
+		}
+
+		done <- nil
+	}()
+
+	// send an event to the listener goroutine
+	dialer.Test.Write(append(testEventHeader, testEvent...))
+
+	// wait for completion
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testLifecycleEvent is a domain lifecycle event for callback id 1.
+var testLifecycleEvent = []byte{
+	0x00, 0x00, 0x00, 0x44, // length
+	0x20, 0x00, 0x80, 0x86, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x01, 0x3e, // procedure
+	0x00, 0x00, 0x00, 0x02, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+
+	0x00, 0x00, 0x00, 0x01, // callback id
+
+	// domain name ("test")
+	0x00, 0x00, 0x00, 0x04, 0x74, 0x65, 0x73, 0x74,
+
+	// uuid (dc229f87d4de47198cfd2e21c6105b01)
+	0xdc, 0x22, 0x9f, 0x87, 0xd4, 0xde, 0x47, 0x19,
+	0x8c, 0xfd, 0x2e, 0x21, 0xc6, 0x10, 0x5b, 0x01,
+
+	0x00, 0x00, 0x00, 0x0e, // domain id (14)
+	0x00, 0x00, 0x00, 0x05, // event (stopped)
+	0x00, 0x00, 0x00, 0x01, // detail (destroyed)
+}
+
+func TestSubscribeDomainLifecycle(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	start := time.Now()
+
+	// The first event arrives before the subscription is set up, and must not
+	// be dropped.
+	dialer.Test.Write(testLifecycleEvent)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := l.SubscribeDomainLifecycle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dialer.Test.Write(testLifecycleEvent)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-stream:
+			if e.Domain.Name != "test" {
+				t.Errorf("expected domain %q, got %q", "test", e.Domain.Name)
+			}
+			if e.Event != DomainEventStopped {
+				t.Errorf("expected event %v, got %v", DomainEventStopped, e.Event)
+			}
+			if DomainEventStoppedDetailType(e.Detail) != DomainEventStoppedDestroyed {
+				t.Errorf("expected detail %v, got %v", DomainEventStoppedDestroyed, e.Detail)
+			}
+			if e.TimeSource != EventTimeReceived || e.Time.Before(start) || e.Time.After(time.Now()) {
+				t.Errorf("expected the time the event was received, got %v from %v", e.Time, e.TimeSource)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-stream:
+		if ok {
+			t.Error("expected stream to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for stream to close")
+	}
+}
+
+func TestLifecycleEventsDeregister(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	// libvirt picks the callback ID, which must be used to deregister.
+	payload, err := encode(&ConnectDomainEventCallbackRegisterAnyRet{CallbackID: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectDomainEventCallbackRegisterAny, payload)
+
+	// Subscribe through a handle bound to the context, and stop reading
+	// events before cancelling it.
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := l.WithContext(ctx).LifecycleEvents(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ev := append([]byte(nil), testLifecycleEvent...)
+	ev[31] = 7
+	dialer.Test.Write(ev)
+	dialer.Test.Write(ev)
+	cancel()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		for _, req := range dialer.Requests() {
+			if req.Header.Procedure != constants.ProcConnectDomainEventCallbackDeregisterAny {
+				continue
+			}
+			var args ConnectDomainEventCallbackDeregisterAnyArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(req.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.CallbackID != 7 {
+				t.Errorf("expected callback ID 7 to be deregistered, got %d", args.CallbackID)
+			}
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("timed out waiting for the callback to be deregistered")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestStorageVolDownloadStream(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	s, err := l.StorageVolDownloadStream(StorageVol{Pool: "default", Name: "test"}, 0, 0,
+		StorageVolDownloadSparseStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ioutil.ReadAll(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte("abc\x00\x00\x00def")
+	if !bytes.Equal(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if _, err := s.Read(make([]byte, 1)); err != ErrStreamClosed {
+		t.Errorf("expected %v reading a closed stream, got %v", ErrStreamClosed, err)
+	}
+}
+
+func TestStorageVolDownloadRecvSparse(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	s, err := l.StorageVolDownloadStream(StorageVol{Pool: "default", Name: "test"}, 0, 0,
+		StorageVolDownloadSparseStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := ioutil.TempFile("", "go-libvirt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	n, err := s.RecvSparse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 9 {
+		t.Errorf("expected 9 bytes, got %d", n)
+	}
+
+	got, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte("abc\x00\x00\x00def")
+	if !bytes.Equal(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRecvSparseTrailingHole(t *testing.T) {
+	hole, err := encode(&streamHole{Length: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Stream{c: make(chan response, 3)}
+	s.c <- response{Payload: []byte("ab"), Type: socket.Stream, Status: socket.StatusContinue}
+	s.c <- response{Payload: hole, Type: socket.StreamHole, Status: socket.StatusContinue}
+	s.c <- response{Type: socket.Stream, Status: socket.StatusOK}
+
+	f, err := ioutil.TempFile("", "go-libvirt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := s.RecvSparse(f); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The file is extended to cover the hole at its end.
+	if expected := []byte("ab\x00\x00\x00\x00\x00"); !bytes.Equal(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestStorageVolUploadSendSparse(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	s, err := l.StorageVolUploadStream(StorageVol{Pool: "default", Name: "test"}, 0, 0,
+		StorageVolUploadSparseStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A block of data, two blocks of zeros and a short block of data.
+	data := make([]byte, 3*sparseBlockSize+3)
+	copy(data, "abc")
+	copy(data[3*sparseBlockSize:], "def")
+
+	n, err := s.SendSparse(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(data)) {
+		t.Errorf("expected %d bytes, got %d", len(data), n)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcStorageVolUpload {
+			continue
+		}
+		switch r.Type {
+		case socket.Stream:
+			got = append(got, fmt.Sprintf("data %d", len(r.Payload)))
+		case socket.StreamHole:
+			var h streamHole
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &h); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, fmt.Sprintf("hole %d", h.Length))
+		}
+	}
+	// The last, empty, packet finishes the stream.
+	expected := []string{
+		fmt.Sprintf("data %d", sparseBlockSize),
+		fmt.Sprintf("hole %d", 2*sparseBlockSize),
+		"data 3",
+		"data 0",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected packets %v, got %v", expected, got)
+	}
+}
+
+func TestStreamAbortUnread(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	s, err := l.StorageVolDownloadStream(StorageVol{Pool: "default", Name: "test"}, 0, 0,
+		StorageVolDownloadSparseStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// libvirt's data is never read, so its packets are still waiting to be
+	// delivered when the stream is aborted.
+	done := make(chan error)
+	go func() { done <- s.Abort() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out aborting the stream")
+	}
+
+	// The connection must still be usable.
+	if _, err := l.ConnectGetLibVersion(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamConnectionLost(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	s, err := l.StorageVolUploadStream(StorageVol{Pool: "default", Name: "test"}, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Write([]byte("abc")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A read blocked waiting for libvirt must return once the connection is
+	// dropped mid-transfer.
+	done := make(chan error)
+	go func() {
+		_, err := s.Read(make([]byte, 1))
+		done <- err
+	}()
+	dialer.Test.Close()
+
+	select {
+	case err := <-done:
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("expected %v reading, got %v", io.ErrUnexpectedEOF, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the blocked read")
+	}
+
+	<-l.Disconnected()
+	if _, err := s.Write([]byte("def")); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v writing, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if err := s.WriteHole(3); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v writing a hole, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if err := s.Close(); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v closing, got %v", io.ErrUnexpectedEOF, err)
 	}
 }
 
-func TestDomainMemoryStats(t *testing.T) {
+func TestDomainOpenConsoleStream(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
 
@@ -306,39 +1228,54 @@ func TestDomainMemoryStats(t *testing.T) {
 	}
 	defer l.Disconnect()
 
-	wantDomainMemoryStats := []DomainMemoryStat{
-		{
-			Tag: 6,
-			Val: 1048576,
-		},
-		{
-			Tag: 7,
-			Val: 91272,
-		},
-	}
-
-	d, err := l.lookup("test")
+	var console io.ReadWriteCloser
+	console, err = l.DomainOpenConsoleStream(Domain{Name: "test"}, "serial0",
+		DomainConsoleForce|DomainConsoleSafe)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
 
-	gotDomainMemoryStats, err := l.DomainMemoryStats(d, 8, 0)
-	if err != nil {
-		t.Error(err)
+	// The mock echoes console input.
+	if _, err := io.WriteString(console, "uptime\n"); err != nil {
+		t.Fatal(err)
 	}
-
-	if len(gotDomainMemoryStats) == 0 {
-		t.Error("No memory stats returned!")
+	got := make([]byte, len("uptime\n"))
+	if _, err := io.ReadFull(console, got); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "uptime\n" {
+		t.Errorf("expected the input echoed, got %q", got)
+	}
+	if err := console.Close(); err != nil {
+		t.Fatal(err)
 	}
 
-	for i := range wantDomainMemoryStats {
-		if wantDomainMemoryStats[i] != gotDomainMemoryStats[i] {
-			t.Errorf("expected domain memory stat %v, got %v", wantDomainMemoryStats[i], gotDomainMemoryStats[i])
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainOpenConsole || r.Type != socket.Call {
+			continue
+		}
+		var args DomainOpenConsoleArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if len(args.DevName) != 1 || args.DevName[0] != "serial0" ||
+			args.Flags != uint32(DomainConsoleForce|DomainConsoleSafe) {
+			t.Errorf("unexpected arguments %+v", args)
 		}
 	}
 }
 
-func TestEvents(t *testing.T) {
+// testNoDomainError is the payload of an ERR_NO_DOMAIN error reply: code,
+// domain, message ("no domain"), level.
+var testNoDomainError = []byte{
+	0x00, 0x00, 0x00, 0x2a,
+	0x00, 0x00, 0x00, 0x0a,
+	0x00, 0x00, 0x00, 0x01,
+	0x00, 0x00, 0x00, 0x09, 'n', 'o', ' ', 'd', 'o', 'm', 'a', 'i', 'n', 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x02,
+}
+
+func TestMockRequests(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
 
@@ -348,50 +1285,57 @@ func TestEvents(t *testing.T) {
 	}
 	defer l.Disconnect()
 
-	done := make(chan error)
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	stream, err := l.SubscribeQEMUEvents(ctx, "test")
+	want := Domain{Name: "queued", ID: 7}
+	payload, err := encode(&want)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainLookupByName, payload)
 
-	go func() {
-		var e DomainEvent
-
-		select {
-		case e = <-stream:
-		case <-time.After(time.Second * 5):
-			done <- errors.New("expected event, received timeout")
-		}
-
-		result := struct {
-			Device string `json:"device"`
-			Len    int    `json:"len"`
-			Offset int    `json:"offset"`
-			Speed  int    `json:"speed"`
-			Type   string `json:"type"`
-		}{}
+	dialer.QueueError(constants.Program, constants.ProcDomainLookupByName, testNoDomainError)
 
-		if err := json.Unmarshal(e.Details, &result); err != nil {
-			t.Error(err)
-		}
+	tests := []struct {
+		name    string
+		wantDom Domain
+		check   func(error) bool
+	}{
+		{name: "queued reply", wantDom: want, check: func(err error) bool { return err == nil }},
+		{name: "queued error", check: IsNotFound},
+		{name: "built in reply", wantDom: Domain{Name: "test", ID: 14}, check: func(err error) bool { return err == nil }},
+	}
 
-		expected := "drive-ide0-0-0"
-		if result.Device != expected {
-			t.Errorf("expected device %q, got %q", expected, result.Device)
-		}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(dialer.Requests())
 
-		done <- nil
-	}()
+			d, err := l.DomainLookupByName("test")
+			if !tt.check(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Name != tt.wantDom.Name || d.ID != tt.wantDom.ID {
+				t.Errorf("expected domain %v, got %v", tt.wantDom, d)
+			}
 
-	// send an event to the listener goroutine
-	dialer.Test.Write(append(testEventHeader, testEvent...))
+			reqs := dialer.Requests()
+			if len(reqs) != before+1 {
+				t.Fatalf("expected 1 new request, got %d", len(reqs)-before)
+			}
+			r := reqs[len(reqs)-1]
+			if r.Program != constants.Program || r.Procedure != constants.ProcDomainLookupByName {
+				t.Errorf("unexpected program/procedure %#x/%d", r.Program, r.Procedure)
+			}
+			if r.Serial != reqs[len(reqs)-2].Serial+1 {
+				t.Errorf("expected serial to follow the last request's, got %d", r.Serial)
+			}
+			if !bytes.Equal(r.Payload, []byte{0x00, 0x00, 0x00, 0x04, 't', 'e', 's', 't'}) {
+				t.Errorf("unexpected payload %x", r.Payload)
+			}
+		})
+	}
 
-	// wait for completion
-	if err := <-done; err != nil {
-		t.Fatal(err)
+	got := libvirttest.ReplyPacket(constants.Program, constants.ProcDomainLookupByName, 3, 0, payload)
+	if len(got) != 28+len(payload) || got[23] != 3 {
+		t.Errorf("unexpected reply packet %x", got)
 	}
 }
 
@@ -635,6 +1579,30 @@ func TestVersion(t *testing.T) {
 	}
 }
 
+func TestConnectGetSysinfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	sysinfo := "<sysinfo type='smbios'><system><entry name='serial'>1234</entry></system></sysinfo>"
+	payload, err := encode(&ConnectGetSysinfoRet{Sysinfo: sysinfo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectGetSysinfo, payload)
+
+	got, err := l.ConnectGetSysinfo(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != sysinfo {
+		t.Errorf("expected sysinfo %q, got %q", sysinfo, got)
+	}
+}
+
 func TestDefineXML(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
@@ -666,10 +1634,103 @@ func TestDomainCreateWithFlags(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to lookup domain: %v", err)
 	}
-	var flags uint32
+	flags := DomainStartPaused | DomainStartAutodestroy
 	if _, err := l.DomainCreateWithFlags(d, flags); err != nil {
 		t.Fatalf("unexpected create error: %v", err)
 	}
+
+	reqs := dialer.Requests()
+	var args DomainCreateWithFlagsArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(reqs[len(reqs)-1].Payload), &args); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if args.Flags != flags {
+		t.Errorf("expected flags %v, got %v", flags, args.Flags)
+	}
+}
+
+func TestDomainVcpusFlags(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	d := Domain{Name: "test"}
+	dialer.QueueReply(constants.Program, constants.ProcDomainSetVcpusFlags, nil)
+	flags := DomainVCPUConfig | DomainVCPUMaximum
+	if err := l.DomainSetVcpusFlags(d, 4, flags); err != nil {
+		t.Fatalf("unexpected set vcpus error: %v", err)
+	}
+
+	ret, err := encode(&DomainGetVcpusFlagsRet{Num: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetVcpusFlags, ret)
+	n, err := l.DomainGetVcpusFlags(d, DomainVCPULive)
+	if err != nil {
+		t.Fatalf("unexpected get vcpus error: %v", err)
+	}
+	if n != 4 {
+		t.Errorf("expected 4 vcpus, got %d", n)
+	}
+
+	reqs := dialer.Requests()
+	var args DomainSetVcpusFlagsArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(reqs[len(reqs)-2].Payload), &args); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if args.Nvcpus != 4 || args.Flags != flags {
+		t.Errorf("expected 4 vcpus with flags %v, got %+v", flags, args)
+	}
+}
+
+func TestDomainDeviceFlags(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+
+	err := l.Connect()
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	d := Domain{Name: "test"}
+	xml := "<disk type='file' device='disk'/>"
+	flags := DomainDeviceModifyLive | DomainDeviceModifyConfig
+
+	calls := []struct {
+		proc uint32
+		call func() error
+	}{
+		{constants.ProcDomainAttachDeviceFlags, func() error { return l.DomainAttachDeviceFlags(d, xml, flags) }},
+		{constants.ProcDomainDetachDeviceFlags, func() error { return l.DomainDetachDeviceFlags(d, xml, flags) }},
+		{constants.ProcDomainUpdateDeviceFlags, func() error { return l.DomainUpdateDeviceFlags(d, xml, flags) }},
+	}
+	for _, c := range calls {
+		dialer.QueueReply(constants.Program, c.proc, nil)
+		if err := c.call(); err != nil {
+			t.Fatalf("procedure %d failed: %v", c.proc, err)
+		}
+
+		reqs := dialer.Requests()
+		r := reqs[len(reqs)-1]
+		if r.Procedure != c.proc {
+			t.Fatalf("expected procedure %d, got %d", c.proc, r.Procedure)
+		}
+		// The three calls share an argument layout.
+		var args DomainAttachDeviceFlagsArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatalf("unmarshal failed: %v", err)
+		}
+		if args.XML != xml || args.Flags != flags {
+			t.Errorf("expected %q with flags %v, got %+v", xml, flags, args)
+		}
+	}
 }
 
 func TestShutdown(t *testing.T) {
@@ -719,6 +1780,136 @@ func TestReset(t *testing.T) {
 	}
 }
 
+func TestDomainShutdownModes(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test"}
+	if err := l.DomainShutdownFlags(dom, DomainShutdownGuestAgent|DomainShutdownAcpiPowerBtn); err != nil {
+		t.Fatalf("unexpected shutdown error: %v", err)
+	}
+	if err := l.DomainReboot(dom, DomainRebootSignal); err != nil {
+		t.Fatalf("unexpected reboot error: %v", err)
+	}
+	if err := l.DomainReset(dom, 0); err != nil {
+		t.Fatalf("unexpected reset error: %v", err)
+	}
+
+	var shutdown, reboot bool
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcDomainShutdownFlags:
+			var args DomainShutdownFlagsArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if want := DomainShutdownGuestAgent | DomainShutdownAcpiPowerBtn; args.Flags != want {
+				t.Errorf("expected shutdown flags %v, got %v", want, args.Flags)
+			}
+			shutdown = true
+		case constants.ProcDomainReboot:
+			var args DomainRebootArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.Flags != DomainRebootSignal {
+				t.Errorf("expected reboot flags %v, got %v", DomainRebootSignal, args.Flags)
+			}
+			reboot = true
+		}
+	}
+	if !shutdown || !reboot {
+		t.Error("expected shutdown and reboot requests")
+	}
+}
+
+func TestDomainSaveFlags(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test"}
+	dialer.QueueReply(constants.Program, constants.ProcDomainSaveFlags, nil)
+	if err := l.DomainSaveFlags(dom, "/var/lib/libvirt/save/test.img", OptString{}, DomainSaveBypassCache|DomainSavePaused); err != nil {
+		t.Fatalf("unexpected save error: %v", err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainManagedSave, nil)
+	if err := l.DomainManagedSave(dom, DomainSaveRunning); err != nil {
+		t.Fatalf("unexpected managed save error: %v", err)
+	}
+	payload, err := encode(&DomainHasManagedSaveImageRet{Result: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainHasManagedSaveImage, payload)
+	if res, err := l.DomainHasManagedSaveImage(dom, 0); err != nil || res != 1 {
+		t.Errorf("expected a managed save image, got %v, error %v", res, err)
+	}
+
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcDomainSaveFlags:
+			var args DomainSaveFlagsArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if want := DomainSaveBypassCache | DomainSavePaused; args.Flags != want || args.To != "/var/lib/libvirt/save/test.img" {
+				t.Errorf("expected save to %q with flags %v, got %+v", "/var/lib/libvirt/save/test.img", want, args)
+			}
+		case constants.ProcDomainManagedSave:
+			var args DomainManagedSaveArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.Flags != DomainSaveRunning {
+				t.Errorf("expected managed save flags %v, got %v", DomainSaveRunning, args.Flags)
+			}
+		}
+	}
+}
+
+func TestDomainCoreDumpWithFormat(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainCoreDumpWithFormat, nil)
+	err := l.DomainCoreDumpWithFormat(Domain{Name: "test"}, "/var/crash/test.core",
+		DomainCoreDumpFormatKdumpZlib, DumpLive|DumpMemoryOnly)
+	if err != nil {
+		t.Fatalf("unexpected core dump error: %v", err)
+	}
+
+	var args DomainCoreDumpWithFormatArgs
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainCoreDumpWithFormat {
+			continue
+		}
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if args.To != "/var/crash/test.core" {
+		t.Errorf("expected a dump to %q, got %q", "/var/crash/test.core", args.To)
+	}
+	if args.Dumpformat != DomainCoreDumpFormatKdumpZlib {
+		t.Errorf("expected format %v, got %v", DomainCoreDumpFormatKdumpZlib, args.Dumpformat)
+	}
+	if want := DumpLive | DumpMemoryOnly; args.Flags != want {
+		t.Errorf("expected flags %v, got %v", want, args.Flags)
+	}
+}
+
 func TestSetBlockIOTune(t *testing.T) {
 	dialer := libvirttest.New()
 	l := NewWithDialer(dialer)
@@ -798,3 +1989,98 @@ func TestConnectGetAllDomainStats(t *testing.T) {
 		t.Fatalf("unexpected typed param value %v", stats[1].Params[1].Value)
 	}
 }
+
+func TestEnumString(t *testing.T) {
+	tests := []struct {
+		val  fmt.Stringer
+		want string
+	}{
+		{AuthPolkit, "AuthPolkit"},
+		{ProcConnectOpen, "ProcConnectOpen"},
+		{QEMUProcDomainMonitorEvent, "QEMUProcDomainMonitorEvent"},
+		{Procedure(-1), "Procedure(-1)"},
+		{DomainRunning, "DomainRunning"},
+		{DomainState(42), "DomainState(42)"},
+		{DomainBlockJobTypeCommit, "DomainBlockJobTypeCommit"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.val.String(); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestConnectSetIdentity(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var identity TypedParams
+	identity.SetString(ConnectIdentityUserName, "alice")
+	identity.SetUllong(ConnectIdentityUnixUserID, 1000)
+	identity.SetString(ConnectIdentitySelinuxContext, "user_u:user_r:user_t:s0")
+
+	dialer.QueueReply(constants.Program, constants.ProcConnectSetIdentity, nil)
+	if err := l.ConnectSetIdentity(identity, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcConnectSetIdentity {
+			continue
+		}
+		var args ConnectSetIdentityArgs
+		dec := xdr.NewDecoderCustomTypes(bytes.NewReader(r.Payload), 0, customTypes)
+		if _, err := dec.Decode(&args); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(TypedParams(args.Params), identity) {
+			t.Errorf("expected identity %v, got %v", identity, args.Params)
+		}
+	}
+}
+
+func TestOptString(t *testing.T) {
+	tests := []struct {
+		args ConnectOpenArgs
+		want []byte
+	}{
+		{
+			args: ConnectOpenArgs{Flags: 5},
+			want: []byte{
+				0x00, 0x00, 0x00, 0x00, // not present
+				0x00, 0x00, 0x00, 0x05, // flags
+			},
+		},
+		{
+			args: ConnectOpenArgs{Name: OptString{"test"}, Flags: 5},
+			want: []byte{
+				0x00, 0x00, 0x00, 0x01, // present
+				0x00, 0x00, 0x00, 0x04, 't', 'e', 's', 't',
+				0x00, 0x00, 0x00, 0x05, // flags
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		buf, err := encode(&tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf, tt.want) {
+			t.Errorf("expected %+v to encode as %x, got %x", tt.args, tt.want, buf)
+		}
+
+		var got ConnectOpenArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(buf), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.args) {
+			t.Errorf("expected %+v, got %+v", tt.args, got)
+		}
+	}
+}
@@ -18,11 +18,14 @@ package libvirttest
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"sync"
 	"sync/atomic"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/socket"
 )
 
 var testDomainResponse = []byte{
@@ -66,6 +69,27 @@ var testDeregisterEvent = []byte{
 	0x00, 0x00, 0x00, 0x00, // status
 }
 
+var testCallbackRegisterReply = []byte{
+	0x00, 0x00, 0x00, 0x20, // length
+	0x20, 0x00, 0x80, 0x86, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x01, 0x3c, // procedure
+	0x00, 0x00, 0x00, 0x01, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+	0x00, 0x00, 0x00, 0x01, // callback id
+}
+
+var testCallbackDeregisterReply = []byte{
+	0x00, 0x00, 0x00, 0x1c, // length
+	0x20, 0x00, 0x80, 0x86, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x01, 0x3d, // procedure
+	0x00, 0x00, 0x00, 0x01, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+}
+
 var testAuthReply = []byte{
 	0x00, 0x00, 0x00, 0x24, // length
 	0x20, 0x00, 0x80, 0x86, // program
@@ -241,6 +265,21 @@ var testDomainStateReply = []byte{
 	0x00, 0x00, 0x00, 0x01, // reason
 }
 
+var testDomainInfoReply = []byte{
+	0x00, 0x00, 0x00, 0x3c, // length
+	0x20, 0x00, 0x80, 0x86, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x00, 0x10, // procedure
+	0x00, 0x00, 0x00, 0x01, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+	0x00, 0x00, 0x00, 0x01, // state
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00, // max memory
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x00, 0x00, // memory
+	0x00, 0x00, 0x00, 0x02, // virtual cpus
+	0x00, 0x00, 0x00, 0x00, 0x3b, 0x9a, 0xca, 0x00, // cpu time
+}
+
 var testSecretsReply = []byte{
 	0x00, 0x00, 0x00, 0x40, // length
 	0x20, 0x00, 0x80, 0x86, // program
@@ -349,6 +388,27 @@ var testVersionReply = []byte{
 	0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x4d, 0xfc, // version (1003004)
 }
 
+var testSupportsFeatureReply = []byte{
+	0x00, 0x00, 0x00, 0x20, // length
+	0x20, 0x00, 0x80, 0x86, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x00, 0x3c, // procedure
+	0x00, 0x00, 0x00, 0x01, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+	0x00, 0x00, 0x00, 0x01, // supported
+}
+
+var testKeepAlivePong = []byte{
+	0x00, 0x00, 0x00, 0x1c, // length
+	0x6b, 0x65, 0x65, 0x70, // program
+	0x00, 0x00, 0x00, 0x01, // version
+	0x00, 0x00, 0x00, 0x02, // procedure
+	0x00, 0x00, 0x00, 0x02, // type
+	0x00, 0x00, 0x00, 0x00, // serial
+	0x00, 0x00, 0x00, 0x00, // status
+}
+
 var testDefineXML = []byte{
 	0x00, 0x00, 0x00, 0x38, // length
 	0x20, 0x00, 0x80, 0x86, // program
@@ -585,18 +645,106 @@ type MockLibvirt struct {
 	Test   net.Conn
 	Fail   bool
 	serial uint32
+
+	// IgnoreKeepAlive stops the mock from answering keepalive pings.
+	IgnoreKeepAlive bool
+
 	disconnected chan struct{}
+
+	// mu guards requests and replies
+	mu       sync.Mutex
+	requests []Request
+	replies  map[replyKey][]cannedReply
+}
+
+// Request is a packet the mock server received from the client.
+type Request struct {
+	socket.Header
+	Payload []byte
+}
+
+type replyKey struct {
+	program, procedure uint32
+}
+
+type cannedReply struct {
+	status  uint32
+	payload []byte
 }
 
 // New creates a new mock Libvirt server.
 func New() *MockLibvirt {
 	m := &MockLibvirt{
 		disconnected: make(chan struct{}),
+		replies:      make(map[replyKey][]cannedReply),
 	}
 	close(m.disconnected)
 	return m
 }
 
+// Requests returns the packets received by the mock server so far, in the
+// order they arrived.
+func (m *MockLibvirt) Requests() []Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Request(nil), m.requests...)
+}
+
+// QueueReply queues an XDR encoded reply payload for a procedure. The next
+// call to the procedure is answered with it, instead of the mock's built in
+// reply. Replies queued for the same procedure are used in order.
+func (m *MockLibvirt) QueueReply(program, procedure uint32, payload []byte) {
+	m.queue(program, procedure, cannedReply{socket.StatusOK, payload})
+}
+
+// QueueError queues an error reply for a procedure, like QueueReply. The
+// payload is an XDR encoded libvirt error.
+func (m *MockLibvirt) QueueError(program, procedure uint32, payload []byte) {
+	m.queue(program, procedure, cannedReply{socket.StatusError, payload})
+}
+
+func (m *MockLibvirt) queue(program, procedure uint32, r cannedReply) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k := replyKey{program, procedure}
+	m.replies[k] = append(m.replies[k], r)
+}
+
+// record saves a request, and returns the reply queued for it, if any.
+func (m *MockLibvirt) record(r Request) (cannedReply, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, r)
+	if r.Type != socket.Call {
+		return cannedReply{}, false
+	}
+
+	k := replyKey{r.Program, r.Procedure}
+	q := m.replies[k]
+	if len(q) == 0 {
+		return cannedReply{}, false
+	}
+	m.replies[k] = q[1:]
+	return q[0], true
+}
+
+// ReplyPacket builds a reply packet for a procedure, as libvirt would send in
+// answer to the call with the given serial. The payload is the XDR encoded
+// return value, or a libvirt error if status is an error status.
+func ReplyPacket(program, procedure uint32, serial int32, status uint32, payload []byte) []byte {
+	return packet(program, procedure, socket.Reply, uint32(serial), status, payload)
+}
+
+// EventPacket builds an event packet for a procedure, as libvirt sends for
+// the events a client has registered for. The payload is the XDR encoded event
+// message.
+func EventPacket(program, procedure uint32, payload []byte) []byte {
+	return packet(program, procedure, socket.Message, 0, socket.StatusOK, payload)
+}
+
 // Dial creates a pipe to use for the server and client
 func (m *MockLibvirt) Dial() (net.Conn, error) {
 	serv, conn := net.Pipe()
@@ -604,11 +752,12 @@ func (m *MockLibvirt) Dial() (net.Conn, error) {
 	m.client = conn
 	m.Test = serv
 	m.disconnected = make(chan struct{})
+	disconnected := m.disconnected
 
 	go func() {
 		m.handle(serv)
 		fmt.Println("libvirttest pipe closed")
-		close(m.disconnected)
+		close(disconnected)
 	}()
 
 	return m.client, nil
@@ -618,7 +767,18 @@ func (m *MockLibvirt) handle(conn net.Conn) {
 	for {
 		// packetLengthSize + headerSize
 		buf := make([]byte, 28)
-		conn.Read(buf)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+
+		length := binary.BigEndian.Uint32(buf[0:4])
+		var payload []byte
+		if length > 28 {
+			payload = make([]byte, length-28)
+			if _, err := io.ReadFull(conn, payload); err != nil {
+				return
+			}
+		}
 
 		// extract program
 		prog := binary.BigEndian.Uint32(buf[4:8])
@@ -626,11 +786,39 @@ func (m *MockLibvirt) handle(conn net.Conn) {
 		// extract procedure
 		proc := binary.BigEndian.Uint32(buf[12:16])
 
-		switch prog {
-		case constants.Program:
+		// extract type and status
+		typ := binary.BigEndian.Uint32(buf[16:20])
+		status := binary.BigEndian.Uint32(buf[24:28])
+
+		// replies carry the serial of the request they answer
+		serial := binary.BigEndian.Uint32(buf[20:24])
+		atomic.StoreUint32(&m.serial, serial)
+
+		r, ok := m.record(Request{
+			Header: socket.Header{
+				Program:   prog,
+				Version:   binary.BigEndian.Uint32(buf[8:12]),
+				Procedure: proc,
+				Type:      typ,
+				Serial:    int32(serial),
+				Status:    status,
+			},
+			Payload: payload,
+		})
+		if ok {
+			conn.Write(ReplyPacket(prog, proc, int32(serial), r.status, r.payload))
+			continue
+		}
+
+		switch {
+		case typ == socket.Stream || typ == socket.StreamHole:
+			m.handleStream(prog, proc, serial, status, payload, conn)
+		case prog == constants.Program:
 			m.handleRemote(proc, conn)
-		case constants.QEMUProgram:
+		case prog == constants.QEMUProgram:
 			m.handleQEMU(proc, conn)
+		case prog == constants.KeepAliveProgram:
+			m.handleKeepAlive(proc, conn)
 		}
 	}
 }
@@ -659,6 +847,8 @@ func (m *MockLibvirt) handleRemote(procedure uint32, conn net.Conn) {
 		conn.Write(m.reply(testSecretsReply))
 	case constants.ProcDomainGetState:
 		conn.Write(m.reply(testDomainStateReply))
+	case constants.ProcDomainGetInfo:
+		conn.Write(m.reply(testDomainInfoReply))
 	case constants.ProcDomainMemoryStats:
 		conn.Write(m.reply(testDomainMemoryStatsReply))
 	case constants.ProcDomainMigrateSetMaxSpeed:
@@ -685,8 +875,22 @@ func (m *MockLibvirt) handleRemote(procedure uint32, conn net.Conn) {
 		conn.Write(m.reply(testGetBlockIoTuneReply))
 	case constants.ProcConnectGetAllDomainStats:
 		conn.Write(m.reply(testGetAllDomainStatsReply))
+	case constants.ProcConnectSupportsFeature:
+		conn.Write(m.reply(testSupportsFeatureReply))
+	case constants.ProcStorageVolDownload:
+		m.sendDownload(procedure, conn)
+	case constants.ProcStorageVolUpload:
+		conn.Write(packet(constants.Program, procedure, socket.Reply,
+			atomic.LoadUint32(&m.serial), socket.StatusOK, nil))
+	case constants.ProcDomainOpenConsole:
+		conn.Write(packet(constants.Program, procedure, socket.Reply,
+			atomic.LoadUint32(&m.serial), socket.StatusOK, nil))
+	case constants.ProcConnectDomainEventCallbackRegisterAny:
+		conn.Write(m.reply(testCallbackRegisterReply))
+	case constants.ProcConnectDomainEventCallbackDeregisterAny:
+		conn.Write(m.reply(testCallbackDeregisterReply))
 	default:
-		fmt.Fprintln(os.Stderr, "unknown procedure", procedure)
+		fmt.Fprintln(os.Stderr, "unknown procedure", constants.ProcName(constants.Program, procedure))
 	}
 }
 
@@ -705,11 +909,58 @@ func (m *MockLibvirt) handleQEMU(procedure uint32, conn net.Conn) {
 	}
 }
 
+// sendDownload replies to a call which downloads a stream, and sends the
+// stream's data: "abc", a hole of 3 bytes, then "def".
+func (m *MockLibvirt) sendDownload(procedure uint32, conn net.Conn) {
+	serial := atomic.LoadUint32(&m.serial)
+	hole := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, // length
+		0x00, 0x00, 0x00, 0x00, // flags
+	}
+
+	conn.Write(packet(constants.Program, procedure, socket.Reply, serial, socket.StatusOK, nil))
+	conn.Write(packet(constants.Program, procedure, socket.Stream, serial, socket.StatusContinue, []byte("abc")))
+	conn.Write(packet(constants.Program, procedure, socket.StreamHole, serial, socket.StatusContinue, hole))
+	conn.Write(packet(constants.Program, procedure, socket.Stream, serial, socket.StatusContinue, []byte("def")))
+	conn.Write(packet(constants.Program, procedure, socket.Stream, serial, socket.StatusOK, nil))
+}
+
+// handleStream answers the client finishing a stream by confirming it has
+// finished. Data sent to a console is echoed back, as a terminal would;
+// otherwise data sent on a stream is discarded.
+func (m *MockLibvirt) handleStream(program, procedure, serial, status uint32, payload []byte, conn net.Conn) {
+	switch {
+	case status == socket.StatusOK:
+		conn.Write(packet(program, procedure, socket.Stream, serial, socket.StatusOK, nil))
+	case status == socket.StatusContinue && program == constants.Program &&
+		procedure == constants.ProcDomainOpenConsole:
+		conn.Write(packet(program, procedure, socket.Stream, serial, socket.StatusContinue, payload))
+	}
+}
+
+func (m *MockLibvirt) handleKeepAlive(procedure uint32, conn net.Conn) {
+	if procedure == constants.KeepAliveProcPing && !m.IgnoreKeepAlive {
+		conn.Write(testKeepAlivePong)
+	}
+}
+
+// packet builds a packet with the given header and payload.
+func packet(program, procedure, typ, serial, status uint32, payload []byte) []byte {
+	buf := make([]byte, 28, 28+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(28+len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], program)
+	binary.BigEndian.PutUint32(buf[8:12], constants.ProtocolVersion)
+	binary.BigEndian.PutUint32(buf[12:16], procedure)
+	binary.BigEndian.PutUint32(buf[16:20], typ)
+	binary.BigEndian.PutUint32(buf[20:24], serial)
+	binary.BigEndian.PutUint32(buf[24:28], status)
+	return append(buf, payload...)
+}
+
 // reply automatically injects the correct serial
 // number into the provided response buffer.
 func (m *MockLibvirt) reply(buf []byte) []byte {
-	atomic.AddUint32(&m.serial, 1)
-	binary.BigEndian.PutUint32(buf[20:24], m.serial)
+	binary.BigEndian.PutUint32(buf[20:24], atomic.LoadUint32(&m.serial))
 
 	return buf
 }
@@ -0,0 +1,124 @@
+// Copyright 2018 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//
+// Code generated by internal/lvgen/generate.go. DO NOT EDIT.
+//
+// To regenerate, run 'go generate' in internal/lvgen.
+//
+
+package libvirt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+)
+
+// References to prevent "imported and not used" errors.
+var (
+	_ = bytes.Buffer{}
+	_ = fmt.Sprintf
+	_ = io.Copy
+	_ = reflect.TypeOf
+	_ = constants.Program
+	_ = xdr.Unmarshal
+)
+
+//
+// Typedefs:
+//
+
+// Enums:
+//
+// LXCProcedure is libvirt's lxc_procedure
+type LXCProcedure int32
+
+// Enum values:
+//
+// LXCProcedure values.
+const (
+	// LXCProcDomainOpenNamespace is libvirt's LXC_PROC_DOMAIN_OPEN_NAMESPACE
+	LXCProcDomainOpenNamespace LXCProcedure = 1
+)
+
+// String returns the name of the LXCProcedure value.
+func (e LXCProcedure) String() string {
+	switch e {
+	case LXCProcDomainOpenNamespace:
+		return "LXCProcDomainOpenNamespace"
+	}
+	return fmt.Sprintf("LXCProcedure(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the LXCProcedure values.
+func (e LXCProcedure) IsValid() bool {
+	switch e {
+	case LXCProcDomainOpenNamespace:
+		return true
+	}
+	return false
+}
+
+// ParseLXCProcedure returns the LXCProcedure value with the given name, as returned
+// by String.
+func ParseLXCProcedure(s string) (LXCProcedure, error) {
+	switch s {
+	case "LXCProcDomainOpenNamespace":
+		return LXCProcDomainOpenNamespace, nil
+	}
+	return 0, fmt.Errorf("invalid LXCProcedure %q", s)
+}
+
+// Structs:
+//
+// LXCDomainOpenNamespaceArgs is libvirt's lxc_domain_open_namespace_args
+type LXCDomainOpenNamespaceArgs struct {
+	Dom   Domain
+	Flags uint32
+}
+
+// LXCDomainOpenNamespace is the go wrapper for LXC_PROC_DOMAIN_OPEN_NAMESPACE.
+func (l *Libvirt) LXCDomainOpenNamespace(Dom Domain, Flags uint32) (err error) {
+	var buf []byte
+
+	args := LXCDomainOpenNamespaceArgs{
+		Dom:   Dom,
+		Flags: Flags,
+	}
+
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
+	if err != nil {
+		return
+	}
+
+	_, err = l.requestStream(1, constants.LXCProgram, buf, nil, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// LXCProcedureTypes maps each lxc_procedure value to the method which calls it
+// and the types of its arguments and return values.
+var LXCProcedureTypes = map[uint32]ProcInfo{
+	constants.LXCProcDomainOpenNamespace: {Method: "LXCDomainOpenNamespace", Args: reflect.TypeOf(LXCDomainOpenNamespaceArgs{})},
+}
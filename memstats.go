@@ -0,0 +1,72 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "fmt"
+
+// StatTag returns the tag of a memory statistic returned by
+// DomainMemoryStats, which identifies what the value measures.
+//
+// Sizes (swap in and out, unused, available, actual balloon, RSS, usable and
+// disk caches) are in KiB. Faults and hugetlb page counts are counts since the
+// domain started, and last update is a timestamp in seconds since the epoch.
+func (s DomainMemoryStat) StatTag() DomainMemoryStatTags {
+	return DomainMemoryStatTags(s.Tag)
+}
+
+// String returns the name libvirt uses for a memory statistic, e.g.
+// "swap_in".
+func (t DomainMemoryStatTags) String() string {
+	switch t {
+	case DomainMemoryStatSwapIn:
+		return "swap_in"
+	case DomainMemoryStatSwapOut:
+		return "swap_out"
+	case DomainMemoryStatMajorFault:
+		return "major_fault"
+	case DomainMemoryStatMinorFault:
+		return "minor_fault"
+	case DomainMemoryStatUnused:
+		return "unused"
+	case DomainMemoryStatAvailable:
+		return "available"
+	case DomainMemoryStatActualBalloon:
+		return "actual"
+	case DomainMemoryStatRss:
+		return "rss"
+	case DomainMemoryStatUsable:
+		return "usable"
+	case DomainMemoryStatLastUpdate:
+		return "last_update"
+	case DomainMemoryStatDiskCaches:
+		return "disk_caches"
+	case DomainMemoryStatHugetlbPgalloc:
+		return "hugetlb_pgalloc"
+	case DomainMemoryStatHugetlbPgfail:
+		return "hugetlb_pgfail"
+	}
+	return fmt.Sprintf("DomainMemoryStatTags(%d)", int32(t))
+}
+
+// DomainMemoryStatMap converts the statistics returned by DomainMemoryStats
+// to a map from each statistic's tag to its value. See StatTag for the units
+// of each value.
+func DomainMemoryStatMap(stats []DomainMemoryStat) map[DomainMemoryStatTags]uint64 {
+	m := make(map[DomainMemoryStatTags]uint64, len(stats))
+	for _, s := range stats {
+		m[s.StatTag()] = s.Val
+	}
+	return m
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// DomainMigrate3 migrates a domain to another host, like libvirt's
+// virDomainMigrateToURI3. The migration is described by params, using names
+// such as MigrateParamURI, MigrateParamBandwidth and MigrateParamListenAddress.
+// To compress the migration, set MigrateCompressed in flags and add each
+// method to use, e.g. "mt" or "xbzrle", with AddString(MigrateParamCompression,
+// ...); their settings, such as MigrateParamCompressionMtLevel, are ordinary
+// parameters.
+//
+// With MigratePeer2peer in flags, the libvirt the client is connected to
+// manages the migration itself, and dconnuri is the URI of the destination
+// libvirt, e.g. "qemu+tls://dest.example.com/system". Without it, the
+// hypervisor migrates the domain directly to the MigrateParamURI given in
+// params, and dconnuri must be empty; not all hypervisors support this.
+//
+// A migration in progress can be tuned from another goroutine. Bandwidth is in
+// MiB/s both for MigrateParamBandwidth and DomainMigrateSetMaxSpeed, which
+// with DomainMigrateMaxSpeedPostcopy sets the bandwidth of the post-copy phase
+// instead; DomainMigrateGetMaxSpeed returns it. DomainMigrateSetMaxDowntime
+// sets the longest the domain may be paused at the end of the migration, in
+// milliseconds, and DomainMigrateSetCompressionCache the size of the cache
+// used by xbzrle compression, in bytes.
+func (l *Libvirt) DomainMigrate3(dom Domain, dconnuri string, params TypedParams,
+	flags DomainMigrateFlags) error {
+	var uri OptString
+	if dconnuri != "" {
+		uri = OptString{dconnuri}
+	}
+
+	// The cookie is only used by the multi-step migration protocol, which
+	// isn't needed when a single libvirt manages the migration.
+	_, err := l.DomainMigratePerform3Params(dom, uri, params, nil, flags)
+	return err
+}
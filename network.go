@@ -0,0 +1,36 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// Networks returns the virtual networks managed by libvirt, filtered by flags.
+// For example, ConnectListNetworksActive|ConnectListNetworksInactive lists
+// every network; 0 does the same. The generated network calls, such as
+// NetworkCreateXML, NetworkDestroy and NetworkGetXMLDesc, take the returned
+// handles.
+func (l *Libvirt) Networks(flags ConnectListAllNetworksFlags) ([]Network, error) {
+	// NeedResults asks for the networks themselves, not just their number.
+	nets, _, err := l.ConnectListAllNetworks(1, flags)
+	return nets, err
+}
+
+// Interfaces returns the host's network interfaces managed by libvirt, such as
+// bridges and bonds, filtered by flags; 0 lists them all. The generated
+// interface calls, such as InterfaceDefineXML, InterfaceCreate,
+// InterfaceDestroy and InterfaceGetXMLDesc, take the returned handles, which
+// carry each interface's name and MAC address.
+func (l *Libvirt) Interfaces(flags ConnectListAllInterfacesFlags) ([]Interface, error) {
+	ifaces, _, err := l.ConnectListAllInterfaces(1, flags)
+	return ifaces, err
+}
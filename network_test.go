@@ -0,0 +1,120 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestNetworks(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	nets := []Network{
+		{Name: "default", UUID: testUUID},
+		{Name: "isolated", UUID: testUUID},
+	}
+	payload, err := encode(&ConnectListAllNetworksRet{Nets: nets, Ret: uint32(len(nets))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectListAllNetworks, payload)
+
+	flags := ConnectListNetworksActive | ConnectListNetworksPersistent
+	got, err := l.Networks(flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, nets) {
+		t.Errorf("expected networks %v, got %v", nets, got)
+	}
+
+	reqs := dialer.Requests()
+	req := reqs[len(reqs)-1]
+	var args ConnectListAllNetworksArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(req.Payload), &args); err != nil {
+		t.Fatal(err)
+	}
+	if args.NeedResults != 1 || args.Flags != flags {
+		t.Errorf("expected NeedResults 1 and flags %v, got %+v", flags, args)
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	ifaces := []Interface{
+		{Name: "br0", Mac: "52:54:00:12:34:56"},
+		{Name: "bond0", Mac: "52:54:00:65:43:21"},
+	}
+	payload, err := encode(&ConnectListAllInterfacesRet{Ifaces: ifaces, Ret: uint32(len(ifaces))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectListAllInterfaces, payload)
+
+	got, err := l.Interfaces(ConnectListInterfacesActive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, ifaces) {
+		t.Errorf("expected interfaces %v, got %v", ifaces, got)
+	}
+
+	payload, err = encode(&InterfaceGetXMLDescRet{XML: "<interface type='bridge' name='br0'/>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcInterfaceGetXMLDesc, payload)
+	if _, err := l.InterfaceGetXMLDesc(got[0], InterfaceXMLInactive); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcConnectListAllInterfaces:
+			var args ConnectListAllInterfacesArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.NeedResults != 1 || args.Flags != ConnectListInterfacesActive {
+				t.Errorf("expected NeedResults 1 and active interfaces, got %+v", args)
+			}
+		case constants.ProcInterfaceGetXMLDesc:
+			var args InterfaceGetXMLDescArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.Iface != ifaces[0] || args.Flags != InterfaceXMLInactive {
+				t.Errorf("expected the inactive XML of %v, got %+v", ifaces[0], args)
+			}
+		}
+	}
+}
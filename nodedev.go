@@ -0,0 +1,26 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// NodeDevices returns the host's devices known to libvirt, filtered by flags.
+// For example, ConnectListNodeDevicesCapPciDev lists just the PCI devices,
+// such as those which could be assigned to a domain; 0 lists every device.
+// The generated node device calls, such as NodeDeviceGetXMLDesc and
+// NodeDeviceDetachFlags, take the returned devices' names.
+func (l *Libvirt) NodeDevices(flags ConnectListAllNodeDeviceFlags) ([]NodeDevice, error) {
+	// NeedResults asks for the devices themselves, not just their number.
+	devs, _, err := l.ConnectListAllNodeDevices(1, flags)
+	return devs, err
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestNodeDevices(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	devs := []NodeDevice{
+		{Name: "pci_0000_00_02_0"},
+		{Name: "pci_0000_03_00_0"},
+	}
+	payload, err := encode(&ConnectListAllNodeDevicesRet{Devices: devs, Ret: uint32(len(devs))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcConnectListAllNodeDevices, payload)
+
+	got, err := l.NodeDevices(ConnectListNodeDevicesCapPciDev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, devs) {
+		t.Errorf("expected devices %v, got %v", devs, got)
+	}
+
+	reqs := dialer.Requests()
+	req := reqs[len(reqs)-1]
+	var args ConnectListAllNodeDevicesArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(req.Payload), &args); err != nil {
+		t.Fatal(err)
+	}
+	if args.NeedResults != 1 || args.Flags != ConnectListNodeDevicesCapPciDev {
+		t.Errorf("expected NeedResults 1 and flags %v, got %+v", ConnectListNodeDevicesCapPciDev, args)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// NodeInfo describes a host's memory and CPUs, returned by NodeInfo.
+type NodeInfo struct {
+	// Model is the CPU model, such as "x86_64".
+	Model string
+	// Memory is the host's memory in KiB.
+	Memory uint64
+	// CPUs is the number of active CPUs, and MHz their expected frequency.
+	CPUs int32
+	MHz  int32
+	// Nodes is the number of NUMA cells, and Sockets, Cores and Threads the
+	// number of CPU sockets per cell, cores per socket and threads per core.
+	// When the topology is unusual, libvirt reports Nodes as 1 and Sockets as
+	// 1, and Cores and Threads multiply to the number of CPUs.
+	Nodes   int32
+	Sockets int32
+	Cores   int32
+	Threads int32
+}
+
+// NodeInfo returns information about the host's memory and CPUs. It wraps
+// NodeGetInfo, converting the model from its fixed size, NUL padded form.
+func (l *Libvirt) NodeInfo() (NodeInfo, error) {
+	model, memory, cpus, mhz, nodes, sockets, cores, threads, err := l.NodeGetInfo()
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	b := make([]byte, 0, len(model))
+	for _, c := range model {
+		if c == 0 {
+			break
+		}
+		b = append(b, byte(c))
+	}
+
+	return NodeInfo{
+		Model:   string(b),
+		Memory:  memory,
+		CPUs:    cpus,
+		MHz:     mhz,
+		Nodes:   nodes,
+		Sockets: sockets,
+		Cores:   cores,
+		Threads: threads,
+	}, nil
+}
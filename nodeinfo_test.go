@@ -0,0 +1,68 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestNodeInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	ret := NodeGetInfoRet{
+		Memory:  16 << 20,
+		Cpus:    8,
+		Mhz:     2400,
+		Nodes:   1,
+		Sockets: 1,
+		Cores:   4,
+		Threads: 2,
+	}
+	for i, c := range "x86_64" {
+		ret.Model[i] = int8(c)
+	}
+	payload, err := encode(&ret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcNodeGetInfo, payload)
+
+	info, err := l.NodeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := NodeInfo{
+		Model:   "x86_64",
+		Memory:  16 << 20,
+		CPUs:    8,
+		MHz:     2400,
+		Nodes:   1,
+		Sockets: 1,
+		Cores:   4,
+		Threads: 2,
+	}
+	if info != want {
+		t.Errorf("expected %+v, got %+v", want, info)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "time"
+
+// AllCPUs and AllCells are passed to NodeCPUStats and NodeMemoryStats for
+// statistics covering the whole host, rather than one CPU or NUMA cell.
+const (
+	AllCPUs  = int32(NodeCPUStatsAllCpus)
+	AllCells = int32(NodeMemoryStatsAllCells)
+)
+
+// NodeCPUStats holds CPU time statistics for a host, returned by NodeCPUStats.
+// Statistics the host doesn't report are zero.
+type NodeCPUStats struct {
+	// Kernel, User, Idle, Iowait and Intr are the cumulative times spent in
+	// each state; libvirt reports them in nanoseconds.
+	Kernel time.Duration
+	User   time.Duration
+	Idle   time.Duration
+	Iowait time.Duration
+	Intr   time.Duration
+	// Utilization is the CPU usage as a percentage, where 100% means all the
+	// CPUs counted are busy.
+	Utilization uint64
+}
+
+// NodeMemoryStats holds memory statistics for a host, returned by
+// NodeMemoryStats. All values are in KiB, and statistics the host doesn't
+// report are zero.
+type NodeMemoryStats struct {
+	Total   uint64
+	Free    uint64
+	Buffers uint64
+	Cached  uint64
+}
+
+// NodeCPUStats returns CPU time statistics for the host's CPU cpuNum, or for
+// all its CPUs if cpuNum is AllCPUs.
+//
+// Unlike NodeGetCPUStats, which must first be called to find the number of
+// statistics and then again to fetch them, this makes both calls.
+func (l *Libvirt) NodeCPUStats(cpuNum int32, flags uint32) (NodeCPUStats, error) {
+	var stats NodeCPUStats
+	_, n, err := l.NodeGetCPUStats(cpuNum, 0, flags)
+	if err != nil || n == 0 {
+		return stats, err
+	}
+
+	params, _, err := l.NodeGetCPUStats(cpuNum, n, flags)
+	if err != nil {
+		return stats, err
+	}
+	for _, p := range params {
+		switch p.Field {
+		case NodeCPUStatsKernel:
+			stats.Kernel = time.Duration(p.Value)
+		case NodeCPUStatsUser:
+			stats.User = time.Duration(p.Value)
+		case NodeCPUStatsIdle:
+			stats.Idle = time.Duration(p.Value)
+		case NodeCPUStatsIowait:
+			stats.Iowait = time.Duration(p.Value)
+		case NodeCPUStatsIntr:
+			stats.Intr = time.Duration(p.Value)
+		case NodeCPUStatsUtilization:
+			stats.Utilization = p.Value
+		}
+	}
+	return stats, nil
+}
+
+// NodeMemoryStats returns memory statistics for the host's NUMA cell cellNum,
+// or for all its memory if cellNum is AllCells.
+//
+// Unlike NodeGetMemoryStats, which must first be called to find the number of
+// statistics and then again to fetch them, this makes both calls.
+func (l *Libvirt) NodeMemoryStats(cellNum int32, flags uint32) (NodeMemoryStats, error) {
+	var stats NodeMemoryStats
+	_, n, err := l.NodeGetMemoryStats(0, cellNum, flags)
+	if err != nil || n == 0 {
+		return stats, err
+	}
+
+	params, _, err := l.NodeGetMemoryStats(n, cellNum, flags)
+	if err != nil {
+		return stats, err
+	}
+	for _, p := range params {
+		switch p.Field {
+		case NodeMemoryStatsTotal:
+			stats.Total = p.Value
+		case NodeMemoryStatsFree:
+			stats.Free = p.Value
+		case NodeMemoryStatsBuffers:
+			stats.Buffers = p.Value
+		case NodeMemoryStatsCached:
+			stats.Cached = p.Value
+		}
+	}
+	return stats, nil
+}
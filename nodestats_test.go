@@ -0,0 +1,91 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestNodeStats(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	cpu := []NodeGetCPUStats{
+		{Field: NodeCPUStatsKernel, Value: 100},
+		{Field: NodeCPUStatsUser, Value: 200},
+		{Field: "unknown", Value: 300},
+	}
+	mem := []NodeGetMemoryStats{
+		{Field: NodeMemoryStatsTotal, Value: 4096},
+		{Field: NodeMemoryStatsFree, Value: 1024},
+	}
+
+	queue := func(proc uint32, ret interface{}) {
+		payload, err := encode(ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcNodeGetCPUStats, &NodeGetCPUStatsRet{Nparams: 3})
+	queue(constants.ProcNodeGetCPUStats, &NodeGetCPUStatsRet{Params: cpu, Nparams: 3})
+	queue(constants.ProcNodeGetMemoryStats, &NodeGetMemoryStatsRet{Nparams: 2})
+	queue(constants.ProcNodeGetMemoryStats, &NodeGetMemoryStatsRet{Params: mem, Nparams: 2})
+
+	gotCPU, err := l.NodeCPUStats(AllCPUs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCPU := NodeCPUStats{Kernel: 100, User: 200}
+	if gotCPU != wantCPU {
+		t.Errorf("expected cpu stats %+v, got %+v", wantCPU, gotCPU)
+	}
+
+	gotMem, err := l.NodeMemoryStats(AllCells, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMem := NodeMemoryStats{Total: 4096, Free: 1024}
+	if gotMem != wantMem {
+		t.Errorf("expected memory stats %+v, got %+v", wantMem, gotMem)
+	}
+
+	// The count is requested first, then the statistics.
+	reqs := dialer.Requests()
+	var nparams []int32
+	for _, r := range reqs {
+		if r.Procedure != constants.ProcNodeGetCPUStats {
+			continue
+		}
+		var args NodeGetCPUStatsArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		nparams = append(nparams, args.Nparams)
+	}
+	if !reflect.DeepEqual(nparams, []int32{0, 3}) {
+		t.Errorf("expected requests for 0 then 3 cpu stats, got %v", nparams)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// NWFilters returns the network filters defined in libvirt. flags is currently
+// unused and should be 0. The generated network filter calls, such as
+// NwfilterGetXMLDesc and NwfilterUndefine, take the returned handles, and
+// NwfilterDefineXML defines a new one.
+func (l *Libvirt) NWFilters(flags uint32) ([]Nwfilter, error) {
+	// NeedResults asks for the filters themselves, not just their number.
+	filters, _, err := l.ConnectListAllNwfilters(1, flags)
+	return filters, err
+}
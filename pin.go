@@ -0,0 +1,115 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// DomainSetVcpuPin pins virtual CPU vcpu of a domain to the host CPUs in cpus.
+// Flags select whether the running domain, its persistent configuration, or
+// both are changed. It wraps DomainPinVcpuFlags.
+func (l *Libvirt) DomainSetVcpuPin(dom Domain, vcpu uint32, cpus CPUSet, flags DomainModificationImpact) error {
+	return l.DomainPinVcpuFlags(dom, vcpu, cpus.Bytes(), uint32(flags))
+}
+
+// DomainVcpuPinInfo returns the host CPUs each of a domain's virtual CPUs may
+// run on, indexed by virtual CPU number. Flags select whether the running
+// domain or its persistent configuration is queried.
+//
+// Unlike DomainGetVcpuPinInfo, which needs the number of virtual and host CPUs
+// to size its result, this looks them up first.
+func (l *Libvirt) DomainVcpuPinInfo(dom Domain, flags DomainModificationImpact) ([]CPUSet, error) {
+	ncpus, maplen, err := l.hostCPUs()
+	if err != nil {
+		return nil, err
+	}
+	nvcpus, err := l.DomainGetVcpusFlags(dom, DomainVCPUFlags(flags)|DomainVCPUMaximum)
+	if err != nil {
+		return nil, err
+	}
+
+	cpumaps, num, err := l.DomainGetVcpuPinInfo(dom, nvcpus, maplen, uint32(flags))
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make([]CPUSet, 0, num)
+	for i := 0; i < int(num) && (i+1)*int(maplen) <= len(cpumaps); i++ {
+		m := cpumaps[i*int(maplen) : (i+1)*int(maplen)]
+		pins = append(pins, cpuSetFromMap(m, int(ncpus)))
+	}
+	return pins, nil
+}
+
+// hostCPUs returns the number of CPUs the host has, and the length of the
+// cpumaps libvirt uses for them.
+func (l *Libvirt) hostCPUs() (ncpus, maplen int32, err error) {
+	_, _, ncpus, err = l.NodeGetCPUMap(0, 0, 0)
+	return ncpus, (ncpus + 7) / 8, err
+}
+
+// NodeOnlineCPUs returns which of the host's CPUs are online, indexed by CPU
+// number, so that a CPUSet can be checked before pinning to it. Its length is
+// the number of CPUs the host has, online or not. It wraps NodeGetCPUMap, and
+// flags is currently unused.
+func (l *Libvirt) NodeOnlineCPUs(flags uint32) (CPUSet, error) {
+	cpumap, _, ncpus, err := l.NodeGetCPUMap(1, 0, flags)
+	if err != nil {
+		return nil, err
+	}
+	return cpuSetFromMap(cpumap, int(ncpus)), nil
+}
+
+// DomainSetEmulatorPin pins a domain's emulator threads to the host CPUs in
+// cpus. It wraps DomainPinEmulator.
+func (l *Libvirt) DomainSetEmulatorPin(dom Domain, cpus CPUSet, flags DomainModificationImpact) error {
+	return l.DomainPinEmulator(dom, cpus.Bytes(), flags)
+}
+
+// DomainEmulatorPinInfo returns the host CPUs a domain's emulator threads may
+// run on. It wraps DomainGetEmulatorPinInfo.
+func (l *Libvirt) DomainEmulatorPinInfo(dom Domain, flags DomainModificationImpact) (CPUSet, error) {
+	ncpus, maplen, err := l.hostCPUs()
+	if err != nil {
+		return nil, err
+	}
+	cpumap, _, err := l.DomainGetEmulatorPinInfo(dom, maplen, flags)
+	if err != nil {
+		return nil, err
+	}
+	return cpuSetFromMap(cpumap, int(ncpus)), nil
+}
+
+// DomainSetIOThreadPin pins one of a domain's I/O threads to the host CPUs in
+// cpus. It wraps DomainPinIothread.
+func (l *Libvirt) DomainSetIOThreadPin(dom Domain, iothread uint32, cpus CPUSet, flags DomainModificationImpact) error {
+	return l.DomainPinIothread(dom, iothread, cpus.Bytes(), flags)
+}
+
+// DomainIOThreadPinInfo returns the host CPUs each of a domain's I/O threads
+// may run on, keyed by I/O thread ID. It wraps DomainGetIothreadInfo.
+func (l *Libvirt) DomainIOThreadPinInfo(dom Domain, flags DomainModificationImpact) (map[uint32]CPUSet, error) {
+	ncpus, _, err := l.hostCPUs()
+	if err != nil {
+		return nil, err
+	}
+	info, _, err := l.DomainGetIothreadInfo(dom, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make(map[uint32]CPUSet, len(info))
+	for _, i := range info {
+		pins[i.IothreadID] = cpuSetFromMap(i.Cpumap, int(ncpus))
+	}
+	return pins, nil
+}
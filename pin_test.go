@@ -0,0 +1,241 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainSetVcpuPin(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainPinVcpuFlags, nil)
+	cpus := CPUSet{false, true, true}
+	if err := l.DomainSetVcpuPin(Domain{Name: "test"}, 1, cpus, DomainAffectLive|DomainAffectConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	var args DomainPinVcpuFlagsArgs
+	for _, r := range dialer.Requests() {
+		if r.Procedure == constants.ProcDomainPinVcpuFlags {
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if args.Vcpu != 1 || !bytes.Equal(args.Cpumap, []byte{0x06}) ||
+		args.Flags != uint32(DomainAffectLive|DomainAffectConfig) {
+		t.Errorf("unexpected arguments %+v", args)
+	}
+}
+
+func TestDomainVcpuPinInfo(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	queue := func(proc uint32, v interface{}) {
+		payload, err := encode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	// A host with 10 CPUs, and a domain with 2 virtual CPUs, pinned to CPUs
+	// 0-3, and 4-9.
+	queue(constants.ProcNodeGetCPUMap, &NodeGetCPUMapRet{Ret: 10})
+	queue(constants.ProcDomainGetVcpusFlags, &DomainGetVcpusFlagsRet{Num: 2})
+	queue(constants.ProcDomainGetVcpuPinInfo, &DomainGetVcpuPinInfoRet{
+		Cpumaps: []byte{0x0f, 0x00, 0xf0, 0x03},
+		Num:     2,
+	})
+
+	pins, err := l.DomainVcpuPinInfo(Domain{Name: "test"}, DomainAffectConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CPUSet{
+		{true, true, true, true, false, false, false, false, false, false},
+		{false, false, false, false, true, true, true, true, true, true},
+	}
+	if !reflect.DeepEqual(pins, want) {
+		t.Errorf("expected %v, got %v", want, pins)
+	}
+
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcDomainGetVcpusFlags:
+			var args DomainGetVcpusFlagsArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if want := DomainVCPUConfig | DomainVCPUMaximum; args.Flags != want {
+				t.Errorf("expected vcpus flags %d, got %d", want, args.Flags)
+			}
+		case constants.ProcDomainGetVcpuPinInfo:
+			var args DomainGetVcpuPinInfoArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.Ncpumaps != 2 || args.Maplen != 2 || args.Flags != uint32(DomainAffectConfig) {
+				t.Errorf("unexpected arguments %+v", args)
+			}
+		}
+	}
+}
+
+func TestDomainEmulatorPin(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainPinEmulator, nil)
+	cpus, err := ParseCPUSet("2-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.DomainSetEmulatorPin(Domain{Name: "test"}, cpus, DomainAffectLive); err != nil {
+		t.Fatal(err)
+	}
+
+	queue := func(proc uint32, v interface{}) {
+		payload, err := encode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcNodeGetCPUMap, &NodeGetCPUMapRet{Ret: 4})
+	queue(constants.ProcDomainGetEmulatorPinInfo, &DomainGetEmulatorPinInfoRet{
+		Cpumaps: []byte{0x0c},
+		Ret:     1,
+	})
+	got, err := l.DomainEmulatorPinInfo(Domain{Name: "test"}, DomainAffectLive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2-3" || len(got) != 4 {
+		t.Errorf("expected CPUs 2-3 of 4, got %v of %d", got, len(got))
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainPinEmulator {
+			continue
+		}
+		var args DomainPinEmulatorArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(args.Cpumap, []byte{0x0c}) || args.Flags != DomainAffectLive {
+			t.Errorf("unexpected arguments %+v", args)
+		}
+	}
+}
+
+func TestDomainIOThreadPin(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainPinIothread, nil)
+	if err := l.DomainSetIOThreadPin(Domain{Name: "test"}, 2, CPUSet{true}, DomainAffectCurrent); err != nil {
+		t.Fatal(err)
+	}
+
+	queue := func(proc uint32, v interface{}) {
+		payload, err := encode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcNodeGetCPUMap, &NodeGetCPUMapRet{Ret: 2})
+	queue(constants.ProcDomainGetIothreadInfo, &DomainGetIothreadInfoRet{
+		Info: []DomainIothreadInfo{
+			{IothreadID: 1, Cpumap: []byte{0x03}},
+			{IothreadID: 2, Cpumap: []byte{0x01}},
+		},
+		Ret: 2,
+	})
+	got, err := l.DomainIOThreadPinInfo(Domain{Name: "test"}, DomainAffectCurrent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[uint32]CPUSet{1: {true, true}, 2: {true, false}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestNodeOnlineCPUs(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	// A host with 10 CPUs, of which 1 and 8 are offline.
+	payload, err := encode(&NodeGetCPUMapRet{Cpumap: []byte{0xfd, 0x02}, Ret: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcNodeGetCPUMap, payload)
+
+	online, err := l.NodeOnlineCPUs(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := CPUSet{true, false, true, true, true, true, true, true, false, true}
+	if !reflect.DeepEqual(online, want) {
+		t.Errorf("expected %v, got %v", want, online)
+	}
+	if online.Contains(8) {
+		t.Error("expected CPU 8 to be offline")
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcNodeGetCPUMap {
+			continue
+		}
+		var args NodeGetCPUMapArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if args.NeedMap == 0 {
+			t.Error("expected the cpumap to be asked for")
+		}
+	}
+}
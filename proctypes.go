@@ -0,0 +1,30 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "reflect"
+
+// ProcInfo describes the go side of a libvirt procedure: the Libvirt method
+// which calls it, and the types of the structs holding its arguments and return
+// values. Args or Ret is nil if the procedure has none. The generated maps
+// ProcedureTypes, QEMUProcedureTypes and LXCProcedureTypes hold the ProcInfo
+// of each procedure, keyed by its number. A new value of either type, from
+// reflect.New, can be decoded from a message's payload, which makes it
+// possible to fuzz the decoding of every procedure's messages.
+type ProcInfo struct {
+	Method string
+	Args   reflect.Type
+	Ret    reflect.Type
+}
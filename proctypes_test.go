@@ -0,0 +1,78 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+)
+
+func TestProcedureTypes(t *testing.T) {
+	for _, procs := range []map[uint32]ProcInfo{
+		ProcedureTypes, QEMUProcedureTypes, LXCProcedureTypes,
+	} {
+		for proc, info := range procs {
+			if _, ok := reflect.TypeOf(&Libvirt{}).MethodByName(info.Method); !ok {
+				t.Errorf("procedure %d: no method %v", proc, info.Method)
+			}
+			for _, typ := range []reflect.Type{info.Args, info.Ret} {
+				if typ != nil {
+					checkDecode(t, info.Method, typ)
+				}
+			}
+		}
+	}
+
+	info := ProcedureTypes[uint32(ProcDomainCreateXML)]
+	if info.Method != "DomainCreateXML" || info.Args != reflect.TypeOf(DomainCreateXMLArgs{}) ||
+		info.Ret != reflect.TypeOf(DomainCreateXMLRet{}) {
+		t.Errorf("unexpected info for DomainCreateXML: %+v", info)
+	}
+}
+
+// checkDecode decodes a zero value of typ after encoding it, then decodes
+// truncated and garbage payloads, which must fail without panicking.
+func checkDecode(t *testing.T, method string, typ reflect.Type) {
+	t.Helper()
+	buf, err := encode(reflect.New(typ).Interface())
+	if err != nil {
+		t.Errorf("%v: encoding %v failed: %v", method, typ, err)
+		return
+	}
+
+	decode := func(p []byte) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Errorf("%v: decoding %v panicked: %v", method, typ, r)
+			}
+		}()
+		dec := xdr.NewDecoderCustomTypes(bytes.NewReader(p), 1024, customTypes)
+		_, err = dec.Decode(reflect.New(typ).Interface())
+		return err
+	}
+
+	if err := decode(buf); err != nil {
+		t.Errorf("%v: decoding %v failed: %v", method, typ, err)
+	}
+	if len(buf) > 0 {
+		if err := decode(buf[:len(buf)-1]); err == nil {
+			t.Errorf("%v: expected an error decoding a truncated %v", method, typ)
+		}
+	}
+	decode(bytes.Repeat([]byte{0xff}, 64))
+}
@@ -22,7 +22,9 @@ package libvirt
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"reflect"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
 	"github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
@@ -31,7 +33,9 @@ import (
 // References to prevent "imported and not used" errors.
 var (
 	_ = bytes.Buffer{}
+	_ = fmt.Sprintf
 	_ = io.Copy
+	_ = reflect.TypeOf
 	_ = constants.Program
 	_ = xdr.Unmarshal
 )
@@ -40,19 +44,88 @@ var (
 // Typedefs:
 //
 
-//
 // Enums:
 //
 // QEMUProcedure is libvirt's qemu_procedure
 type QEMUProcedure int32
 
+// Enum values:
 //
+// QEMUProcedure values.
+const (
+	// QEMUProcDomainMonitorCommand is libvirt's QEMU_PROC_DOMAIN_MONITOR_COMMAND
+	QEMUProcDomainMonitorCommand QEMUProcedure = 1
+	// QEMUProcDomainAttach is libvirt's QEMU_PROC_DOMAIN_ATTACH
+	QEMUProcDomainAttach QEMUProcedure = 2
+	// QEMUProcDomainAgentCommand is libvirt's QEMU_PROC_DOMAIN_AGENT_COMMAND
+	QEMUProcDomainAgentCommand QEMUProcedure = 3
+	// QEMUProcConnectDomainMonitorEventRegister is libvirt's QEMU_PROC_CONNECT_DOMAIN_MONITOR_EVENT_REGISTER
+	QEMUProcConnectDomainMonitorEventRegister QEMUProcedure = 4
+	// QEMUProcConnectDomainMonitorEventDeregister is libvirt's QEMU_PROC_CONNECT_DOMAIN_MONITOR_EVENT_DEREGISTER
+	QEMUProcConnectDomainMonitorEventDeregister QEMUProcedure = 5
+	// QEMUProcDomainMonitorEvent is libvirt's QEMU_PROC_DOMAIN_MONITOR_EVENT
+	QEMUProcDomainMonitorEvent QEMUProcedure = 6
+)
+
+// String returns the name of the QEMUProcedure value.
+func (e QEMUProcedure) String() string {
+	switch e {
+	case QEMUProcDomainMonitorCommand:
+		return "QEMUProcDomainMonitorCommand"
+	case QEMUProcDomainAttach:
+		return "QEMUProcDomainAttach"
+	case QEMUProcDomainAgentCommand:
+		return "QEMUProcDomainAgentCommand"
+	case QEMUProcConnectDomainMonitorEventRegister:
+		return "QEMUProcConnectDomainMonitorEventRegister"
+	case QEMUProcConnectDomainMonitorEventDeregister:
+		return "QEMUProcConnectDomainMonitorEventDeregister"
+	case QEMUProcDomainMonitorEvent:
+		return "QEMUProcDomainMonitorEvent"
+	}
+	return fmt.Sprintf("QEMUProcedure(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the QEMUProcedure values.
+func (e QEMUProcedure) IsValid() bool {
+	switch e {
+	case QEMUProcDomainMonitorCommand,
+		QEMUProcDomainAttach,
+		QEMUProcDomainAgentCommand,
+		QEMUProcConnectDomainMonitorEventRegister,
+		QEMUProcConnectDomainMonitorEventDeregister,
+		QEMUProcDomainMonitorEvent:
+		return true
+	}
+	return false
+}
+
+// ParseQEMUProcedure returns the QEMUProcedure value with the given name, as returned
+// by String.
+func ParseQEMUProcedure(s string) (QEMUProcedure, error) {
+	switch s {
+	case "QEMUProcDomainMonitorCommand":
+		return QEMUProcDomainMonitorCommand, nil
+	case "QEMUProcDomainAttach":
+		return QEMUProcDomainAttach, nil
+	case "QEMUProcDomainAgentCommand":
+		return QEMUProcDomainAgentCommand, nil
+	case "QEMUProcConnectDomainMonitorEventRegister":
+		return QEMUProcConnectDomainMonitorEventRegister, nil
+	case "QEMUProcConnectDomainMonitorEventDeregister":
+		return QEMUProcConnectDomainMonitorEventDeregister, nil
+	case "QEMUProcDomainMonitorEvent":
+		return QEMUProcDomainMonitorEvent, nil
+	}
+	return 0, fmt.Errorf("invalid QEMUProcedure %q", s)
+}
+
 // Structs:
 //
 // QEMUDomainMonitorCommandArgs is libvirt's qemu_domain_monitor_command_args
 type QEMUDomainMonitorCommandArgs struct {
-	Dom Domain
-	Cmd string
+	Dom   Domain
+	Cmd   string
 	Flags uint32
 }
 
@@ -64,7 +137,7 @@ type QEMUDomainMonitorCommandRet struct {
 // QEMUDomainAttachArgs is libvirt's qemu_domain_attach_args
 type QEMUDomainAttachArgs struct {
 	PidValue uint32
-	Flags uint32
+	Flags    uint32
 }
 
 // QEMUDomainAttachRet is libvirt's qemu_domain_attach_ret
@@ -74,10 +147,10 @@ type QEMUDomainAttachRet struct {
 
 // QEMUDomainAgentCommandArgs is libvirt's qemu_domain_agent_command_args
 type QEMUDomainAgentCommandArgs struct {
-	Dom Domain
-	Cmd string
+	Dom     Domain
+	Cmd     string
 	Timeout int32
-	Flags uint32
+	Flags   uint32
 }
 
 // QEMUDomainAgentCommandRet is libvirt's qemu_domain_agent_command_ret
@@ -87,7 +160,7 @@ type QEMUDomainAgentCommandRet struct {
 
 // QEMUConnectDomainMonitorEventRegisterArgs is libvirt's qemu_connect_domain_monitor_event_register_args
 type QEMUConnectDomainMonitorEventRegisterArgs struct {
-	Dom OptDomain
+	Dom   OptDomain
 	Event OptString
 	Flags uint32
 }
@@ -105,27 +178,26 @@ type QEMUConnectDomainMonitorEventDeregisterArgs struct {
 // QEMUDomainMonitorEventMsg is libvirt's qemu_domain_monitor_event_msg
 type QEMUDomainMonitorEventMsg struct {
 	CallbackID int32
-	Dom Domain
-	Event string
-	Seconds int64
-	Micros uint32
-	Details OptString
+	Dom        Domain
+	Event      string
+	Seconds    int64
+	Micros     uint32
+	Details    OptString
 }
 
-
-
-
 // QEMUDomainMonitorCommand is the go wrapper for QEMU_PROC_DOMAIN_MONITOR_COMMAND.
 func (l *Libvirt) QEMUDomainMonitorCommand(Dom Domain, Cmd string, Flags uint32) (rResult string, err error) {
 	var buf []byte
 
-	args := QEMUDomainMonitorCommandArgs {
-		Dom: Dom,
-		Cmd: Cmd,
+	args := QEMUDomainMonitorCommandArgs{
+		Dom:   Dom,
+		Cmd:   Cmd,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -137,10 +209,8 @@ func (l *Libvirt) QEMUDomainMonitorCommand(Dom Domain, Cmd string, Flags uint32)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: string
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -154,12 +224,14 @@ func (l *Libvirt) QEMUDomainMonitorCommand(Dom Domain, Cmd string, Flags uint32)
 func (l *Libvirt) QEMUDomainAttach(PidValue uint32, Flags uint32) (rDom Domain, err error) {
 	var buf []byte
 
-	args := QEMUDomainAttachArgs {
+	args := QEMUDomainAttachArgs{
 		PidValue: PidValue,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -171,10 +243,8 @@ func (l *Libvirt) QEMUDomainAttach(PidValue uint32, Flags uint32) (rDom Domain,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -188,14 +258,16 @@ func (l *Libvirt) QEMUDomainAttach(PidValue uint32, Flags uint32) (rDom Domain,
 func (l *Libvirt) QEMUDomainAgentCommand(Dom Domain, Cmd string, Timeout int32, Flags uint32) (rResult OptString, err error) {
 	var buf []byte
 
-	args := QEMUDomainAgentCommandArgs {
-		Dom: Dom,
-		Cmd: Cmd,
+	args := QEMUDomainAgentCommandArgs{
+		Dom:     Dom,
+		Cmd:     Cmd,
 		Timeout: Timeout,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -207,10 +279,8 @@ func (l *Libvirt) QEMUDomainAgentCommand(Dom Domain, Cmd string, Timeout int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: OptString
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -224,13 +294,15 @@ func (l *Libvirt) QEMUDomainAgentCommand(Dom Domain, Cmd string, Timeout int32,
 func (l *Libvirt) QEMUConnectDomainMonitorEventRegister(Dom OptDomain, Event OptString, Flags uint32) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := QEMUConnectDomainMonitorEventRegisterArgs {
-		Dom: Dom,
+	args := QEMUConnectDomainMonitorEventRegisterArgs{
+		Dom:   Dom,
 		Event: Event,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -242,10 +314,8 @@ func (l *Libvirt) QEMUConnectDomainMonitorEventRegister(Dom OptDomain, Event Opt
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -259,16 +329,17 @@ func (l *Libvirt) QEMUConnectDomainMonitorEventRegister(Dom OptDomain, Event Opt
 func (l *Libvirt) QEMUConnectDomainMonitorEventDeregister(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := QEMUConnectDomainMonitorEventDeregisterArgs {
+	args := QEMUConnectDomainMonitorEventDeregisterArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(5, constants.QEMUProgram, buf, nil, nil)
 	if err != nil {
 		return
@@ -281,7 +352,6 @@ func (l *Libvirt) QEMUConnectDomainMonitorEventDeregister(CallbackID int32) (err
 func (l *Libvirt) QEMUDomainMonitorEvent() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(6, constants.QEMUProgram, buf, nil, nil)
 	if err != nil {
 		return
@@ -290,3 +360,13 @@ func (l *Libvirt) QEMUDomainMonitorEvent() (err error) {
 	return
 }
 
+// QEMUProcedureTypes maps each qemu_procedure value to the method which calls it
+// and the types of its arguments and return values.
+var QEMUProcedureTypes = map[uint32]ProcInfo{
+	constants.QEMUProcDomainMonitorCommand:                {Method: "QEMUDomainMonitorCommand", Args: reflect.TypeOf(QEMUDomainMonitorCommandArgs{}), Ret: reflect.TypeOf(QEMUDomainMonitorCommandRet{})},
+	constants.QEMUProcDomainAttach:                        {Method: "QEMUDomainAttach", Args: reflect.TypeOf(QEMUDomainAttachArgs{}), Ret: reflect.TypeOf(QEMUDomainAttachRet{})},
+	constants.QEMUProcDomainAgentCommand:                  {Method: "QEMUDomainAgentCommand", Args: reflect.TypeOf(QEMUDomainAgentCommandArgs{}), Ret: reflect.TypeOf(QEMUDomainAgentCommandRet{})},
+	constants.QEMUProcConnectDomainMonitorEventRegister:   {Method: "QEMUConnectDomainMonitorEventRegister", Args: reflect.TypeOf(QEMUConnectDomainMonitorEventRegisterArgs{}), Ret: reflect.TypeOf(QEMUConnectDomainMonitorEventRegisterRet{})},
+	constants.QEMUProcConnectDomainMonitorEventDeregister: {Method: "QEMUConnectDomainMonitorEventDeregister", Args: reflect.TypeOf(QEMUConnectDomainMonitorEventDeregisterArgs{})},
+	constants.QEMUProcDomainMonitorEvent:                  {Method: "QEMUDomainMonitorEvent"},
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/internal/event"
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// ErrReconnecting is returned by calls which gave up waiting for the
+// connection to libvirt to be re-established.
+var ErrReconnecting = errors.New("timed out waiting to reconnect to libvirt")
+
+// Backoff controls how the client reconnects to libvirt once the connection
+// has been lost.
+type Backoff struct {
+	// Initial is the delay before the first attempt to reconnect.
+	Initial time.Duration
+	// Max caps the delay between attempts.
+	Max time.Duration
+	// Multiplier is applied to the delay after each failed attempt. Values
+	// less than 1 are treated as 2.
+	Multiplier float64
+	// MaxAttempts is the number of attempts made before giving up, after which
+	// the client is disconnected. 0 means never give up.
+	MaxAttempts int
+	// Wait is how long calls made while reconnecting wait for the connection
+	// to be re-established before returning ErrReconnecting. 0 means wait until
+	// the client reconnects or gives up.
+	Wait time.Duration
+}
+
+// DefaultBackoff retries every 100ms at first, backing off to every 30s, and
+// never gives up. Calls wait up to 30s for the connection to be re-established.
+var DefaultBackoff = Backoff{
+	Initial:    100 * time.Millisecond,
+	Max:        30 * time.Second,
+	Multiplier: 2,
+	Wait:       30 * time.Second,
+}
+
+// next returns the delay to use after an attempt which waited d.
+func (b Backoff) next(d time.Duration) time.Duration {
+	m := b.Multiplier
+	if m < 1 {
+		m = 2
+	}
+	d = time.Duration(float64(d) * m)
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	return d
+}
+
+// EnableReconnect makes the client reconnect to libvirt using dialer when the
+// connection is lost, rather than staying disconnected. Once the connection is
+// re-established the client authenticates and opens the URI it was connected
+// to again, and resumes any event subscriptions; keepalives are also resumed.
+// Calls made while reconnecting wait for the connection to be re-established,
+// up to backoff.Wait, and calls which were waiting for a reply when the
+// connection was lost fail.
+//
+// The dialer replaces the one the client was created with, and is also used by
+// Connect. Calling Disconnect stops the client reconnecting until it is
+// connected again.
+func (l *Libvirt) EnableReconnect(dialer socket.Dialer, backoff Backoff) {
+	r := l.root()
+	r.socket.SetDialer(dialer)
+
+	r.rmux.Lock()
+	defer r.rmux.Unlock()
+
+	r.backoff = &backoff
+	if r.next == nil {
+		r.next = make(chan struct{})
+	}
+}
+
+// reconnectState returns the channel closed when the next connection is ready
+// and whether the client is currently reconnecting. The channel is nil if the
+// client doesn't reconnect.
+func (l *Libvirt) reconnectState() (<-chan struct{}, bool) {
+	l.rmux.Lock()
+	defer l.rmux.Unlock()
+
+	if l.backoff == nil || l.closing {
+		return nil, false
+	}
+	return l.next, l.reconnecting
+}
+
+// awaitReconnect waits for next to be closed, giving up once ctx is done or
+// the backoff's Wait has passed.
+func (l *Libvirt) awaitReconnect(ctx context.Context, next <-chan struct{}) error {
+	l.rmux.Lock()
+	wait := l.backoff.Wait
+	l.rmux.Unlock()
+
+	var timeout <-chan time.Time
+	if wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	select {
+	case <-next:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeout:
+		return ErrReconnecting
+	}
+}
+
+// stopReconnect stops the client reconnecting until it next connects.
+func (l *Libvirt) stopReconnect() {
+	l.rmux.Lock()
+	defer l.rmux.Unlock()
+
+	l.closing = true
+	if l.abort != nil {
+		close(l.abort)
+		l.abort = nil
+	}
+	if l.next != nil {
+		close(l.next)
+		l.next = nil
+	}
+}
+
+// reconnect re-establishes the connection to libvirt, and reports whether it
+// succeeded. It returns false straight away if the client doesn't reconnect.
+func (l *Libvirt) reconnect() bool {
+	l.rmux.Lock()
+	if l.backoff == nil || l.closing {
+		l.rmux.Unlock()
+		return false
+	}
+	b := *l.backoff
+	abort := l.abort
+	l.reconnecting = true
+	l.rmux.Unlock()
+
+	ok := l.redial(b, abort)
+
+	l.rmux.Lock()
+	defer l.rmux.Unlock()
+
+	l.reconnecting = false
+	if l.next != nil {
+		close(l.next)
+		l.next = nil
+	}
+	if ok && !l.closing {
+		l.next = make(chan struct{})
+	}
+	return ok
+}
+
+// redial makes attempts to reconnect until one succeeds, the backoff's
+// attempts run out, or abort is closed.
+func (l *Libvirt) redial(b Backoff, abort <-chan struct{}) bool {
+	// Calls made while reconnecting must not wait for the reconnection to
+	// complete.
+	h := &Libvirt{parent: l, internal: true}
+
+	delay := b.Initial
+	for attempt := 0; b.MaxAttempts == 0 || attempt < b.MaxAttempts; attempt++ {
+		t := time.NewTimer(delay)
+		select {
+		case <-t.C:
+		case <-abort:
+			t.Stop()
+			return false
+		}
+		delay = b.next(delay)
+
+		if err := l.socket.Connect(); err != nil {
+			continue
+		}
+		if err := h.initLibvirtComms(l.uri); err != nil {
+			l.socket.Disconnect()
+			continue
+		}
+
+		select {
+		case <-abort:
+			l.socket.Disconnect()
+			return false
+		default:
+		}
+
+		h.resubscribeAll()
+		h.resumeKeepAlive()
+		return true
+	}
+
+	return false
+}
+
+// resubscribeAll registers for events again for each event stream, after the
+// connection has been re-established. Streams which can't be registered again
+// are closed.
+func (l *Libvirt) resubscribeAll() {
+	r := l.root()
+
+	// Take all the streams out first, so that a new callback ID which happens
+	// to match one in use on the old connection doesn't clash.
+	r.emux.Lock()
+	events, registers := r.events, r.registers
+	r.events = make(map[int32]*event.Stream)
+	r.registers = make(map[int32]registerFunc)
+	r.emux.Unlock()
+
+	for old, s := range events {
+		register := registers[old]
+		if register == nil {
+			s.Shutdown()
+			continue
+		}
+		id, err := register(l)
+		if err != nil {
+			s.Shutdown()
+			continue
+		}
+
+		r.emux.Lock()
+		s.CallbackID = id
+		r.events[id] = s
+		r.registers[id] = register
+		r.flushPending(s)
+		r.emux.Unlock()
+	}
+}
+
+// resumeKeepAlive starts sending keepalive messages again, if SetKeepAlive
+// was used on the previous connection.
+func (l *Libvirt) resumeKeepAlive() {
+	r := l.root()
+	r.kmux.Lock()
+	interval, count := r.kaInterval, r.kaCount
+	r.kmux.Unlock()
+
+	if interval > 0 {
+		l.SetKeepAlive(interval, count)
+	}
+}
@@ -22,7 +22,9 @@ package libvirt
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"reflect"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
 	"github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
@@ -31,53 +33,3122 @@ import (
 // References to prevent "imported and not used" errors.
 var (
 	_ = bytes.Buffer{}
+	_ = fmt.Sprintf
 	_ = io.Copy
+	_ = reflect.TypeOf
 	_ = constants.Program
 	_ = xdr.Unmarshal
 )
 
-//
 // Typedefs:
 //
 // OptString is libvirt's remote_string
 type OptString []string
+
 // UUID is libvirt's remote_uuid
 type UUID [UUIDBuflen]byte
+
 // OptDomain is libvirt's remote_domain
 type OptDomain []Domain
+
 // OptNetwork is libvirt's remote_network
 type OptNetwork []Network
+
 // OptNetworkPort is libvirt's remote_network_port
 type OptNetworkPort []NetworkPort
+
 // OptNwfilter is libvirt's remote_nwfilter
 type OptNwfilter []Nwfilter
+
 // OptNwfilterBinding is libvirt's remote_nwfilter_binding
 type OptNwfilterBinding []NwfilterBinding
+
 // OptStoragePool is libvirt's remote_storage_pool
 type OptStoragePool []StoragePool
+
 // OptStorageVol is libvirt's remote_storage_vol
 type OptStorageVol []StorageVol
+
 // OptNodeDevice is libvirt's remote_node_device
 type OptNodeDevice []NodeDevice
+
 // OptSecret is libvirt's remote_secret
 type OptSecret []Secret
 
-//
 // Enums:
 //
 // AuthType is libvirt's remote_auth_type
 type AuthType int32
+
 // Procedure is libvirt's remote_procedure
 type Procedure int32
 
+// Enum values:
 //
+// AuthType values.
+const (
+	// AuthNone is libvirt's REMOTE_AUTH_NONE
+	AuthNone AuthType = 0
+	// AuthSasl is libvirt's REMOTE_AUTH_SASL
+	AuthSasl AuthType = 1
+	// AuthPolkit is libvirt's REMOTE_AUTH_POLKIT
+	AuthPolkit AuthType = 2
+)
+
+// String returns the name of the AuthType value.
+func (e AuthType) String() string {
+	switch e {
+	case AuthNone:
+		return "AuthNone"
+	case AuthSasl:
+		return "AuthSasl"
+	case AuthPolkit:
+		return "AuthPolkit"
+	}
+	return fmt.Sprintf("AuthType(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the AuthType values.
+func (e AuthType) IsValid() bool {
+	switch e {
+	case AuthNone,
+		AuthSasl,
+		AuthPolkit:
+		return true
+	}
+	return false
+}
+
+// ParseAuthType returns the AuthType value with the given name, as returned
+// by String.
+func ParseAuthType(s string) (AuthType, error) {
+	switch s {
+	case "AuthNone":
+		return AuthNone, nil
+	case "AuthSasl":
+		return AuthSasl, nil
+	case "AuthPolkit":
+		return AuthPolkit, nil
+	}
+	return 0, fmt.Errorf("invalid AuthType %q", s)
+}
+
+// Procedure values.
+const (
+	// ProcConnectOpen is libvirt's REMOTE_PROC_CONNECT_OPEN
+	ProcConnectOpen Procedure = 1
+	// ProcConnectClose is libvirt's REMOTE_PROC_CONNECT_CLOSE
+	ProcConnectClose Procedure = 2
+	// ProcConnectGetType is libvirt's REMOTE_PROC_CONNECT_GET_TYPE
+	ProcConnectGetType Procedure = 3
+	// ProcConnectGetVersion is libvirt's REMOTE_PROC_CONNECT_GET_VERSION
+	ProcConnectGetVersion Procedure = 4
+	// ProcConnectGetMaxVcpus is libvirt's REMOTE_PROC_CONNECT_GET_MAX_VCPUS
+	ProcConnectGetMaxVcpus Procedure = 5
+	// ProcNodeGetInfo is libvirt's REMOTE_PROC_NODE_GET_INFO
+	ProcNodeGetInfo Procedure = 6
+	// ProcConnectGetCapabilities is libvirt's REMOTE_PROC_CONNECT_GET_CAPABILITIES
+	ProcConnectGetCapabilities Procedure = 7
+	// ProcDomainAttachDevice is libvirt's REMOTE_PROC_DOMAIN_ATTACH_DEVICE
+	ProcDomainAttachDevice Procedure = 8
+	// ProcDomainCreate is libvirt's REMOTE_PROC_DOMAIN_CREATE
+	ProcDomainCreate Procedure = 9
+	// ProcDomainCreateXML is libvirt's REMOTE_PROC_DOMAIN_CREATE_XML
+	ProcDomainCreateXML Procedure = 10
+	// ProcDomainDefineXML is libvirt's REMOTE_PROC_DOMAIN_DEFINE_XML
+	ProcDomainDefineXML Procedure = 11
+	// ProcDomainDestroy is libvirt's REMOTE_PROC_DOMAIN_DESTROY
+	ProcDomainDestroy Procedure = 12
+	// ProcDomainDetachDevice is libvirt's REMOTE_PROC_DOMAIN_DETACH_DEVICE
+	ProcDomainDetachDevice Procedure = 13
+	// ProcDomainGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_GET_XML_DESC
+	ProcDomainGetXMLDesc Procedure = 14
+	// ProcDomainGetAutostart is libvirt's REMOTE_PROC_DOMAIN_GET_AUTOSTART
+	ProcDomainGetAutostart Procedure = 15
+	// ProcDomainGetInfo is libvirt's REMOTE_PROC_DOMAIN_GET_INFO
+	ProcDomainGetInfo Procedure = 16
+	// ProcDomainGetMaxMemory is libvirt's REMOTE_PROC_DOMAIN_GET_MAX_MEMORY
+	ProcDomainGetMaxMemory Procedure = 17
+	// ProcDomainGetMaxVcpus is libvirt's REMOTE_PROC_DOMAIN_GET_MAX_VCPUS
+	ProcDomainGetMaxVcpus Procedure = 18
+	// ProcDomainGetOsType is libvirt's REMOTE_PROC_DOMAIN_GET_OS_TYPE
+	ProcDomainGetOsType Procedure = 19
+	// ProcDomainGetVcpus is libvirt's REMOTE_PROC_DOMAIN_GET_VCPUS
+	ProcDomainGetVcpus Procedure = 20
+	// ProcConnectListDefinedDomains is libvirt's REMOTE_PROC_CONNECT_LIST_DEFINED_DOMAINS
+	ProcConnectListDefinedDomains Procedure = 21
+	// ProcDomainLookupByID is libvirt's REMOTE_PROC_DOMAIN_LOOKUP_BY_ID
+	ProcDomainLookupByID Procedure = 22
+	// ProcDomainLookupByName is libvirt's REMOTE_PROC_DOMAIN_LOOKUP_BY_NAME
+	ProcDomainLookupByName Procedure = 23
+	// ProcDomainLookupByUUID is libvirt's REMOTE_PROC_DOMAIN_LOOKUP_BY_UUID
+	ProcDomainLookupByUUID Procedure = 24
+	// ProcConnectNumOfDefinedDomains is libvirt's REMOTE_PROC_CONNECT_NUM_OF_DEFINED_DOMAINS
+	ProcConnectNumOfDefinedDomains Procedure = 25
+	// ProcDomainPinVcpu is libvirt's REMOTE_PROC_DOMAIN_PIN_VCPU
+	ProcDomainPinVcpu Procedure = 26
+	// ProcDomainReboot is libvirt's REMOTE_PROC_DOMAIN_REBOOT
+	ProcDomainReboot Procedure = 27
+	// ProcDomainResume is libvirt's REMOTE_PROC_DOMAIN_RESUME
+	ProcDomainResume Procedure = 28
+	// ProcDomainSetAutostart is libvirt's REMOTE_PROC_DOMAIN_SET_AUTOSTART
+	ProcDomainSetAutostart Procedure = 29
+	// ProcDomainSetMaxMemory is libvirt's REMOTE_PROC_DOMAIN_SET_MAX_MEMORY
+	ProcDomainSetMaxMemory Procedure = 30
+	// ProcDomainSetMemory is libvirt's REMOTE_PROC_DOMAIN_SET_MEMORY
+	ProcDomainSetMemory Procedure = 31
+	// ProcDomainSetVcpus is libvirt's REMOTE_PROC_DOMAIN_SET_VCPUS
+	ProcDomainSetVcpus Procedure = 32
+	// ProcDomainShutdown is libvirt's REMOTE_PROC_DOMAIN_SHUTDOWN
+	ProcDomainShutdown Procedure = 33
+	// ProcDomainSuspend is libvirt's REMOTE_PROC_DOMAIN_SUSPEND
+	ProcDomainSuspend Procedure = 34
+	// ProcDomainUndefine is libvirt's REMOTE_PROC_DOMAIN_UNDEFINE
+	ProcDomainUndefine Procedure = 35
+	// ProcConnectListDefinedNetworks is libvirt's REMOTE_PROC_CONNECT_LIST_DEFINED_NETWORKS
+	ProcConnectListDefinedNetworks Procedure = 36
+	// ProcConnectListDomains is libvirt's REMOTE_PROC_CONNECT_LIST_DOMAINS
+	ProcConnectListDomains Procedure = 37
+	// ProcConnectListNetworks is libvirt's REMOTE_PROC_CONNECT_LIST_NETWORKS
+	ProcConnectListNetworks Procedure = 38
+	// ProcNetworkCreate is libvirt's REMOTE_PROC_NETWORK_CREATE
+	ProcNetworkCreate Procedure = 39
+	// ProcNetworkCreateXML is libvirt's REMOTE_PROC_NETWORK_CREATE_XML
+	ProcNetworkCreateXML Procedure = 40
+	// ProcNetworkDefineXML is libvirt's REMOTE_PROC_NETWORK_DEFINE_XML
+	ProcNetworkDefineXML Procedure = 41
+	// ProcNetworkDestroy is libvirt's REMOTE_PROC_NETWORK_DESTROY
+	ProcNetworkDestroy Procedure = 42
+	// ProcNetworkGetXMLDesc is libvirt's REMOTE_PROC_NETWORK_GET_XML_DESC
+	ProcNetworkGetXMLDesc Procedure = 43
+	// ProcNetworkGetAutostart is libvirt's REMOTE_PROC_NETWORK_GET_AUTOSTART
+	ProcNetworkGetAutostart Procedure = 44
+	// ProcNetworkGetBridgeName is libvirt's REMOTE_PROC_NETWORK_GET_BRIDGE_NAME
+	ProcNetworkGetBridgeName Procedure = 45
+	// ProcNetworkLookupByName is libvirt's REMOTE_PROC_NETWORK_LOOKUP_BY_NAME
+	ProcNetworkLookupByName Procedure = 46
+	// ProcNetworkLookupByUUID is libvirt's REMOTE_PROC_NETWORK_LOOKUP_BY_UUID
+	ProcNetworkLookupByUUID Procedure = 47
+	// ProcNetworkSetAutostart is libvirt's REMOTE_PROC_NETWORK_SET_AUTOSTART
+	ProcNetworkSetAutostart Procedure = 48
+	// ProcNetworkUndefine is libvirt's REMOTE_PROC_NETWORK_UNDEFINE
+	ProcNetworkUndefine Procedure = 49
+	// ProcConnectNumOfDefinedNetworks is libvirt's REMOTE_PROC_CONNECT_NUM_OF_DEFINED_NETWORKS
+	ProcConnectNumOfDefinedNetworks Procedure = 50
+	// ProcConnectNumOfDomains is libvirt's REMOTE_PROC_CONNECT_NUM_OF_DOMAINS
+	ProcConnectNumOfDomains Procedure = 51
+	// ProcConnectNumOfNetworks is libvirt's REMOTE_PROC_CONNECT_NUM_OF_NETWORKS
+	ProcConnectNumOfNetworks Procedure = 52
+	// ProcDomainCoreDump is libvirt's REMOTE_PROC_DOMAIN_CORE_DUMP
+	ProcDomainCoreDump Procedure = 53
+	// ProcDomainRestore is libvirt's REMOTE_PROC_DOMAIN_RESTORE
+	ProcDomainRestore Procedure = 54
+	// ProcDomainSave is libvirt's REMOTE_PROC_DOMAIN_SAVE
+	ProcDomainSave Procedure = 55
+	// ProcDomainGetSchedulerType is libvirt's REMOTE_PROC_DOMAIN_GET_SCHEDULER_TYPE
+	ProcDomainGetSchedulerType Procedure = 56
+	// ProcDomainGetSchedulerParameters is libvirt's REMOTE_PROC_DOMAIN_GET_SCHEDULER_PARAMETERS
+	ProcDomainGetSchedulerParameters Procedure = 57
+	// ProcDomainSetSchedulerParameters is libvirt's REMOTE_PROC_DOMAIN_SET_SCHEDULER_PARAMETERS
+	ProcDomainSetSchedulerParameters Procedure = 58
+	// ProcConnectGetHostname is libvirt's REMOTE_PROC_CONNECT_GET_HOSTNAME
+	ProcConnectGetHostname Procedure = 59
+	// ProcConnectSupportsFeature is libvirt's REMOTE_PROC_CONNECT_SUPPORTS_FEATURE
+	ProcConnectSupportsFeature Procedure = 60
+	// ProcDomainMigratePrepare is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE
+	ProcDomainMigratePrepare Procedure = 61
+	// ProcDomainMigratePerform is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PERFORM
+	ProcDomainMigratePerform Procedure = 62
+	// ProcDomainMigrateFinish is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_FINISH
+	ProcDomainMigrateFinish Procedure = 63
+	// ProcDomainBlockStats is libvirt's REMOTE_PROC_DOMAIN_BLOCK_STATS
+	ProcDomainBlockStats Procedure = 64
+	// ProcDomainInterfaceStats is libvirt's REMOTE_PROC_DOMAIN_INTERFACE_STATS
+	ProcDomainInterfaceStats Procedure = 65
+	// ProcAuthList is libvirt's REMOTE_PROC_AUTH_LIST
+	ProcAuthList Procedure = 66
+	// ProcAuthSaslInit is libvirt's REMOTE_PROC_AUTH_SASL_INIT
+	ProcAuthSaslInit Procedure = 67
+	// ProcAuthSaslStart is libvirt's REMOTE_PROC_AUTH_SASL_START
+	ProcAuthSaslStart Procedure = 68
+	// ProcAuthSaslStep is libvirt's REMOTE_PROC_AUTH_SASL_STEP
+	ProcAuthSaslStep Procedure = 69
+	// ProcAuthPolkit is libvirt's REMOTE_PROC_AUTH_POLKIT
+	ProcAuthPolkit Procedure = 70
+	// ProcConnectNumOfStoragePools is libvirt's REMOTE_PROC_CONNECT_NUM_OF_STORAGE_POOLS
+	ProcConnectNumOfStoragePools Procedure = 71
+	// ProcConnectListStoragePools is libvirt's REMOTE_PROC_CONNECT_LIST_STORAGE_POOLS
+	ProcConnectListStoragePools Procedure = 72
+	// ProcConnectNumOfDefinedStoragePools is libvirt's REMOTE_PROC_CONNECT_NUM_OF_DEFINED_STORAGE_POOLS
+	ProcConnectNumOfDefinedStoragePools Procedure = 73
+	// ProcConnectListDefinedStoragePools is libvirt's REMOTE_PROC_CONNECT_LIST_DEFINED_STORAGE_POOLS
+	ProcConnectListDefinedStoragePools Procedure = 74
+	// ProcConnectFindStoragePoolSources is libvirt's REMOTE_PROC_CONNECT_FIND_STORAGE_POOL_SOURCES
+	ProcConnectFindStoragePoolSources Procedure = 75
+	// ProcStoragePoolCreateXML is libvirt's REMOTE_PROC_STORAGE_POOL_CREATE_XML
+	ProcStoragePoolCreateXML Procedure = 76
+	// ProcStoragePoolDefineXML is libvirt's REMOTE_PROC_STORAGE_POOL_DEFINE_XML
+	ProcStoragePoolDefineXML Procedure = 77
+	// ProcStoragePoolCreate is libvirt's REMOTE_PROC_STORAGE_POOL_CREATE
+	ProcStoragePoolCreate Procedure = 78
+	// ProcStoragePoolBuild is libvirt's REMOTE_PROC_STORAGE_POOL_BUILD
+	ProcStoragePoolBuild Procedure = 79
+	// ProcStoragePoolDestroy is libvirt's REMOTE_PROC_STORAGE_POOL_DESTROY
+	ProcStoragePoolDestroy Procedure = 80
+	// ProcStoragePoolDelete is libvirt's REMOTE_PROC_STORAGE_POOL_DELETE
+	ProcStoragePoolDelete Procedure = 81
+	// ProcStoragePoolUndefine is libvirt's REMOTE_PROC_STORAGE_POOL_UNDEFINE
+	ProcStoragePoolUndefine Procedure = 82
+	// ProcStoragePoolRefresh is libvirt's REMOTE_PROC_STORAGE_POOL_REFRESH
+	ProcStoragePoolRefresh Procedure = 83
+	// ProcStoragePoolLookupByName is libvirt's REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_NAME
+	ProcStoragePoolLookupByName Procedure = 84
+	// ProcStoragePoolLookupByUUID is libvirt's REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_UUID
+	ProcStoragePoolLookupByUUID Procedure = 85
+	// ProcStoragePoolLookupByVolume is libvirt's REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_VOLUME
+	ProcStoragePoolLookupByVolume Procedure = 86
+	// ProcStoragePoolGetInfo is libvirt's REMOTE_PROC_STORAGE_POOL_GET_INFO
+	ProcStoragePoolGetInfo Procedure = 87
+	// ProcStoragePoolGetXMLDesc is libvirt's REMOTE_PROC_STORAGE_POOL_GET_XML_DESC
+	ProcStoragePoolGetXMLDesc Procedure = 88
+	// ProcStoragePoolGetAutostart is libvirt's REMOTE_PROC_STORAGE_POOL_GET_AUTOSTART
+	ProcStoragePoolGetAutostart Procedure = 89
+	// ProcStoragePoolSetAutostart is libvirt's REMOTE_PROC_STORAGE_POOL_SET_AUTOSTART
+	ProcStoragePoolSetAutostart Procedure = 90
+	// ProcStoragePoolNumOfVolumes is libvirt's REMOTE_PROC_STORAGE_POOL_NUM_OF_VOLUMES
+	ProcStoragePoolNumOfVolumes Procedure = 91
+	// ProcStoragePoolListVolumes is libvirt's REMOTE_PROC_STORAGE_POOL_LIST_VOLUMES
+	ProcStoragePoolListVolumes Procedure = 92
+	// ProcStorageVolCreateXML is libvirt's REMOTE_PROC_STORAGE_VOL_CREATE_XML
+	ProcStorageVolCreateXML Procedure = 93
+	// ProcStorageVolDelete is libvirt's REMOTE_PROC_STORAGE_VOL_DELETE
+	ProcStorageVolDelete Procedure = 94
+	// ProcStorageVolLookupByName is libvirt's REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_NAME
+	ProcStorageVolLookupByName Procedure = 95
+	// ProcStorageVolLookupByKey is libvirt's REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_KEY
+	ProcStorageVolLookupByKey Procedure = 96
+	// ProcStorageVolLookupByPath is libvirt's REMOTE_PROC_STORAGE_VOL_LOOKUP_BY_PATH
+	ProcStorageVolLookupByPath Procedure = 97
+	// ProcStorageVolGetInfo is libvirt's REMOTE_PROC_STORAGE_VOL_GET_INFO
+	ProcStorageVolGetInfo Procedure = 98
+	// ProcStorageVolGetXMLDesc is libvirt's REMOTE_PROC_STORAGE_VOL_GET_XML_DESC
+	ProcStorageVolGetXMLDesc Procedure = 99
+	// ProcStorageVolGetPath is libvirt's REMOTE_PROC_STORAGE_VOL_GET_PATH
+	ProcStorageVolGetPath Procedure = 100
+	// ProcNodeGetCellsFreeMemory is libvirt's REMOTE_PROC_NODE_GET_CELLS_FREE_MEMORY
+	ProcNodeGetCellsFreeMemory Procedure = 101
+	// ProcNodeGetFreeMemory is libvirt's REMOTE_PROC_NODE_GET_FREE_MEMORY
+	ProcNodeGetFreeMemory Procedure = 102
+	// ProcDomainBlockPeek is libvirt's REMOTE_PROC_DOMAIN_BLOCK_PEEK
+	ProcDomainBlockPeek Procedure = 103
+	// ProcDomainMemoryPeek is libvirt's REMOTE_PROC_DOMAIN_MEMORY_PEEK
+	ProcDomainMemoryPeek Procedure = 104
+	// ProcConnectDomainEventRegister is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_REGISTER
+	ProcConnectDomainEventRegister Procedure = 105
+	// ProcConnectDomainEventDeregister is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_DEREGISTER
+	ProcConnectDomainEventDeregister Procedure = 106
+	// ProcDomainEventLifecycle is libvirt's REMOTE_PROC_DOMAIN_EVENT_LIFECYCLE
+	ProcDomainEventLifecycle Procedure = 107
+	// ProcDomainMigratePrepare2 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE2
+	ProcDomainMigratePrepare2 Procedure = 108
+	// ProcDomainMigrateFinish2 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_FINISH2
+	ProcDomainMigrateFinish2 Procedure = 109
+	// ProcConnectGetUri is libvirt's REMOTE_PROC_CONNECT_GET_URI
+	ProcConnectGetUri Procedure = 110
+	// ProcNodeNumOfDevices is libvirt's REMOTE_PROC_NODE_NUM_OF_DEVICES
+	ProcNodeNumOfDevices Procedure = 111
+	// ProcNodeListDevices is libvirt's REMOTE_PROC_NODE_LIST_DEVICES
+	ProcNodeListDevices Procedure = 112
+	// ProcNodeDeviceLookupByName is libvirt's REMOTE_PROC_NODE_DEVICE_LOOKUP_BY_NAME
+	ProcNodeDeviceLookupByName Procedure = 113
+	// ProcNodeDeviceGetXMLDesc is libvirt's REMOTE_PROC_NODE_DEVICE_GET_XML_DESC
+	ProcNodeDeviceGetXMLDesc Procedure = 114
+	// ProcNodeDeviceGetParent is libvirt's REMOTE_PROC_NODE_DEVICE_GET_PARENT
+	ProcNodeDeviceGetParent Procedure = 115
+	// ProcNodeDeviceNumOfCaps is libvirt's REMOTE_PROC_NODE_DEVICE_NUM_OF_CAPS
+	ProcNodeDeviceNumOfCaps Procedure = 116
+	// ProcNodeDeviceListCaps is libvirt's REMOTE_PROC_NODE_DEVICE_LIST_CAPS
+	ProcNodeDeviceListCaps Procedure = 117
+	// ProcNodeDeviceDettach is libvirt's REMOTE_PROC_NODE_DEVICE_DETTACH
+	ProcNodeDeviceDettach Procedure = 118
+	// ProcNodeDeviceReAttach is libvirt's REMOTE_PROC_NODE_DEVICE_RE_ATTACH
+	ProcNodeDeviceReAttach Procedure = 119
+	// ProcNodeDeviceReset is libvirt's REMOTE_PROC_NODE_DEVICE_RESET
+	ProcNodeDeviceReset Procedure = 120
+	// ProcDomainGetSecurityLabel is libvirt's REMOTE_PROC_DOMAIN_GET_SECURITY_LABEL
+	ProcDomainGetSecurityLabel Procedure = 121
+	// ProcNodeGetSecurityModel is libvirt's REMOTE_PROC_NODE_GET_SECURITY_MODEL
+	ProcNodeGetSecurityModel Procedure = 122
+	// ProcNodeDeviceCreateXML is libvirt's REMOTE_PROC_NODE_DEVICE_CREATE_XML
+	ProcNodeDeviceCreateXML Procedure = 123
+	// ProcNodeDeviceDestroy is libvirt's REMOTE_PROC_NODE_DEVICE_DESTROY
+	ProcNodeDeviceDestroy Procedure = 124
+	// ProcStorageVolCreateXMLFrom is libvirt's REMOTE_PROC_STORAGE_VOL_CREATE_XML_FROM
+	ProcStorageVolCreateXMLFrom Procedure = 125
+	// ProcConnectNumOfInterfaces is libvirt's REMOTE_PROC_CONNECT_NUM_OF_INTERFACES
+	ProcConnectNumOfInterfaces Procedure = 126
+	// ProcConnectListInterfaces is libvirt's REMOTE_PROC_CONNECT_LIST_INTERFACES
+	ProcConnectListInterfaces Procedure = 127
+	// ProcInterfaceLookupByName is libvirt's REMOTE_PROC_INTERFACE_LOOKUP_BY_NAME
+	ProcInterfaceLookupByName Procedure = 128
+	// ProcInterfaceLookupByMacString is libvirt's REMOTE_PROC_INTERFACE_LOOKUP_BY_MAC_STRING
+	ProcInterfaceLookupByMacString Procedure = 129
+	// ProcInterfaceGetXMLDesc is libvirt's REMOTE_PROC_INTERFACE_GET_XML_DESC
+	ProcInterfaceGetXMLDesc Procedure = 130
+	// ProcInterfaceDefineXML is libvirt's REMOTE_PROC_INTERFACE_DEFINE_XML
+	ProcInterfaceDefineXML Procedure = 131
+	// ProcInterfaceUndefine is libvirt's REMOTE_PROC_INTERFACE_UNDEFINE
+	ProcInterfaceUndefine Procedure = 132
+	// ProcInterfaceCreate is libvirt's REMOTE_PROC_INTERFACE_CREATE
+	ProcInterfaceCreate Procedure = 133
+	// ProcInterfaceDestroy is libvirt's REMOTE_PROC_INTERFACE_DESTROY
+	ProcInterfaceDestroy Procedure = 134
+	// ProcConnectDomainXMLFromNative is libvirt's REMOTE_PROC_CONNECT_DOMAIN_XML_FROM_NATIVE
+	ProcConnectDomainXMLFromNative Procedure = 135
+	// ProcConnectDomainXMLToNative is libvirt's REMOTE_PROC_CONNECT_DOMAIN_XML_TO_NATIVE
+	ProcConnectDomainXMLToNative Procedure = 136
+	// ProcConnectNumOfDefinedInterfaces is libvirt's REMOTE_PROC_CONNECT_NUM_OF_DEFINED_INTERFACES
+	ProcConnectNumOfDefinedInterfaces Procedure = 137
+	// ProcConnectListDefinedInterfaces is libvirt's REMOTE_PROC_CONNECT_LIST_DEFINED_INTERFACES
+	ProcConnectListDefinedInterfaces Procedure = 138
+	// ProcConnectNumOfSecrets is libvirt's REMOTE_PROC_CONNECT_NUM_OF_SECRETS
+	ProcConnectNumOfSecrets Procedure = 139
+	// ProcConnectListSecrets is libvirt's REMOTE_PROC_CONNECT_LIST_SECRETS
+	ProcConnectListSecrets Procedure = 140
+	// ProcSecretLookupByUUID is libvirt's REMOTE_PROC_SECRET_LOOKUP_BY_UUID
+	ProcSecretLookupByUUID Procedure = 141
+	// ProcSecretDefineXML is libvirt's REMOTE_PROC_SECRET_DEFINE_XML
+	ProcSecretDefineXML Procedure = 142
+	// ProcSecretGetXMLDesc is libvirt's REMOTE_PROC_SECRET_GET_XML_DESC
+	ProcSecretGetXMLDesc Procedure = 143
+	// ProcSecretSetValue is libvirt's REMOTE_PROC_SECRET_SET_VALUE
+	ProcSecretSetValue Procedure = 144
+	// ProcSecretGetValue is libvirt's REMOTE_PROC_SECRET_GET_VALUE
+	ProcSecretGetValue Procedure = 145
+	// ProcSecretUndefine is libvirt's REMOTE_PROC_SECRET_UNDEFINE
+	ProcSecretUndefine Procedure = 146
+	// ProcSecretLookupByUsage is libvirt's REMOTE_PROC_SECRET_LOOKUP_BY_USAGE
+	ProcSecretLookupByUsage Procedure = 147
+	// ProcDomainMigratePrepareTunnel is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL
+	ProcDomainMigratePrepareTunnel Procedure = 148
+	// ProcConnectIsSecure is libvirt's REMOTE_PROC_CONNECT_IS_SECURE
+	ProcConnectIsSecure Procedure = 149
+	// ProcDomainIsActive is libvirt's REMOTE_PROC_DOMAIN_IS_ACTIVE
+	ProcDomainIsActive Procedure = 150
+	// ProcDomainIsPersistent is libvirt's REMOTE_PROC_DOMAIN_IS_PERSISTENT
+	ProcDomainIsPersistent Procedure = 151
+	// ProcNetworkIsActive is libvirt's REMOTE_PROC_NETWORK_IS_ACTIVE
+	ProcNetworkIsActive Procedure = 152
+	// ProcNetworkIsPersistent is libvirt's REMOTE_PROC_NETWORK_IS_PERSISTENT
+	ProcNetworkIsPersistent Procedure = 153
+	// ProcStoragePoolIsActive is libvirt's REMOTE_PROC_STORAGE_POOL_IS_ACTIVE
+	ProcStoragePoolIsActive Procedure = 154
+	// ProcStoragePoolIsPersistent is libvirt's REMOTE_PROC_STORAGE_POOL_IS_PERSISTENT
+	ProcStoragePoolIsPersistent Procedure = 155
+	// ProcInterfaceIsActive is libvirt's REMOTE_PROC_INTERFACE_IS_ACTIVE
+	ProcInterfaceIsActive Procedure = 156
+	// ProcConnectGetLibVersion is libvirt's REMOTE_PROC_CONNECT_GET_LIB_VERSION
+	ProcConnectGetLibVersion Procedure = 157
+	// ProcConnectCompareCPU is libvirt's REMOTE_PROC_CONNECT_COMPARE_CPU
+	ProcConnectCompareCPU Procedure = 158
+	// ProcDomainMemoryStats is libvirt's REMOTE_PROC_DOMAIN_MEMORY_STATS
+	ProcDomainMemoryStats Procedure = 159
+	// ProcDomainAttachDeviceFlags is libvirt's REMOTE_PROC_DOMAIN_ATTACH_DEVICE_FLAGS
+	ProcDomainAttachDeviceFlags Procedure = 160
+	// ProcDomainDetachDeviceFlags is libvirt's REMOTE_PROC_DOMAIN_DETACH_DEVICE_FLAGS
+	ProcDomainDetachDeviceFlags Procedure = 161
+	// ProcConnectBaselineCPU is libvirt's REMOTE_PROC_CONNECT_BASELINE_CPU
+	ProcConnectBaselineCPU Procedure = 162
+	// ProcDomainGetJobInfo is libvirt's REMOTE_PROC_DOMAIN_GET_JOB_INFO
+	ProcDomainGetJobInfo Procedure = 163
+	// ProcDomainAbortJob is libvirt's REMOTE_PROC_DOMAIN_ABORT_JOB
+	ProcDomainAbortJob Procedure = 164
+	// ProcStorageVolWipe is libvirt's REMOTE_PROC_STORAGE_VOL_WIPE
+	ProcStorageVolWipe Procedure = 165
+	// ProcDomainMigrateSetMaxDowntime is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_SET_MAX_DOWNTIME
+	ProcDomainMigrateSetMaxDowntime Procedure = 166
+	// ProcConnectDomainEventRegisterAny is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_REGISTER_ANY
+	ProcConnectDomainEventRegisterAny Procedure = 167
+	// ProcConnectDomainEventDeregisterAny is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_DEREGISTER_ANY
+	ProcConnectDomainEventDeregisterAny Procedure = 168
+	// ProcDomainEventReboot is libvirt's REMOTE_PROC_DOMAIN_EVENT_REBOOT
+	ProcDomainEventReboot Procedure = 169
+	// ProcDomainEventRtcChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_RTC_CHANGE
+	ProcDomainEventRtcChange Procedure = 170
+	// ProcDomainEventWatchdog is libvirt's REMOTE_PROC_DOMAIN_EVENT_WATCHDOG
+	ProcDomainEventWatchdog Procedure = 171
+	// ProcDomainEventIOError is libvirt's REMOTE_PROC_DOMAIN_EVENT_IO_ERROR
+	ProcDomainEventIOError Procedure = 172
+	// ProcDomainEventGraphics is libvirt's REMOTE_PROC_DOMAIN_EVENT_GRAPHICS
+	ProcDomainEventGraphics Procedure = 173
+	// ProcDomainUpdateDeviceFlags is libvirt's REMOTE_PROC_DOMAIN_UPDATE_DEVICE_FLAGS
+	ProcDomainUpdateDeviceFlags Procedure = 174
+	// ProcNwfilterLookupByName is libvirt's REMOTE_PROC_NWFILTER_LOOKUP_BY_NAME
+	ProcNwfilterLookupByName Procedure = 175
+	// ProcNwfilterLookupByUUID is libvirt's REMOTE_PROC_NWFILTER_LOOKUP_BY_UUID
+	ProcNwfilterLookupByUUID Procedure = 176
+	// ProcNwfilterGetXMLDesc is libvirt's REMOTE_PROC_NWFILTER_GET_XML_DESC
+	ProcNwfilterGetXMLDesc Procedure = 177
+	// ProcConnectNumOfNwfilters is libvirt's REMOTE_PROC_CONNECT_NUM_OF_NWFILTERS
+	ProcConnectNumOfNwfilters Procedure = 178
+	// ProcConnectListNwfilters is libvirt's REMOTE_PROC_CONNECT_LIST_NWFILTERS
+	ProcConnectListNwfilters Procedure = 179
+	// ProcNwfilterDefineXML is libvirt's REMOTE_PROC_NWFILTER_DEFINE_XML
+	ProcNwfilterDefineXML Procedure = 180
+	// ProcNwfilterUndefine is libvirt's REMOTE_PROC_NWFILTER_UNDEFINE
+	ProcNwfilterUndefine Procedure = 181
+	// ProcDomainManagedSave is libvirt's REMOTE_PROC_DOMAIN_MANAGED_SAVE
+	ProcDomainManagedSave Procedure = 182
+	// ProcDomainHasManagedSaveImage is libvirt's REMOTE_PROC_DOMAIN_HAS_MANAGED_SAVE_IMAGE
+	ProcDomainHasManagedSaveImage Procedure = 183
+	// ProcDomainManagedSaveRemove is libvirt's REMOTE_PROC_DOMAIN_MANAGED_SAVE_REMOVE
+	ProcDomainManagedSaveRemove Procedure = 184
+	// ProcDomainSnapshotCreateXML is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_CREATE_XML
+	ProcDomainSnapshotCreateXML Procedure = 185
+	// ProcDomainSnapshotGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_GET_XML_DESC
+	ProcDomainSnapshotGetXMLDesc Procedure = 186
+	// ProcDomainSnapshotNum is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_NUM
+	ProcDomainSnapshotNum Procedure = 187
+	// ProcDomainSnapshotListNames is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_NAMES
+	ProcDomainSnapshotListNames Procedure = 188
+	// ProcDomainSnapshotLookupByName is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_LOOKUP_BY_NAME
+	ProcDomainSnapshotLookupByName Procedure = 189
+	// ProcDomainHasCurrentSnapshot is libvirt's REMOTE_PROC_DOMAIN_HAS_CURRENT_SNAPSHOT
+	ProcDomainHasCurrentSnapshot Procedure = 190
+	// ProcDomainSnapshotCurrent is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_CURRENT
+	ProcDomainSnapshotCurrent Procedure = 191
+	// ProcDomainRevertToSnapshot is libvirt's REMOTE_PROC_DOMAIN_REVERT_TO_SNAPSHOT
+	ProcDomainRevertToSnapshot Procedure = 192
+	// ProcDomainSnapshotDelete is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_DELETE
+	ProcDomainSnapshotDelete Procedure = 193
+	// ProcDomainGetBlockInfo is libvirt's REMOTE_PROC_DOMAIN_GET_BLOCK_INFO
+	ProcDomainGetBlockInfo Procedure = 194
+	// ProcDomainEventIOErrorReason is libvirt's REMOTE_PROC_DOMAIN_EVENT_IO_ERROR_REASON
+	ProcDomainEventIOErrorReason Procedure = 195
+	// ProcDomainCreateWithFlags is libvirt's REMOTE_PROC_DOMAIN_CREATE_WITH_FLAGS
+	ProcDomainCreateWithFlags Procedure = 196
+	// ProcDomainSetMemoryParameters is libvirt's REMOTE_PROC_DOMAIN_SET_MEMORY_PARAMETERS
+	ProcDomainSetMemoryParameters Procedure = 197
+	// ProcDomainGetMemoryParameters is libvirt's REMOTE_PROC_DOMAIN_GET_MEMORY_PARAMETERS
+	ProcDomainGetMemoryParameters Procedure = 198
+	// ProcDomainSetVcpusFlags is libvirt's REMOTE_PROC_DOMAIN_SET_VCPUS_FLAGS
+	ProcDomainSetVcpusFlags Procedure = 199
+	// ProcDomainGetVcpusFlags is libvirt's REMOTE_PROC_DOMAIN_GET_VCPUS_FLAGS
+	ProcDomainGetVcpusFlags Procedure = 200
+	// ProcDomainOpenConsole is libvirt's REMOTE_PROC_DOMAIN_OPEN_CONSOLE
+	ProcDomainOpenConsole Procedure = 201
+	// ProcDomainIsUpdated is libvirt's REMOTE_PROC_DOMAIN_IS_UPDATED
+	ProcDomainIsUpdated Procedure = 202
+	// ProcConnectGetSysinfo is libvirt's REMOTE_PROC_CONNECT_GET_SYSINFO
+	ProcConnectGetSysinfo Procedure = 203
+	// ProcDomainSetMemoryFlags is libvirt's REMOTE_PROC_DOMAIN_SET_MEMORY_FLAGS
+	ProcDomainSetMemoryFlags Procedure = 204
+	// ProcDomainSetBlkioParameters is libvirt's REMOTE_PROC_DOMAIN_SET_BLKIO_PARAMETERS
+	ProcDomainSetBlkioParameters Procedure = 205
+	// ProcDomainGetBlkioParameters is libvirt's REMOTE_PROC_DOMAIN_GET_BLKIO_PARAMETERS
+	ProcDomainGetBlkioParameters Procedure = 206
+	// ProcDomainMigrateSetMaxSpeed is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_SET_MAX_SPEED
+	ProcDomainMigrateSetMaxSpeed Procedure = 207
+	// ProcStorageVolUpload is libvirt's REMOTE_PROC_STORAGE_VOL_UPLOAD
+	ProcStorageVolUpload Procedure = 208
+	// ProcStorageVolDownload is libvirt's REMOTE_PROC_STORAGE_VOL_DOWNLOAD
+	ProcStorageVolDownload Procedure = 209
+	// ProcDomainInjectNmi is libvirt's REMOTE_PROC_DOMAIN_INJECT_NMI
+	ProcDomainInjectNmi Procedure = 210
+	// ProcDomainScreenshot is libvirt's REMOTE_PROC_DOMAIN_SCREENSHOT
+	ProcDomainScreenshot Procedure = 211
+	// ProcDomainGetState is libvirt's REMOTE_PROC_DOMAIN_GET_STATE
+	ProcDomainGetState Procedure = 212
+	// ProcDomainMigrateBegin3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_BEGIN3
+	ProcDomainMigrateBegin3 Procedure = 213
+	// ProcDomainMigratePrepare3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE3
+	ProcDomainMigratePrepare3 Procedure = 214
+	// ProcDomainMigratePrepareTunnel3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL3
+	ProcDomainMigratePrepareTunnel3 Procedure = 215
+	// ProcDomainMigratePerform3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PERFORM3
+	ProcDomainMigratePerform3 Procedure = 216
+	// ProcDomainMigrateFinish3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_FINISH3
+	ProcDomainMigrateFinish3 Procedure = 217
+	// ProcDomainMigrateConfirm3 is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_CONFIRM3
+	ProcDomainMigrateConfirm3 Procedure = 218
+	// ProcDomainSetSchedulerParametersFlags is libvirt's REMOTE_PROC_DOMAIN_SET_SCHEDULER_PARAMETERS_FLAGS
+	ProcDomainSetSchedulerParametersFlags Procedure = 219
+	// ProcInterfaceChangeBegin is libvirt's REMOTE_PROC_INTERFACE_CHANGE_BEGIN
+	ProcInterfaceChangeBegin Procedure = 220
+	// ProcInterfaceChangeCommit is libvirt's REMOTE_PROC_INTERFACE_CHANGE_COMMIT
+	ProcInterfaceChangeCommit Procedure = 221
+	// ProcInterfaceChangeRollback is libvirt's REMOTE_PROC_INTERFACE_CHANGE_ROLLBACK
+	ProcInterfaceChangeRollback Procedure = 222
+	// ProcDomainGetSchedulerParametersFlags is libvirt's REMOTE_PROC_DOMAIN_GET_SCHEDULER_PARAMETERS_FLAGS
+	ProcDomainGetSchedulerParametersFlags Procedure = 223
+	// ProcDomainEventControlError is libvirt's REMOTE_PROC_DOMAIN_EVENT_CONTROL_ERROR
+	ProcDomainEventControlError Procedure = 224
+	// ProcDomainPinVcpuFlags is libvirt's REMOTE_PROC_DOMAIN_PIN_VCPU_FLAGS
+	ProcDomainPinVcpuFlags Procedure = 225
+	// ProcDomainSendKey is libvirt's REMOTE_PROC_DOMAIN_SEND_KEY
+	ProcDomainSendKey Procedure = 226
+	// ProcNodeGetCPUStats is libvirt's REMOTE_PROC_NODE_GET_CPU_STATS
+	ProcNodeGetCPUStats Procedure = 227
+	// ProcNodeGetMemoryStats is libvirt's REMOTE_PROC_NODE_GET_MEMORY_STATS
+	ProcNodeGetMemoryStats Procedure = 228
+	// ProcDomainGetControlInfo is libvirt's REMOTE_PROC_DOMAIN_GET_CONTROL_INFO
+	ProcDomainGetControlInfo Procedure = 229
+	// ProcDomainGetVcpuPinInfo is libvirt's REMOTE_PROC_DOMAIN_GET_VCPU_PIN_INFO
+	ProcDomainGetVcpuPinInfo Procedure = 230
+	// ProcDomainUndefineFlags is libvirt's REMOTE_PROC_DOMAIN_UNDEFINE_FLAGS
+	ProcDomainUndefineFlags Procedure = 231
+	// ProcDomainSaveFlags is libvirt's REMOTE_PROC_DOMAIN_SAVE_FLAGS
+	ProcDomainSaveFlags Procedure = 232
+	// ProcDomainRestoreFlags is libvirt's REMOTE_PROC_DOMAIN_RESTORE_FLAGS
+	ProcDomainRestoreFlags Procedure = 233
+	// ProcDomainDestroyFlags is libvirt's REMOTE_PROC_DOMAIN_DESTROY_FLAGS
+	ProcDomainDestroyFlags Procedure = 234
+	// ProcDomainSaveImageGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_SAVE_IMAGE_GET_XML_DESC
+	ProcDomainSaveImageGetXMLDesc Procedure = 235
+	// ProcDomainSaveImageDefineXML is libvirt's REMOTE_PROC_DOMAIN_SAVE_IMAGE_DEFINE_XML
+	ProcDomainSaveImageDefineXML Procedure = 236
+	// ProcDomainBlockJobAbort is libvirt's REMOTE_PROC_DOMAIN_BLOCK_JOB_ABORT
+	ProcDomainBlockJobAbort Procedure = 237
+	// ProcDomainGetBlockJobInfo is libvirt's REMOTE_PROC_DOMAIN_GET_BLOCK_JOB_INFO
+	ProcDomainGetBlockJobInfo Procedure = 238
+	// ProcDomainBlockJobSetSpeed is libvirt's REMOTE_PROC_DOMAIN_BLOCK_JOB_SET_SPEED
+	ProcDomainBlockJobSetSpeed Procedure = 239
+	// ProcDomainBlockPull is libvirt's REMOTE_PROC_DOMAIN_BLOCK_PULL
+	ProcDomainBlockPull Procedure = 240
+	// ProcDomainEventBlockJob is libvirt's REMOTE_PROC_DOMAIN_EVENT_BLOCK_JOB
+	ProcDomainEventBlockJob Procedure = 241
+	// ProcDomainMigrateGetMaxSpeed is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_GET_MAX_SPEED
+	ProcDomainMigrateGetMaxSpeed Procedure = 242
+	// ProcDomainBlockStatsFlags is libvirt's REMOTE_PROC_DOMAIN_BLOCK_STATS_FLAGS
+	ProcDomainBlockStatsFlags Procedure = 243
+	// ProcDomainSnapshotGetParent is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_GET_PARENT
+	ProcDomainSnapshotGetParent Procedure = 244
+	// ProcDomainReset is libvirt's REMOTE_PROC_DOMAIN_RESET
+	ProcDomainReset Procedure = 245
+	// ProcDomainSnapshotNumChildren is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_NUM_CHILDREN
+	ProcDomainSnapshotNumChildren Procedure = 246
+	// ProcDomainSnapshotListChildrenNames is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_CHILDREN_NAMES
+	ProcDomainSnapshotListChildrenNames Procedure = 247
+	// ProcDomainEventDiskChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_DISK_CHANGE
+	ProcDomainEventDiskChange Procedure = 248
+	// ProcDomainOpenGraphics is libvirt's REMOTE_PROC_DOMAIN_OPEN_GRAPHICS
+	ProcDomainOpenGraphics Procedure = 249
+	// ProcNodeSuspendForDuration is libvirt's REMOTE_PROC_NODE_SUSPEND_FOR_DURATION
+	ProcNodeSuspendForDuration Procedure = 250
+	// ProcDomainBlockResize is libvirt's REMOTE_PROC_DOMAIN_BLOCK_RESIZE
+	ProcDomainBlockResize Procedure = 251
+	// ProcDomainSetBlockIOTune is libvirt's REMOTE_PROC_DOMAIN_SET_BLOCK_IO_TUNE
+	ProcDomainSetBlockIOTune Procedure = 252
+	// ProcDomainGetBlockIOTune is libvirt's REMOTE_PROC_DOMAIN_GET_BLOCK_IO_TUNE
+	ProcDomainGetBlockIOTune Procedure = 253
+	// ProcDomainSetNumaParameters is libvirt's REMOTE_PROC_DOMAIN_SET_NUMA_PARAMETERS
+	ProcDomainSetNumaParameters Procedure = 254
+	// ProcDomainGetNumaParameters is libvirt's REMOTE_PROC_DOMAIN_GET_NUMA_PARAMETERS
+	ProcDomainGetNumaParameters Procedure = 255
+	// ProcDomainSetInterfaceParameters is libvirt's REMOTE_PROC_DOMAIN_SET_INTERFACE_PARAMETERS
+	ProcDomainSetInterfaceParameters Procedure = 256
+	// ProcDomainGetInterfaceParameters is libvirt's REMOTE_PROC_DOMAIN_GET_INTERFACE_PARAMETERS
+	ProcDomainGetInterfaceParameters Procedure = 257
+	// ProcDomainShutdownFlags is libvirt's REMOTE_PROC_DOMAIN_SHUTDOWN_FLAGS
+	ProcDomainShutdownFlags Procedure = 258
+	// ProcStorageVolWipePattern is libvirt's REMOTE_PROC_STORAGE_VOL_WIPE_PATTERN
+	ProcStorageVolWipePattern Procedure = 259
+	// ProcStorageVolResize is libvirt's REMOTE_PROC_STORAGE_VOL_RESIZE
+	ProcStorageVolResize Procedure = 260
+	// ProcDomainPmSuspendForDuration is libvirt's REMOTE_PROC_DOMAIN_PM_SUSPEND_FOR_DURATION
+	ProcDomainPmSuspendForDuration Procedure = 261
+	// ProcDomainGetCPUStats is libvirt's REMOTE_PROC_DOMAIN_GET_CPU_STATS
+	ProcDomainGetCPUStats Procedure = 262
+	// ProcDomainGetDiskErrors is libvirt's REMOTE_PROC_DOMAIN_GET_DISK_ERRORS
+	ProcDomainGetDiskErrors Procedure = 263
+	// ProcDomainSetMetadata is libvirt's REMOTE_PROC_DOMAIN_SET_METADATA
+	ProcDomainSetMetadata Procedure = 264
+	// ProcDomainGetMetadata is libvirt's REMOTE_PROC_DOMAIN_GET_METADATA
+	ProcDomainGetMetadata Procedure = 265
+	// ProcDomainBlockRebase is libvirt's REMOTE_PROC_DOMAIN_BLOCK_REBASE
+	ProcDomainBlockRebase Procedure = 266
+	// ProcDomainPmWakeup is libvirt's REMOTE_PROC_DOMAIN_PM_WAKEUP
+	ProcDomainPmWakeup Procedure = 267
+	// ProcDomainEventTrayChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_TRAY_CHANGE
+	ProcDomainEventTrayChange Procedure = 268
+	// ProcDomainEventPmwakeup is libvirt's REMOTE_PROC_DOMAIN_EVENT_PMWAKEUP
+	ProcDomainEventPmwakeup Procedure = 269
+	// ProcDomainEventPmsuspend is libvirt's REMOTE_PROC_DOMAIN_EVENT_PMSUSPEND
+	ProcDomainEventPmsuspend Procedure = 270
+	// ProcDomainSnapshotIsCurrent is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_IS_CURRENT
+	ProcDomainSnapshotIsCurrent Procedure = 271
+	// ProcDomainSnapshotHasMetadata is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_HAS_METADATA
+	ProcDomainSnapshotHasMetadata Procedure = 272
+	// ProcConnectListAllDomains is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_DOMAINS
+	ProcConnectListAllDomains Procedure = 273
+	// ProcDomainListAllSnapshots is libvirt's REMOTE_PROC_DOMAIN_LIST_ALL_SNAPSHOTS
+	ProcDomainListAllSnapshots Procedure = 274
+	// ProcDomainSnapshotListAllChildren is libvirt's REMOTE_PROC_DOMAIN_SNAPSHOT_LIST_ALL_CHILDREN
+	ProcDomainSnapshotListAllChildren Procedure = 275
+	// ProcDomainEventBalloonChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_BALLOON_CHANGE
+	ProcDomainEventBalloonChange Procedure = 276
+	// ProcDomainGetHostname is libvirt's REMOTE_PROC_DOMAIN_GET_HOSTNAME
+	ProcDomainGetHostname Procedure = 277
+	// ProcDomainGetSecurityLabelList is libvirt's REMOTE_PROC_DOMAIN_GET_SECURITY_LABEL_LIST
+	ProcDomainGetSecurityLabelList Procedure = 278
+	// ProcDomainPinEmulator is libvirt's REMOTE_PROC_DOMAIN_PIN_EMULATOR
+	ProcDomainPinEmulator Procedure = 279
+	// ProcDomainGetEmulatorPinInfo is libvirt's REMOTE_PROC_DOMAIN_GET_EMULATOR_PIN_INFO
+	ProcDomainGetEmulatorPinInfo Procedure = 280
+	// ProcConnectListAllStoragePools is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_STORAGE_POOLS
+	ProcConnectListAllStoragePools Procedure = 281
+	// ProcStoragePoolListAllVolumes is libvirt's REMOTE_PROC_STORAGE_POOL_LIST_ALL_VOLUMES
+	ProcStoragePoolListAllVolumes Procedure = 282
+	// ProcConnectListAllNetworks is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_NETWORKS
+	ProcConnectListAllNetworks Procedure = 283
+	// ProcConnectListAllInterfaces is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_INTERFACES
+	ProcConnectListAllInterfaces Procedure = 284
+	// ProcConnectListAllNodeDevices is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_NODE_DEVICES
+	ProcConnectListAllNodeDevices Procedure = 285
+	// ProcConnectListAllNwfilters is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_NWFILTERS
+	ProcConnectListAllNwfilters Procedure = 286
+	// ProcConnectListAllSecrets is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_SECRETS
+	ProcConnectListAllSecrets Procedure = 287
+	// ProcNodeSetMemoryParameters is libvirt's REMOTE_PROC_NODE_SET_MEMORY_PARAMETERS
+	ProcNodeSetMemoryParameters Procedure = 288
+	// ProcNodeGetMemoryParameters is libvirt's REMOTE_PROC_NODE_GET_MEMORY_PARAMETERS
+	ProcNodeGetMemoryParameters Procedure = 289
+	// ProcDomainBlockCommit is libvirt's REMOTE_PROC_DOMAIN_BLOCK_COMMIT
+	ProcDomainBlockCommit Procedure = 290
+	// ProcNetworkUpdate is libvirt's REMOTE_PROC_NETWORK_UPDATE
+	ProcNetworkUpdate Procedure = 291
+	// ProcDomainEventPmsuspendDisk is libvirt's REMOTE_PROC_DOMAIN_EVENT_PMSUSPEND_DISK
+	ProcDomainEventPmsuspendDisk Procedure = 292
+	// ProcNodeGetCPUMap is libvirt's REMOTE_PROC_NODE_GET_CPU_MAP
+	ProcNodeGetCPUMap Procedure = 293
+	// ProcDomainFstrim is libvirt's REMOTE_PROC_DOMAIN_FSTRIM
+	ProcDomainFstrim Procedure = 294
+	// ProcDomainSendProcessSignal is libvirt's REMOTE_PROC_DOMAIN_SEND_PROCESS_SIGNAL
+	ProcDomainSendProcessSignal Procedure = 295
+	// ProcDomainOpenChannel is libvirt's REMOTE_PROC_DOMAIN_OPEN_CHANNEL
+	ProcDomainOpenChannel Procedure = 296
+	// ProcNodeDeviceLookupScsiHostByWwn is libvirt's REMOTE_PROC_NODE_DEVICE_LOOKUP_SCSI_HOST_BY_WWN
+	ProcNodeDeviceLookupScsiHostByWwn Procedure = 297
+	// ProcDomainGetJobStats is libvirt's REMOTE_PROC_DOMAIN_GET_JOB_STATS
+	ProcDomainGetJobStats Procedure = 298
+	// ProcDomainMigrateGetCompressionCache is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_GET_COMPRESSION_CACHE
+	ProcDomainMigrateGetCompressionCache Procedure = 299
+	// ProcDomainMigrateSetCompressionCache is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_SET_COMPRESSION_CACHE
+	ProcDomainMigrateSetCompressionCache Procedure = 300
+	// ProcNodeDeviceDetachFlags is libvirt's REMOTE_PROC_NODE_DEVICE_DETACH_FLAGS
+	ProcNodeDeviceDetachFlags Procedure = 301
+	// ProcDomainMigrateBegin3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_BEGIN3_PARAMS
+	ProcDomainMigrateBegin3Params Procedure = 302
+	// ProcDomainMigratePrepare3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE3_PARAMS
+	ProcDomainMigratePrepare3Params Procedure = 303
+	// ProcDomainMigratePrepareTunnel3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PREPARE_TUNNEL3_PARAMS
+	ProcDomainMigratePrepareTunnel3Params Procedure = 304
+	// ProcDomainMigratePerform3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_PERFORM3_PARAMS
+	ProcDomainMigratePerform3Params Procedure = 305
+	// ProcDomainMigrateFinish3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_FINISH3_PARAMS
+	ProcDomainMigrateFinish3Params Procedure = 306
+	// ProcDomainMigrateConfirm3Params is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_CONFIRM3_PARAMS
+	ProcDomainMigrateConfirm3Params Procedure = 307
+	// ProcDomainSetMemoryStatsPeriod is libvirt's REMOTE_PROC_DOMAIN_SET_MEMORY_STATS_PERIOD
+	ProcDomainSetMemoryStatsPeriod Procedure = 308
+	// ProcDomainCreateXMLWithFiles is libvirt's REMOTE_PROC_DOMAIN_CREATE_XML_WITH_FILES
+	ProcDomainCreateXMLWithFiles Procedure = 309
+	// ProcDomainCreateWithFiles is libvirt's REMOTE_PROC_DOMAIN_CREATE_WITH_FILES
+	ProcDomainCreateWithFiles Procedure = 310
+	// ProcDomainEventDeviceRemoved is libvirt's REMOTE_PROC_DOMAIN_EVENT_DEVICE_REMOVED
+	ProcDomainEventDeviceRemoved Procedure = 311
+	// ProcConnectGetCPUModelNames is libvirt's REMOTE_PROC_CONNECT_GET_CPU_MODEL_NAMES
+	ProcConnectGetCPUModelNames Procedure = 312
+	// ProcConnectNetworkEventRegisterAny is libvirt's REMOTE_PROC_CONNECT_NETWORK_EVENT_REGISTER_ANY
+	ProcConnectNetworkEventRegisterAny Procedure = 313
+	// ProcConnectNetworkEventDeregisterAny is libvirt's REMOTE_PROC_CONNECT_NETWORK_EVENT_DEREGISTER_ANY
+	ProcConnectNetworkEventDeregisterAny Procedure = 314
+	// ProcNetworkEventLifecycle is libvirt's REMOTE_PROC_NETWORK_EVENT_LIFECYCLE
+	ProcNetworkEventLifecycle Procedure = 315
+	// ProcConnectDomainEventCallbackRegisterAny is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_CALLBACK_REGISTER_ANY
+	ProcConnectDomainEventCallbackRegisterAny Procedure = 316
+	// ProcConnectDomainEventCallbackDeregisterAny is libvirt's REMOTE_PROC_CONNECT_DOMAIN_EVENT_CALLBACK_DEREGISTER_ANY
+	ProcConnectDomainEventCallbackDeregisterAny Procedure = 317
+	// ProcDomainEventCallbackLifecycle is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_LIFECYCLE
+	ProcDomainEventCallbackLifecycle Procedure = 318
+	// ProcDomainEventCallbackReboot is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_REBOOT
+	ProcDomainEventCallbackReboot Procedure = 319
+	// ProcDomainEventCallbackRtcChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_RTC_CHANGE
+	ProcDomainEventCallbackRtcChange Procedure = 320
+	// ProcDomainEventCallbackWatchdog is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_WATCHDOG
+	ProcDomainEventCallbackWatchdog Procedure = 321
+	// ProcDomainEventCallbackIOError is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_IO_ERROR
+	ProcDomainEventCallbackIOError Procedure = 322
+	// ProcDomainEventCallbackGraphics is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_GRAPHICS
+	ProcDomainEventCallbackGraphics Procedure = 323
+	// ProcDomainEventCallbackIOErrorReason is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_IO_ERROR_REASON
+	ProcDomainEventCallbackIOErrorReason Procedure = 324
+	// ProcDomainEventCallbackControlError is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_CONTROL_ERROR
+	ProcDomainEventCallbackControlError Procedure = 325
+	// ProcDomainEventCallbackBlockJob is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_BLOCK_JOB
+	ProcDomainEventCallbackBlockJob Procedure = 326
+	// ProcDomainEventCallbackDiskChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DISK_CHANGE
+	ProcDomainEventCallbackDiskChange Procedure = 327
+	// ProcDomainEventCallbackTrayChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_TRAY_CHANGE
+	ProcDomainEventCallbackTrayChange Procedure = 328
+	// ProcDomainEventCallbackPmwakeup is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMWAKEUP
+	ProcDomainEventCallbackPmwakeup Procedure = 329
+	// ProcDomainEventCallbackPmsuspend is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMSUSPEND
+	ProcDomainEventCallbackPmsuspend Procedure = 330
+	// ProcDomainEventCallbackBalloonChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_BALLOON_CHANGE
+	ProcDomainEventCallbackBalloonChange Procedure = 331
+	// ProcDomainEventCallbackPmsuspendDisk is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_PMSUSPEND_DISK
+	ProcDomainEventCallbackPmsuspendDisk Procedure = 332
+	// ProcDomainEventCallbackDeviceRemoved is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_REMOVED
+	ProcDomainEventCallbackDeviceRemoved Procedure = 333
+	// ProcDomainCoreDumpWithFormat is libvirt's REMOTE_PROC_DOMAIN_CORE_DUMP_WITH_FORMAT
+	ProcDomainCoreDumpWithFormat Procedure = 334
+	// ProcDomainFsfreeze is libvirt's REMOTE_PROC_DOMAIN_FSFREEZE
+	ProcDomainFsfreeze Procedure = 335
+	// ProcDomainFsthaw is libvirt's REMOTE_PROC_DOMAIN_FSTHAW
+	ProcDomainFsthaw Procedure = 336
+	// ProcDomainGetTime is libvirt's REMOTE_PROC_DOMAIN_GET_TIME
+	ProcDomainGetTime Procedure = 337
+	// ProcDomainSetTime is libvirt's REMOTE_PROC_DOMAIN_SET_TIME
+	ProcDomainSetTime Procedure = 338
+	// ProcDomainEventBlockJob2 is libvirt's REMOTE_PROC_DOMAIN_EVENT_BLOCK_JOB_2
+	ProcDomainEventBlockJob2 Procedure = 339
+	// ProcNodeGetFreePages is libvirt's REMOTE_PROC_NODE_GET_FREE_PAGES
+	ProcNodeGetFreePages Procedure = 340
+	// ProcNetworkGetDhcpLeases is libvirt's REMOTE_PROC_NETWORK_GET_DHCP_LEASES
+	ProcNetworkGetDhcpLeases Procedure = 341
+	// ProcConnectGetDomainCapabilities is libvirt's REMOTE_PROC_CONNECT_GET_DOMAIN_CAPABILITIES
+	ProcConnectGetDomainCapabilities Procedure = 342
+	// ProcDomainOpenGraphicsFd is libvirt's REMOTE_PROC_DOMAIN_OPEN_GRAPHICS_FD
+	ProcDomainOpenGraphicsFd Procedure = 343
+	// ProcConnectGetAllDomainStats is libvirt's REMOTE_PROC_CONNECT_GET_ALL_DOMAIN_STATS
+	ProcConnectGetAllDomainStats Procedure = 344
+	// ProcDomainBlockCopy is libvirt's REMOTE_PROC_DOMAIN_BLOCK_COPY
+	ProcDomainBlockCopy Procedure = 345
+	// ProcDomainEventCallbackTunable is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_TUNABLE
+	ProcDomainEventCallbackTunable Procedure = 346
+	// ProcNodeAllocPages is libvirt's REMOTE_PROC_NODE_ALLOC_PAGES
+	ProcNodeAllocPages Procedure = 347
+	// ProcDomainEventCallbackAgentLifecycle is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_AGENT_LIFECYCLE
+	ProcDomainEventCallbackAgentLifecycle Procedure = 348
+	// ProcDomainGetFsinfo is libvirt's REMOTE_PROC_DOMAIN_GET_FSINFO
+	ProcDomainGetFsinfo Procedure = 349
+	// ProcDomainDefineXMLFlags is libvirt's REMOTE_PROC_DOMAIN_DEFINE_XML_FLAGS
+	ProcDomainDefineXMLFlags Procedure = 350
+	// ProcDomainGetIothreadInfo is libvirt's REMOTE_PROC_DOMAIN_GET_IOTHREAD_INFO
+	ProcDomainGetIothreadInfo Procedure = 351
+	// ProcDomainPinIothread is libvirt's REMOTE_PROC_DOMAIN_PIN_IOTHREAD
+	ProcDomainPinIothread Procedure = 352
+	// ProcDomainInterfaceAddresses is libvirt's REMOTE_PROC_DOMAIN_INTERFACE_ADDRESSES
+	ProcDomainInterfaceAddresses Procedure = 353
+	// ProcDomainEventCallbackDeviceAdded is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_ADDED
+	ProcDomainEventCallbackDeviceAdded Procedure = 354
+	// ProcDomainAddIothread is libvirt's REMOTE_PROC_DOMAIN_ADD_IOTHREAD
+	ProcDomainAddIothread Procedure = 355
+	// ProcDomainDelIothread is libvirt's REMOTE_PROC_DOMAIN_DEL_IOTHREAD
+	ProcDomainDelIothread Procedure = 356
+	// ProcDomainSetUserPassword is libvirt's REMOTE_PROC_DOMAIN_SET_USER_PASSWORD
+	ProcDomainSetUserPassword Procedure = 357
+	// ProcDomainRename is libvirt's REMOTE_PROC_DOMAIN_RENAME
+	ProcDomainRename Procedure = 358
+	// ProcDomainEventCallbackMigrationIteration is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_MIGRATION_ITERATION
+	ProcDomainEventCallbackMigrationIteration Procedure = 359
+	// ProcConnectRegisterCloseCallback is libvirt's REMOTE_PROC_CONNECT_REGISTER_CLOSE_CALLBACK
+	ProcConnectRegisterCloseCallback Procedure = 360
+	// ProcConnectUnregisterCloseCallback is libvirt's REMOTE_PROC_CONNECT_UNREGISTER_CLOSE_CALLBACK
+	ProcConnectUnregisterCloseCallback Procedure = 361
+	// ProcConnectEventConnectionClosed is libvirt's REMOTE_PROC_CONNECT_EVENT_CONNECTION_CLOSED
+	ProcConnectEventConnectionClosed Procedure = 362
+	// ProcDomainEventCallbackJobCompleted is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_JOB_COMPLETED
+	ProcDomainEventCallbackJobCompleted Procedure = 363
+	// ProcDomainMigrateStartPostCopy is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_START_POST_COPY
+	ProcDomainMigrateStartPostCopy Procedure = 364
+	// ProcDomainGetPerfEvents is libvirt's REMOTE_PROC_DOMAIN_GET_PERF_EVENTS
+	ProcDomainGetPerfEvents Procedure = 365
+	// ProcDomainSetPerfEvents is libvirt's REMOTE_PROC_DOMAIN_SET_PERF_EVENTS
+	ProcDomainSetPerfEvents Procedure = 366
+	// ProcDomainEventCallbackDeviceRemovalFailed is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_DEVICE_REMOVAL_FAILED
+	ProcDomainEventCallbackDeviceRemovalFailed Procedure = 367
+	// ProcConnectStoragePoolEventRegisterAny is libvirt's REMOTE_PROC_CONNECT_STORAGE_POOL_EVENT_REGISTER_ANY
+	ProcConnectStoragePoolEventRegisterAny Procedure = 368
+	// ProcConnectStoragePoolEventDeregisterAny is libvirt's REMOTE_PROC_CONNECT_STORAGE_POOL_EVENT_DEREGISTER_ANY
+	ProcConnectStoragePoolEventDeregisterAny Procedure = 369
+	// ProcStoragePoolEventLifecycle is libvirt's REMOTE_PROC_STORAGE_POOL_EVENT_LIFECYCLE
+	ProcStoragePoolEventLifecycle Procedure = 370
+	// ProcDomainGetGuestVcpus is libvirt's REMOTE_PROC_DOMAIN_GET_GUEST_VCPUS
+	ProcDomainGetGuestVcpus Procedure = 371
+	// ProcDomainSetGuestVcpus is libvirt's REMOTE_PROC_DOMAIN_SET_GUEST_VCPUS
+	ProcDomainSetGuestVcpus Procedure = 372
+	// ProcStoragePoolEventRefresh is libvirt's REMOTE_PROC_STORAGE_POOL_EVENT_REFRESH
+	ProcStoragePoolEventRefresh Procedure = 373
+	// ProcConnectNodeDeviceEventRegisterAny is libvirt's REMOTE_PROC_CONNECT_NODE_DEVICE_EVENT_REGISTER_ANY
+	ProcConnectNodeDeviceEventRegisterAny Procedure = 374
+	// ProcConnectNodeDeviceEventDeregisterAny is libvirt's REMOTE_PROC_CONNECT_NODE_DEVICE_EVENT_DEREGISTER_ANY
+	ProcConnectNodeDeviceEventDeregisterAny Procedure = 375
+	// ProcNodeDeviceEventLifecycle is libvirt's REMOTE_PROC_NODE_DEVICE_EVENT_LIFECYCLE
+	ProcNodeDeviceEventLifecycle Procedure = 376
+	// ProcNodeDeviceEventUpdate is libvirt's REMOTE_PROC_NODE_DEVICE_EVENT_UPDATE
+	ProcNodeDeviceEventUpdate Procedure = 377
+	// ProcStorageVolGetInfoFlags is libvirt's REMOTE_PROC_STORAGE_VOL_GET_INFO_FLAGS
+	ProcStorageVolGetInfoFlags Procedure = 378
+	// ProcDomainEventCallbackMetadataChange is libvirt's REMOTE_PROC_DOMAIN_EVENT_CALLBACK_METADATA_CHANGE
+	ProcDomainEventCallbackMetadataChange Procedure = 379
+	// ProcConnectSecretEventRegisterAny is libvirt's REMOTE_PROC_CONNECT_SECRET_EVENT_REGISTER_ANY
+	ProcConnectSecretEventRegisterAny Procedure = 380
+	// ProcConnectSecretEventDeregisterAny is libvirt's REMOTE_PROC_CONNECT_SECRET_EVENT_DEREGISTER_ANY
+	ProcConnectSecretEventDeregisterAny Procedure = 381
+	// ProcSecretEventLifecycle is libvirt's REMOTE_PROC_SECRET_EVENT_LIFECYCLE
+	ProcSecretEventLifecycle Procedure = 382
+	// ProcSecretEventValueChanged is libvirt's REMOTE_PROC_SECRET_EVENT_VALUE_CHANGED
+	ProcSecretEventValueChanged Procedure = 383
+	// ProcDomainSetVcpu is libvirt's REMOTE_PROC_DOMAIN_SET_VCPU
+	ProcDomainSetVcpu Procedure = 384
+	// ProcDomainEventBlockThreshold is libvirt's REMOTE_PROC_DOMAIN_EVENT_BLOCK_THRESHOLD
+	ProcDomainEventBlockThreshold Procedure = 385
+	// ProcDomainSetBlockThreshold is libvirt's REMOTE_PROC_DOMAIN_SET_BLOCK_THRESHOLD
+	ProcDomainSetBlockThreshold Procedure = 386
+	// ProcDomainMigrateGetMaxDowntime is libvirt's REMOTE_PROC_DOMAIN_MIGRATE_GET_MAX_DOWNTIME
+	ProcDomainMigrateGetMaxDowntime Procedure = 387
+	// ProcDomainManagedSaveGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_MANAGED_SAVE_GET_XML_DESC
+	ProcDomainManagedSaveGetXMLDesc Procedure = 388
+	// ProcDomainManagedSaveDefineXML is libvirt's REMOTE_PROC_DOMAIN_MANAGED_SAVE_DEFINE_XML
+	ProcDomainManagedSaveDefineXML Procedure = 389
+	// ProcDomainSetLifecycleAction is libvirt's REMOTE_PROC_DOMAIN_SET_LIFECYCLE_ACTION
+	ProcDomainSetLifecycleAction Procedure = 390
+	// ProcStoragePoolLookupByTargetPath is libvirt's REMOTE_PROC_STORAGE_POOL_LOOKUP_BY_TARGET_PATH
+	ProcStoragePoolLookupByTargetPath Procedure = 391
+	// ProcDomainDetachDeviceAlias is libvirt's REMOTE_PROC_DOMAIN_DETACH_DEVICE_ALIAS
+	ProcDomainDetachDeviceAlias Procedure = 392
+	// ProcConnectCompareHypervisorCPU is libvirt's REMOTE_PROC_CONNECT_COMPARE_HYPERVISOR_CPU
+	ProcConnectCompareHypervisorCPU Procedure = 393
+	// ProcConnectBaselineHypervisorCPU is libvirt's REMOTE_PROC_CONNECT_BASELINE_HYPERVISOR_CPU
+	ProcConnectBaselineHypervisorCPU Procedure = 394
+	// ProcNodeGetSevInfo is libvirt's REMOTE_PROC_NODE_GET_SEV_INFO
+	ProcNodeGetSevInfo Procedure = 395
+	// ProcDomainGetLaunchSecurityInfo is libvirt's REMOTE_PROC_DOMAIN_GET_LAUNCH_SECURITY_INFO
+	ProcDomainGetLaunchSecurityInfo Procedure = 396
+	// ProcNwfilterBindingLookupByPortDev is libvirt's REMOTE_PROC_NWFILTER_BINDING_LOOKUP_BY_PORT_DEV
+	ProcNwfilterBindingLookupByPortDev Procedure = 397
+	// ProcNwfilterBindingGetXMLDesc is libvirt's REMOTE_PROC_NWFILTER_BINDING_GET_XML_DESC
+	ProcNwfilterBindingGetXMLDesc Procedure = 398
+	// ProcNwfilterBindingCreateXML is libvirt's REMOTE_PROC_NWFILTER_BINDING_CREATE_XML
+	ProcNwfilterBindingCreateXML Procedure = 399
+	// ProcNwfilterBindingDelete is libvirt's REMOTE_PROC_NWFILTER_BINDING_DELETE
+	ProcNwfilterBindingDelete Procedure = 400
+	// ProcConnectListAllNwfilterBindings is libvirt's REMOTE_PROC_CONNECT_LIST_ALL_NWFILTER_BINDINGS
+	ProcConnectListAllNwfilterBindings Procedure = 401
+	// ProcDomainSetIothreadParams is libvirt's REMOTE_PROC_DOMAIN_SET_IOTHREAD_PARAMS
+	ProcDomainSetIothreadParams Procedure = 402
+	// ProcConnectGetStoragePoolCapabilities is libvirt's REMOTE_PROC_CONNECT_GET_STORAGE_POOL_CAPABILITIES
+	ProcConnectGetStoragePoolCapabilities Procedure = 403
+	// ProcNetworkListAllPorts is libvirt's REMOTE_PROC_NETWORK_LIST_ALL_PORTS
+	ProcNetworkListAllPorts Procedure = 404
+	// ProcNetworkPortLookupByUUID is libvirt's REMOTE_PROC_NETWORK_PORT_LOOKUP_BY_UUID
+	ProcNetworkPortLookupByUUID Procedure = 405
+	// ProcNetworkPortCreateXML is libvirt's REMOTE_PROC_NETWORK_PORT_CREATE_XML
+	ProcNetworkPortCreateXML Procedure = 406
+	// ProcNetworkPortGetParameters is libvirt's REMOTE_PROC_NETWORK_PORT_GET_PARAMETERS
+	ProcNetworkPortGetParameters Procedure = 407
+	// ProcNetworkPortSetParameters is libvirt's REMOTE_PROC_NETWORK_PORT_SET_PARAMETERS
+	ProcNetworkPortSetParameters Procedure = 408
+	// ProcNetworkPortGetXMLDesc is libvirt's REMOTE_PROC_NETWORK_PORT_GET_XML_DESC
+	ProcNetworkPortGetXMLDesc Procedure = 409
+	// ProcNetworkPortDelete is libvirt's REMOTE_PROC_NETWORK_PORT_DELETE
+	ProcNetworkPortDelete Procedure = 410
+	// ProcDomainCheckpointCreateXML is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_CREATE_XML
+	ProcDomainCheckpointCreateXML Procedure = 411
+	// ProcDomainCheckpointGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_GET_XML_DESC
+	ProcDomainCheckpointGetXMLDesc Procedure = 412
+	// ProcDomainListAllCheckpoints is libvirt's REMOTE_PROC_DOMAIN_LIST_ALL_CHECKPOINTS
+	ProcDomainListAllCheckpoints Procedure = 413
+	// ProcDomainCheckpointListAllChildren is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_LIST_ALL_CHILDREN
+	ProcDomainCheckpointListAllChildren Procedure = 414
+	// ProcDomainCheckpointLookupByName is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_LOOKUP_BY_NAME
+	ProcDomainCheckpointLookupByName Procedure = 415
+	// ProcDomainCheckpointGetParent is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_GET_PARENT
+	ProcDomainCheckpointGetParent Procedure = 416
+	// ProcDomainCheckpointDelete is libvirt's REMOTE_PROC_DOMAIN_CHECKPOINT_DELETE
+	ProcDomainCheckpointDelete Procedure = 417
+	// ProcDomainGetGuestInfo is libvirt's REMOTE_PROC_DOMAIN_GET_GUEST_INFO
+	ProcDomainGetGuestInfo Procedure = 418
+	// ProcConnectSetIdentity is libvirt's REMOTE_PROC_CONNECT_SET_IDENTITY
+	ProcConnectSetIdentity Procedure = 419
+	// ProcDomainAgentSetResponseTimeout is libvirt's REMOTE_PROC_DOMAIN_AGENT_SET_RESPONSE_TIMEOUT
+	ProcDomainAgentSetResponseTimeout Procedure = 420
+	// ProcDomainBackupBegin is libvirt's REMOTE_PROC_DOMAIN_BACKUP_BEGIN
+	ProcDomainBackupBegin Procedure = 421
+	// ProcDomainBackupGetXMLDesc is libvirt's REMOTE_PROC_DOMAIN_BACKUP_GET_XML_DESC
+	ProcDomainBackupGetXMLDesc Procedure = 422
+	// ProcDomainEventMemoryFailure is libvirt's REMOTE_PROC_DOMAIN_EVENT_MEMORY_FAILURE
+	ProcDomainEventMemoryFailure Procedure = 423
+	// ProcDomainAuthorizedSshKeysGet is libvirt's REMOTE_PROC_DOMAIN_AUTHORIZED_SSH_KEYS_GET
+	ProcDomainAuthorizedSshKeysGet Procedure = 424
+	// ProcDomainAuthorizedSshKeysSet is libvirt's REMOTE_PROC_DOMAIN_AUTHORIZED_SSH_KEYS_SET
+	ProcDomainAuthorizedSshKeysSet Procedure = 425
+	// ProcDomainGetMessages is libvirt's REMOTE_PROC_DOMAIN_GET_MESSAGES
+	ProcDomainGetMessages Procedure = 426
+)
+
+// String returns the name of the Procedure value.
+func (e Procedure) String() string {
+	switch e {
+	case ProcConnectOpen:
+		return "ProcConnectOpen"
+	case ProcConnectClose:
+		return "ProcConnectClose"
+	case ProcConnectGetType:
+		return "ProcConnectGetType"
+	case ProcConnectGetVersion:
+		return "ProcConnectGetVersion"
+	case ProcConnectGetMaxVcpus:
+		return "ProcConnectGetMaxVcpus"
+	case ProcNodeGetInfo:
+		return "ProcNodeGetInfo"
+	case ProcConnectGetCapabilities:
+		return "ProcConnectGetCapabilities"
+	case ProcDomainAttachDevice:
+		return "ProcDomainAttachDevice"
+	case ProcDomainCreate:
+		return "ProcDomainCreate"
+	case ProcDomainCreateXML:
+		return "ProcDomainCreateXML"
+	case ProcDomainDefineXML:
+		return "ProcDomainDefineXML"
+	case ProcDomainDestroy:
+		return "ProcDomainDestroy"
+	case ProcDomainDetachDevice:
+		return "ProcDomainDetachDevice"
+	case ProcDomainGetXMLDesc:
+		return "ProcDomainGetXMLDesc"
+	case ProcDomainGetAutostart:
+		return "ProcDomainGetAutostart"
+	case ProcDomainGetInfo:
+		return "ProcDomainGetInfo"
+	case ProcDomainGetMaxMemory:
+		return "ProcDomainGetMaxMemory"
+	case ProcDomainGetMaxVcpus:
+		return "ProcDomainGetMaxVcpus"
+	case ProcDomainGetOsType:
+		return "ProcDomainGetOsType"
+	case ProcDomainGetVcpus:
+		return "ProcDomainGetVcpus"
+	case ProcConnectListDefinedDomains:
+		return "ProcConnectListDefinedDomains"
+	case ProcDomainLookupByID:
+		return "ProcDomainLookupByID"
+	case ProcDomainLookupByName:
+		return "ProcDomainLookupByName"
+	case ProcDomainLookupByUUID:
+		return "ProcDomainLookupByUUID"
+	case ProcConnectNumOfDefinedDomains:
+		return "ProcConnectNumOfDefinedDomains"
+	case ProcDomainPinVcpu:
+		return "ProcDomainPinVcpu"
+	case ProcDomainReboot:
+		return "ProcDomainReboot"
+	case ProcDomainResume:
+		return "ProcDomainResume"
+	case ProcDomainSetAutostart:
+		return "ProcDomainSetAutostart"
+	case ProcDomainSetMaxMemory:
+		return "ProcDomainSetMaxMemory"
+	case ProcDomainSetMemory:
+		return "ProcDomainSetMemory"
+	case ProcDomainSetVcpus:
+		return "ProcDomainSetVcpus"
+	case ProcDomainShutdown:
+		return "ProcDomainShutdown"
+	case ProcDomainSuspend:
+		return "ProcDomainSuspend"
+	case ProcDomainUndefine:
+		return "ProcDomainUndefine"
+	case ProcConnectListDefinedNetworks:
+		return "ProcConnectListDefinedNetworks"
+	case ProcConnectListDomains:
+		return "ProcConnectListDomains"
+	case ProcConnectListNetworks:
+		return "ProcConnectListNetworks"
+	case ProcNetworkCreate:
+		return "ProcNetworkCreate"
+	case ProcNetworkCreateXML:
+		return "ProcNetworkCreateXML"
+	case ProcNetworkDefineXML:
+		return "ProcNetworkDefineXML"
+	case ProcNetworkDestroy:
+		return "ProcNetworkDestroy"
+	case ProcNetworkGetXMLDesc:
+		return "ProcNetworkGetXMLDesc"
+	case ProcNetworkGetAutostart:
+		return "ProcNetworkGetAutostart"
+	case ProcNetworkGetBridgeName:
+		return "ProcNetworkGetBridgeName"
+	case ProcNetworkLookupByName:
+		return "ProcNetworkLookupByName"
+	case ProcNetworkLookupByUUID:
+		return "ProcNetworkLookupByUUID"
+	case ProcNetworkSetAutostart:
+		return "ProcNetworkSetAutostart"
+	case ProcNetworkUndefine:
+		return "ProcNetworkUndefine"
+	case ProcConnectNumOfDefinedNetworks:
+		return "ProcConnectNumOfDefinedNetworks"
+	case ProcConnectNumOfDomains:
+		return "ProcConnectNumOfDomains"
+	case ProcConnectNumOfNetworks:
+		return "ProcConnectNumOfNetworks"
+	case ProcDomainCoreDump:
+		return "ProcDomainCoreDump"
+	case ProcDomainRestore:
+		return "ProcDomainRestore"
+	case ProcDomainSave:
+		return "ProcDomainSave"
+	case ProcDomainGetSchedulerType:
+		return "ProcDomainGetSchedulerType"
+	case ProcDomainGetSchedulerParameters:
+		return "ProcDomainGetSchedulerParameters"
+	case ProcDomainSetSchedulerParameters:
+		return "ProcDomainSetSchedulerParameters"
+	case ProcConnectGetHostname:
+		return "ProcConnectGetHostname"
+	case ProcConnectSupportsFeature:
+		return "ProcConnectSupportsFeature"
+	case ProcDomainMigratePrepare:
+		return "ProcDomainMigratePrepare"
+	case ProcDomainMigratePerform:
+		return "ProcDomainMigratePerform"
+	case ProcDomainMigrateFinish:
+		return "ProcDomainMigrateFinish"
+	case ProcDomainBlockStats:
+		return "ProcDomainBlockStats"
+	case ProcDomainInterfaceStats:
+		return "ProcDomainInterfaceStats"
+	case ProcAuthList:
+		return "ProcAuthList"
+	case ProcAuthSaslInit:
+		return "ProcAuthSaslInit"
+	case ProcAuthSaslStart:
+		return "ProcAuthSaslStart"
+	case ProcAuthSaslStep:
+		return "ProcAuthSaslStep"
+	case ProcAuthPolkit:
+		return "ProcAuthPolkit"
+	case ProcConnectNumOfStoragePools:
+		return "ProcConnectNumOfStoragePools"
+	case ProcConnectListStoragePools:
+		return "ProcConnectListStoragePools"
+	case ProcConnectNumOfDefinedStoragePools:
+		return "ProcConnectNumOfDefinedStoragePools"
+	case ProcConnectListDefinedStoragePools:
+		return "ProcConnectListDefinedStoragePools"
+	case ProcConnectFindStoragePoolSources:
+		return "ProcConnectFindStoragePoolSources"
+	case ProcStoragePoolCreateXML:
+		return "ProcStoragePoolCreateXML"
+	case ProcStoragePoolDefineXML:
+		return "ProcStoragePoolDefineXML"
+	case ProcStoragePoolCreate:
+		return "ProcStoragePoolCreate"
+	case ProcStoragePoolBuild:
+		return "ProcStoragePoolBuild"
+	case ProcStoragePoolDestroy:
+		return "ProcStoragePoolDestroy"
+	case ProcStoragePoolDelete:
+		return "ProcStoragePoolDelete"
+	case ProcStoragePoolUndefine:
+		return "ProcStoragePoolUndefine"
+	case ProcStoragePoolRefresh:
+		return "ProcStoragePoolRefresh"
+	case ProcStoragePoolLookupByName:
+		return "ProcStoragePoolLookupByName"
+	case ProcStoragePoolLookupByUUID:
+		return "ProcStoragePoolLookupByUUID"
+	case ProcStoragePoolLookupByVolume:
+		return "ProcStoragePoolLookupByVolume"
+	case ProcStoragePoolGetInfo:
+		return "ProcStoragePoolGetInfo"
+	case ProcStoragePoolGetXMLDesc:
+		return "ProcStoragePoolGetXMLDesc"
+	case ProcStoragePoolGetAutostart:
+		return "ProcStoragePoolGetAutostart"
+	case ProcStoragePoolSetAutostart:
+		return "ProcStoragePoolSetAutostart"
+	case ProcStoragePoolNumOfVolumes:
+		return "ProcStoragePoolNumOfVolumes"
+	case ProcStoragePoolListVolumes:
+		return "ProcStoragePoolListVolumes"
+	case ProcStorageVolCreateXML:
+		return "ProcStorageVolCreateXML"
+	case ProcStorageVolDelete:
+		return "ProcStorageVolDelete"
+	case ProcStorageVolLookupByName:
+		return "ProcStorageVolLookupByName"
+	case ProcStorageVolLookupByKey:
+		return "ProcStorageVolLookupByKey"
+	case ProcStorageVolLookupByPath:
+		return "ProcStorageVolLookupByPath"
+	case ProcStorageVolGetInfo:
+		return "ProcStorageVolGetInfo"
+	case ProcStorageVolGetXMLDesc:
+		return "ProcStorageVolGetXMLDesc"
+	case ProcStorageVolGetPath:
+		return "ProcStorageVolGetPath"
+	case ProcNodeGetCellsFreeMemory:
+		return "ProcNodeGetCellsFreeMemory"
+	case ProcNodeGetFreeMemory:
+		return "ProcNodeGetFreeMemory"
+	case ProcDomainBlockPeek:
+		return "ProcDomainBlockPeek"
+	case ProcDomainMemoryPeek:
+		return "ProcDomainMemoryPeek"
+	case ProcConnectDomainEventRegister:
+		return "ProcConnectDomainEventRegister"
+	case ProcConnectDomainEventDeregister:
+		return "ProcConnectDomainEventDeregister"
+	case ProcDomainEventLifecycle:
+		return "ProcDomainEventLifecycle"
+	case ProcDomainMigratePrepare2:
+		return "ProcDomainMigratePrepare2"
+	case ProcDomainMigrateFinish2:
+		return "ProcDomainMigrateFinish2"
+	case ProcConnectGetUri:
+		return "ProcConnectGetUri"
+	case ProcNodeNumOfDevices:
+		return "ProcNodeNumOfDevices"
+	case ProcNodeListDevices:
+		return "ProcNodeListDevices"
+	case ProcNodeDeviceLookupByName:
+		return "ProcNodeDeviceLookupByName"
+	case ProcNodeDeviceGetXMLDesc:
+		return "ProcNodeDeviceGetXMLDesc"
+	case ProcNodeDeviceGetParent:
+		return "ProcNodeDeviceGetParent"
+	case ProcNodeDeviceNumOfCaps:
+		return "ProcNodeDeviceNumOfCaps"
+	case ProcNodeDeviceListCaps:
+		return "ProcNodeDeviceListCaps"
+	case ProcNodeDeviceDettach:
+		return "ProcNodeDeviceDettach"
+	case ProcNodeDeviceReAttach:
+		return "ProcNodeDeviceReAttach"
+	case ProcNodeDeviceReset:
+		return "ProcNodeDeviceReset"
+	case ProcDomainGetSecurityLabel:
+		return "ProcDomainGetSecurityLabel"
+	case ProcNodeGetSecurityModel:
+		return "ProcNodeGetSecurityModel"
+	case ProcNodeDeviceCreateXML:
+		return "ProcNodeDeviceCreateXML"
+	case ProcNodeDeviceDestroy:
+		return "ProcNodeDeviceDestroy"
+	case ProcStorageVolCreateXMLFrom:
+		return "ProcStorageVolCreateXMLFrom"
+	case ProcConnectNumOfInterfaces:
+		return "ProcConnectNumOfInterfaces"
+	case ProcConnectListInterfaces:
+		return "ProcConnectListInterfaces"
+	case ProcInterfaceLookupByName:
+		return "ProcInterfaceLookupByName"
+	case ProcInterfaceLookupByMacString:
+		return "ProcInterfaceLookupByMacString"
+	case ProcInterfaceGetXMLDesc:
+		return "ProcInterfaceGetXMLDesc"
+	case ProcInterfaceDefineXML:
+		return "ProcInterfaceDefineXML"
+	case ProcInterfaceUndefine:
+		return "ProcInterfaceUndefine"
+	case ProcInterfaceCreate:
+		return "ProcInterfaceCreate"
+	case ProcInterfaceDestroy:
+		return "ProcInterfaceDestroy"
+	case ProcConnectDomainXMLFromNative:
+		return "ProcConnectDomainXMLFromNative"
+	case ProcConnectDomainXMLToNative:
+		return "ProcConnectDomainXMLToNative"
+	case ProcConnectNumOfDefinedInterfaces:
+		return "ProcConnectNumOfDefinedInterfaces"
+	case ProcConnectListDefinedInterfaces:
+		return "ProcConnectListDefinedInterfaces"
+	case ProcConnectNumOfSecrets:
+		return "ProcConnectNumOfSecrets"
+	case ProcConnectListSecrets:
+		return "ProcConnectListSecrets"
+	case ProcSecretLookupByUUID:
+		return "ProcSecretLookupByUUID"
+	case ProcSecretDefineXML:
+		return "ProcSecretDefineXML"
+	case ProcSecretGetXMLDesc:
+		return "ProcSecretGetXMLDesc"
+	case ProcSecretSetValue:
+		return "ProcSecretSetValue"
+	case ProcSecretGetValue:
+		return "ProcSecretGetValue"
+	case ProcSecretUndefine:
+		return "ProcSecretUndefine"
+	case ProcSecretLookupByUsage:
+		return "ProcSecretLookupByUsage"
+	case ProcDomainMigratePrepareTunnel:
+		return "ProcDomainMigratePrepareTunnel"
+	case ProcConnectIsSecure:
+		return "ProcConnectIsSecure"
+	case ProcDomainIsActive:
+		return "ProcDomainIsActive"
+	case ProcDomainIsPersistent:
+		return "ProcDomainIsPersistent"
+	case ProcNetworkIsActive:
+		return "ProcNetworkIsActive"
+	case ProcNetworkIsPersistent:
+		return "ProcNetworkIsPersistent"
+	case ProcStoragePoolIsActive:
+		return "ProcStoragePoolIsActive"
+	case ProcStoragePoolIsPersistent:
+		return "ProcStoragePoolIsPersistent"
+	case ProcInterfaceIsActive:
+		return "ProcInterfaceIsActive"
+	case ProcConnectGetLibVersion:
+		return "ProcConnectGetLibVersion"
+	case ProcConnectCompareCPU:
+		return "ProcConnectCompareCPU"
+	case ProcDomainMemoryStats:
+		return "ProcDomainMemoryStats"
+	case ProcDomainAttachDeviceFlags:
+		return "ProcDomainAttachDeviceFlags"
+	case ProcDomainDetachDeviceFlags:
+		return "ProcDomainDetachDeviceFlags"
+	case ProcConnectBaselineCPU:
+		return "ProcConnectBaselineCPU"
+	case ProcDomainGetJobInfo:
+		return "ProcDomainGetJobInfo"
+	case ProcDomainAbortJob:
+		return "ProcDomainAbortJob"
+	case ProcStorageVolWipe:
+		return "ProcStorageVolWipe"
+	case ProcDomainMigrateSetMaxDowntime:
+		return "ProcDomainMigrateSetMaxDowntime"
+	case ProcConnectDomainEventRegisterAny:
+		return "ProcConnectDomainEventRegisterAny"
+	case ProcConnectDomainEventDeregisterAny:
+		return "ProcConnectDomainEventDeregisterAny"
+	case ProcDomainEventReboot:
+		return "ProcDomainEventReboot"
+	case ProcDomainEventRtcChange:
+		return "ProcDomainEventRtcChange"
+	case ProcDomainEventWatchdog:
+		return "ProcDomainEventWatchdog"
+	case ProcDomainEventIOError:
+		return "ProcDomainEventIOError"
+	case ProcDomainEventGraphics:
+		return "ProcDomainEventGraphics"
+	case ProcDomainUpdateDeviceFlags:
+		return "ProcDomainUpdateDeviceFlags"
+	case ProcNwfilterLookupByName:
+		return "ProcNwfilterLookupByName"
+	case ProcNwfilterLookupByUUID:
+		return "ProcNwfilterLookupByUUID"
+	case ProcNwfilterGetXMLDesc:
+		return "ProcNwfilterGetXMLDesc"
+	case ProcConnectNumOfNwfilters:
+		return "ProcConnectNumOfNwfilters"
+	case ProcConnectListNwfilters:
+		return "ProcConnectListNwfilters"
+	case ProcNwfilterDefineXML:
+		return "ProcNwfilterDefineXML"
+	case ProcNwfilterUndefine:
+		return "ProcNwfilterUndefine"
+	case ProcDomainManagedSave:
+		return "ProcDomainManagedSave"
+	case ProcDomainHasManagedSaveImage:
+		return "ProcDomainHasManagedSaveImage"
+	case ProcDomainManagedSaveRemove:
+		return "ProcDomainManagedSaveRemove"
+	case ProcDomainSnapshotCreateXML:
+		return "ProcDomainSnapshotCreateXML"
+	case ProcDomainSnapshotGetXMLDesc:
+		return "ProcDomainSnapshotGetXMLDesc"
+	case ProcDomainSnapshotNum:
+		return "ProcDomainSnapshotNum"
+	case ProcDomainSnapshotListNames:
+		return "ProcDomainSnapshotListNames"
+	case ProcDomainSnapshotLookupByName:
+		return "ProcDomainSnapshotLookupByName"
+	case ProcDomainHasCurrentSnapshot:
+		return "ProcDomainHasCurrentSnapshot"
+	case ProcDomainSnapshotCurrent:
+		return "ProcDomainSnapshotCurrent"
+	case ProcDomainRevertToSnapshot:
+		return "ProcDomainRevertToSnapshot"
+	case ProcDomainSnapshotDelete:
+		return "ProcDomainSnapshotDelete"
+	case ProcDomainGetBlockInfo:
+		return "ProcDomainGetBlockInfo"
+	case ProcDomainEventIOErrorReason:
+		return "ProcDomainEventIOErrorReason"
+	case ProcDomainCreateWithFlags:
+		return "ProcDomainCreateWithFlags"
+	case ProcDomainSetMemoryParameters:
+		return "ProcDomainSetMemoryParameters"
+	case ProcDomainGetMemoryParameters:
+		return "ProcDomainGetMemoryParameters"
+	case ProcDomainSetVcpusFlags:
+		return "ProcDomainSetVcpusFlags"
+	case ProcDomainGetVcpusFlags:
+		return "ProcDomainGetVcpusFlags"
+	case ProcDomainOpenConsole:
+		return "ProcDomainOpenConsole"
+	case ProcDomainIsUpdated:
+		return "ProcDomainIsUpdated"
+	case ProcConnectGetSysinfo:
+		return "ProcConnectGetSysinfo"
+	case ProcDomainSetMemoryFlags:
+		return "ProcDomainSetMemoryFlags"
+	case ProcDomainSetBlkioParameters:
+		return "ProcDomainSetBlkioParameters"
+	case ProcDomainGetBlkioParameters:
+		return "ProcDomainGetBlkioParameters"
+	case ProcDomainMigrateSetMaxSpeed:
+		return "ProcDomainMigrateSetMaxSpeed"
+	case ProcStorageVolUpload:
+		return "ProcStorageVolUpload"
+	case ProcStorageVolDownload:
+		return "ProcStorageVolDownload"
+	case ProcDomainInjectNmi:
+		return "ProcDomainInjectNmi"
+	case ProcDomainScreenshot:
+		return "ProcDomainScreenshot"
+	case ProcDomainGetState:
+		return "ProcDomainGetState"
+	case ProcDomainMigrateBegin3:
+		return "ProcDomainMigrateBegin3"
+	case ProcDomainMigratePrepare3:
+		return "ProcDomainMigratePrepare3"
+	case ProcDomainMigratePrepareTunnel3:
+		return "ProcDomainMigratePrepareTunnel3"
+	case ProcDomainMigratePerform3:
+		return "ProcDomainMigratePerform3"
+	case ProcDomainMigrateFinish3:
+		return "ProcDomainMigrateFinish3"
+	case ProcDomainMigrateConfirm3:
+		return "ProcDomainMigrateConfirm3"
+	case ProcDomainSetSchedulerParametersFlags:
+		return "ProcDomainSetSchedulerParametersFlags"
+	case ProcInterfaceChangeBegin:
+		return "ProcInterfaceChangeBegin"
+	case ProcInterfaceChangeCommit:
+		return "ProcInterfaceChangeCommit"
+	case ProcInterfaceChangeRollback:
+		return "ProcInterfaceChangeRollback"
+	case ProcDomainGetSchedulerParametersFlags:
+		return "ProcDomainGetSchedulerParametersFlags"
+	case ProcDomainEventControlError:
+		return "ProcDomainEventControlError"
+	case ProcDomainPinVcpuFlags:
+		return "ProcDomainPinVcpuFlags"
+	case ProcDomainSendKey:
+		return "ProcDomainSendKey"
+	case ProcNodeGetCPUStats:
+		return "ProcNodeGetCPUStats"
+	case ProcNodeGetMemoryStats:
+		return "ProcNodeGetMemoryStats"
+	case ProcDomainGetControlInfo:
+		return "ProcDomainGetControlInfo"
+	case ProcDomainGetVcpuPinInfo:
+		return "ProcDomainGetVcpuPinInfo"
+	case ProcDomainUndefineFlags:
+		return "ProcDomainUndefineFlags"
+	case ProcDomainSaveFlags:
+		return "ProcDomainSaveFlags"
+	case ProcDomainRestoreFlags:
+		return "ProcDomainRestoreFlags"
+	case ProcDomainDestroyFlags:
+		return "ProcDomainDestroyFlags"
+	case ProcDomainSaveImageGetXMLDesc:
+		return "ProcDomainSaveImageGetXMLDesc"
+	case ProcDomainSaveImageDefineXML:
+		return "ProcDomainSaveImageDefineXML"
+	case ProcDomainBlockJobAbort:
+		return "ProcDomainBlockJobAbort"
+	case ProcDomainGetBlockJobInfo:
+		return "ProcDomainGetBlockJobInfo"
+	case ProcDomainBlockJobSetSpeed:
+		return "ProcDomainBlockJobSetSpeed"
+	case ProcDomainBlockPull:
+		return "ProcDomainBlockPull"
+	case ProcDomainEventBlockJob:
+		return "ProcDomainEventBlockJob"
+	case ProcDomainMigrateGetMaxSpeed:
+		return "ProcDomainMigrateGetMaxSpeed"
+	case ProcDomainBlockStatsFlags:
+		return "ProcDomainBlockStatsFlags"
+	case ProcDomainSnapshotGetParent:
+		return "ProcDomainSnapshotGetParent"
+	case ProcDomainReset:
+		return "ProcDomainReset"
+	case ProcDomainSnapshotNumChildren:
+		return "ProcDomainSnapshotNumChildren"
+	case ProcDomainSnapshotListChildrenNames:
+		return "ProcDomainSnapshotListChildrenNames"
+	case ProcDomainEventDiskChange:
+		return "ProcDomainEventDiskChange"
+	case ProcDomainOpenGraphics:
+		return "ProcDomainOpenGraphics"
+	case ProcNodeSuspendForDuration:
+		return "ProcNodeSuspendForDuration"
+	case ProcDomainBlockResize:
+		return "ProcDomainBlockResize"
+	case ProcDomainSetBlockIOTune:
+		return "ProcDomainSetBlockIOTune"
+	case ProcDomainGetBlockIOTune:
+		return "ProcDomainGetBlockIOTune"
+	case ProcDomainSetNumaParameters:
+		return "ProcDomainSetNumaParameters"
+	case ProcDomainGetNumaParameters:
+		return "ProcDomainGetNumaParameters"
+	case ProcDomainSetInterfaceParameters:
+		return "ProcDomainSetInterfaceParameters"
+	case ProcDomainGetInterfaceParameters:
+		return "ProcDomainGetInterfaceParameters"
+	case ProcDomainShutdownFlags:
+		return "ProcDomainShutdownFlags"
+	case ProcStorageVolWipePattern:
+		return "ProcStorageVolWipePattern"
+	case ProcStorageVolResize:
+		return "ProcStorageVolResize"
+	case ProcDomainPmSuspendForDuration:
+		return "ProcDomainPmSuspendForDuration"
+	case ProcDomainGetCPUStats:
+		return "ProcDomainGetCPUStats"
+	case ProcDomainGetDiskErrors:
+		return "ProcDomainGetDiskErrors"
+	case ProcDomainSetMetadata:
+		return "ProcDomainSetMetadata"
+	case ProcDomainGetMetadata:
+		return "ProcDomainGetMetadata"
+	case ProcDomainBlockRebase:
+		return "ProcDomainBlockRebase"
+	case ProcDomainPmWakeup:
+		return "ProcDomainPmWakeup"
+	case ProcDomainEventTrayChange:
+		return "ProcDomainEventTrayChange"
+	case ProcDomainEventPmwakeup:
+		return "ProcDomainEventPmwakeup"
+	case ProcDomainEventPmsuspend:
+		return "ProcDomainEventPmsuspend"
+	case ProcDomainSnapshotIsCurrent:
+		return "ProcDomainSnapshotIsCurrent"
+	case ProcDomainSnapshotHasMetadata:
+		return "ProcDomainSnapshotHasMetadata"
+	case ProcConnectListAllDomains:
+		return "ProcConnectListAllDomains"
+	case ProcDomainListAllSnapshots:
+		return "ProcDomainListAllSnapshots"
+	case ProcDomainSnapshotListAllChildren:
+		return "ProcDomainSnapshotListAllChildren"
+	case ProcDomainEventBalloonChange:
+		return "ProcDomainEventBalloonChange"
+	case ProcDomainGetHostname:
+		return "ProcDomainGetHostname"
+	case ProcDomainGetSecurityLabelList:
+		return "ProcDomainGetSecurityLabelList"
+	case ProcDomainPinEmulator:
+		return "ProcDomainPinEmulator"
+	case ProcDomainGetEmulatorPinInfo:
+		return "ProcDomainGetEmulatorPinInfo"
+	case ProcConnectListAllStoragePools:
+		return "ProcConnectListAllStoragePools"
+	case ProcStoragePoolListAllVolumes:
+		return "ProcStoragePoolListAllVolumes"
+	case ProcConnectListAllNetworks:
+		return "ProcConnectListAllNetworks"
+	case ProcConnectListAllInterfaces:
+		return "ProcConnectListAllInterfaces"
+	case ProcConnectListAllNodeDevices:
+		return "ProcConnectListAllNodeDevices"
+	case ProcConnectListAllNwfilters:
+		return "ProcConnectListAllNwfilters"
+	case ProcConnectListAllSecrets:
+		return "ProcConnectListAllSecrets"
+	case ProcNodeSetMemoryParameters:
+		return "ProcNodeSetMemoryParameters"
+	case ProcNodeGetMemoryParameters:
+		return "ProcNodeGetMemoryParameters"
+	case ProcDomainBlockCommit:
+		return "ProcDomainBlockCommit"
+	case ProcNetworkUpdate:
+		return "ProcNetworkUpdate"
+	case ProcDomainEventPmsuspendDisk:
+		return "ProcDomainEventPmsuspendDisk"
+	case ProcNodeGetCPUMap:
+		return "ProcNodeGetCPUMap"
+	case ProcDomainFstrim:
+		return "ProcDomainFstrim"
+	case ProcDomainSendProcessSignal:
+		return "ProcDomainSendProcessSignal"
+	case ProcDomainOpenChannel:
+		return "ProcDomainOpenChannel"
+	case ProcNodeDeviceLookupScsiHostByWwn:
+		return "ProcNodeDeviceLookupScsiHostByWwn"
+	case ProcDomainGetJobStats:
+		return "ProcDomainGetJobStats"
+	case ProcDomainMigrateGetCompressionCache:
+		return "ProcDomainMigrateGetCompressionCache"
+	case ProcDomainMigrateSetCompressionCache:
+		return "ProcDomainMigrateSetCompressionCache"
+	case ProcNodeDeviceDetachFlags:
+		return "ProcNodeDeviceDetachFlags"
+	case ProcDomainMigrateBegin3Params:
+		return "ProcDomainMigrateBegin3Params"
+	case ProcDomainMigratePrepare3Params:
+		return "ProcDomainMigratePrepare3Params"
+	case ProcDomainMigratePrepareTunnel3Params:
+		return "ProcDomainMigratePrepareTunnel3Params"
+	case ProcDomainMigratePerform3Params:
+		return "ProcDomainMigratePerform3Params"
+	case ProcDomainMigrateFinish3Params:
+		return "ProcDomainMigrateFinish3Params"
+	case ProcDomainMigrateConfirm3Params:
+		return "ProcDomainMigrateConfirm3Params"
+	case ProcDomainSetMemoryStatsPeriod:
+		return "ProcDomainSetMemoryStatsPeriod"
+	case ProcDomainCreateXMLWithFiles:
+		return "ProcDomainCreateXMLWithFiles"
+	case ProcDomainCreateWithFiles:
+		return "ProcDomainCreateWithFiles"
+	case ProcDomainEventDeviceRemoved:
+		return "ProcDomainEventDeviceRemoved"
+	case ProcConnectGetCPUModelNames:
+		return "ProcConnectGetCPUModelNames"
+	case ProcConnectNetworkEventRegisterAny:
+		return "ProcConnectNetworkEventRegisterAny"
+	case ProcConnectNetworkEventDeregisterAny:
+		return "ProcConnectNetworkEventDeregisterAny"
+	case ProcNetworkEventLifecycle:
+		return "ProcNetworkEventLifecycle"
+	case ProcConnectDomainEventCallbackRegisterAny:
+		return "ProcConnectDomainEventCallbackRegisterAny"
+	case ProcConnectDomainEventCallbackDeregisterAny:
+		return "ProcConnectDomainEventCallbackDeregisterAny"
+	case ProcDomainEventCallbackLifecycle:
+		return "ProcDomainEventCallbackLifecycle"
+	case ProcDomainEventCallbackReboot:
+		return "ProcDomainEventCallbackReboot"
+	case ProcDomainEventCallbackRtcChange:
+		return "ProcDomainEventCallbackRtcChange"
+	case ProcDomainEventCallbackWatchdog:
+		return "ProcDomainEventCallbackWatchdog"
+	case ProcDomainEventCallbackIOError:
+		return "ProcDomainEventCallbackIOError"
+	case ProcDomainEventCallbackGraphics:
+		return "ProcDomainEventCallbackGraphics"
+	case ProcDomainEventCallbackIOErrorReason:
+		return "ProcDomainEventCallbackIOErrorReason"
+	case ProcDomainEventCallbackControlError:
+		return "ProcDomainEventCallbackControlError"
+	case ProcDomainEventCallbackBlockJob:
+		return "ProcDomainEventCallbackBlockJob"
+	case ProcDomainEventCallbackDiskChange:
+		return "ProcDomainEventCallbackDiskChange"
+	case ProcDomainEventCallbackTrayChange:
+		return "ProcDomainEventCallbackTrayChange"
+	case ProcDomainEventCallbackPmwakeup:
+		return "ProcDomainEventCallbackPmwakeup"
+	case ProcDomainEventCallbackPmsuspend:
+		return "ProcDomainEventCallbackPmsuspend"
+	case ProcDomainEventCallbackBalloonChange:
+		return "ProcDomainEventCallbackBalloonChange"
+	case ProcDomainEventCallbackPmsuspendDisk:
+		return "ProcDomainEventCallbackPmsuspendDisk"
+	case ProcDomainEventCallbackDeviceRemoved:
+		return "ProcDomainEventCallbackDeviceRemoved"
+	case ProcDomainCoreDumpWithFormat:
+		return "ProcDomainCoreDumpWithFormat"
+	case ProcDomainFsfreeze:
+		return "ProcDomainFsfreeze"
+	case ProcDomainFsthaw:
+		return "ProcDomainFsthaw"
+	case ProcDomainGetTime:
+		return "ProcDomainGetTime"
+	case ProcDomainSetTime:
+		return "ProcDomainSetTime"
+	case ProcDomainEventBlockJob2:
+		return "ProcDomainEventBlockJob2"
+	case ProcNodeGetFreePages:
+		return "ProcNodeGetFreePages"
+	case ProcNetworkGetDhcpLeases:
+		return "ProcNetworkGetDhcpLeases"
+	case ProcConnectGetDomainCapabilities:
+		return "ProcConnectGetDomainCapabilities"
+	case ProcDomainOpenGraphicsFd:
+		return "ProcDomainOpenGraphicsFd"
+	case ProcConnectGetAllDomainStats:
+		return "ProcConnectGetAllDomainStats"
+	case ProcDomainBlockCopy:
+		return "ProcDomainBlockCopy"
+	case ProcDomainEventCallbackTunable:
+		return "ProcDomainEventCallbackTunable"
+	case ProcNodeAllocPages:
+		return "ProcNodeAllocPages"
+	case ProcDomainEventCallbackAgentLifecycle:
+		return "ProcDomainEventCallbackAgentLifecycle"
+	case ProcDomainGetFsinfo:
+		return "ProcDomainGetFsinfo"
+	case ProcDomainDefineXMLFlags:
+		return "ProcDomainDefineXMLFlags"
+	case ProcDomainGetIothreadInfo:
+		return "ProcDomainGetIothreadInfo"
+	case ProcDomainPinIothread:
+		return "ProcDomainPinIothread"
+	case ProcDomainInterfaceAddresses:
+		return "ProcDomainInterfaceAddresses"
+	case ProcDomainEventCallbackDeviceAdded:
+		return "ProcDomainEventCallbackDeviceAdded"
+	case ProcDomainAddIothread:
+		return "ProcDomainAddIothread"
+	case ProcDomainDelIothread:
+		return "ProcDomainDelIothread"
+	case ProcDomainSetUserPassword:
+		return "ProcDomainSetUserPassword"
+	case ProcDomainRename:
+		return "ProcDomainRename"
+	case ProcDomainEventCallbackMigrationIteration:
+		return "ProcDomainEventCallbackMigrationIteration"
+	case ProcConnectRegisterCloseCallback:
+		return "ProcConnectRegisterCloseCallback"
+	case ProcConnectUnregisterCloseCallback:
+		return "ProcConnectUnregisterCloseCallback"
+	case ProcConnectEventConnectionClosed:
+		return "ProcConnectEventConnectionClosed"
+	case ProcDomainEventCallbackJobCompleted:
+		return "ProcDomainEventCallbackJobCompleted"
+	case ProcDomainMigrateStartPostCopy:
+		return "ProcDomainMigrateStartPostCopy"
+	case ProcDomainGetPerfEvents:
+		return "ProcDomainGetPerfEvents"
+	case ProcDomainSetPerfEvents:
+		return "ProcDomainSetPerfEvents"
+	case ProcDomainEventCallbackDeviceRemovalFailed:
+		return "ProcDomainEventCallbackDeviceRemovalFailed"
+	case ProcConnectStoragePoolEventRegisterAny:
+		return "ProcConnectStoragePoolEventRegisterAny"
+	case ProcConnectStoragePoolEventDeregisterAny:
+		return "ProcConnectStoragePoolEventDeregisterAny"
+	case ProcStoragePoolEventLifecycle:
+		return "ProcStoragePoolEventLifecycle"
+	case ProcDomainGetGuestVcpus:
+		return "ProcDomainGetGuestVcpus"
+	case ProcDomainSetGuestVcpus:
+		return "ProcDomainSetGuestVcpus"
+	case ProcStoragePoolEventRefresh:
+		return "ProcStoragePoolEventRefresh"
+	case ProcConnectNodeDeviceEventRegisterAny:
+		return "ProcConnectNodeDeviceEventRegisterAny"
+	case ProcConnectNodeDeviceEventDeregisterAny:
+		return "ProcConnectNodeDeviceEventDeregisterAny"
+	case ProcNodeDeviceEventLifecycle:
+		return "ProcNodeDeviceEventLifecycle"
+	case ProcNodeDeviceEventUpdate:
+		return "ProcNodeDeviceEventUpdate"
+	case ProcStorageVolGetInfoFlags:
+		return "ProcStorageVolGetInfoFlags"
+	case ProcDomainEventCallbackMetadataChange:
+		return "ProcDomainEventCallbackMetadataChange"
+	case ProcConnectSecretEventRegisterAny:
+		return "ProcConnectSecretEventRegisterAny"
+	case ProcConnectSecretEventDeregisterAny:
+		return "ProcConnectSecretEventDeregisterAny"
+	case ProcSecretEventLifecycle:
+		return "ProcSecretEventLifecycle"
+	case ProcSecretEventValueChanged:
+		return "ProcSecretEventValueChanged"
+	case ProcDomainSetVcpu:
+		return "ProcDomainSetVcpu"
+	case ProcDomainEventBlockThreshold:
+		return "ProcDomainEventBlockThreshold"
+	case ProcDomainSetBlockThreshold:
+		return "ProcDomainSetBlockThreshold"
+	case ProcDomainMigrateGetMaxDowntime:
+		return "ProcDomainMigrateGetMaxDowntime"
+	case ProcDomainManagedSaveGetXMLDesc:
+		return "ProcDomainManagedSaveGetXMLDesc"
+	case ProcDomainManagedSaveDefineXML:
+		return "ProcDomainManagedSaveDefineXML"
+	case ProcDomainSetLifecycleAction:
+		return "ProcDomainSetLifecycleAction"
+	case ProcStoragePoolLookupByTargetPath:
+		return "ProcStoragePoolLookupByTargetPath"
+	case ProcDomainDetachDeviceAlias:
+		return "ProcDomainDetachDeviceAlias"
+	case ProcConnectCompareHypervisorCPU:
+		return "ProcConnectCompareHypervisorCPU"
+	case ProcConnectBaselineHypervisorCPU:
+		return "ProcConnectBaselineHypervisorCPU"
+	case ProcNodeGetSevInfo:
+		return "ProcNodeGetSevInfo"
+	case ProcDomainGetLaunchSecurityInfo:
+		return "ProcDomainGetLaunchSecurityInfo"
+	case ProcNwfilterBindingLookupByPortDev:
+		return "ProcNwfilterBindingLookupByPortDev"
+	case ProcNwfilterBindingGetXMLDesc:
+		return "ProcNwfilterBindingGetXMLDesc"
+	case ProcNwfilterBindingCreateXML:
+		return "ProcNwfilterBindingCreateXML"
+	case ProcNwfilterBindingDelete:
+		return "ProcNwfilterBindingDelete"
+	case ProcConnectListAllNwfilterBindings:
+		return "ProcConnectListAllNwfilterBindings"
+	case ProcDomainSetIothreadParams:
+		return "ProcDomainSetIothreadParams"
+	case ProcConnectGetStoragePoolCapabilities:
+		return "ProcConnectGetStoragePoolCapabilities"
+	case ProcNetworkListAllPorts:
+		return "ProcNetworkListAllPorts"
+	case ProcNetworkPortLookupByUUID:
+		return "ProcNetworkPortLookupByUUID"
+	case ProcNetworkPortCreateXML:
+		return "ProcNetworkPortCreateXML"
+	case ProcNetworkPortGetParameters:
+		return "ProcNetworkPortGetParameters"
+	case ProcNetworkPortSetParameters:
+		return "ProcNetworkPortSetParameters"
+	case ProcNetworkPortGetXMLDesc:
+		return "ProcNetworkPortGetXMLDesc"
+	case ProcNetworkPortDelete:
+		return "ProcNetworkPortDelete"
+	case ProcDomainCheckpointCreateXML:
+		return "ProcDomainCheckpointCreateXML"
+	case ProcDomainCheckpointGetXMLDesc:
+		return "ProcDomainCheckpointGetXMLDesc"
+	case ProcDomainListAllCheckpoints:
+		return "ProcDomainListAllCheckpoints"
+	case ProcDomainCheckpointListAllChildren:
+		return "ProcDomainCheckpointListAllChildren"
+	case ProcDomainCheckpointLookupByName:
+		return "ProcDomainCheckpointLookupByName"
+	case ProcDomainCheckpointGetParent:
+		return "ProcDomainCheckpointGetParent"
+	case ProcDomainCheckpointDelete:
+		return "ProcDomainCheckpointDelete"
+	case ProcDomainGetGuestInfo:
+		return "ProcDomainGetGuestInfo"
+	case ProcConnectSetIdentity:
+		return "ProcConnectSetIdentity"
+	case ProcDomainAgentSetResponseTimeout:
+		return "ProcDomainAgentSetResponseTimeout"
+	case ProcDomainBackupBegin:
+		return "ProcDomainBackupBegin"
+	case ProcDomainBackupGetXMLDesc:
+		return "ProcDomainBackupGetXMLDesc"
+	case ProcDomainEventMemoryFailure:
+		return "ProcDomainEventMemoryFailure"
+	case ProcDomainAuthorizedSshKeysGet:
+		return "ProcDomainAuthorizedSshKeysGet"
+	case ProcDomainAuthorizedSshKeysSet:
+		return "ProcDomainAuthorizedSshKeysSet"
+	case ProcDomainGetMessages:
+		return "ProcDomainGetMessages"
+	}
+	return fmt.Sprintf("Procedure(%d)", int32(e))
+}
+
+// IsValid reports whether e is one of the Procedure values.
+func (e Procedure) IsValid() bool {
+	switch e {
+	case ProcConnectOpen,
+		ProcConnectClose,
+		ProcConnectGetType,
+		ProcConnectGetVersion,
+		ProcConnectGetMaxVcpus,
+		ProcNodeGetInfo,
+		ProcConnectGetCapabilities,
+		ProcDomainAttachDevice,
+		ProcDomainCreate,
+		ProcDomainCreateXML,
+		ProcDomainDefineXML,
+		ProcDomainDestroy,
+		ProcDomainDetachDevice,
+		ProcDomainGetXMLDesc,
+		ProcDomainGetAutostart,
+		ProcDomainGetInfo,
+		ProcDomainGetMaxMemory,
+		ProcDomainGetMaxVcpus,
+		ProcDomainGetOsType,
+		ProcDomainGetVcpus,
+		ProcConnectListDefinedDomains,
+		ProcDomainLookupByID,
+		ProcDomainLookupByName,
+		ProcDomainLookupByUUID,
+		ProcConnectNumOfDefinedDomains,
+		ProcDomainPinVcpu,
+		ProcDomainReboot,
+		ProcDomainResume,
+		ProcDomainSetAutostart,
+		ProcDomainSetMaxMemory,
+		ProcDomainSetMemory,
+		ProcDomainSetVcpus,
+		ProcDomainShutdown,
+		ProcDomainSuspend,
+		ProcDomainUndefine,
+		ProcConnectListDefinedNetworks,
+		ProcConnectListDomains,
+		ProcConnectListNetworks,
+		ProcNetworkCreate,
+		ProcNetworkCreateXML,
+		ProcNetworkDefineXML,
+		ProcNetworkDestroy,
+		ProcNetworkGetXMLDesc,
+		ProcNetworkGetAutostart,
+		ProcNetworkGetBridgeName,
+		ProcNetworkLookupByName,
+		ProcNetworkLookupByUUID,
+		ProcNetworkSetAutostart,
+		ProcNetworkUndefine,
+		ProcConnectNumOfDefinedNetworks,
+		ProcConnectNumOfDomains,
+		ProcConnectNumOfNetworks,
+		ProcDomainCoreDump,
+		ProcDomainRestore,
+		ProcDomainSave,
+		ProcDomainGetSchedulerType,
+		ProcDomainGetSchedulerParameters,
+		ProcDomainSetSchedulerParameters,
+		ProcConnectGetHostname,
+		ProcConnectSupportsFeature,
+		ProcDomainMigratePrepare,
+		ProcDomainMigratePerform,
+		ProcDomainMigrateFinish,
+		ProcDomainBlockStats,
+		ProcDomainInterfaceStats,
+		ProcAuthList,
+		ProcAuthSaslInit,
+		ProcAuthSaslStart,
+		ProcAuthSaslStep,
+		ProcAuthPolkit,
+		ProcConnectNumOfStoragePools,
+		ProcConnectListStoragePools,
+		ProcConnectNumOfDefinedStoragePools,
+		ProcConnectListDefinedStoragePools,
+		ProcConnectFindStoragePoolSources,
+		ProcStoragePoolCreateXML,
+		ProcStoragePoolDefineXML,
+		ProcStoragePoolCreate,
+		ProcStoragePoolBuild,
+		ProcStoragePoolDestroy,
+		ProcStoragePoolDelete,
+		ProcStoragePoolUndefine,
+		ProcStoragePoolRefresh,
+		ProcStoragePoolLookupByName,
+		ProcStoragePoolLookupByUUID,
+		ProcStoragePoolLookupByVolume,
+		ProcStoragePoolGetInfo,
+		ProcStoragePoolGetXMLDesc,
+		ProcStoragePoolGetAutostart,
+		ProcStoragePoolSetAutostart,
+		ProcStoragePoolNumOfVolumes,
+		ProcStoragePoolListVolumes,
+		ProcStorageVolCreateXML,
+		ProcStorageVolDelete,
+		ProcStorageVolLookupByName,
+		ProcStorageVolLookupByKey,
+		ProcStorageVolLookupByPath,
+		ProcStorageVolGetInfo,
+		ProcStorageVolGetXMLDesc,
+		ProcStorageVolGetPath,
+		ProcNodeGetCellsFreeMemory,
+		ProcNodeGetFreeMemory,
+		ProcDomainBlockPeek,
+		ProcDomainMemoryPeek,
+		ProcConnectDomainEventRegister,
+		ProcConnectDomainEventDeregister,
+		ProcDomainEventLifecycle,
+		ProcDomainMigratePrepare2,
+		ProcDomainMigrateFinish2,
+		ProcConnectGetUri,
+		ProcNodeNumOfDevices,
+		ProcNodeListDevices,
+		ProcNodeDeviceLookupByName,
+		ProcNodeDeviceGetXMLDesc,
+		ProcNodeDeviceGetParent,
+		ProcNodeDeviceNumOfCaps,
+		ProcNodeDeviceListCaps,
+		ProcNodeDeviceDettach,
+		ProcNodeDeviceReAttach,
+		ProcNodeDeviceReset,
+		ProcDomainGetSecurityLabel,
+		ProcNodeGetSecurityModel,
+		ProcNodeDeviceCreateXML,
+		ProcNodeDeviceDestroy,
+		ProcStorageVolCreateXMLFrom,
+		ProcConnectNumOfInterfaces,
+		ProcConnectListInterfaces,
+		ProcInterfaceLookupByName,
+		ProcInterfaceLookupByMacString,
+		ProcInterfaceGetXMLDesc,
+		ProcInterfaceDefineXML,
+		ProcInterfaceUndefine,
+		ProcInterfaceCreate,
+		ProcInterfaceDestroy,
+		ProcConnectDomainXMLFromNative,
+		ProcConnectDomainXMLToNative,
+		ProcConnectNumOfDefinedInterfaces,
+		ProcConnectListDefinedInterfaces,
+		ProcConnectNumOfSecrets,
+		ProcConnectListSecrets,
+		ProcSecretLookupByUUID,
+		ProcSecretDefineXML,
+		ProcSecretGetXMLDesc,
+		ProcSecretSetValue,
+		ProcSecretGetValue,
+		ProcSecretUndefine,
+		ProcSecretLookupByUsage,
+		ProcDomainMigratePrepareTunnel,
+		ProcConnectIsSecure,
+		ProcDomainIsActive,
+		ProcDomainIsPersistent,
+		ProcNetworkIsActive,
+		ProcNetworkIsPersistent,
+		ProcStoragePoolIsActive,
+		ProcStoragePoolIsPersistent,
+		ProcInterfaceIsActive,
+		ProcConnectGetLibVersion,
+		ProcConnectCompareCPU,
+		ProcDomainMemoryStats,
+		ProcDomainAttachDeviceFlags,
+		ProcDomainDetachDeviceFlags,
+		ProcConnectBaselineCPU,
+		ProcDomainGetJobInfo,
+		ProcDomainAbortJob,
+		ProcStorageVolWipe,
+		ProcDomainMigrateSetMaxDowntime,
+		ProcConnectDomainEventRegisterAny,
+		ProcConnectDomainEventDeregisterAny,
+		ProcDomainEventReboot,
+		ProcDomainEventRtcChange,
+		ProcDomainEventWatchdog,
+		ProcDomainEventIOError,
+		ProcDomainEventGraphics,
+		ProcDomainUpdateDeviceFlags,
+		ProcNwfilterLookupByName,
+		ProcNwfilterLookupByUUID,
+		ProcNwfilterGetXMLDesc,
+		ProcConnectNumOfNwfilters,
+		ProcConnectListNwfilters,
+		ProcNwfilterDefineXML,
+		ProcNwfilterUndefine,
+		ProcDomainManagedSave,
+		ProcDomainHasManagedSaveImage,
+		ProcDomainManagedSaveRemove,
+		ProcDomainSnapshotCreateXML,
+		ProcDomainSnapshotGetXMLDesc,
+		ProcDomainSnapshotNum,
+		ProcDomainSnapshotListNames,
+		ProcDomainSnapshotLookupByName,
+		ProcDomainHasCurrentSnapshot,
+		ProcDomainSnapshotCurrent,
+		ProcDomainRevertToSnapshot,
+		ProcDomainSnapshotDelete,
+		ProcDomainGetBlockInfo,
+		ProcDomainEventIOErrorReason,
+		ProcDomainCreateWithFlags,
+		ProcDomainSetMemoryParameters,
+		ProcDomainGetMemoryParameters,
+		ProcDomainSetVcpusFlags,
+		ProcDomainGetVcpusFlags,
+		ProcDomainOpenConsole,
+		ProcDomainIsUpdated,
+		ProcConnectGetSysinfo,
+		ProcDomainSetMemoryFlags,
+		ProcDomainSetBlkioParameters,
+		ProcDomainGetBlkioParameters,
+		ProcDomainMigrateSetMaxSpeed,
+		ProcStorageVolUpload,
+		ProcStorageVolDownload,
+		ProcDomainInjectNmi,
+		ProcDomainScreenshot,
+		ProcDomainGetState,
+		ProcDomainMigrateBegin3,
+		ProcDomainMigratePrepare3,
+		ProcDomainMigratePrepareTunnel3,
+		ProcDomainMigratePerform3,
+		ProcDomainMigrateFinish3,
+		ProcDomainMigrateConfirm3,
+		ProcDomainSetSchedulerParametersFlags,
+		ProcInterfaceChangeBegin,
+		ProcInterfaceChangeCommit,
+		ProcInterfaceChangeRollback,
+		ProcDomainGetSchedulerParametersFlags,
+		ProcDomainEventControlError,
+		ProcDomainPinVcpuFlags,
+		ProcDomainSendKey,
+		ProcNodeGetCPUStats,
+		ProcNodeGetMemoryStats,
+		ProcDomainGetControlInfo,
+		ProcDomainGetVcpuPinInfo,
+		ProcDomainUndefineFlags,
+		ProcDomainSaveFlags,
+		ProcDomainRestoreFlags,
+		ProcDomainDestroyFlags,
+		ProcDomainSaveImageGetXMLDesc,
+		ProcDomainSaveImageDefineXML,
+		ProcDomainBlockJobAbort,
+		ProcDomainGetBlockJobInfo,
+		ProcDomainBlockJobSetSpeed,
+		ProcDomainBlockPull,
+		ProcDomainEventBlockJob,
+		ProcDomainMigrateGetMaxSpeed,
+		ProcDomainBlockStatsFlags,
+		ProcDomainSnapshotGetParent,
+		ProcDomainReset,
+		ProcDomainSnapshotNumChildren,
+		ProcDomainSnapshotListChildrenNames,
+		ProcDomainEventDiskChange,
+		ProcDomainOpenGraphics,
+		ProcNodeSuspendForDuration,
+		ProcDomainBlockResize,
+		ProcDomainSetBlockIOTune,
+		ProcDomainGetBlockIOTune,
+		ProcDomainSetNumaParameters,
+		ProcDomainGetNumaParameters,
+		ProcDomainSetInterfaceParameters,
+		ProcDomainGetInterfaceParameters,
+		ProcDomainShutdownFlags,
+		ProcStorageVolWipePattern,
+		ProcStorageVolResize,
+		ProcDomainPmSuspendForDuration,
+		ProcDomainGetCPUStats,
+		ProcDomainGetDiskErrors,
+		ProcDomainSetMetadata,
+		ProcDomainGetMetadata,
+		ProcDomainBlockRebase,
+		ProcDomainPmWakeup,
+		ProcDomainEventTrayChange,
+		ProcDomainEventPmwakeup,
+		ProcDomainEventPmsuspend,
+		ProcDomainSnapshotIsCurrent,
+		ProcDomainSnapshotHasMetadata,
+		ProcConnectListAllDomains,
+		ProcDomainListAllSnapshots,
+		ProcDomainSnapshotListAllChildren,
+		ProcDomainEventBalloonChange,
+		ProcDomainGetHostname,
+		ProcDomainGetSecurityLabelList,
+		ProcDomainPinEmulator,
+		ProcDomainGetEmulatorPinInfo,
+		ProcConnectListAllStoragePools,
+		ProcStoragePoolListAllVolumes,
+		ProcConnectListAllNetworks,
+		ProcConnectListAllInterfaces,
+		ProcConnectListAllNodeDevices,
+		ProcConnectListAllNwfilters,
+		ProcConnectListAllSecrets,
+		ProcNodeSetMemoryParameters,
+		ProcNodeGetMemoryParameters,
+		ProcDomainBlockCommit,
+		ProcNetworkUpdate,
+		ProcDomainEventPmsuspendDisk,
+		ProcNodeGetCPUMap,
+		ProcDomainFstrim,
+		ProcDomainSendProcessSignal,
+		ProcDomainOpenChannel,
+		ProcNodeDeviceLookupScsiHostByWwn,
+		ProcDomainGetJobStats,
+		ProcDomainMigrateGetCompressionCache,
+		ProcDomainMigrateSetCompressionCache,
+		ProcNodeDeviceDetachFlags,
+		ProcDomainMigrateBegin3Params,
+		ProcDomainMigratePrepare3Params,
+		ProcDomainMigratePrepareTunnel3Params,
+		ProcDomainMigratePerform3Params,
+		ProcDomainMigrateFinish3Params,
+		ProcDomainMigrateConfirm3Params,
+		ProcDomainSetMemoryStatsPeriod,
+		ProcDomainCreateXMLWithFiles,
+		ProcDomainCreateWithFiles,
+		ProcDomainEventDeviceRemoved,
+		ProcConnectGetCPUModelNames,
+		ProcConnectNetworkEventRegisterAny,
+		ProcConnectNetworkEventDeregisterAny,
+		ProcNetworkEventLifecycle,
+		ProcConnectDomainEventCallbackRegisterAny,
+		ProcConnectDomainEventCallbackDeregisterAny,
+		ProcDomainEventCallbackLifecycle,
+		ProcDomainEventCallbackReboot,
+		ProcDomainEventCallbackRtcChange,
+		ProcDomainEventCallbackWatchdog,
+		ProcDomainEventCallbackIOError,
+		ProcDomainEventCallbackGraphics,
+		ProcDomainEventCallbackIOErrorReason,
+		ProcDomainEventCallbackControlError,
+		ProcDomainEventCallbackBlockJob,
+		ProcDomainEventCallbackDiskChange,
+		ProcDomainEventCallbackTrayChange,
+		ProcDomainEventCallbackPmwakeup,
+		ProcDomainEventCallbackPmsuspend,
+		ProcDomainEventCallbackBalloonChange,
+		ProcDomainEventCallbackPmsuspendDisk,
+		ProcDomainEventCallbackDeviceRemoved,
+		ProcDomainCoreDumpWithFormat,
+		ProcDomainFsfreeze,
+		ProcDomainFsthaw,
+		ProcDomainGetTime,
+		ProcDomainSetTime,
+		ProcDomainEventBlockJob2,
+		ProcNodeGetFreePages,
+		ProcNetworkGetDhcpLeases,
+		ProcConnectGetDomainCapabilities,
+		ProcDomainOpenGraphicsFd,
+		ProcConnectGetAllDomainStats,
+		ProcDomainBlockCopy,
+		ProcDomainEventCallbackTunable,
+		ProcNodeAllocPages,
+		ProcDomainEventCallbackAgentLifecycle,
+		ProcDomainGetFsinfo,
+		ProcDomainDefineXMLFlags,
+		ProcDomainGetIothreadInfo,
+		ProcDomainPinIothread,
+		ProcDomainInterfaceAddresses,
+		ProcDomainEventCallbackDeviceAdded,
+		ProcDomainAddIothread,
+		ProcDomainDelIothread,
+		ProcDomainSetUserPassword,
+		ProcDomainRename,
+		ProcDomainEventCallbackMigrationIteration,
+		ProcConnectRegisterCloseCallback,
+		ProcConnectUnregisterCloseCallback,
+		ProcConnectEventConnectionClosed,
+		ProcDomainEventCallbackJobCompleted,
+		ProcDomainMigrateStartPostCopy,
+		ProcDomainGetPerfEvents,
+		ProcDomainSetPerfEvents,
+		ProcDomainEventCallbackDeviceRemovalFailed,
+		ProcConnectStoragePoolEventRegisterAny,
+		ProcConnectStoragePoolEventDeregisterAny,
+		ProcStoragePoolEventLifecycle,
+		ProcDomainGetGuestVcpus,
+		ProcDomainSetGuestVcpus,
+		ProcStoragePoolEventRefresh,
+		ProcConnectNodeDeviceEventRegisterAny,
+		ProcConnectNodeDeviceEventDeregisterAny,
+		ProcNodeDeviceEventLifecycle,
+		ProcNodeDeviceEventUpdate,
+		ProcStorageVolGetInfoFlags,
+		ProcDomainEventCallbackMetadataChange,
+		ProcConnectSecretEventRegisterAny,
+		ProcConnectSecretEventDeregisterAny,
+		ProcSecretEventLifecycle,
+		ProcSecretEventValueChanged,
+		ProcDomainSetVcpu,
+		ProcDomainEventBlockThreshold,
+		ProcDomainSetBlockThreshold,
+		ProcDomainMigrateGetMaxDowntime,
+		ProcDomainManagedSaveGetXMLDesc,
+		ProcDomainManagedSaveDefineXML,
+		ProcDomainSetLifecycleAction,
+		ProcStoragePoolLookupByTargetPath,
+		ProcDomainDetachDeviceAlias,
+		ProcConnectCompareHypervisorCPU,
+		ProcConnectBaselineHypervisorCPU,
+		ProcNodeGetSevInfo,
+		ProcDomainGetLaunchSecurityInfo,
+		ProcNwfilterBindingLookupByPortDev,
+		ProcNwfilterBindingGetXMLDesc,
+		ProcNwfilterBindingCreateXML,
+		ProcNwfilterBindingDelete,
+		ProcConnectListAllNwfilterBindings,
+		ProcDomainSetIothreadParams,
+		ProcConnectGetStoragePoolCapabilities,
+		ProcNetworkListAllPorts,
+		ProcNetworkPortLookupByUUID,
+		ProcNetworkPortCreateXML,
+		ProcNetworkPortGetParameters,
+		ProcNetworkPortSetParameters,
+		ProcNetworkPortGetXMLDesc,
+		ProcNetworkPortDelete,
+		ProcDomainCheckpointCreateXML,
+		ProcDomainCheckpointGetXMLDesc,
+		ProcDomainListAllCheckpoints,
+		ProcDomainCheckpointListAllChildren,
+		ProcDomainCheckpointLookupByName,
+		ProcDomainCheckpointGetParent,
+		ProcDomainCheckpointDelete,
+		ProcDomainGetGuestInfo,
+		ProcConnectSetIdentity,
+		ProcDomainAgentSetResponseTimeout,
+		ProcDomainBackupBegin,
+		ProcDomainBackupGetXMLDesc,
+		ProcDomainEventMemoryFailure,
+		ProcDomainAuthorizedSshKeysGet,
+		ProcDomainAuthorizedSshKeysSet,
+		ProcDomainGetMessages:
+		return true
+	}
+	return false
+}
+
+// ParseProcedure returns the Procedure value with the given name, as returned
+// by String.
+func ParseProcedure(s string) (Procedure, error) {
+	switch s {
+	case "ProcConnectOpen":
+		return ProcConnectOpen, nil
+	case "ProcConnectClose":
+		return ProcConnectClose, nil
+	case "ProcConnectGetType":
+		return ProcConnectGetType, nil
+	case "ProcConnectGetVersion":
+		return ProcConnectGetVersion, nil
+	case "ProcConnectGetMaxVcpus":
+		return ProcConnectGetMaxVcpus, nil
+	case "ProcNodeGetInfo":
+		return ProcNodeGetInfo, nil
+	case "ProcConnectGetCapabilities":
+		return ProcConnectGetCapabilities, nil
+	case "ProcDomainAttachDevice":
+		return ProcDomainAttachDevice, nil
+	case "ProcDomainCreate":
+		return ProcDomainCreate, nil
+	case "ProcDomainCreateXML":
+		return ProcDomainCreateXML, nil
+	case "ProcDomainDefineXML":
+		return ProcDomainDefineXML, nil
+	case "ProcDomainDestroy":
+		return ProcDomainDestroy, nil
+	case "ProcDomainDetachDevice":
+		return ProcDomainDetachDevice, nil
+	case "ProcDomainGetXMLDesc":
+		return ProcDomainGetXMLDesc, nil
+	case "ProcDomainGetAutostart":
+		return ProcDomainGetAutostart, nil
+	case "ProcDomainGetInfo":
+		return ProcDomainGetInfo, nil
+	case "ProcDomainGetMaxMemory":
+		return ProcDomainGetMaxMemory, nil
+	case "ProcDomainGetMaxVcpus":
+		return ProcDomainGetMaxVcpus, nil
+	case "ProcDomainGetOsType":
+		return ProcDomainGetOsType, nil
+	case "ProcDomainGetVcpus":
+		return ProcDomainGetVcpus, nil
+	case "ProcConnectListDefinedDomains":
+		return ProcConnectListDefinedDomains, nil
+	case "ProcDomainLookupByID":
+		return ProcDomainLookupByID, nil
+	case "ProcDomainLookupByName":
+		return ProcDomainLookupByName, nil
+	case "ProcDomainLookupByUUID":
+		return ProcDomainLookupByUUID, nil
+	case "ProcConnectNumOfDefinedDomains":
+		return ProcConnectNumOfDefinedDomains, nil
+	case "ProcDomainPinVcpu":
+		return ProcDomainPinVcpu, nil
+	case "ProcDomainReboot":
+		return ProcDomainReboot, nil
+	case "ProcDomainResume":
+		return ProcDomainResume, nil
+	case "ProcDomainSetAutostart":
+		return ProcDomainSetAutostart, nil
+	case "ProcDomainSetMaxMemory":
+		return ProcDomainSetMaxMemory, nil
+	case "ProcDomainSetMemory":
+		return ProcDomainSetMemory, nil
+	case "ProcDomainSetVcpus":
+		return ProcDomainSetVcpus, nil
+	case "ProcDomainShutdown":
+		return ProcDomainShutdown, nil
+	case "ProcDomainSuspend":
+		return ProcDomainSuspend, nil
+	case "ProcDomainUndefine":
+		return ProcDomainUndefine, nil
+	case "ProcConnectListDefinedNetworks":
+		return ProcConnectListDefinedNetworks, nil
+	case "ProcConnectListDomains":
+		return ProcConnectListDomains, nil
+	case "ProcConnectListNetworks":
+		return ProcConnectListNetworks, nil
+	case "ProcNetworkCreate":
+		return ProcNetworkCreate, nil
+	case "ProcNetworkCreateXML":
+		return ProcNetworkCreateXML, nil
+	case "ProcNetworkDefineXML":
+		return ProcNetworkDefineXML, nil
+	case "ProcNetworkDestroy":
+		return ProcNetworkDestroy, nil
+	case "ProcNetworkGetXMLDesc":
+		return ProcNetworkGetXMLDesc, nil
+	case "ProcNetworkGetAutostart":
+		return ProcNetworkGetAutostart, nil
+	case "ProcNetworkGetBridgeName":
+		return ProcNetworkGetBridgeName, nil
+	case "ProcNetworkLookupByName":
+		return ProcNetworkLookupByName, nil
+	case "ProcNetworkLookupByUUID":
+		return ProcNetworkLookupByUUID, nil
+	case "ProcNetworkSetAutostart":
+		return ProcNetworkSetAutostart, nil
+	case "ProcNetworkUndefine":
+		return ProcNetworkUndefine, nil
+	case "ProcConnectNumOfDefinedNetworks":
+		return ProcConnectNumOfDefinedNetworks, nil
+	case "ProcConnectNumOfDomains":
+		return ProcConnectNumOfDomains, nil
+	case "ProcConnectNumOfNetworks":
+		return ProcConnectNumOfNetworks, nil
+	case "ProcDomainCoreDump":
+		return ProcDomainCoreDump, nil
+	case "ProcDomainRestore":
+		return ProcDomainRestore, nil
+	case "ProcDomainSave":
+		return ProcDomainSave, nil
+	case "ProcDomainGetSchedulerType":
+		return ProcDomainGetSchedulerType, nil
+	case "ProcDomainGetSchedulerParameters":
+		return ProcDomainGetSchedulerParameters, nil
+	case "ProcDomainSetSchedulerParameters":
+		return ProcDomainSetSchedulerParameters, nil
+	case "ProcConnectGetHostname":
+		return ProcConnectGetHostname, nil
+	case "ProcConnectSupportsFeature":
+		return ProcConnectSupportsFeature, nil
+	case "ProcDomainMigratePrepare":
+		return ProcDomainMigratePrepare, nil
+	case "ProcDomainMigratePerform":
+		return ProcDomainMigratePerform, nil
+	case "ProcDomainMigrateFinish":
+		return ProcDomainMigrateFinish, nil
+	case "ProcDomainBlockStats":
+		return ProcDomainBlockStats, nil
+	case "ProcDomainInterfaceStats":
+		return ProcDomainInterfaceStats, nil
+	case "ProcAuthList":
+		return ProcAuthList, nil
+	case "ProcAuthSaslInit":
+		return ProcAuthSaslInit, nil
+	case "ProcAuthSaslStart":
+		return ProcAuthSaslStart, nil
+	case "ProcAuthSaslStep":
+		return ProcAuthSaslStep, nil
+	case "ProcAuthPolkit":
+		return ProcAuthPolkit, nil
+	case "ProcConnectNumOfStoragePools":
+		return ProcConnectNumOfStoragePools, nil
+	case "ProcConnectListStoragePools":
+		return ProcConnectListStoragePools, nil
+	case "ProcConnectNumOfDefinedStoragePools":
+		return ProcConnectNumOfDefinedStoragePools, nil
+	case "ProcConnectListDefinedStoragePools":
+		return ProcConnectListDefinedStoragePools, nil
+	case "ProcConnectFindStoragePoolSources":
+		return ProcConnectFindStoragePoolSources, nil
+	case "ProcStoragePoolCreateXML":
+		return ProcStoragePoolCreateXML, nil
+	case "ProcStoragePoolDefineXML":
+		return ProcStoragePoolDefineXML, nil
+	case "ProcStoragePoolCreate":
+		return ProcStoragePoolCreate, nil
+	case "ProcStoragePoolBuild":
+		return ProcStoragePoolBuild, nil
+	case "ProcStoragePoolDestroy":
+		return ProcStoragePoolDestroy, nil
+	case "ProcStoragePoolDelete":
+		return ProcStoragePoolDelete, nil
+	case "ProcStoragePoolUndefine":
+		return ProcStoragePoolUndefine, nil
+	case "ProcStoragePoolRefresh":
+		return ProcStoragePoolRefresh, nil
+	case "ProcStoragePoolLookupByName":
+		return ProcStoragePoolLookupByName, nil
+	case "ProcStoragePoolLookupByUUID":
+		return ProcStoragePoolLookupByUUID, nil
+	case "ProcStoragePoolLookupByVolume":
+		return ProcStoragePoolLookupByVolume, nil
+	case "ProcStoragePoolGetInfo":
+		return ProcStoragePoolGetInfo, nil
+	case "ProcStoragePoolGetXMLDesc":
+		return ProcStoragePoolGetXMLDesc, nil
+	case "ProcStoragePoolGetAutostart":
+		return ProcStoragePoolGetAutostart, nil
+	case "ProcStoragePoolSetAutostart":
+		return ProcStoragePoolSetAutostart, nil
+	case "ProcStoragePoolNumOfVolumes":
+		return ProcStoragePoolNumOfVolumes, nil
+	case "ProcStoragePoolListVolumes":
+		return ProcStoragePoolListVolumes, nil
+	case "ProcStorageVolCreateXML":
+		return ProcStorageVolCreateXML, nil
+	case "ProcStorageVolDelete":
+		return ProcStorageVolDelete, nil
+	case "ProcStorageVolLookupByName":
+		return ProcStorageVolLookupByName, nil
+	case "ProcStorageVolLookupByKey":
+		return ProcStorageVolLookupByKey, nil
+	case "ProcStorageVolLookupByPath":
+		return ProcStorageVolLookupByPath, nil
+	case "ProcStorageVolGetInfo":
+		return ProcStorageVolGetInfo, nil
+	case "ProcStorageVolGetXMLDesc":
+		return ProcStorageVolGetXMLDesc, nil
+	case "ProcStorageVolGetPath":
+		return ProcStorageVolGetPath, nil
+	case "ProcNodeGetCellsFreeMemory":
+		return ProcNodeGetCellsFreeMemory, nil
+	case "ProcNodeGetFreeMemory":
+		return ProcNodeGetFreeMemory, nil
+	case "ProcDomainBlockPeek":
+		return ProcDomainBlockPeek, nil
+	case "ProcDomainMemoryPeek":
+		return ProcDomainMemoryPeek, nil
+	case "ProcConnectDomainEventRegister":
+		return ProcConnectDomainEventRegister, nil
+	case "ProcConnectDomainEventDeregister":
+		return ProcConnectDomainEventDeregister, nil
+	case "ProcDomainEventLifecycle":
+		return ProcDomainEventLifecycle, nil
+	case "ProcDomainMigratePrepare2":
+		return ProcDomainMigratePrepare2, nil
+	case "ProcDomainMigrateFinish2":
+		return ProcDomainMigrateFinish2, nil
+	case "ProcConnectGetUri":
+		return ProcConnectGetUri, nil
+	case "ProcNodeNumOfDevices":
+		return ProcNodeNumOfDevices, nil
+	case "ProcNodeListDevices":
+		return ProcNodeListDevices, nil
+	case "ProcNodeDeviceLookupByName":
+		return ProcNodeDeviceLookupByName, nil
+	case "ProcNodeDeviceGetXMLDesc":
+		return ProcNodeDeviceGetXMLDesc, nil
+	case "ProcNodeDeviceGetParent":
+		return ProcNodeDeviceGetParent, nil
+	case "ProcNodeDeviceNumOfCaps":
+		return ProcNodeDeviceNumOfCaps, nil
+	case "ProcNodeDeviceListCaps":
+		return ProcNodeDeviceListCaps, nil
+	case "ProcNodeDeviceDettach":
+		return ProcNodeDeviceDettach, nil
+	case "ProcNodeDeviceReAttach":
+		return ProcNodeDeviceReAttach, nil
+	case "ProcNodeDeviceReset":
+		return ProcNodeDeviceReset, nil
+	case "ProcDomainGetSecurityLabel":
+		return ProcDomainGetSecurityLabel, nil
+	case "ProcNodeGetSecurityModel":
+		return ProcNodeGetSecurityModel, nil
+	case "ProcNodeDeviceCreateXML":
+		return ProcNodeDeviceCreateXML, nil
+	case "ProcNodeDeviceDestroy":
+		return ProcNodeDeviceDestroy, nil
+	case "ProcStorageVolCreateXMLFrom":
+		return ProcStorageVolCreateXMLFrom, nil
+	case "ProcConnectNumOfInterfaces":
+		return ProcConnectNumOfInterfaces, nil
+	case "ProcConnectListInterfaces":
+		return ProcConnectListInterfaces, nil
+	case "ProcInterfaceLookupByName":
+		return ProcInterfaceLookupByName, nil
+	case "ProcInterfaceLookupByMacString":
+		return ProcInterfaceLookupByMacString, nil
+	case "ProcInterfaceGetXMLDesc":
+		return ProcInterfaceGetXMLDesc, nil
+	case "ProcInterfaceDefineXML":
+		return ProcInterfaceDefineXML, nil
+	case "ProcInterfaceUndefine":
+		return ProcInterfaceUndefine, nil
+	case "ProcInterfaceCreate":
+		return ProcInterfaceCreate, nil
+	case "ProcInterfaceDestroy":
+		return ProcInterfaceDestroy, nil
+	case "ProcConnectDomainXMLFromNative":
+		return ProcConnectDomainXMLFromNative, nil
+	case "ProcConnectDomainXMLToNative":
+		return ProcConnectDomainXMLToNative, nil
+	case "ProcConnectNumOfDefinedInterfaces":
+		return ProcConnectNumOfDefinedInterfaces, nil
+	case "ProcConnectListDefinedInterfaces":
+		return ProcConnectListDefinedInterfaces, nil
+	case "ProcConnectNumOfSecrets":
+		return ProcConnectNumOfSecrets, nil
+	case "ProcConnectListSecrets":
+		return ProcConnectListSecrets, nil
+	case "ProcSecretLookupByUUID":
+		return ProcSecretLookupByUUID, nil
+	case "ProcSecretDefineXML":
+		return ProcSecretDefineXML, nil
+	case "ProcSecretGetXMLDesc":
+		return ProcSecretGetXMLDesc, nil
+	case "ProcSecretSetValue":
+		return ProcSecretSetValue, nil
+	case "ProcSecretGetValue":
+		return ProcSecretGetValue, nil
+	case "ProcSecretUndefine":
+		return ProcSecretUndefine, nil
+	case "ProcSecretLookupByUsage":
+		return ProcSecretLookupByUsage, nil
+	case "ProcDomainMigratePrepareTunnel":
+		return ProcDomainMigratePrepareTunnel, nil
+	case "ProcConnectIsSecure":
+		return ProcConnectIsSecure, nil
+	case "ProcDomainIsActive":
+		return ProcDomainIsActive, nil
+	case "ProcDomainIsPersistent":
+		return ProcDomainIsPersistent, nil
+	case "ProcNetworkIsActive":
+		return ProcNetworkIsActive, nil
+	case "ProcNetworkIsPersistent":
+		return ProcNetworkIsPersistent, nil
+	case "ProcStoragePoolIsActive":
+		return ProcStoragePoolIsActive, nil
+	case "ProcStoragePoolIsPersistent":
+		return ProcStoragePoolIsPersistent, nil
+	case "ProcInterfaceIsActive":
+		return ProcInterfaceIsActive, nil
+	case "ProcConnectGetLibVersion":
+		return ProcConnectGetLibVersion, nil
+	case "ProcConnectCompareCPU":
+		return ProcConnectCompareCPU, nil
+	case "ProcDomainMemoryStats":
+		return ProcDomainMemoryStats, nil
+	case "ProcDomainAttachDeviceFlags":
+		return ProcDomainAttachDeviceFlags, nil
+	case "ProcDomainDetachDeviceFlags":
+		return ProcDomainDetachDeviceFlags, nil
+	case "ProcConnectBaselineCPU":
+		return ProcConnectBaselineCPU, nil
+	case "ProcDomainGetJobInfo":
+		return ProcDomainGetJobInfo, nil
+	case "ProcDomainAbortJob":
+		return ProcDomainAbortJob, nil
+	case "ProcStorageVolWipe":
+		return ProcStorageVolWipe, nil
+	case "ProcDomainMigrateSetMaxDowntime":
+		return ProcDomainMigrateSetMaxDowntime, nil
+	case "ProcConnectDomainEventRegisterAny":
+		return ProcConnectDomainEventRegisterAny, nil
+	case "ProcConnectDomainEventDeregisterAny":
+		return ProcConnectDomainEventDeregisterAny, nil
+	case "ProcDomainEventReboot":
+		return ProcDomainEventReboot, nil
+	case "ProcDomainEventRtcChange":
+		return ProcDomainEventRtcChange, nil
+	case "ProcDomainEventWatchdog":
+		return ProcDomainEventWatchdog, nil
+	case "ProcDomainEventIOError":
+		return ProcDomainEventIOError, nil
+	case "ProcDomainEventGraphics":
+		return ProcDomainEventGraphics, nil
+	case "ProcDomainUpdateDeviceFlags":
+		return ProcDomainUpdateDeviceFlags, nil
+	case "ProcNwfilterLookupByName":
+		return ProcNwfilterLookupByName, nil
+	case "ProcNwfilterLookupByUUID":
+		return ProcNwfilterLookupByUUID, nil
+	case "ProcNwfilterGetXMLDesc":
+		return ProcNwfilterGetXMLDesc, nil
+	case "ProcConnectNumOfNwfilters":
+		return ProcConnectNumOfNwfilters, nil
+	case "ProcConnectListNwfilters":
+		return ProcConnectListNwfilters, nil
+	case "ProcNwfilterDefineXML":
+		return ProcNwfilterDefineXML, nil
+	case "ProcNwfilterUndefine":
+		return ProcNwfilterUndefine, nil
+	case "ProcDomainManagedSave":
+		return ProcDomainManagedSave, nil
+	case "ProcDomainHasManagedSaveImage":
+		return ProcDomainHasManagedSaveImage, nil
+	case "ProcDomainManagedSaveRemove":
+		return ProcDomainManagedSaveRemove, nil
+	case "ProcDomainSnapshotCreateXML":
+		return ProcDomainSnapshotCreateXML, nil
+	case "ProcDomainSnapshotGetXMLDesc":
+		return ProcDomainSnapshotGetXMLDesc, nil
+	case "ProcDomainSnapshotNum":
+		return ProcDomainSnapshotNum, nil
+	case "ProcDomainSnapshotListNames":
+		return ProcDomainSnapshotListNames, nil
+	case "ProcDomainSnapshotLookupByName":
+		return ProcDomainSnapshotLookupByName, nil
+	case "ProcDomainHasCurrentSnapshot":
+		return ProcDomainHasCurrentSnapshot, nil
+	case "ProcDomainSnapshotCurrent":
+		return ProcDomainSnapshotCurrent, nil
+	case "ProcDomainRevertToSnapshot":
+		return ProcDomainRevertToSnapshot, nil
+	case "ProcDomainSnapshotDelete":
+		return ProcDomainSnapshotDelete, nil
+	case "ProcDomainGetBlockInfo":
+		return ProcDomainGetBlockInfo, nil
+	case "ProcDomainEventIOErrorReason":
+		return ProcDomainEventIOErrorReason, nil
+	case "ProcDomainCreateWithFlags":
+		return ProcDomainCreateWithFlags, nil
+	case "ProcDomainSetMemoryParameters":
+		return ProcDomainSetMemoryParameters, nil
+	case "ProcDomainGetMemoryParameters":
+		return ProcDomainGetMemoryParameters, nil
+	case "ProcDomainSetVcpusFlags":
+		return ProcDomainSetVcpusFlags, nil
+	case "ProcDomainGetVcpusFlags":
+		return ProcDomainGetVcpusFlags, nil
+	case "ProcDomainOpenConsole":
+		return ProcDomainOpenConsole, nil
+	case "ProcDomainIsUpdated":
+		return ProcDomainIsUpdated, nil
+	case "ProcConnectGetSysinfo":
+		return ProcConnectGetSysinfo, nil
+	case "ProcDomainSetMemoryFlags":
+		return ProcDomainSetMemoryFlags, nil
+	case "ProcDomainSetBlkioParameters":
+		return ProcDomainSetBlkioParameters, nil
+	case "ProcDomainGetBlkioParameters":
+		return ProcDomainGetBlkioParameters, nil
+	case "ProcDomainMigrateSetMaxSpeed":
+		return ProcDomainMigrateSetMaxSpeed, nil
+	case "ProcStorageVolUpload":
+		return ProcStorageVolUpload, nil
+	case "ProcStorageVolDownload":
+		return ProcStorageVolDownload, nil
+	case "ProcDomainInjectNmi":
+		return ProcDomainInjectNmi, nil
+	case "ProcDomainScreenshot":
+		return ProcDomainScreenshot, nil
+	case "ProcDomainGetState":
+		return ProcDomainGetState, nil
+	case "ProcDomainMigrateBegin3":
+		return ProcDomainMigrateBegin3, nil
+	case "ProcDomainMigratePrepare3":
+		return ProcDomainMigratePrepare3, nil
+	case "ProcDomainMigratePrepareTunnel3":
+		return ProcDomainMigratePrepareTunnel3, nil
+	case "ProcDomainMigratePerform3":
+		return ProcDomainMigratePerform3, nil
+	case "ProcDomainMigrateFinish3":
+		return ProcDomainMigrateFinish3, nil
+	case "ProcDomainMigrateConfirm3":
+		return ProcDomainMigrateConfirm3, nil
+	case "ProcDomainSetSchedulerParametersFlags":
+		return ProcDomainSetSchedulerParametersFlags, nil
+	case "ProcInterfaceChangeBegin":
+		return ProcInterfaceChangeBegin, nil
+	case "ProcInterfaceChangeCommit":
+		return ProcInterfaceChangeCommit, nil
+	case "ProcInterfaceChangeRollback":
+		return ProcInterfaceChangeRollback, nil
+	case "ProcDomainGetSchedulerParametersFlags":
+		return ProcDomainGetSchedulerParametersFlags, nil
+	case "ProcDomainEventControlError":
+		return ProcDomainEventControlError, nil
+	case "ProcDomainPinVcpuFlags":
+		return ProcDomainPinVcpuFlags, nil
+	case "ProcDomainSendKey":
+		return ProcDomainSendKey, nil
+	case "ProcNodeGetCPUStats":
+		return ProcNodeGetCPUStats, nil
+	case "ProcNodeGetMemoryStats":
+		return ProcNodeGetMemoryStats, nil
+	case "ProcDomainGetControlInfo":
+		return ProcDomainGetControlInfo, nil
+	case "ProcDomainGetVcpuPinInfo":
+		return ProcDomainGetVcpuPinInfo, nil
+	case "ProcDomainUndefineFlags":
+		return ProcDomainUndefineFlags, nil
+	case "ProcDomainSaveFlags":
+		return ProcDomainSaveFlags, nil
+	case "ProcDomainRestoreFlags":
+		return ProcDomainRestoreFlags, nil
+	case "ProcDomainDestroyFlags":
+		return ProcDomainDestroyFlags, nil
+	case "ProcDomainSaveImageGetXMLDesc":
+		return ProcDomainSaveImageGetXMLDesc, nil
+	case "ProcDomainSaveImageDefineXML":
+		return ProcDomainSaveImageDefineXML, nil
+	case "ProcDomainBlockJobAbort":
+		return ProcDomainBlockJobAbort, nil
+	case "ProcDomainGetBlockJobInfo":
+		return ProcDomainGetBlockJobInfo, nil
+	case "ProcDomainBlockJobSetSpeed":
+		return ProcDomainBlockJobSetSpeed, nil
+	case "ProcDomainBlockPull":
+		return ProcDomainBlockPull, nil
+	case "ProcDomainEventBlockJob":
+		return ProcDomainEventBlockJob, nil
+	case "ProcDomainMigrateGetMaxSpeed":
+		return ProcDomainMigrateGetMaxSpeed, nil
+	case "ProcDomainBlockStatsFlags":
+		return ProcDomainBlockStatsFlags, nil
+	case "ProcDomainSnapshotGetParent":
+		return ProcDomainSnapshotGetParent, nil
+	case "ProcDomainReset":
+		return ProcDomainReset, nil
+	case "ProcDomainSnapshotNumChildren":
+		return ProcDomainSnapshotNumChildren, nil
+	case "ProcDomainSnapshotListChildrenNames":
+		return ProcDomainSnapshotListChildrenNames, nil
+	case "ProcDomainEventDiskChange":
+		return ProcDomainEventDiskChange, nil
+	case "ProcDomainOpenGraphics":
+		return ProcDomainOpenGraphics, nil
+	case "ProcNodeSuspendForDuration":
+		return ProcNodeSuspendForDuration, nil
+	case "ProcDomainBlockResize":
+		return ProcDomainBlockResize, nil
+	case "ProcDomainSetBlockIOTune":
+		return ProcDomainSetBlockIOTune, nil
+	case "ProcDomainGetBlockIOTune":
+		return ProcDomainGetBlockIOTune, nil
+	case "ProcDomainSetNumaParameters":
+		return ProcDomainSetNumaParameters, nil
+	case "ProcDomainGetNumaParameters":
+		return ProcDomainGetNumaParameters, nil
+	case "ProcDomainSetInterfaceParameters":
+		return ProcDomainSetInterfaceParameters, nil
+	case "ProcDomainGetInterfaceParameters":
+		return ProcDomainGetInterfaceParameters, nil
+	case "ProcDomainShutdownFlags":
+		return ProcDomainShutdownFlags, nil
+	case "ProcStorageVolWipePattern":
+		return ProcStorageVolWipePattern, nil
+	case "ProcStorageVolResize":
+		return ProcStorageVolResize, nil
+	case "ProcDomainPmSuspendForDuration":
+		return ProcDomainPmSuspendForDuration, nil
+	case "ProcDomainGetCPUStats":
+		return ProcDomainGetCPUStats, nil
+	case "ProcDomainGetDiskErrors":
+		return ProcDomainGetDiskErrors, nil
+	case "ProcDomainSetMetadata":
+		return ProcDomainSetMetadata, nil
+	case "ProcDomainGetMetadata":
+		return ProcDomainGetMetadata, nil
+	case "ProcDomainBlockRebase":
+		return ProcDomainBlockRebase, nil
+	case "ProcDomainPmWakeup":
+		return ProcDomainPmWakeup, nil
+	case "ProcDomainEventTrayChange":
+		return ProcDomainEventTrayChange, nil
+	case "ProcDomainEventPmwakeup":
+		return ProcDomainEventPmwakeup, nil
+	case "ProcDomainEventPmsuspend":
+		return ProcDomainEventPmsuspend, nil
+	case "ProcDomainSnapshotIsCurrent":
+		return ProcDomainSnapshotIsCurrent, nil
+	case "ProcDomainSnapshotHasMetadata":
+		return ProcDomainSnapshotHasMetadata, nil
+	case "ProcConnectListAllDomains":
+		return ProcConnectListAllDomains, nil
+	case "ProcDomainListAllSnapshots":
+		return ProcDomainListAllSnapshots, nil
+	case "ProcDomainSnapshotListAllChildren":
+		return ProcDomainSnapshotListAllChildren, nil
+	case "ProcDomainEventBalloonChange":
+		return ProcDomainEventBalloonChange, nil
+	case "ProcDomainGetHostname":
+		return ProcDomainGetHostname, nil
+	case "ProcDomainGetSecurityLabelList":
+		return ProcDomainGetSecurityLabelList, nil
+	case "ProcDomainPinEmulator":
+		return ProcDomainPinEmulator, nil
+	case "ProcDomainGetEmulatorPinInfo":
+		return ProcDomainGetEmulatorPinInfo, nil
+	case "ProcConnectListAllStoragePools":
+		return ProcConnectListAllStoragePools, nil
+	case "ProcStoragePoolListAllVolumes":
+		return ProcStoragePoolListAllVolumes, nil
+	case "ProcConnectListAllNetworks":
+		return ProcConnectListAllNetworks, nil
+	case "ProcConnectListAllInterfaces":
+		return ProcConnectListAllInterfaces, nil
+	case "ProcConnectListAllNodeDevices":
+		return ProcConnectListAllNodeDevices, nil
+	case "ProcConnectListAllNwfilters":
+		return ProcConnectListAllNwfilters, nil
+	case "ProcConnectListAllSecrets":
+		return ProcConnectListAllSecrets, nil
+	case "ProcNodeSetMemoryParameters":
+		return ProcNodeSetMemoryParameters, nil
+	case "ProcNodeGetMemoryParameters":
+		return ProcNodeGetMemoryParameters, nil
+	case "ProcDomainBlockCommit":
+		return ProcDomainBlockCommit, nil
+	case "ProcNetworkUpdate":
+		return ProcNetworkUpdate, nil
+	case "ProcDomainEventPmsuspendDisk":
+		return ProcDomainEventPmsuspendDisk, nil
+	case "ProcNodeGetCPUMap":
+		return ProcNodeGetCPUMap, nil
+	case "ProcDomainFstrim":
+		return ProcDomainFstrim, nil
+	case "ProcDomainSendProcessSignal":
+		return ProcDomainSendProcessSignal, nil
+	case "ProcDomainOpenChannel":
+		return ProcDomainOpenChannel, nil
+	case "ProcNodeDeviceLookupScsiHostByWwn":
+		return ProcNodeDeviceLookupScsiHostByWwn, nil
+	case "ProcDomainGetJobStats":
+		return ProcDomainGetJobStats, nil
+	case "ProcDomainMigrateGetCompressionCache":
+		return ProcDomainMigrateGetCompressionCache, nil
+	case "ProcDomainMigrateSetCompressionCache":
+		return ProcDomainMigrateSetCompressionCache, nil
+	case "ProcNodeDeviceDetachFlags":
+		return ProcNodeDeviceDetachFlags, nil
+	case "ProcDomainMigrateBegin3Params":
+		return ProcDomainMigrateBegin3Params, nil
+	case "ProcDomainMigratePrepare3Params":
+		return ProcDomainMigratePrepare3Params, nil
+	case "ProcDomainMigratePrepareTunnel3Params":
+		return ProcDomainMigratePrepareTunnel3Params, nil
+	case "ProcDomainMigratePerform3Params":
+		return ProcDomainMigratePerform3Params, nil
+	case "ProcDomainMigrateFinish3Params":
+		return ProcDomainMigrateFinish3Params, nil
+	case "ProcDomainMigrateConfirm3Params":
+		return ProcDomainMigrateConfirm3Params, nil
+	case "ProcDomainSetMemoryStatsPeriod":
+		return ProcDomainSetMemoryStatsPeriod, nil
+	case "ProcDomainCreateXMLWithFiles":
+		return ProcDomainCreateXMLWithFiles, nil
+	case "ProcDomainCreateWithFiles":
+		return ProcDomainCreateWithFiles, nil
+	case "ProcDomainEventDeviceRemoved":
+		return ProcDomainEventDeviceRemoved, nil
+	case "ProcConnectGetCPUModelNames":
+		return ProcConnectGetCPUModelNames, nil
+	case "ProcConnectNetworkEventRegisterAny":
+		return ProcConnectNetworkEventRegisterAny, nil
+	case "ProcConnectNetworkEventDeregisterAny":
+		return ProcConnectNetworkEventDeregisterAny, nil
+	case "ProcNetworkEventLifecycle":
+		return ProcNetworkEventLifecycle, nil
+	case "ProcConnectDomainEventCallbackRegisterAny":
+		return ProcConnectDomainEventCallbackRegisterAny, nil
+	case "ProcConnectDomainEventCallbackDeregisterAny":
+		return ProcConnectDomainEventCallbackDeregisterAny, nil
+	case "ProcDomainEventCallbackLifecycle":
+		return ProcDomainEventCallbackLifecycle, nil
+	case "ProcDomainEventCallbackReboot":
+		return ProcDomainEventCallbackReboot, nil
+	case "ProcDomainEventCallbackRtcChange":
+		return ProcDomainEventCallbackRtcChange, nil
+	case "ProcDomainEventCallbackWatchdog":
+		return ProcDomainEventCallbackWatchdog, nil
+	case "ProcDomainEventCallbackIOError":
+		return ProcDomainEventCallbackIOError, nil
+	case "ProcDomainEventCallbackGraphics":
+		return ProcDomainEventCallbackGraphics, nil
+	case "ProcDomainEventCallbackIOErrorReason":
+		return ProcDomainEventCallbackIOErrorReason, nil
+	case "ProcDomainEventCallbackControlError":
+		return ProcDomainEventCallbackControlError, nil
+	case "ProcDomainEventCallbackBlockJob":
+		return ProcDomainEventCallbackBlockJob, nil
+	case "ProcDomainEventCallbackDiskChange":
+		return ProcDomainEventCallbackDiskChange, nil
+	case "ProcDomainEventCallbackTrayChange":
+		return ProcDomainEventCallbackTrayChange, nil
+	case "ProcDomainEventCallbackPmwakeup":
+		return ProcDomainEventCallbackPmwakeup, nil
+	case "ProcDomainEventCallbackPmsuspend":
+		return ProcDomainEventCallbackPmsuspend, nil
+	case "ProcDomainEventCallbackBalloonChange":
+		return ProcDomainEventCallbackBalloonChange, nil
+	case "ProcDomainEventCallbackPmsuspendDisk":
+		return ProcDomainEventCallbackPmsuspendDisk, nil
+	case "ProcDomainEventCallbackDeviceRemoved":
+		return ProcDomainEventCallbackDeviceRemoved, nil
+	case "ProcDomainCoreDumpWithFormat":
+		return ProcDomainCoreDumpWithFormat, nil
+	case "ProcDomainFsfreeze":
+		return ProcDomainFsfreeze, nil
+	case "ProcDomainFsthaw":
+		return ProcDomainFsthaw, nil
+	case "ProcDomainGetTime":
+		return ProcDomainGetTime, nil
+	case "ProcDomainSetTime":
+		return ProcDomainSetTime, nil
+	case "ProcDomainEventBlockJob2":
+		return ProcDomainEventBlockJob2, nil
+	case "ProcNodeGetFreePages":
+		return ProcNodeGetFreePages, nil
+	case "ProcNetworkGetDhcpLeases":
+		return ProcNetworkGetDhcpLeases, nil
+	case "ProcConnectGetDomainCapabilities":
+		return ProcConnectGetDomainCapabilities, nil
+	case "ProcDomainOpenGraphicsFd":
+		return ProcDomainOpenGraphicsFd, nil
+	case "ProcConnectGetAllDomainStats":
+		return ProcConnectGetAllDomainStats, nil
+	case "ProcDomainBlockCopy":
+		return ProcDomainBlockCopy, nil
+	case "ProcDomainEventCallbackTunable":
+		return ProcDomainEventCallbackTunable, nil
+	case "ProcNodeAllocPages":
+		return ProcNodeAllocPages, nil
+	case "ProcDomainEventCallbackAgentLifecycle":
+		return ProcDomainEventCallbackAgentLifecycle, nil
+	case "ProcDomainGetFsinfo":
+		return ProcDomainGetFsinfo, nil
+	case "ProcDomainDefineXMLFlags":
+		return ProcDomainDefineXMLFlags, nil
+	case "ProcDomainGetIothreadInfo":
+		return ProcDomainGetIothreadInfo, nil
+	case "ProcDomainPinIothread":
+		return ProcDomainPinIothread, nil
+	case "ProcDomainInterfaceAddresses":
+		return ProcDomainInterfaceAddresses, nil
+	case "ProcDomainEventCallbackDeviceAdded":
+		return ProcDomainEventCallbackDeviceAdded, nil
+	case "ProcDomainAddIothread":
+		return ProcDomainAddIothread, nil
+	case "ProcDomainDelIothread":
+		return ProcDomainDelIothread, nil
+	case "ProcDomainSetUserPassword":
+		return ProcDomainSetUserPassword, nil
+	case "ProcDomainRename":
+		return ProcDomainRename, nil
+	case "ProcDomainEventCallbackMigrationIteration":
+		return ProcDomainEventCallbackMigrationIteration, nil
+	case "ProcConnectRegisterCloseCallback":
+		return ProcConnectRegisterCloseCallback, nil
+	case "ProcConnectUnregisterCloseCallback":
+		return ProcConnectUnregisterCloseCallback, nil
+	case "ProcConnectEventConnectionClosed":
+		return ProcConnectEventConnectionClosed, nil
+	case "ProcDomainEventCallbackJobCompleted":
+		return ProcDomainEventCallbackJobCompleted, nil
+	case "ProcDomainMigrateStartPostCopy":
+		return ProcDomainMigrateStartPostCopy, nil
+	case "ProcDomainGetPerfEvents":
+		return ProcDomainGetPerfEvents, nil
+	case "ProcDomainSetPerfEvents":
+		return ProcDomainSetPerfEvents, nil
+	case "ProcDomainEventCallbackDeviceRemovalFailed":
+		return ProcDomainEventCallbackDeviceRemovalFailed, nil
+	case "ProcConnectStoragePoolEventRegisterAny":
+		return ProcConnectStoragePoolEventRegisterAny, nil
+	case "ProcConnectStoragePoolEventDeregisterAny":
+		return ProcConnectStoragePoolEventDeregisterAny, nil
+	case "ProcStoragePoolEventLifecycle":
+		return ProcStoragePoolEventLifecycle, nil
+	case "ProcDomainGetGuestVcpus":
+		return ProcDomainGetGuestVcpus, nil
+	case "ProcDomainSetGuestVcpus":
+		return ProcDomainSetGuestVcpus, nil
+	case "ProcStoragePoolEventRefresh":
+		return ProcStoragePoolEventRefresh, nil
+	case "ProcConnectNodeDeviceEventRegisterAny":
+		return ProcConnectNodeDeviceEventRegisterAny, nil
+	case "ProcConnectNodeDeviceEventDeregisterAny":
+		return ProcConnectNodeDeviceEventDeregisterAny, nil
+	case "ProcNodeDeviceEventLifecycle":
+		return ProcNodeDeviceEventLifecycle, nil
+	case "ProcNodeDeviceEventUpdate":
+		return ProcNodeDeviceEventUpdate, nil
+	case "ProcStorageVolGetInfoFlags":
+		return ProcStorageVolGetInfoFlags, nil
+	case "ProcDomainEventCallbackMetadataChange":
+		return ProcDomainEventCallbackMetadataChange, nil
+	case "ProcConnectSecretEventRegisterAny":
+		return ProcConnectSecretEventRegisterAny, nil
+	case "ProcConnectSecretEventDeregisterAny":
+		return ProcConnectSecretEventDeregisterAny, nil
+	case "ProcSecretEventLifecycle":
+		return ProcSecretEventLifecycle, nil
+	case "ProcSecretEventValueChanged":
+		return ProcSecretEventValueChanged, nil
+	case "ProcDomainSetVcpu":
+		return ProcDomainSetVcpu, nil
+	case "ProcDomainEventBlockThreshold":
+		return ProcDomainEventBlockThreshold, nil
+	case "ProcDomainSetBlockThreshold":
+		return ProcDomainSetBlockThreshold, nil
+	case "ProcDomainMigrateGetMaxDowntime":
+		return ProcDomainMigrateGetMaxDowntime, nil
+	case "ProcDomainManagedSaveGetXMLDesc":
+		return ProcDomainManagedSaveGetXMLDesc, nil
+	case "ProcDomainManagedSaveDefineXML":
+		return ProcDomainManagedSaveDefineXML, nil
+	case "ProcDomainSetLifecycleAction":
+		return ProcDomainSetLifecycleAction, nil
+	case "ProcStoragePoolLookupByTargetPath":
+		return ProcStoragePoolLookupByTargetPath, nil
+	case "ProcDomainDetachDeviceAlias":
+		return ProcDomainDetachDeviceAlias, nil
+	case "ProcConnectCompareHypervisorCPU":
+		return ProcConnectCompareHypervisorCPU, nil
+	case "ProcConnectBaselineHypervisorCPU":
+		return ProcConnectBaselineHypervisorCPU, nil
+	case "ProcNodeGetSevInfo":
+		return ProcNodeGetSevInfo, nil
+	case "ProcDomainGetLaunchSecurityInfo":
+		return ProcDomainGetLaunchSecurityInfo, nil
+	case "ProcNwfilterBindingLookupByPortDev":
+		return ProcNwfilterBindingLookupByPortDev, nil
+	case "ProcNwfilterBindingGetXMLDesc":
+		return ProcNwfilterBindingGetXMLDesc, nil
+	case "ProcNwfilterBindingCreateXML":
+		return ProcNwfilterBindingCreateXML, nil
+	case "ProcNwfilterBindingDelete":
+		return ProcNwfilterBindingDelete, nil
+	case "ProcConnectListAllNwfilterBindings":
+		return ProcConnectListAllNwfilterBindings, nil
+	case "ProcDomainSetIothreadParams":
+		return ProcDomainSetIothreadParams, nil
+	case "ProcConnectGetStoragePoolCapabilities":
+		return ProcConnectGetStoragePoolCapabilities, nil
+	case "ProcNetworkListAllPorts":
+		return ProcNetworkListAllPorts, nil
+	case "ProcNetworkPortLookupByUUID":
+		return ProcNetworkPortLookupByUUID, nil
+	case "ProcNetworkPortCreateXML":
+		return ProcNetworkPortCreateXML, nil
+	case "ProcNetworkPortGetParameters":
+		return ProcNetworkPortGetParameters, nil
+	case "ProcNetworkPortSetParameters":
+		return ProcNetworkPortSetParameters, nil
+	case "ProcNetworkPortGetXMLDesc":
+		return ProcNetworkPortGetXMLDesc, nil
+	case "ProcNetworkPortDelete":
+		return ProcNetworkPortDelete, nil
+	case "ProcDomainCheckpointCreateXML":
+		return ProcDomainCheckpointCreateXML, nil
+	case "ProcDomainCheckpointGetXMLDesc":
+		return ProcDomainCheckpointGetXMLDesc, nil
+	case "ProcDomainListAllCheckpoints":
+		return ProcDomainListAllCheckpoints, nil
+	case "ProcDomainCheckpointListAllChildren":
+		return ProcDomainCheckpointListAllChildren, nil
+	case "ProcDomainCheckpointLookupByName":
+		return ProcDomainCheckpointLookupByName, nil
+	case "ProcDomainCheckpointGetParent":
+		return ProcDomainCheckpointGetParent, nil
+	case "ProcDomainCheckpointDelete":
+		return ProcDomainCheckpointDelete, nil
+	case "ProcDomainGetGuestInfo":
+		return ProcDomainGetGuestInfo, nil
+	case "ProcConnectSetIdentity":
+		return ProcConnectSetIdentity, nil
+	case "ProcDomainAgentSetResponseTimeout":
+		return ProcDomainAgentSetResponseTimeout, nil
+	case "ProcDomainBackupBegin":
+		return ProcDomainBackupBegin, nil
+	case "ProcDomainBackupGetXMLDesc":
+		return ProcDomainBackupGetXMLDesc, nil
+	case "ProcDomainEventMemoryFailure":
+		return ProcDomainEventMemoryFailure, nil
+	case "ProcDomainAuthorizedSshKeysGet":
+		return ProcDomainAuthorizedSshKeysGet, nil
+	case "ProcDomainAuthorizedSshKeysSet":
+		return ProcDomainAuthorizedSshKeysSet, nil
+	case "ProcDomainGetMessages":
+		return ProcDomainGetMessages, nil
+	}
+	return 0, fmt.Errorf("invalid Procedure %q", s)
+}
+
 // Structs:
 //
 // Domain is libvirt's remote_nonnull_domain
 type Domain struct {
 	Name string
 	UUID UUID
-	ID int32
+	ID   int32
 }
 
 // Network is libvirt's remote_nonnull_network
@@ -88,7 +3159,7 @@ type Network struct {
 
 // NetworkPort is libvirt's remote_nonnull_network_port
 type NetworkPort struct {
-	Net Network
+	Net  Network
 	UUID UUID
 }
 
@@ -100,14 +3171,14 @@ type Nwfilter struct {
 
 // NwfilterBinding is libvirt's remote_nonnull_nwfilter_binding
 type NwfilterBinding struct {
-	Portdev string
+	Portdev    string
 	Filtername string
 }
 
 // Interface is libvirt's remote_nonnull_interface
 type Interface struct {
 	Name string
-	Mac string
+	Mac  string
 }
 
 // StoragePool is libvirt's remote_nonnull_storage_pool
@@ -120,7 +3191,7 @@ type StoragePool struct {
 type StorageVol struct {
 	Pool string
 	Name string
-	Key string
+	Key  string
 }
 
 // NodeDevice is libvirt's remote_nonnull_node_device
@@ -130,44 +3201,44 @@ type NodeDevice struct {
 
 // Secret is libvirt's remote_nonnull_secret
 type Secret struct {
-	UUID UUID
+	UUID      UUID
 	UsageType int32
-	UsageID string
+	UsageID   string
 }
 
 // DomainCheckpoint is libvirt's remote_nonnull_domain_checkpoint
 type DomainCheckpoint struct {
 	Name string
-	Dom Domain
+	Dom  Domain
 }
 
 // DomainSnapshot is libvirt's remote_nonnull_domain_snapshot
 type DomainSnapshot struct {
 	Name string
-	Dom Domain
+	Dom  Domain
 }
 
 // remote_error is libvirt's remote_error
 type remote_error struct {
-	Code int32
+	Code      int32
 	OptDomain int32
-	Message OptString
-	Level int32
-	Dom OptDomain
-	Str1 OptString
-	Str2 OptString
-	Str3 OptString
-	Int1 int32
-	Int2 int32
-	Net OptNetwork
+	Message   OptString
+	Level     int32
+	Dom       OptDomain
+	Str1      OptString
+	Str2      OptString
+	Str3      OptString
+	Int1      int32
+	Int2      int32
+	Net       OptNetwork
 }
 
 // VcpuInfo is libvirt's remote_vcpu_info
 type VcpuInfo struct {
-	Number uint32
-	State int32
+	Number  uint32
+	State   int32
 	CPUTime uint64
-	CPU int32
+	CPU     int32
 }
 
 // TypedParam is libvirt's remote_typed_param
@@ -190,13 +3261,13 @@ type NodeGetMemoryStats struct {
 
 // DomainDiskError is libvirt's remote_domain_disk_error
 type DomainDiskError struct {
-	Disk string
+	Disk         string
 	remote_error int32
 }
 
 // ConnectOpenArgs is libvirt's remote_connect_open_args
 type ConnectOpenArgs struct {
-	Name OptString
+	Name  OptString
 	Flags ConnectFlags
 }
 
@@ -257,13 +3328,13 @@ type ConnectGetMaxVcpusRet struct {
 
 // NodeGetInfoRet is libvirt's remote_node_get_info_ret
 type NodeGetInfoRet struct {
-	Model [32]int8
-	Memory uint64
-	Cpus int32
-	Mhz int32
-	Nodes int32
+	Model   [32]int8
+	Memory  uint64
+	Cpus    int32
+	Mhz     int32
+	Nodes   int32
 	Sockets int32
-	Cores int32
+	Cores   int32
 	Threads int32
 }
 
@@ -275,10 +3346,10 @@ type ConnectGetCapabilitiesRet struct {
 // ConnectGetDomainCapabilitiesArgs is libvirt's remote_connect_get_domain_capabilities_args
 type ConnectGetDomainCapabilitiesArgs struct {
 	Emulatorbin OptString
-	Arch OptString
-	Machine OptString
-	Virttype OptString
-	Flags uint32
+	Arch        OptString
+	Machine     OptString
+	Virttype    OptString
+	Flags       uint32
 }
 
 // ConnectGetDomainCapabilitiesRet is libvirt's remote_connect_get_domain_capabilities_ret
@@ -288,14 +3359,14 @@ type ConnectGetDomainCapabilitiesRet struct {
 
 // NodeGetCPUStatsArgs is libvirt's remote_node_get_cpu_stats_args
 type NodeGetCPUStatsArgs struct {
-	CPUNum int32
+	CPUNum  int32
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // NodeGetCPUStatsRet is libvirt's remote_node_get_cpu_stats_ret
 type NodeGetCPUStatsRet struct {
-	Params []NodeGetCPUStats
+	Params  []NodeGetCPUStats
 	Nparams int32
 }
 
@@ -303,19 +3374,19 @@ type NodeGetCPUStatsRet struct {
 type NodeGetMemoryStatsArgs struct {
 	Nparams int32
 	CellNum int32
-	Flags uint32
+	Flags   uint32
 }
 
 // NodeGetMemoryStatsRet is libvirt's remote_node_get_memory_stats_ret
 type NodeGetMemoryStatsRet struct {
-	Params []NodeGetMemoryStats
+	Params  []NodeGetMemoryStats
 	Nparams int32
 }
 
 // NodeGetCellsFreeMemoryArgs is libvirt's remote_node_get_cells_free_memory_args
 type NodeGetCellsFreeMemoryArgs struct {
 	StartCell int32
-	Maxcells int32
+	Maxcells  int32
 }
 
 // NodeGetCellsFreeMemoryRet is libvirt's remote_node_get_cells_free_memory_ret
@@ -335,13 +3406,13 @@ type DomainGetSchedulerTypeArgs struct {
 
 // DomainGetSchedulerTypeRet is libvirt's remote_domain_get_scheduler_type_ret
 type DomainGetSchedulerTypeRet struct {
-	Type string
+	Type    string
 	Nparams int32
 }
 
 // DomainGetSchedulerParametersArgs is libvirt's remote_domain_get_scheduler_parameters_args
 type DomainGetSchedulerParametersArgs struct {
-	Dom Domain
+	Dom     Domain
 	Nparams int32
 }
 
@@ -352,9 +3423,9 @@ type DomainGetSchedulerParametersRet struct {
 
 // DomainGetSchedulerParametersFlagsArgs is libvirt's remote_domain_get_scheduler_parameters_flags_args
 type DomainGetSchedulerParametersFlagsArgs struct {
-	Dom Domain
+	Dom     Domain
 	Nparams int32
-	Flags uint32
+	Flags   DomainModificationImpact
 }
 
 // DomainGetSchedulerParametersFlagsRet is libvirt's remote_domain_get_scheduler_parameters_flags_ret
@@ -364,95 +3435,95 @@ type DomainGetSchedulerParametersFlagsRet struct {
 
 // DomainSetSchedulerParametersArgs is libvirt's remote_domain_set_scheduler_parameters_args
 type DomainSetSchedulerParametersArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
 }
 
 // DomainSetSchedulerParametersFlagsArgs is libvirt's remote_domain_set_scheduler_parameters_flags_args
 type DomainSetSchedulerParametersFlagsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags uint32
+	Flags  DomainModificationImpact
 }
 
 // DomainSetBlkioParametersArgs is libvirt's remote_domain_set_blkio_parameters_args
 type DomainSetBlkioParametersArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetBlkioParametersArgs is libvirt's remote_domain_get_blkio_parameters_args
 type DomainGetBlkioParametersArgs struct {
-	Dom Domain
+	Dom     Domain
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainGetBlkioParametersRet is libvirt's remote_domain_get_blkio_parameters_ret
 type DomainGetBlkioParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainSetMemoryParametersArgs is libvirt's remote_domain_set_memory_parameters_args
 type DomainSetMemoryParametersArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetMemoryParametersArgs is libvirt's remote_domain_get_memory_parameters_args
 type DomainGetMemoryParametersArgs struct {
-	Dom Domain
+	Dom     Domain
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainGetMemoryParametersRet is libvirt's remote_domain_get_memory_parameters_ret
 type DomainGetMemoryParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainBlockResizeArgs is libvirt's remote_domain_block_resize_args
 type DomainBlockResizeArgs struct {
-	Dom Domain
-	Disk string
-	Size uint64
+	Dom   Domain
+	Disk  string
+	Size  uint64
 	Flags DomainBlockResizeFlags
 }
 
 // DomainSetNumaParametersArgs is libvirt's remote_domain_set_numa_parameters_args
 type DomainSetNumaParametersArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetNumaParametersArgs is libvirt's remote_domain_get_numa_parameters_args
 type DomainGetNumaParametersArgs struct {
-	Dom Domain
+	Dom     Domain
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainGetNumaParametersRet is libvirt's remote_domain_get_numa_parameters_ret
 type DomainGetNumaParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainSetPerfEventsArgs is libvirt's remote_domain_set_perf_events_args
 type DomainSetPerfEventsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags DomainModificationImpact
+	Flags  DomainModificationImpact
 }
 
 // DomainGetPerfEventsArgs is libvirt's remote_domain_get_perf_events_args
 type DomainGetPerfEventsArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainModificationImpact
 }
 
@@ -463,78 +3534,78 @@ type DomainGetPerfEventsRet struct {
 
 // DomainBlockStatsArgs is libvirt's remote_domain_block_stats_args
 type DomainBlockStatsArgs struct {
-	Dom Domain
+	Dom  Domain
 	Path string
 }
 
 // DomainBlockStatsRet is libvirt's remote_domain_block_stats_ret
 type DomainBlockStatsRet struct {
-	RdReq int64
+	RdReq   int64
 	RdBytes int64
-	WrReq int64
+	WrReq   int64
 	WrBytes int64
-	Errs int64
+	Errs    int64
 }
 
 // DomainBlockStatsFlagsArgs is libvirt's remote_domain_block_stats_flags_args
 type DomainBlockStatsFlagsArgs struct {
-	Dom Domain
-	Path string
+	Dom     Domain
+	Path    string
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainBlockStatsFlagsRet is libvirt's remote_domain_block_stats_flags_ret
 type DomainBlockStatsFlagsRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainInterfaceStatsArgs is libvirt's remote_domain_interface_stats_args
 type DomainInterfaceStatsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Device string
 }
 
 // DomainInterfaceStatsRet is libvirt's remote_domain_interface_stats_ret
 type DomainInterfaceStatsRet struct {
-	RxBytes int64
+	RxBytes   int64
 	RxPackets int64
-	RxErrs int64
-	RxDrop int64
-	TxBytes int64
+	RxErrs    int64
+	RxDrop    int64
+	TxBytes   int64
 	TxPackets int64
-	TxErrs int64
-	TxDrop int64
+	TxErrs    int64
+	TxDrop    int64
 }
 
 // DomainSetInterfaceParametersArgs is libvirt's remote_domain_set_interface_parameters_args
 type DomainSetInterfaceParametersArgs struct {
-	Dom Domain
+	Dom    Domain
 	Device string
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetInterfaceParametersArgs is libvirt's remote_domain_get_interface_parameters_args
 type DomainGetInterfaceParametersArgs struct {
-	Dom Domain
-	Device string
+	Dom     Domain
+	Device  string
 	Nparams int32
-	Flags DomainModificationImpact
+	Flags   DomainModificationImpact
 }
 
 // DomainGetInterfaceParametersRet is libvirt's remote_domain_get_interface_parameters_ret
 type DomainGetInterfaceParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainMemoryStatsArgs is libvirt's remote_domain_memory_stats_args
 type DomainMemoryStatsArgs struct {
-	Dom Domain
+	Dom      Domain
 	MaxStats uint32
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainMemoryStat is libvirt's remote_domain_memory_stat
@@ -550,11 +3621,11 @@ type DomainMemoryStatsRet struct {
 
 // DomainBlockPeekArgs is libvirt's remote_domain_block_peek_args
 type DomainBlockPeekArgs struct {
-	Dom Domain
-	Path string
+	Dom    Domain
+	Path   string
 	Offset uint64
-	Size uint32
-	Flags uint32
+	Size   uint32
+	Flags  uint32
 }
 
 // DomainBlockPeekRet is libvirt's remote_domain_block_peek_ret
@@ -564,10 +3635,10 @@ type DomainBlockPeekRet struct {
 
 // DomainMemoryPeekArgs is libvirt's remote_domain_memory_peek_args
 type DomainMemoryPeekArgs struct {
-	Dom Domain
+	Dom    Domain
 	Offset uint64
-	Size uint32
-	Flags DomainMemoryFlags
+	Size   uint32
+	Flags  DomainMemoryFlags
 }
 
 // DomainMemoryPeekRet is libvirt's remote_domain_memory_peek_ret
@@ -577,16 +3648,16 @@ type DomainMemoryPeekRet struct {
 
 // DomainGetBlockInfoArgs is libvirt's remote_domain_get_block_info_args
 type DomainGetBlockInfoArgs struct {
-	Dom Domain
-	Path string
+	Dom   Domain
+	Path  string
 	Flags uint32
 }
 
 // DomainGetBlockInfoRet is libvirt's remote_domain_get_block_info_ret
 type DomainGetBlockInfoRet struct {
 	Allocation uint64
-	Capacity uint64
-	Physical uint64
+	Capacity   uint64
+	Physical   uint64
 }
 
 // ConnectListDomainsArgs is libvirt's remote_connect_list_domains_args
@@ -607,7 +3678,7 @@ type ConnectNumOfDomainsRet struct {
 // DomainCreateXMLArgs is libvirt's remote_domain_create_xml_args
 type DomainCreateXMLArgs struct {
 	XMLDesc string
-	Flags DomainCreateFlags
+	Flags   DomainCreateFlags
 }
 
 // DomainCreateXMLRet is libvirt's remote_domain_create_xml_ret
@@ -618,7 +3689,7 @@ type DomainCreateXMLRet struct {
 // DomainCreateXMLWithFilesArgs is libvirt's remote_domain_create_xml_with_files_args
 type DomainCreateXMLWithFilesArgs struct {
 	XMLDesc string
-	Flags DomainCreateFlags
+	Flags   DomainCreateFlags
 }
 
 // DomainCreateXMLWithFilesRet is libvirt's remote_domain_create_xml_with_files_ret
@@ -668,15 +3739,15 @@ type DomainResumeArgs struct {
 
 // DomainPmSuspendForDurationArgs is libvirt's remote_domain_pm_suspend_for_duration_args
 type DomainPmSuspendForDurationArgs struct {
-	Dom Domain
-	Target uint32
+	Dom      Domain
+	Target   uint32
 	Duration uint64
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainPmWakeupArgs is libvirt's remote_domain_pm_wakeup_args
 type DomainPmWakeupArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -687,13 +3758,13 @@ type DomainShutdownArgs struct {
 
 // DomainRebootArgs is libvirt's remote_domain_reboot_args
 type DomainRebootArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainRebootFlagValues
 }
 
 // DomainResetArgs is libvirt's remote_domain_reset_args
 type DomainResetArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -704,7 +3775,7 @@ type DomainDestroyArgs struct {
 
 // DomainDestroyFlagsArgs is libvirt's remote_domain_destroy_flags_args
 type DomainDestroyFlagsArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainDestroyFlagsValues
 }
 
@@ -730,28 +3801,28 @@ type DomainGetMaxMemoryRet struct {
 
 // DomainSetMaxMemoryArgs is libvirt's remote_domain_set_max_memory_args
 type DomainSetMaxMemoryArgs struct {
-	Dom Domain
+	Dom    Domain
 	Memory uint64
 }
 
 // DomainSetMemoryArgs is libvirt's remote_domain_set_memory_args
 type DomainSetMemoryArgs struct {
-	Dom Domain
+	Dom    Domain
 	Memory uint64
 }
 
 // DomainSetMemoryFlagsArgs is libvirt's remote_domain_set_memory_flags_args
 type DomainSetMemoryFlagsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Memory uint64
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainSetMemoryStatsPeriodArgs is libvirt's remote_domain_set_memory_stats_period_args
 type DomainSetMemoryStatsPeriodArgs struct {
-	Dom Domain
+	Dom    Domain
 	Period int32
-	Flags DomainMemoryModFlags
+	Flags  DomainMemoryModFlags
 }
 
 // DomainGetInfoArgs is libvirt's remote_domain_get_info_args
@@ -761,25 +3832,25 @@ type DomainGetInfoArgs struct {
 
 // DomainGetInfoRet is libvirt's remote_domain_get_info_ret
 type DomainGetInfoRet struct {
-	State uint8
-	MaxMem uint64
-	Memory uint64
+	State     uint8
+	MaxMem    uint64
+	Memory    uint64
 	NrVirtCPU uint16
-	CPUTime uint64
+	CPUTime   uint64
 }
 
 // DomainSaveArgs is libvirt's remote_domain_save_args
 type DomainSaveArgs struct {
 	Dom Domain
-	To string
+	To  string
 }
 
 // DomainSaveFlagsArgs is libvirt's remote_domain_save_flags_args
 type DomainSaveFlagsArgs struct {
-	Dom Domain
-	To string
-	Dxml OptString
-	Flags uint32
+	Dom   Domain
+	To    string
+	Dxml  OptString
+	Flags DomainSaveRestoreFlags
 }
 
 // DomainRestoreArgs is libvirt's remote_domain_restore_args
@@ -789,14 +3860,14 @@ type DomainRestoreArgs struct {
 
 // DomainRestoreFlagsArgs is libvirt's remote_domain_restore_flags_args
 type DomainRestoreFlagsArgs struct {
-	From string
-	Dxml OptString
-	Flags uint32
+	From  string
+	Dxml  OptString
+	Flags DomainSaveRestoreFlags
 }
 
 // DomainSaveImageGetXMLDescArgs is libvirt's remote_domain_save_image_get_xml_desc_args
 type DomainSaveImageGetXMLDescArgs struct {
-	File string
+	File  string
 	Flags uint32
 }
 
@@ -807,31 +3878,31 @@ type DomainSaveImageGetXMLDescRet struct {
 
 // DomainSaveImageDefineXMLArgs is libvirt's remote_domain_save_image_define_xml_args
 type DomainSaveImageDefineXMLArgs struct {
-	File string
-	Dxml string
+	File  string
+	Dxml  string
 	Flags uint32
 }
 
 // DomainCoreDumpArgs is libvirt's remote_domain_core_dump_args
 type DomainCoreDumpArgs struct {
-	Dom Domain
-	To string
+	Dom   Domain
+	To    string
 	Flags DomainCoreDumpFlags
 }
 
 // DomainCoreDumpWithFormatArgs is libvirt's remote_domain_core_dump_with_format_args
 type DomainCoreDumpWithFormatArgs struct {
-	Dom Domain
-	To string
-	Dumpformat uint32
-	Flags DomainCoreDumpFlags
+	Dom        Domain
+	To         string
+	Dumpformat DomainCoreDumpFormat
+	Flags      DomainCoreDumpFlags
 }
 
 // DomainScreenshotArgs is libvirt's remote_domain_screenshot_args
 type DomainScreenshotArgs struct {
-	Dom Domain
+	Dom    Domain
 	Screen uint32
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainScreenshotRet is libvirt's remote_domain_screenshot_ret
@@ -841,7 +3912,7 @@ type DomainScreenshotRet struct {
 
 // DomainGetXMLDescArgs is libvirt's remote_domain_get_xml_desc_args
 type DomainGetXMLDescArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainXMLFlags
 }
 
@@ -852,9 +3923,9 @@ type DomainGetXMLDescRet struct {
 
 // DomainMigratePrepareArgs is libvirt's remote_domain_migrate_prepare_args
 type DomainMigratePrepareArgs struct {
-	UriIn OptString
-	Flags uint64
-	Dname OptString
+	UriIn    OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
 }
 
@@ -866,20 +3937,20 @@ type DomainMigratePrepareRet struct {
 
 // DomainMigratePerformArgs is libvirt's remote_domain_migrate_perform_args
 type DomainMigratePerformArgs struct {
-	Dom Domain
-	Cookie []byte
-	Uri string
-	Flags uint64
-	Dname OptString
+	Dom      Domain
+	Cookie   []byte
+	Uri      string
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
 }
 
 // DomainMigrateFinishArgs is libvirt's remote_domain_migrate_finish_args
 type DomainMigrateFinishArgs struct {
-	Dname string
+	Dname  string
 	Cookie []byte
-	Uri string
-	Flags uint64
+	Uri    string
+	Flags  uint64
 }
 
 // DomainMigrateFinishRet is libvirt's remote_domain_migrate_finish_ret
@@ -889,11 +3960,11 @@ type DomainMigrateFinishRet struct {
 
 // DomainMigratePrepare2Args is libvirt's remote_domain_migrate_prepare2_args
 type DomainMigratePrepare2Args struct {
-	UriIn OptString
-	Flags uint64
-	Dname OptString
+	UriIn    OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
-	DomXML string
+	DomXML   string
 }
 
 // DomainMigratePrepare2Ret is libvirt's remote_domain_migrate_prepare2_ret
@@ -904,10 +3975,10 @@ type DomainMigratePrepare2Ret struct {
 
 // DomainMigrateFinish2Args is libvirt's remote_domain_migrate_finish2_args
 type DomainMigrateFinish2Args struct {
-	Dname string
-	Cookie []byte
-	Uri string
-	Flags uint64
+	Dname   string
+	Cookie  []byte
+	Uri     string
+	Flags   uint64
 	Retcode int32
 }
 
@@ -938,8 +4009,8 @@ type DomainCreateArgs struct {
 
 // DomainCreateWithFlagsArgs is libvirt's remote_domain_create_with_flags_args
 type DomainCreateWithFlagsArgs struct {
-	Dom Domain
-	Flags uint32
+	Dom   Domain
+	Flags DomainCreateFlags
 }
 
 // DomainCreateWithFlagsRet is libvirt's remote_domain_create_with_flags_ret
@@ -949,7 +4020,7 @@ type DomainCreateWithFlagsRet struct {
 
 // DomainCreateWithFilesArgs is libvirt's remote_domain_create_with_files_args
 type DomainCreateWithFilesArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainCreateFlags
 }
 
@@ -970,7 +4041,7 @@ type DomainDefineXMLRet struct {
 
 // DomainDefineXMLFlagsArgs is libvirt's remote_domain_define_xml_flags_args
 type DomainDefineXMLFlagsArgs struct {
-	XML string
+	XML   string
 	Flags DomainDefineFlags
 }
 
@@ -986,50 +4057,50 @@ type DomainUndefineArgs struct {
 
 // DomainUndefineFlagsArgs is libvirt's remote_domain_undefine_flags_args
 type DomainUndefineFlagsArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainUndefineFlagsValues
 }
 
 // DomainInjectNmiArgs is libvirt's remote_domain_inject_nmi_args
 type DomainInjectNmiArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainSendKeyArgs is libvirt's remote_domain_send_key_args
 type DomainSendKeyArgs struct {
-	Dom Domain
-	Codeset uint32
+	Dom      Domain
+	Codeset  uint32
 	Holdtime uint32
 	Keycodes []uint32
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainSendProcessSignalArgs is libvirt's remote_domain_send_process_signal_args
 type DomainSendProcessSignalArgs struct {
-	Dom Domain
+	Dom      Domain
 	PidValue int64
-	Signum uint32
-	Flags uint32
+	Signum   uint32
+	Flags    uint32
 }
 
 // DomainSetVcpusArgs is libvirt's remote_domain_set_vcpus_args
 type DomainSetVcpusArgs struct {
-	Dom Domain
+	Dom    Domain
 	Nvcpus uint32
 }
 
 // DomainSetVcpusFlagsArgs is libvirt's remote_domain_set_vcpus_flags_args
 type DomainSetVcpusFlagsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Nvcpus uint32
-	Flags uint32
+	Flags  DomainVCPUFlags
 }
 
 // DomainGetVcpusFlagsArgs is libvirt's remote_domain_get_vcpus_flags_args
 type DomainGetVcpusFlagsArgs struct {
-	Dom Domain
-	Flags uint32
+	Dom   Domain
+	Flags DomainVCPUFlags
 }
 
 // DomainGetVcpusFlagsRet is libvirt's remote_domain_get_vcpus_flags_ret
@@ -1039,63 +4110,63 @@ type DomainGetVcpusFlagsRet struct {
 
 // DomainPinVcpuArgs is libvirt's remote_domain_pin_vcpu_args
 type DomainPinVcpuArgs struct {
-	Dom Domain
-	Vcpu uint32
+	Dom    Domain
+	Vcpu   uint32
 	Cpumap []byte
 }
 
 // DomainPinVcpuFlagsArgs is libvirt's remote_domain_pin_vcpu_flags_args
 type DomainPinVcpuFlagsArgs struct {
-	Dom Domain
-	Vcpu uint32
+	Dom    Domain
+	Vcpu   uint32
 	Cpumap []byte
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetVcpuPinInfoArgs is libvirt's remote_domain_get_vcpu_pin_info_args
 type DomainGetVcpuPinInfoArgs struct {
-	Dom Domain
+	Dom      Domain
 	Ncpumaps int32
-	Maplen int32
-	Flags uint32
+	Maplen   int32
+	Flags    uint32
 }
 
 // DomainGetVcpuPinInfoRet is libvirt's remote_domain_get_vcpu_pin_info_ret
 type DomainGetVcpuPinInfoRet struct {
 	Cpumaps []byte
-	Num int32
+	Num     int32
 }
 
 // DomainPinEmulatorArgs is libvirt's remote_domain_pin_emulator_args
 type DomainPinEmulatorArgs struct {
-	Dom Domain
+	Dom    Domain
 	Cpumap []byte
-	Flags DomainModificationImpact
+	Flags  DomainModificationImpact
 }
 
 // DomainGetEmulatorPinInfoArgs is libvirt's remote_domain_get_emulator_pin_info_args
 type DomainGetEmulatorPinInfoArgs struct {
-	Dom Domain
+	Dom    Domain
 	Maplen int32
-	Flags DomainModificationImpact
+	Flags  DomainModificationImpact
 }
 
 // DomainGetEmulatorPinInfoRet is libvirt's remote_domain_get_emulator_pin_info_ret
 type DomainGetEmulatorPinInfoRet struct {
 	Cpumaps []byte
-	Ret int32
+	Ret     int32
 }
 
 // DomainGetVcpusArgs is libvirt's remote_domain_get_vcpus_args
 type DomainGetVcpusArgs struct {
-	Dom Domain
+	Dom     Domain
 	Maxinfo int32
-	Maplen int32
+	Maplen  int32
 }
 
 // DomainGetVcpusRet is libvirt's remote_domain_get_vcpus_ret
 type DomainGetVcpusRet struct {
-	Info []VcpuInfo
+	Info    []VcpuInfo
 	Cpumaps []byte
 }
 
@@ -1112,49 +4183,49 @@ type DomainGetMaxVcpusRet struct {
 // DomainIothreadInfo is libvirt's remote_domain_iothread_info
 type DomainIothreadInfo struct {
 	IothreadID uint32
-	Cpumap []byte
+	Cpumap     []byte
 }
 
 // DomainGetIothreadInfoArgs is libvirt's remote_domain_get_iothread_info_args
 type DomainGetIothreadInfoArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainModificationImpact
 }
 
 // DomainGetIothreadInfoRet is libvirt's remote_domain_get_iothread_info_ret
 type DomainGetIothreadInfoRet struct {
 	Info []DomainIothreadInfo
-	Ret uint32
+	Ret  uint32
 }
 
 // DomainPinIothreadArgs is libvirt's remote_domain_pin_iothread_args
 type DomainPinIothreadArgs struct {
-	Dom Domain
+	Dom         Domain
 	IothreadsID uint32
-	Cpumap []byte
-	Flags DomainModificationImpact
+	Cpumap      []byte
+	Flags       DomainModificationImpact
 }
 
 // DomainAddIothreadArgs is libvirt's remote_domain_add_iothread_args
 type DomainAddIothreadArgs struct {
-	Dom Domain
+	Dom        Domain
 	IothreadID uint32
-	Flags DomainModificationImpact
+	Flags      DomainModificationImpact
 }
 
 // DomainDelIothreadArgs is libvirt's remote_domain_del_iothread_args
 type DomainDelIothreadArgs struct {
-	Dom Domain
+	Dom        Domain
 	IothreadID uint32
-	Flags DomainModificationImpact
+	Flags      DomainModificationImpact
 }
 
 // DomainSetIothreadParamsArgs is libvirt's remote_domain_set_iothread_params_args
 type DomainSetIothreadParamsArgs struct {
-	Dom Domain
+	Dom        Domain
 	IothreadID uint32
-	Params []TypedParam
-	Flags uint32
+	Params     []TypedParam
+	Flags      uint32
 }
 
 // DomainGetSecurityLabelArgs is libvirt's remote_domain_get_security_label_args
@@ -1164,7 +4235,7 @@ type DomainGetSecurityLabelArgs struct {
 
 // DomainGetSecurityLabelRet is libvirt's remote_domain_get_security_label_ret
 type DomainGetSecurityLabelRet struct {
-	Label []int8
+	Label     []int8
 	Enforcing int32
 }
 
@@ -1176,13 +4247,13 @@ type DomainGetSecurityLabelListArgs struct {
 // DomainGetSecurityLabelListRet is libvirt's remote_domain_get_security_label_list_ret
 type DomainGetSecurityLabelListRet struct {
 	Labels []DomainGetSecurityLabelRet
-	Ret int32
+	Ret    int32
 }
 
 // NodeGetSecurityModelRet is libvirt's remote_node_get_security_model_ret
 type NodeGetSecurityModelRet struct {
 	Model []int8
-	Doi []int8
+	Doi   []int8
 }
 
 // DomainAttachDeviceArgs is libvirt's remote_domain_attach_device_args
@@ -1193,9 +4264,9 @@ type DomainAttachDeviceArgs struct {
 
 // DomainAttachDeviceFlagsArgs is libvirt's remote_domain_attach_device_flags_args
 type DomainAttachDeviceFlagsArgs struct {
-	Dom Domain
-	XML string
-	Flags uint32
+	Dom   Domain
+	XML   string
+	Flags DomainDeviceModifyFlags
 }
 
 // DomainDetachDeviceArgs is libvirt's remote_domain_detach_device_args
@@ -1206,23 +4277,23 @@ type DomainDetachDeviceArgs struct {
 
 // DomainDetachDeviceFlagsArgs is libvirt's remote_domain_detach_device_flags_args
 type DomainDetachDeviceFlagsArgs struct {
-	Dom Domain
-	XML string
-	Flags uint32
+	Dom   Domain
+	XML   string
+	Flags DomainDeviceModifyFlags
 }
 
 // DomainUpdateDeviceFlagsArgs is libvirt's remote_domain_update_device_flags_args
 type DomainUpdateDeviceFlagsArgs struct {
-	Dom Domain
-	XML string
+	Dom   Domain
+	XML   string
 	Flags DomainDeviceModifyFlags
 }
 
 // DomainDetachDeviceAliasArgs is libvirt's remote_domain_detach_device_alias_args
 type DomainDetachDeviceAliasArgs struct {
-	Dom Domain
+	Dom   Domain
 	Alias string
-	Flags uint32
+	Flags DomainDeviceModifyFlags
 }
 
 // DomainGetAutostartArgs is libvirt's remote_domain_get_autostart_args
@@ -1237,25 +4308,25 @@ type DomainGetAutostartRet struct {
 
 // DomainSetAutostartArgs is libvirt's remote_domain_set_autostart_args
 type DomainSetAutostartArgs struct {
-	Dom Domain
+	Dom       Domain
 	Autostart int32
 }
 
 // DomainSetMetadataArgs is libvirt's remote_domain_set_metadata_args
 type DomainSetMetadataArgs struct {
-	Dom Domain
-	Type int32
+	Dom      Domain
+	Type     int32
 	Metadata OptString
-	Key OptString
-	Uri OptString
-	Flags DomainModificationImpact
+	Key      OptString
+	Uri      OptString
+	Flags    DomainModificationImpact
 }
 
 // DomainGetMetadataArgs is libvirt's remote_domain_get_metadata_args
 type DomainGetMetadataArgs struct {
-	Dom Domain
-	Type int32
-	Uri OptString
+	Dom   Domain
+	Type  int32
+	Uri   OptString
 	Flags DomainModificationImpact
 }
 
@@ -1266,111 +4337,111 @@ type DomainGetMetadataRet struct {
 
 // DomainBlockJobAbortArgs is libvirt's remote_domain_block_job_abort_args
 type DomainBlockJobAbortArgs struct {
-	Dom Domain
-	Path string
+	Dom   Domain
+	Path  string
 	Flags DomainBlockJobAbortFlags
 }
 
 // DomainGetBlockJobInfoArgs is libvirt's remote_domain_get_block_job_info_args
 type DomainGetBlockJobInfoArgs struct {
-	Dom Domain
-	Path string
+	Dom   Domain
+	Path  string
 	Flags uint32
 }
 
 // DomainGetBlockJobInfoRet is libvirt's remote_domain_get_block_job_info_ret
 type DomainGetBlockJobInfoRet struct {
-	Found int32
-	Type int32
+	Found     int32
+	Type      int32
 	Bandwidth uint64
-	Cur uint64
-	End uint64
+	Cur       uint64
+	End       uint64
 }
 
 // DomainBlockJobSetSpeedArgs is libvirt's remote_domain_block_job_set_speed_args
 type DomainBlockJobSetSpeedArgs struct {
-	Dom Domain
-	Path string
+	Dom       Domain
+	Path      string
 	Bandwidth uint64
-	Flags DomainBlockJobSetSpeedFlags
+	Flags     DomainBlockJobSetSpeedFlags
 }
 
 // DomainBlockPullArgs is libvirt's remote_domain_block_pull_args
 type DomainBlockPullArgs struct {
-	Dom Domain
-	Path string
+	Dom       Domain
+	Path      string
 	Bandwidth uint64
-	Flags DomainBlockPullFlags
+	Flags     DomainBlockPullFlags
 }
 
 // DomainBlockRebaseArgs is libvirt's remote_domain_block_rebase_args
 type DomainBlockRebaseArgs struct {
-	Dom Domain
-	Path string
-	Base OptString
+	Dom       Domain
+	Path      string
+	Base      OptString
 	Bandwidth uint64
-	Flags DomainBlockRebaseFlags
+	Flags     DomainBlockRebaseFlags
 }
 
 // DomainBlockCopyArgs is libvirt's remote_domain_block_copy_args
 type DomainBlockCopyArgs struct {
-	Dom Domain
-	Path string
+	Dom     Domain
+	Path    string
 	Destxml string
-	Params []TypedParam
-	Flags DomainBlockCopyFlags
+	Params  []TypedParam
+	Flags   DomainBlockCopyFlags
 }
 
 // DomainBlockCommitArgs is libvirt's remote_domain_block_commit_args
 type DomainBlockCommitArgs struct {
-	Dom Domain
-	Disk string
-	Base OptString
-	Top OptString
+	Dom       Domain
+	Disk      string
+	Base      OptString
+	Top       OptString
 	Bandwidth uint64
-	Flags DomainBlockCommitFlags
+	Flags     DomainBlockCommitFlags
 }
 
 // DomainSetBlockIOTuneArgs is libvirt's remote_domain_set_block_io_tune_args
 type DomainSetBlockIOTuneArgs struct {
-	Dom Domain
-	Disk string
+	Dom    Domain
+	Disk   string
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainGetBlockIOTuneArgs is libvirt's remote_domain_get_block_io_tune_args
 type DomainGetBlockIOTuneArgs struct {
-	Dom Domain
-	Disk OptString
+	Dom     Domain
+	Disk    OptString
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainGetBlockIOTuneRet is libvirt's remote_domain_get_block_io_tune_ret
 type DomainGetBlockIOTuneRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainGetCPUStatsArgs is libvirt's remote_domain_get_cpu_stats_args
 type DomainGetCPUStatsArgs struct {
-	Dom Domain
-	Nparams uint32
+	Dom      Domain
+	Nparams  uint32
 	StartCPU int32
-	Ncpus uint32
-	Flags TypedParameterFlags
+	Ncpus    uint32
+	Flags    TypedParameterFlags
 }
 
 // DomainGetCPUStatsRet is libvirt's remote_domain_get_cpu_stats_ret
 type DomainGetCPUStatsRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainGetHostnameArgs is libvirt's remote_domain_get_hostname_args
 type DomainGetHostnameArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainGetHostnameFlags
 }
 
@@ -1456,12 +4527,12 @@ type NetworkUndefineArgs struct {
 
 // NetworkUpdateArgs is libvirt's remote_network_update_args
 type NetworkUpdateArgs struct {
-	Net Network
-	Command uint32
-	Section uint32
+	Net         Network
+	Command     uint32
+	Section     uint32
 	ParentIndex int32
-	XML string
-	Flags NetworkUpdateFlags
+	XML         string
+	Flags       NetworkUpdateFlags
 }
 
 // NetworkCreateArgs is libvirt's remote_network_create_args
@@ -1476,7 +4547,7 @@ type NetworkDestroyArgs struct {
 
 // NetworkGetXMLDescArgs is libvirt's remote_network_get_xml_desc_args
 type NetworkGetXMLDescArgs struct {
-	Net Network
+	Net   Network
 	Flags uint32
 }
 
@@ -1507,7 +4578,7 @@ type NetworkGetAutostartRet struct {
 
 // NetworkSetAutostartArgs is libvirt's remote_network_set_autostart_args
 type NetworkSetAutostartArgs struct {
-	Net Network
+	Net       Network
 	Autostart int32
 }
 
@@ -1564,7 +4635,7 @@ type NwfilterUndefineArgs struct {
 // NwfilterGetXMLDescArgs is libvirt's remote_nwfilter_get_xml_desc_args
 type NwfilterGetXMLDescArgs struct {
 	OptNwfilter Nwfilter
-	Flags uint32
+	Flags       uint32
 }
 
 // NwfilterGetXMLDescRet is libvirt's remote_nwfilter_get_xml_desc_ret
@@ -1625,7 +4696,7 @@ type InterfaceLookupByMacStringRet struct {
 // InterfaceGetXMLDescArgs is libvirt's remote_interface_get_xml_desc_args
 type InterfaceGetXMLDescArgs struct {
 	Iface Interface
-	Flags uint32
+	Flags InterfaceXMLFlags
 }
 
 // InterfaceGetXMLDescRet is libvirt's remote_interface_get_xml_desc_ret
@@ -1635,7 +4706,7 @@ type InterfaceGetXMLDescRet struct {
 
 // InterfaceDefineXMLArgs is libvirt's remote_interface_define_xml_args
 type InterfaceDefineXMLArgs struct {
-	XML string
+	XML   string
 	Flags uint32
 }
 
@@ -1689,28 +4760,28 @@ type AuthSaslInitRet struct {
 // AuthSaslStartArgs is libvirt's remote_auth_sasl_start_args
 type AuthSaslStartArgs struct {
 	Mech string
-	Nil int32
+	Nil  int32
 	Data []int8
 }
 
 // AuthSaslStartRet is libvirt's remote_auth_sasl_start_ret
 type AuthSaslStartRet struct {
 	Complete int32
-	Nil int32
-	Data []int8
+	Nil      int32
+	Data     []int8
 }
 
 // AuthSaslStepArgs is libvirt's remote_auth_sasl_step_args
 type AuthSaslStepArgs struct {
-	Nil int32
+	Nil  int32
 	Data []int8
 }
 
 // AuthSaslStepRet is libvirt's remote_auth_sasl_step_ret
 type AuthSaslStepRet struct {
 	Complete int32
-	Nil int32
-	Data []int8
+	Nil      int32
+	Data     []int8
 }
 
 // AuthPolkitRet is libvirt's remote_auth_polkit_ret
@@ -1750,9 +4821,9 @@ type ConnectListDefinedStoragePoolsRet struct {
 
 // ConnectFindStoragePoolSourcesArgs is libvirt's remote_connect_find_storage_pool_sources_args
 type ConnectFindStoragePoolSourcesArgs struct {
-	Type string
+	Type    string
 	SrcSpec OptString
-	Flags uint32
+	Flags   uint32
 }
 
 // ConnectFindStoragePoolSourcesRet is libvirt's remote_connect_find_storage_pool_sources_ret
@@ -1802,7 +4873,7 @@ type StoragePoolLookupByTargetPathRet struct {
 
 // StoragePoolCreateXMLArgs is libvirt's remote_storage_pool_create_xml_args
 type StoragePoolCreateXMLArgs struct {
-	XML string
+	XML   string
 	Flags StoragePoolCreateFlags
 }
 
@@ -1813,7 +4884,7 @@ type StoragePoolCreateXMLRet struct {
 
 // StoragePoolDefineXMLArgs is libvirt's remote_storage_pool_define_xml_args
 type StoragePoolDefineXMLArgs struct {
-	XML string
+	XML   string
 	Flags uint32
 }
 
@@ -1824,7 +4895,7 @@ type StoragePoolDefineXMLRet struct {
 
 // StoragePoolBuildArgs is libvirt's remote_storage_pool_build_args
 type StoragePoolBuildArgs struct {
-	Pool StoragePool
+	Pool  StoragePool
 	Flags StoragePoolBuildFlags
 }
 
@@ -1835,7 +4906,7 @@ type StoragePoolUndefineArgs struct {
 
 // StoragePoolCreateArgs is libvirt's remote_storage_pool_create_args
 type StoragePoolCreateArgs struct {
-	Pool StoragePool
+	Pool  StoragePool
 	Flags StoragePoolCreateFlags
 }
 
@@ -1846,19 +4917,19 @@ type StoragePoolDestroyArgs struct {
 
 // StoragePoolDeleteArgs is libvirt's remote_storage_pool_delete_args
 type StoragePoolDeleteArgs struct {
-	Pool StoragePool
+	Pool  StoragePool
 	Flags StoragePoolDeleteFlags
 }
 
 // StoragePoolRefreshArgs is libvirt's remote_storage_pool_refresh_args
 type StoragePoolRefreshArgs struct {
-	Pool StoragePool
+	Pool  StoragePool
 	Flags uint32
 }
 
 // StoragePoolGetXMLDescArgs is libvirt's remote_storage_pool_get_xml_desc_args
 type StoragePoolGetXMLDescArgs struct {
-	Pool StoragePool
+	Pool  StoragePool
 	Flags StorageXMLFlags
 }
 
@@ -1874,10 +4945,10 @@ type StoragePoolGetInfoArgs struct {
 
 // StoragePoolGetInfoRet is libvirt's remote_storage_pool_get_info_ret
 type StoragePoolGetInfoRet struct {
-	State uint8
-	Capacity uint64
+	State      uint8
+	Capacity   uint64
 	Allocation uint64
-	Available uint64
+	Available  uint64
 }
 
 // StoragePoolGetAutostartArgs is libvirt's remote_storage_pool_get_autostart_args
@@ -1892,7 +4963,7 @@ type StoragePoolGetAutostartRet struct {
 
 // StoragePoolSetAutostartArgs is libvirt's remote_storage_pool_set_autostart_args
 type StoragePoolSetAutostartArgs struct {
-	Pool StoragePool
+	Pool      StoragePool
 	Autostart int32
 }
 
@@ -1908,7 +4979,7 @@ type StoragePoolNumOfVolumesRet struct {
 
 // StoragePoolListVolumesArgs is libvirt's remote_storage_pool_list_volumes_args
 type StoragePoolListVolumesArgs struct {
-	Pool StoragePool
+	Pool     StoragePool
 	Maxnames int32
 }
 
@@ -1950,8 +5021,8 @@ type StorageVolLookupByPathRet struct {
 
 // StorageVolCreateXMLArgs is libvirt's remote_storage_vol_create_xml_args
 type StorageVolCreateXMLArgs struct {
-	Pool StoragePool
-	XML string
+	Pool  StoragePool
+	XML   string
 	Flags StorageVolCreateFlags
 }
 
@@ -1962,10 +5033,10 @@ type StorageVolCreateXMLRet struct {
 
 // StorageVolCreateXMLFromArgs is libvirt's remote_storage_vol_create_xml_from_args
 type StorageVolCreateXMLFromArgs struct {
-	Pool StoragePool
-	XML string
+	Pool     StoragePool
+	XML      string
 	Clonevol StorageVol
-	Flags StorageVolCreateFlags
+	Flags    StorageVolCreateFlags
 }
 
 // StorageVolCreateXMLFromRet is libvirt's remote_storage_vol_create_xml_from_ret
@@ -1975,26 +5046,26 @@ type StorageVolCreateXMLFromRet struct {
 
 // StorageVolDeleteArgs is libvirt's remote_storage_vol_delete_args
 type StorageVolDeleteArgs struct {
-	Vol StorageVol
+	Vol   StorageVol
 	Flags StorageVolDeleteFlags
 }
 
 // StorageVolWipeArgs is libvirt's remote_storage_vol_wipe_args
 type StorageVolWipeArgs struct {
-	Vol StorageVol
+	Vol   StorageVol
 	Flags uint32
 }
 
 // StorageVolWipePatternArgs is libvirt's remote_storage_vol_wipe_pattern_args
 type StorageVolWipePatternArgs struct {
-	Vol StorageVol
+	Vol       StorageVol
 	Algorithm uint32
-	Flags uint32
+	Flags     uint32
 }
 
 // StorageVolGetXMLDescArgs is libvirt's remote_storage_vol_get_xml_desc_args
 type StorageVolGetXMLDescArgs struct {
-	Vol StorageVol
+	Vol   StorageVol
 	Flags uint32
 }
 
@@ -2010,21 +5081,21 @@ type StorageVolGetInfoArgs struct {
 
 // StorageVolGetInfoRet is libvirt's remote_storage_vol_get_info_ret
 type StorageVolGetInfoRet struct {
-	Type int8
-	Capacity uint64
+	Type       int8
+	Capacity   uint64
 	Allocation uint64
 }
 
 // StorageVolGetInfoFlagsArgs is libvirt's remote_storage_vol_get_info_flags_args
 type StorageVolGetInfoFlagsArgs struct {
-	Vol StorageVol
+	Vol   StorageVol
 	Flags uint32
 }
 
 // StorageVolGetInfoFlagsRet is libvirt's remote_storage_vol_get_info_flags_ret
 type StorageVolGetInfoFlagsRet struct {
-	Type int8
-	Capacity uint64
+	Type       int8
+	Capacity   uint64
 	Allocation uint64
 }
 
@@ -2040,14 +5111,14 @@ type StorageVolGetPathRet struct {
 
 // StorageVolResizeArgs is libvirt's remote_storage_vol_resize_args
 type StorageVolResizeArgs struct {
-	Vol StorageVol
+	Vol      StorageVol
 	Capacity uint64
-	Flags StorageVolResizeFlags
+	Flags    StorageVolResizeFlags
 }
 
 // NodeNumOfDevicesArgs is libvirt's remote_node_num_of_devices_args
 type NodeNumOfDevicesArgs struct {
-	Cap OptString
+	Cap   OptString
 	Flags uint32
 }
 
@@ -2058,9 +5129,9 @@ type NodeNumOfDevicesRet struct {
 
 // NodeListDevicesArgs is libvirt's remote_node_list_devices_args
 type NodeListDevicesArgs struct {
-	Cap OptString
+	Cap      OptString
 	Maxnames int32
-	Flags uint32
+	Flags    uint32
 }
 
 // NodeListDevicesRet is libvirt's remote_node_list_devices_ret
@@ -2080,8 +5151,8 @@ type NodeDeviceLookupByNameRet struct {
 
 // NodeDeviceLookupScsiHostByWwnArgs is libvirt's remote_node_device_lookup_scsi_host_by_wwn_args
 type NodeDeviceLookupScsiHostByWwnArgs struct {
-	Wwnn string
-	Wwpn string
+	Wwnn  string
+	Wwpn  string
 	Flags uint32
 }
 
@@ -2092,7 +5163,7 @@ type NodeDeviceLookupScsiHostByWwnRet struct {
 
 // NodeDeviceGetXMLDescArgs is libvirt's remote_node_device_get_xml_desc_args
 type NodeDeviceGetXMLDescArgs struct {
-	Name string
+	Name  string
 	Flags uint32
 }
 
@@ -2123,7 +5194,7 @@ type NodeDeviceNumOfCapsRet struct {
 
 // NodeDeviceListCapsArgs is libvirt's remote_node_device_list_caps_args
 type NodeDeviceListCapsArgs struct {
-	Name string
+	Name     string
 	Maxnames int32
 }
 
@@ -2139,9 +5210,9 @@ type NodeDeviceDettachArgs struct {
 
 // NodeDeviceDetachFlagsArgs is libvirt's remote_node_device_detach_flags_args
 type NodeDeviceDetachFlagsArgs struct {
-	Name string
+	Name       string
 	DriverName OptString
-	Flags uint32
+	Flags      uint32
 }
 
 // NodeDeviceReAttachArgs is libvirt's remote_node_device_re_attach_args
@@ -2157,7 +5228,7 @@ type NodeDeviceResetArgs struct {
 // NodeDeviceCreateXMLArgs is libvirt's remote_node_device_create_xml_args
 type NodeDeviceCreateXMLArgs struct {
 	XMLDesc string
-	Flags uint32
+	Flags   uint32
 }
 
 // NodeDeviceCreateXMLRet is libvirt's remote_node_device_create_xml_ret
@@ -2182,22 +5253,22 @@ type ConnectDomainEventDeregisterRet struct {
 
 // DomainEventLifecycleMsg is libvirt's remote_domain_event_lifecycle_msg
 type DomainEventLifecycleMsg struct {
-	Dom Domain
-	Event int32
+	Dom    Domain
+	Event  int32
 	Detail int32
 }
 
 // DomainEventCallbackLifecycleMsg is libvirt's remote_domain_event_callback_lifecycle_msg
 type DomainEventCallbackLifecycleMsg struct {
 	CallbackID int32
-	Msg DomainEventLifecycleMsg
+	Msg        DomainEventLifecycleMsg
 }
 
 // ConnectDomainXMLFromNativeArgs is libvirt's remote_connect_domain_xml_from_native_args
 type ConnectDomainXMLFromNativeArgs struct {
 	NativeFormat string
 	NativeConfig string
-	Flags uint32
+	Flags        uint32
 }
 
 // ConnectDomainXMLFromNativeRet is libvirt's remote_connect_domain_xml_from_native_ret
@@ -2208,8 +5279,8 @@ type ConnectDomainXMLFromNativeRet struct {
 // ConnectDomainXMLToNativeArgs is libvirt's remote_connect_domain_xml_to_native_args
 type ConnectDomainXMLToNativeArgs struct {
 	NativeFormat string
-	DomainXML string
-	Flags uint32
+	DomainXML    string
+	Flags        uint32
 }
 
 // ConnectDomainXMLToNativeRet is libvirt's remote_connect_domain_xml_to_native_ret
@@ -2244,7 +5315,7 @@ type SecretLookupByUUIDRet struct {
 
 // SecretDefineXMLArgs is libvirt's remote_secret_define_xml_args
 type SecretDefineXMLArgs struct {
-	XML string
+	XML   string
 	Flags uint32
 }
 
@@ -2256,7 +5327,7 @@ type SecretDefineXMLRet struct {
 // SecretGetXMLDescArgs is libvirt's remote_secret_get_xml_desc_args
 type SecretGetXMLDescArgs struct {
 	OptSecret Secret
-	Flags uint32
+	Flags     uint32
 }
 
 // SecretGetXMLDescRet is libvirt's remote_secret_get_xml_desc_ret
@@ -2267,14 +5338,14 @@ type SecretGetXMLDescRet struct {
 // SecretSetValueArgs is libvirt's remote_secret_set_value_args
 type SecretSetValueArgs struct {
 	OptSecret Secret
-	Value []byte
-	Flags uint32
+	Value     []byte
+	Flags     uint32
 }
 
 // SecretGetValueArgs is libvirt's remote_secret_get_value_args
 type SecretGetValueArgs struct {
 	OptSecret Secret
-	Flags uint32
+	Flags     uint32
 }
 
 // SecretGetValueRet is libvirt's remote_secret_get_value_ret
@@ -2290,7 +5361,7 @@ type SecretUndefineArgs struct {
 // SecretLookupByUsageArgs is libvirt's remote_secret_lookup_by_usage_args
 type SecretLookupByUsageArgs struct {
 	UsageType int32
-	UsageID string
+	UsageID   string
 }
 
 // SecretLookupByUsageRet is libvirt's remote_secret_lookup_by_usage_ret
@@ -2300,10 +5371,10 @@ type SecretLookupByUsageRet struct {
 
 // DomainMigratePrepareTunnelArgs is libvirt's remote_domain_migrate_prepare_tunnel_args
 type DomainMigratePrepareTunnelArgs struct {
-	Flags uint64
-	Dname OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
-	DomXML string
+	DomXML   string
 }
 
 // ConnectIsSecureRet is libvirt's remote_connect_is_secure_ret
@@ -2393,7 +5464,7 @@ type InterfaceIsActiveRet struct {
 
 // ConnectCompareCPUArgs is libvirt's remote_connect_compare_cpu_args
 type ConnectCompareCPUArgs struct {
-	XML string
+	XML   string
 	Flags ConnectCompareCPUFlags
 }
 
@@ -2405,7 +5476,7 @@ type ConnectCompareCPURet struct {
 // ConnectBaselineCPUArgs is libvirt's remote_connect_baseline_cpu_args
 type ConnectBaselineCPUArgs struct {
 	XMLCPUs []string
-	Flags ConnectBaselineCPUFlags
+	Flags   ConnectBaselineCPUFlags
 }
 
 // ConnectBaselineCPURet is libvirt's remote_connect_baseline_cpu_ret
@@ -2420,29 +5491,29 @@ type DomainGetJobInfoArgs struct {
 
 // DomainGetJobInfoRet is libvirt's remote_domain_get_job_info_ret
 type DomainGetJobInfoRet struct {
-	Type int32
-	TimeElapsed uint64
+	Type          int32
+	TimeElapsed   uint64
 	TimeRemaining uint64
-	DataTotal uint64
+	DataTotal     uint64
 	DataProcessed uint64
 	DataRemaining uint64
-	MemTotal uint64
-	MemProcessed uint64
-	MemRemaining uint64
-	FileTotal uint64
+	MemTotal      uint64
+	MemProcessed  uint64
+	MemRemaining  uint64
+	FileTotal     uint64
 	FileProcessed uint64
 	FileRemaining uint64
 }
 
 // DomainGetJobStatsArgs is libvirt's remote_domain_get_job_stats_args
 type DomainGetJobStatsArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainGetJobStatsFlags
 }
 
 // DomainGetJobStatsRet is libvirt's remote_domain_get_job_stats_ret
 type DomainGetJobStatsRet struct {
-	Type int32
+	Type   int32
 	Params []TypedParam
 }
 
@@ -2453,7 +5524,7 @@ type DomainAbortJobArgs struct {
 
 // DomainMigrateGetMaxDowntimeArgs is libvirt's remote_domain_migrate_get_max_downtime_args
 type DomainMigrateGetMaxDowntimeArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2464,14 +5535,14 @@ type DomainMigrateGetMaxDowntimeRet struct {
 
 // DomainMigrateSetMaxDowntimeArgs is libvirt's remote_domain_migrate_set_max_downtime_args
 type DomainMigrateSetMaxDowntimeArgs struct {
-	Dom Domain
+	Dom      Domain
 	Downtime uint64
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainMigrateGetCompressionCacheArgs is libvirt's remote_domain_migrate_get_compression_cache_args
 type DomainMigrateGetCompressionCacheArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2482,22 +5553,22 @@ type DomainMigrateGetCompressionCacheRet struct {
 
 // DomainMigrateSetCompressionCacheArgs is libvirt's remote_domain_migrate_set_compression_cache_args
 type DomainMigrateSetCompressionCacheArgs struct {
-	Dom Domain
+	Dom       Domain
 	CacheSize uint64
-	Flags uint32
+	Flags     uint32
 }
 
 // DomainMigrateSetMaxSpeedArgs is libvirt's remote_domain_migrate_set_max_speed_args
 type DomainMigrateSetMaxSpeedArgs struct {
-	Dom Domain
+	Dom       Domain
 	Bandwidth uint64
-	Flags uint32
+	Flags     DomainMigrateMaxSpeedFlags
 }
 
 // DomainMigrateGetMaxSpeedArgs is libvirt's remote_domain_migrate_get_max_speed_args
 type DomainMigrateGetMaxSpeedArgs struct {
-	Dom Domain
-	Flags uint32
+	Dom   Domain
+	Flags DomainMigrateMaxSpeedFlags
 }
 
 // DomainMigrateGetMaxSpeedRet is libvirt's remote_domain_migrate_get_max_speed_ret
@@ -2518,7 +5589,7 @@ type ConnectDomainEventDeregisterAnyArgs struct {
 // ConnectDomainEventCallbackRegisterAnyArgs is libvirt's remote_connect_domain_event_callback_register_any_args
 type ConnectDomainEventCallbackRegisterAnyArgs struct {
 	EventID int32
-	Dom OptDomain
+	Dom     OptDomain
 }
 
 // ConnectDomainEventCallbackRegisterAnyRet is libvirt's remote_connect_domain_event_callback_register_any_ret
@@ -2539,66 +5610,66 @@ type DomainEventRebootMsg struct {
 // DomainEventCallbackRebootMsg is libvirt's remote_domain_event_callback_reboot_msg
 type DomainEventCallbackRebootMsg struct {
 	CallbackID int32
-	Msg DomainEventRebootMsg
+	Msg        DomainEventRebootMsg
 }
 
 // DomainEventRtcChangeMsg is libvirt's remote_domain_event_rtc_change_msg
 type DomainEventRtcChangeMsg struct {
-	Dom Domain
+	Dom    Domain
 	Offset int64
 }
 
 // DomainEventCallbackRtcChangeMsg is libvirt's remote_domain_event_callback_rtc_change_msg
 type DomainEventCallbackRtcChangeMsg struct {
 	CallbackID int32
-	Msg DomainEventRtcChangeMsg
+	Msg        DomainEventRtcChangeMsg
 }
 
 // DomainEventWatchdogMsg is libvirt's remote_domain_event_watchdog_msg
 type DomainEventWatchdogMsg struct {
-	Dom Domain
+	Dom    Domain
 	Action int32
 }
 
 // DomainEventCallbackWatchdogMsg is libvirt's remote_domain_event_callback_watchdog_msg
 type DomainEventCallbackWatchdogMsg struct {
 	CallbackID int32
-	Msg DomainEventWatchdogMsg
+	Msg        DomainEventWatchdogMsg
 }
 
 // DomainEventIOErrorMsg is libvirt's remote_domain_event_io_error_msg
 type DomainEventIOErrorMsg struct {
-	Dom Domain
-	SrcPath string
+	Dom      Domain
+	SrcPath  string
 	DevAlias string
-	Action int32
+	Action   int32
 }
 
 // DomainEventCallbackIOErrorMsg is libvirt's remote_domain_event_callback_io_error_msg
 type DomainEventCallbackIOErrorMsg struct {
 	CallbackID int32
-	Msg DomainEventIOErrorMsg
+	Msg        DomainEventIOErrorMsg
 }
 
 // DomainEventIOErrorReasonMsg is libvirt's remote_domain_event_io_error_reason_msg
 type DomainEventIOErrorReasonMsg struct {
-	Dom Domain
-	SrcPath string
+	Dom      Domain
+	SrcPath  string
 	DevAlias string
-	Action int32
-	Reason string
+	Action   int32
+	Reason   string
 }
 
 // DomainEventCallbackIOErrorReasonMsg is libvirt's remote_domain_event_callback_io_error_reason_msg
 type DomainEventCallbackIOErrorReasonMsg struct {
 	CallbackID int32
-	Msg DomainEventIOErrorReasonMsg
+	Msg        DomainEventIOErrorReasonMsg
 }
 
 // DomainEventGraphicsAddress is libvirt's remote_domain_event_graphics_address
 type DomainEventGraphicsAddress struct {
-	Family int32
-	Node string
+	Family  int32
+	Node    string
 	Service string
 }
 
@@ -2610,60 +5681,60 @@ type DomainEventGraphicsIdentity struct {
 
 // DomainEventGraphicsMsg is libvirt's remote_domain_event_graphics_msg
 type DomainEventGraphicsMsg struct {
-	Dom Domain
-	Phase int32
-	Local DomainEventGraphicsAddress
-	Remote DomainEventGraphicsAddress
+	Dom        Domain
+	Phase      int32
+	Local      DomainEventGraphicsAddress
+	Remote     DomainEventGraphicsAddress
 	AuthScheme string
-	Subject []DomainEventGraphicsIdentity
+	Subject    []DomainEventGraphicsIdentity
 }
 
 // DomainEventCallbackGraphicsMsg is libvirt's remote_domain_event_callback_graphics_msg
 type DomainEventCallbackGraphicsMsg struct {
 	CallbackID int32
-	Msg DomainEventGraphicsMsg
+	Msg        DomainEventGraphicsMsg
 }
 
 // DomainEventBlockJobMsg is libvirt's remote_domain_event_block_job_msg
 type DomainEventBlockJobMsg struct {
-	Dom Domain
-	Path string
-	Type int32
+	Dom    Domain
+	Path   string
+	Type   int32
 	Status int32
 }
 
 // DomainEventCallbackBlockJobMsg is libvirt's remote_domain_event_callback_block_job_msg
 type DomainEventCallbackBlockJobMsg struct {
 	CallbackID int32
-	Msg DomainEventBlockJobMsg
+	Msg        DomainEventBlockJobMsg
 }
 
 // DomainEventDiskChangeMsg is libvirt's remote_domain_event_disk_change_msg
 type DomainEventDiskChangeMsg struct {
-	Dom Domain
+	Dom        Domain
 	OldSrcPath OptString
 	NewSrcPath OptString
-	DevAlias string
-	Reason int32
+	DevAlias   string
+	Reason     int32
 }
 
 // DomainEventCallbackDiskChangeMsg is libvirt's remote_domain_event_callback_disk_change_msg
 type DomainEventCallbackDiskChangeMsg struct {
 	CallbackID int32
-	Msg DomainEventDiskChangeMsg
+	Msg        DomainEventDiskChangeMsg
 }
 
 // DomainEventTrayChangeMsg is libvirt's remote_domain_event_tray_change_msg
 type DomainEventTrayChangeMsg struct {
-	Dom Domain
+	Dom      Domain
 	DevAlias string
-	Reason int32
+	Reason   int32
 }
 
 // DomainEventCallbackTrayChangeMsg is libvirt's remote_domain_event_callback_tray_change_msg
 type DomainEventCallbackTrayChangeMsg struct {
 	CallbackID int32
-	Msg DomainEventTrayChangeMsg
+	Msg        DomainEventTrayChangeMsg
 }
 
 // DomainEventPmwakeupMsg is libvirt's remote_domain_event_pmwakeup_msg
@@ -2674,8 +5745,8 @@ type DomainEventPmwakeupMsg struct {
 // DomainEventCallbackPmwakeupMsg is libvirt's remote_domain_event_callback_pmwakeup_msg
 type DomainEventCallbackPmwakeupMsg struct {
 	CallbackID int32
-	Reason int32
-	Msg DomainEventPmwakeupMsg
+	Reason     int32
+	Msg        DomainEventPmwakeupMsg
 }
 
 // DomainEventPmsuspendMsg is libvirt's remote_domain_event_pmsuspend_msg
@@ -2686,20 +5757,20 @@ type DomainEventPmsuspendMsg struct {
 // DomainEventCallbackPmsuspendMsg is libvirt's remote_domain_event_callback_pmsuspend_msg
 type DomainEventCallbackPmsuspendMsg struct {
 	CallbackID int32
-	Reason int32
-	Msg DomainEventPmsuspendMsg
+	Reason     int32
+	Msg        DomainEventPmsuspendMsg
 }
 
 // DomainEventBalloonChangeMsg is libvirt's remote_domain_event_balloon_change_msg
 type DomainEventBalloonChangeMsg struct {
-	Dom Domain
+	Dom    Domain
 	Actual uint64
 }
 
 // DomainEventCallbackBalloonChangeMsg is libvirt's remote_domain_event_callback_balloon_change_msg
 type DomainEventCallbackBalloonChangeMsg struct {
 	CallbackID int32
-	Msg DomainEventBalloonChangeMsg
+	Msg        DomainEventBalloonChangeMsg
 }
 
 // DomainEventPmsuspendDiskMsg is libvirt's remote_domain_event_pmsuspend_disk_msg
@@ -2710,19 +5781,19 @@ type DomainEventPmsuspendDiskMsg struct {
 // DomainEventCallbackPmsuspendDiskMsg is libvirt's remote_domain_event_callback_pmsuspend_disk_msg
 type DomainEventCallbackPmsuspendDiskMsg struct {
 	CallbackID int32
-	Reason int32
-	Msg DomainEventPmsuspendDiskMsg
+	Reason     int32
+	Msg        DomainEventPmsuspendDiskMsg
 }
 
 // DomainManagedSaveArgs is libvirt's remote_domain_managed_save_args
 type DomainManagedSaveArgs struct {
-	Dom Domain
-	Flags uint32
+	Dom   Domain
+	Flags DomainSaveRestoreFlags
 }
 
 // DomainHasManagedSaveImageArgs is libvirt's remote_domain_has_managed_save_image_args
 type DomainHasManagedSaveImageArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2733,13 +5804,13 @@ type DomainHasManagedSaveImageRet struct {
 
 // DomainManagedSaveRemoveArgs is libvirt's remote_domain_managed_save_remove_args
 type DomainManagedSaveRemoveArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainManagedSaveGetXMLDescArgs is libvirt's remote_domain_managed_save_get_xml_desc_args
 type DomainManagedSaveGetXMLDescArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainXMLFlags
 }
 
@@ -2750,16 +5821,16 @@ type DomainManagedSaveGetXMLDescRet struct {
 
 // DomainManagedSaveDefineXMLArgs is libvirt's remote_domain_managed_save_define_xml_args
 type DomainManagedSaveDefineXMLArgs struct {
-	Dom Domain
-	Dxml OptString
+	Dom   Domain
+	Dxml  OptString
 	Flags DomainSaveRestoreFlags
 }
 
 // DomainSnapshotCreateXMLArgs is libvirt's remote_domain_snapshot_create_xml_args
 type DomainSnapshotCreateXMLArgs struct {
-	Dom Domain
+	Dom     Domain
 	XMLDesc string
-	Flags uint32
+	Flags   DomainSnapshotCreateFlags
 }
 
 // DomainSnapshotCreateXMLRet is libvirt's remote_domain_snapshot_create_xml_ret
@@ -2769,8 +5840,8 @@ type DomainSnapshotCreateXMLRet struct {
 
 // DomainSnapshotGetXMLDescArgs is libvirt's remote_domain_snapshot_get_xml_desc_args
 type DomainSnapshotGetXMLDescArgs struct {
-	Snap DomainSnapshot
-	Flags uint32
+	Snap  DomainSnapshot
+	Flags DomainSnapshotXMLFlags
 }
 
 // DomainSnapshotGetXMLDescRet is libvirt's remote_domain_snapshot_get_xml_desc_ret
@@ -2780,7 +5851,7 @@ type DomainSnapshotGetXMLDescRet struct {
 
 // DomainSnapshotNumArgs is libvirt's remote_domain_snapshot_num_args
 type DomainSnapshotNumArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2791,9 +5862,9 @@ type DomainSnapshotNumRet struct {
 
 // DomainSnapshotListNamesArgs is libvirt's remote_domain_snapshot_list_names_args
 type DomainSnapshotListNamesArgs struct {
-	Dom Domain
+	Dom      Domain
 	Maxnames int32
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainSnapshotListNamesRet is libvirt's remote_domain_snapshot_list_names_ret
@@ -2803,20 +5874,20 @@ type DomainSnapshotListNamesRet struct {
 
 // DomainListAllSnapshotsArgs is libvirt's remote_domain_list_all_snapshots_args
 type DomainListAllSnapshotsArgs struct {
-	Dom Domain
+	Dom         Domain
 	NeedResults int32
-	Flags uint32
+	Flags       DomainSnapshotListFlags
 }
 
 // DomainListAllSnapshotsRet is libvirt's remote_domain_list_all_snapshots_ret
 type DomainListAllSnapshotsRet struct {
 	Snapshots []DomainSnapshot
-	Ret int32
+	Ret       int32
 }
 
 // DomainSnapshotNumChildrenArgs is libvirt's remote_domain_snapshot_num_children_args
 type DomainSnapshotNumChildrenArgs struct {
-	Snap DomainSnapshot
+	Snap  DomainSnapshot
 	Flags uint32
 }
 
@@ -2827,9 +5898,9 @@ type DomainSnapshotNumChildrenRet struct {
 
 // DomainSnapshotListChildrenNamesArgs is libvirt's remote_domain_snapshot_list_children_names_args
 type DomainSnapshotListChildrenNamesArgs struct {
-	Snap DomainSnapshot
+	Snap     DomainSnapshot
 	Maxnames int32
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainSnapshotListChildrenNamesRet is libvirt's remote_domain_snapshot_list_children_names_ret
@@ -2839,21 +5910,21 @@ type DomainSnapshotListChildrenNamesRet struct {
 
 // DomainSnapshotListAllChildrenArgs is libvirt's remote_domain_snapshot_list_all_children_args
 type DomainSnapshotListAllChildrenArgs struct {
-	Snapshot DomainSnapshot
+	Snapshot    DomainSnapshot
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // DomainSnapshotListAllChildrenRet is libvirt's remote_domain_snapshot_list_all_children_ret
 type DomainSnapshotListAllChildrenRet struct {
 	Snapshots []DomainSnapshot
-	Ret int32
+	Ret       int32
 }
 
 // DomainSnapshotLookupByNameArgs is libvirt's remote_domain_snapshot_lookup_by_name_args
 type DomainSnapshotLookupByNameArgs struct {
-	Dom Domain
-	Name string
+	Dom   Domain
+	Name  string
 	Flags uint32
 }
 
@@ -2864,7 +5935,7 @@ type DomainSnapshotLookupByNameRet struct {
 
 // DomainHasCurrentSnapshotArgs is libvirt's remote_domain_has_current_snapshot_args
 type DomainHasCurrentSnapshotArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2875,7 +5946,7 @@ type DomainHasCurrentSnapshotRet struct {
 
 // DomainSnapshotGetParentArgs is libvirt's remote_domain_snapshot_get_parent_args
 type DomainSnapshotGetParentArgs struct {
-	Snap DomainSnapshot
+	Snap  DomainSnapshot
 	Flags uint32
 }
 
@@ -2886,7 +5957,7 @@ type DomainSnapshotGetParentRet struct {
 
 // DomainSnapshotCurrentArgs is libvirt's remote_domain_snapshot_current_args
 type DomainSnapshotCurrentArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -2897,7 +5968,7 @@ type DomainSnapshotCurrentRet struct {
 
 // DomainSnapshotIsCurrentArgs is libvirt's remote_domain_snapshot_is_current_args
 type DomainSnapshotIsCurrentArgs struct {
-	Snap DomainSnapshot
+	Snap  DomainSnapshot
 	Flags uint32
 }
 
@@ -2908,7 +5979,7 @@ type DomainSnapshotIsCurrentRet struct {
 
 // DomainSnapshotHasMetadataArgs is libvirt's remote_domain_snapshot_has_metadata_args
 type DomainSnapshotHasMetadataArgs struct {
-	Snap DomainSnapshot
+	Snap  DomainSnapshot
 	Flags uint32
 }
 
@@ -2919,96 +5990,96 @@ type DomainSnapshotHasMetadataRet struct {
 
 // DomainRevertToSnapshotArgs is libvirt's remote_domain_revert_to_snapshot_args
 type DomainRevertToSnapshotArgs struct {
-	Snap DomainSnapshot
-	Flags uint32
+	Snap  DomainSnapshot
+	Flags DomainSnapshotRevertFlags
 }
 
 // DomainSnapshotDeleteArgs is libvirt's remote_domain_snapshot_delete_args
 type DomainSnapshotDeleteArgs struct {
-	Snap DomainSnapshot
+	Snap  DomainSnapshot
 	Flags DomainSnapshotDeleteFlags
 }
 
 // DomainOpenConsoleArgs is libvirt's remote_domain_open_console_args
 type DomainOpenConsoleArgs struct {
-	Dom Domain
+	Dom     Domain
 	DevName OptString
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainOpenChannelArgs is libvirt's remote_domain_open_channel_args
 type DomainOpenChannelArgs struct {
-	Dom Domain
-	Name OptString
+	Dom   Domain
+	Name  OptString
 	Flags DomainChannelFlags
 }
 
 // StorageVolUploadArgs is libvirt's remote_storage_vol_upload_args
 type StorageVolUploadArgs struct {
-	Vol StorageVol
+	Vol    StorageVol
 	Offset uint64
 	Length uint64
-	Flags StorageVolUploadFlags
+	Flags  StorageVolUploadFlags
 }
 
 // StorageVolDownloadArgs is libvirt's remote_storage_vol_download_args
 type StorageVolDownloadArgs struct {
-	Vol StorageVol
+	Vol    StorageVol
 	Offset uint64
 	Length uint64
-	Flags StorageVolDownloadFlags
+	Flags  StorageVolDownloadFlags
 }
 
 // DomainGetStateArgs is libvirt's remote_domain_get_state_args
 type DomainGetStateArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainGetStateRet is libvirt's remote_domain_get_state_ret
 type DomainGetStateRet struct {
-	State int32
+	State  int32
 	Reason int32
 }
 
 // DomainMigrateBegin3Args is libvirt's remote_domain_migrate_begin3_args
 type DomainMigrateBegin3Args struct {
-	Dom Domain
-	Xmlin OptString
-	Flags uint64
-	Dname OptString
+	Dom      Domain
+	Xmlin    OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
 }
 
 // DomainMigrateBegin3Ret is libvirt's remote_domain_migrate_begin3_ret
 type DomainMigrateBegin3Ret struct {
 	CookieOut []byte
-	XML string
+	XML       string
 }
 
 // DomainMigratePrepare3Args is libvirt's remote_domain_migrate_prepare3_args
 type DomainMigratePrepare3Args struct {
 	CookieIn []byte
-	UriIn OptString
-	Flags uint64
-	Dname OptString
+	UriIn    OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
-	DomXML string
+	DomXML   string
 }
 
 // DomainMigratePrepare3Ret is libvirt's remote_domain_migrate_prepare3_ret
 type DomainMigratePrepare3Ret struct {
 	CookieOut []byte
-	UriOut OptString
+	UriOut    OptString
 }
 
 // DomainMigratePrepareTunnel3Args is libvirt's remote_domain_migrate_prepare_tunnel3_args
 type DomainMigratePrepareTunnel3Args struct {
 	CookieIn []byte
-	Flags uint64
-	Dname OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
-	DomXML string
+	DomXML   string
 }
 
 // DomainMigratePrepareTunnel3Ret is libvirt's remote_domain_migrate_prepare_tunnel3_ret
@@ -3018,13 +6089,13 @@ type DomainMigratePrepareTunnel3Ret struct {
 
 // DomainMigratePerform3Args is libvirt's remote_domain_migrate_perform3_args
 type DomainMigratePerform3Args struct {
-	Dom Domain
-	Xmlin OptString
+	Dom      Domain
+	Xmlin    OptString
 	CookieIn []byte
 	Dconnuri OptString
-	Uri OptString
-	Flags uint64
-	Dname OptString
+	Uri      OptString
+	Flags    uint64
+	Dname    OptString
 	Resource uint64
 }
 
@@ -3035,25 +6106,25 @@ type DomainMigratePerform3Ret struct {
 
 // DomainMigrateFinish3Args is libvirt's remote_domain_migrate_finish3_args
 type DomainMigrateFinish3Args struct {
-	Dname string
-	CookieIn []byte
-	Dconnuri OptString
-	Uri OptString
-	Flags uint64
+	Dname     string
+	CookieIn  []byte
+	Dconnuri  OptString
+	Uri       OptString
+	Flags     uint64
 	Cancelled int32
 }
 
 // DomainMigrateFinish3Ret is libvirt's remote_domain_migrate_finish3_ret
 type DomainMigrateFinish3Ret struct {
-	Dom Domain
+	Dom       Domain
 	CookieOut []byte
 }
 
 // DomainMigrateConfirm3Args is libvirt's remote_domain_migrate_confirm3_args
 type DomainMigrateConfirm3Args struct {
-	Dom Domain
-	CookieIn []byte
-	Flags uint64
+	Dom       Domain
+	CookieIn  []byte
+	Flags     uint64
 	Cancelled int32
 }
 
@@ -3065,250 +6136,250 @@ type DomainEventControlErrorMsg struct {
 // DomainEventCallbackControlErrorMsg is libvirt's remote_domain_event_callback_control_error_msg
 type DomainEventCallbackControlErrorMsg struct {
 	CallbackID int32
-	Msg DomainEventControlErrorMsg
+	Msg        DomainEventControlErrorMsg
 }
 
 // DomainGetControlInfoArgs is libvirt's remote_domain_get_control_info_args
 type DomainGetControlInfoArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainGetControlInfoRet is libvirt's remote_domain_get_control_info_ret
 type DomainGetControlInfoRet struct {
-	State uint32
-	Details uint32
+	State     uint32
+	Details   uint32
 	StateTime uint64
 }
 
 // DomainOpenGraphicsArgs is libvirt's remote_domain_open_graphics_args
 type DomainOpenGraphicsArgs struct {
-	Dom Domain
-	Idx uint32
+	Dom   Domain
+	Idx   uint32
 	Flags DomainOpenGraphicsFlags
 }
 
 // DomainOpenGraphicsFdArgs is libvirt's remote_domain_open_graphics_fd_args
 type DomainOpenGraphicsFdArgs struct {
-	Dom Domain
-	Idx uint32
+	Dom   Domain
+	Idx   uint32
 	Flags DomainOpenGraphicsFlags
 }
 
 // NodeSuspendForDurationArgs is libvirt's remote_node_suspend_for_duration_args
 type NodeSuspendForDurationArgs struct {
-	Target uint32
+	Target   uint32
 	Duration uint64
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainShutdownFlagsArgs is libvirt's remote_domain_shutdown_flags_args
 type DomainShutdownFlagsArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags DomainShutdownFlagValues
 }
 
 // DomainGetDiskErrorsArgs is libvirt's remote_domain_get_disk_errors_args
 type DomainGetDiskErrorsArgs struct {
-	Dom Domain
+	Dom       Domain
 	Maxerrors uint32
-	Flags uint32
+	Flags     uint32
 }
 
 // DomainGetDiskErrorsRet is libvirt's remote_domain_get_disk_errors_ret
 type DomainGetDiskErrorsRet struct {
-	Errors []DomainDiskError
+	Errors  []DomainDiskError
 	Nerrors int32
 }
 
 // ConnectListAllDomainsArgs is libvirt's remote_connect_list_all_domains_args
 type ConnectListAllDomainsArgs struct {
 	NeedResults int32
-	Flags ConnectListAllDomainsFlags
+	Flags       ConnectListAllDomainsFlags
 }
 
 // ConnectListAllDomainsRet is libvirt's remote_connect_list_all_domains_ret
 type ConnectListAllDomainsRet struct {
 	Domains []Domain
-	Ret uint32
+	Ret     uint32
 }
 
 // ConnectListAllStoragePoolsArgs is libvirt's remote_connect_list_all_storage_pools_args
 type ConnectListAllStoragePoolsArgs struct {
 	NeedResults int32
-	Flags ConnectListAllStoragePoolsFlags
+	Flags       ConnectListAllStoragePoolsFlags
 }
 
 // ConnectListAllStoragePoolsRet is libvirt's remote_connect_list_all_storage_pools_ret
 type ConnectListAllStoragePoolsRet struct {
 	Pools []StoragePool
-	Ret uint32
+	Ret   uint32
 }
 
 // StoragePoolListAllVolumesArgs is libvirt's remote_storage_pool_list_all_volumes_args
 type StoragePoolListAllVolumesArgs struct {
-	Pool StoragePool
+	Pool        StoragePool
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // StoragePoolListAllVolumesRet is libvirt's remote_storage_pool_list_all_volumes_ret
 type StoragePoolListAllVolumesRet struct {
 	Vols []StorageVol
-	Ret uint32
+	Ret  uint32
 }
 
 // ConnectListAllNetworksArgs is libvirt's remote_connect_list_all_networks_args
 type ConnectListAllNetworksArgs struct {
 	NeedResults int32
-	Flags ConnectListAllNetworksFlags
+	Flags       ConnectListAllNetworksFlags
 }
 
 // ConnectListAllNetworksRet is libvirt's remote_connect_list_all_networks_ret
 type ConnectListAllNetworksRet struct {
 	Nets []Network
-	Ret uint32
+	Ret  uint32
 }
 
 // ConnectListAllInterfacesArgs is libvirt's remote_connect_list_all_interfaces_args
 type ConnectListAllInterfacesArgs struct {
 	NeedResults int32
-	Flags ConnectListAllInterfacesFlags
+	Flags       ConnectListAllInterfacesFlags
 }
 
 // ConnectListAllInterfacesRet is libvirt's remote_connect_list_all_interfaces_ret
 type ConnectListAllInterfacesRet struct {
 	Ifaces []Interface
-	Ret uint32
+	Ret    uint32
 }
 
 // ConnectListAllNodeDevicesArgs is libvirt's remote_connect_list_all_node_devices_args
 type ConnectListAllNodeDevicesArgs struct {
 	NeedResults int32
-	Flags uint32
+	Flags       ConnectListAllNodeDeviceFlags
 }
 
 // ConnectListAllNodeDevicesRet is libvirt's remote_connect_list_all_node_devices_ret
 type ConnectListAllNodeDevicesRet struct {
 	Devices []NodeDevice
-	Ret uint32
+	Ret     uint32
 }
 
 // ConnectListAllNwfiltersArgs is libvirt's remote_connect_list_all_nwfilters_args
 type ConnectListAllNwfiltersArgs struct {
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // ConnectListAllNwfiltersRet is libvirt's remote_connect_list_all_nwfilters_ret
 type ConnectListAllNwfiltersRet struct {
 	Filters []Nwfilter
-	Ret uint32
+	Ret     uint32
 }
 
 // ConnectListAllSecretsArgs is libvirt's remote_connect_list_all_secrets_args
 type ConnectListAllSecretsArgs struct {
 	NeedResults int32
-	Flags ConnectListAllSecretsFlags
+	Flags       ConnectListAllSecretsFlags
 }
 
 // ConnectListAllSecretsRet is libvirt's remote_connect_list_all_secrets_ret
 type ConnectListAllSecretsRet struct {
 	Secrets []Secret
-	Ret uint32
+	Ret     uint32
 }
 
 // NodeSetMemoryParametersArgs is libvirt's remote_node_set_memory_parameters_args
 type NodeSetMemoryParametersArgs struct {
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // NodeGetMemoryParametersArgs is libvirt's remote_node_get_memory_parameters_args
 type NodeGetMemoryParametersArgs struct {
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // NodeGetMemoryParametersRet is libvirt's remote_node_get_memory_parameters_ret
 type NodeGetMemoryParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // NodeGetCPUMapArgs is libvirt's remote_node_get_cpu_map_args
 type NodeGetCPUMapArgs struct {
-	NeedMap int32
+	NeedMap    int32
 	NeedOnline int32
-	Flags uint32
+	Flags      uint32
 }
 
 // NodeGetCPUMapRet is libvirt's remote_node_get_cpu_map_ret
 type NodeGetCPUMapRet struct {
 	Cpumap []byte
 	Online uint32
-	Ret int32
+	Ret    int32
 }
 
 // DomainFstrimArgs is libvirt's remote_domain_fstrim_args
 type DomainFstrimArgs struct {
-	Dom Domain
+	Dom        Domain
 	MountPoint OptString
-	Minimum uint64
-	Flags uint32
+	Minimum    uint64
+	Flags      uint32
 }
 
 // DomainGetTimeArgs is libvirt's remote_domain_get_time_args
 type DomainGetTimeArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainGetTimeRet is libvirt's remote_domain_get_time_ret
 type DomainGetTimeRet struct {
-	Seconds int64
+	Seconds  int64
 	Nseconds uint32
 }
 
 // DomainSetTimeArgs is libvirt's remote_domain_set_time_args
 type DomainSetTimeArgs struct {
-	Dom Domain
-	Seconds int64
+	Dom      Domain
+	Seconds  int64
 	Nseconds uint32
-	Flags DomainSetTimeFlags
+	Flags    DomainSetTimeFlags
 }
 
 // DomainMigrateBegin3ParamsArgs is libvirt's remote_domain_migrate_begin3_params_args
 type DomainMigrateBegin3ParamsArgs struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainMigrateBegin3ParamsRet is libvirt's remote_domain_migrate_begin3_params_ret
 type DomainMigrateBegin3ParamsRet struct {
 	CookieOut []byte
-	XML string
+	XML       string
 }
 
 // DomainMigratePrepare3ParamsArgs is libvirt's remote_domain_migrate_prepare3_params_args
 type DomainMigratePrepare3ParamsArgs struct {
-	Params []TypedParam
+	Params   []TypedParam
 	CookieIn []byte
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainMigratePrepare3ParamsRet is libvirt's remote_domain_migrate_prepare3_params_ret
 type DomainMigratePrepare3ParamsRet struct {
 	CookieOut []byte
-	UriOut OptString
+	UriOut    OptString
 }
 
 // DomainMigratePrepareTunnel3ParamsArgs is libvirt's remote_domain_migrate_prepare_tunnel3_params_args
 type DomainMigratePrepareTunnel3ParamsArgs struct {
-	Params []TypedParam
+	Params   []TypedParam
 	CookieIn []byte
-	Flags uint32
+	Flags    uint32
 }
 
 // DomainMigratePrepareTunnel3ParamsRet is libvirt's remote_domain_migrate_prepare_tunnel3_params_ret
@@ -3318,11 +6389,11 @@ type DomainMigratePrepareTunnel3ParamsRet struct {
 
 // DomainMigratePerform3ParamsArgs is libvirt's remote_domain_migrate_perform3_params_args
 type DomainMigratePerform3ParamsArgs struct {
-	Dom Domain
+	Dom      Domain
 	Dconnuri OptString
-	Params []TypedParam
+	Params   []TypedParam
 	CookieIn []byte
-	Flags DomainMigrateFlags
+	Flags    DomainMigrateFlags
 }
 
 // DomainMigratePerform3ParamsRet is libvirt's remote_domain_migrate_perform3_params_ret
@@ -3332,70 +6403,70 @@ type DomainMigratePerform3ParamsRet struct {
 
 // DomainMigrateFinish3ParamsArgs is libvirt's remote_domain_migrate_finish3_params_args
 type DomainMigrateFinish3ParamsArgs struct {
-	Params []TypedParam
-	CookieIn []byte
-	Flags uint32
+	Params    []TypedParam
+	CookieIn  []byte
+	Flags     uint32
 	Cancelled int32
 }
 
 // DomainMigrateFinish3ParamsRet is libvirt's remote_domain_migrate_finish3_params_ret
 type DomainMigrateFinish3ParamsRet struct {
-	Dom Domain
+	Dom       Domain
 	CookieOut []byte
 }
 
 // DomainMigrateConfirm3ParamsArgs is libvirt's remote_domain_migrate_confirm3_params_args
 type DomainMigrateConfirm3ParamsArgs struct {
-	Dom Domain
-	Params []TypedParam
-	CookieIn []byte
-	Flags uint32
+	Dom       Domain
+	Params    []TypedParam
+	CookieIn  []byte
+	Flags     uint32
 	Cancelled int32
 }
 
 // DomainEventDeviceRemovedMsg is libvirt's remote_domain_event_device_removed_msg
 type DomainEventDeviceRemovedMsg struct {
-	Dom Domain
+	Dom      Domain
 	DevAlias string
 }
 
 // DomainEventCallbackDeviceRemovedMsg is libvirt's remote_domain_event_callback_device_removed_msg
 type DomainEventCallbackDeviceRemovedMsg struct {
 	CallbackID int32
-	Msg DomainEventDeviceRemovedMsg
+	Msg        DomainEventDeviceRemovedMsg
 }
 
 // DomainEventBlockJob2Msg is libvirt's remote_domain_event_block_job_2_msg
 type DomainEventBlockJob2Msg struct {
 	CallbackID int32
-	Dom Domain
-	Dst string
-	Type int32
-	Status int32
+	Dom        Domain
+	Dst        string
+	Type       int32
+	Status     int32
 }
 
 // DomainEventBlockThresholdMsg is libvirt's remote_domain_event_block_threshold_msg
 type DomainEventBlockThresholdMsg struct {
 	CallbackID int32
-	Dom Domain
-	Dev string
-	Path OptString
-	Threshold uint64
-	Excess uint64
+	Dom        Domain
+	Dev        string
+	Path       OptString
+	Threshold  uint64
+	Excess     uint64
 }
 
 // DomainEventCallbackTunableMsg is libvirt's remote_domain_event_callback_tunable_msg
 type DomainEventCallbackTunableMsg struct {
 	CallbackID int32
-	Dom Domain
-	Params []TypedParam
+	Dom        Domain
+	Params     []TypedParam
 }
 
 // DomainEventCallbackDeviceAddedMsg is libvirt's remote_domain_event_callback_device_added_msg
 type DomainEventCallbackDeviceAddedMsg struct {
 	CallbackID int32
-	Dom Domain
-	DevAlias string
+	Dom        Domain
+	DevAlias   string
 }
 
 // ConnectEventConnectionClosedMsg is libvirt's remote_connect_event_connection_closed_msg
@@ -3405,21 +6476,21 @@ type ConnectEventConnectionClosedMsg struct {
 
 // ConnectGetCPUModelNamesArgs is libvirt's remote_connect_get_cpu_model_names_args
 type ConnectGetCPUModelNamesArgs struct {
-	Arch string
+	Arch        string
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // ConnectGetCPUModelNamesRet is libvirt's remote_connect_get_cpu_model_names_ret
 type ConnectGetCPUModelNamesRet struct {
 	Models []string
-	Ret int32
+	Ret    int32
 }
 
 // ConnectNetworkEventRegisterAnyArgs is libvirt's remote_connect_network_event_register_any_args
 type ConnectNetworkEventRegisterAnyArgs struct {
 	EventID int32
-	Net OptNetwork
+	Net     OptNetwork
 }
 
 // ConnectNetworkEventRegisterAnyRet is libvirt's remote_connect_network_event_register_any_ret
@@ -3435,15 +6506,15 @@ type ConnectNetworkEventDeregisterAnyArgs struct {
 // NetworkEventLifecycleMsg is libvirt's remote_network_event_lifecycle_msg
 type NetworkEventLifecycleMsg struct {
 	CallbackID int32
-	Net Network
-	Event int32
-	Detail int32
+	Net        Network
+	Event      int32
+	Detail     int32
 }
 
 // ConnectStoragePoolEventRegisterAnyArgs is libvirt's remote_connect_storage_pool_event_register_any_args
 type ConnectStoragePoolEventRegisterAnyArgs struct {
 	EventID int32
-	Pool OptStoragePool
+	Pool    OptStoragePool
 }
 
 // ConnectStoragePoolEventRegisterAnyRet is libvirt's remote_connect_storage_pool_event_register_any_ret
@@ -3459,21 +6530,21 @@ type ConnectStoragePoolEventDeregisterAnyArgs struct {
 // StoragePoolEventLifecycleMsg is libvirt's remote_storage_pool_event_lifecycle_msg
 type StoragePoolEventLifecycleMsg struct {
 	CallbackID int32
-	Pool StoragePool
-	Event int32
-	Detail int32
+	Pool       StoragePool
+	Event      int32
+	Detail     int32
 }
 
 // StoragePoolEventRefreshMsg is libvirt's remote_storage_pool_event_refresh_msg
 type StoragePoolEventRefreshMsg struct {
 	CallbackID int32
-	Pool StoragePool
+	Pool       StoragePool
 }
 
 // ConnectNodeDeviceEventRegisterAnyArgs is libvirt's remote_connect_node_device_event_register_any_args
 type ConnectNodeDeviceEventRegisterAnyArgs struct {
 	EventID int32
-	Dev OptNodeDevice
+	Dev     OptNodeDevice
 }
 
 // ConnectNodeDeviceEventRegisterAnyRet is libvirt's remote_connect_node_device_event_register_any_ret
@@ -3489,22 +6560,22 @@ type ConnectNodeDeviceEventDeregisterAnyArgs struct {
 // NodeDeviceEventLifecycleMsg is libvirt's remote_node_device_event_lifecycle_msg
 type NodeDeviceEventLifecycleMsg struct {
 	CallbackID int32
-	Dev NodeDevice
-	Event int32
-	Detail int32
+	Dev        NodeDevice
+	Event      int32
+	Detail     int32
 }
 
 // NodeDeviceEventUpdateMsg is libvirt's remote_node_device_event_update_msg
 type NodeDeviceEventUpdateMsg struct {
 	CallbackID int32
-	Dev NodeDevice
+	Dev        NodeDevice
 }
 
 // DomainFsfreezeArgs is libvirt's remote_domain_fsfreeze_args
 type DomainFsfreezeArgs struct {
-	Dom Domain
+	Dom         Domain
 	Mountpoints []string
-	Flags uint32
+	Flags       uint32
 }
 
 // DomainFsfreezeRet is libvirt's remote_domain_fsfreeze_ret
@@ -3514,9 +6585,9 @@ type DomainFsfreezeRet struct {
 
 // DomainFsthawArgs is libvirt's remote_domain_fsthaw_args
 type DomainFsthawArgs struct {
-	Dom Domain
+	Dom         Domain
 	Mountpoints []string
-	Flags uint32
+	Flags       uint32
 }
 
 // DomainFsthawRet is libvirt's remote_domain_fsthaw_ret
@@ -3526,10 +6597,10 @@ type DomainFsthawRet struct {
 
 // NodeGetFreePagesArgs is libvirt's remote_node_get_free_pages_args
 type NodeGetFreePagesArgs struct {
-	Pages []uint32
+	Pages     []uint32
 	StartCell int32
 	CellCount uint32
-	Flags uint32
+	Flags     uint32
 }
 
 // NodeGetFreePagesRet is libvirt's remote_node_get_free_pages_ret
@@ -3539,11 +6610,11 @@ type NodeGetFreePagesRet struct {
 
 // NodeAllocPagesArgs is libvirt's remote_node_alloc_pages_args
 type NodeAllocPagesArgs struct {
-	PageSizes []uint32
+	PageSizes  []uint32
 	PageCounts []uint64
-	StartCell int32
-	CellCount uint32
-	Flags NodeAllocPagesFlags
+	StartCell  int32
+	CellCount  uint32
+	Flags      NodeAllocPagesFlags
 }
 
 // NodeAllocPagesRet is libvirt's remote_node_alloc_pages_ret
@@ -3553,40 +6624,40 @@ type NodeAllocPagesRet struct {
 
 // NetworkDhcpLease is libvirt's remote_network_dhcp_lease
 type NetworkDhcpLease struct {
-	Iface string
+	Iface      string
 	Expirytime int64
-	Type int32
-	Mac OptString
-	Iaid OptString
-	Ipaddr string
-	Prefix uint32
-	Hostname OptString
-	Clientid OptString
+	Type       int32
+	Mac        OptString
+	Iaid       OptString
+	Ipaddr     string
+	Prefix     uint32
+	Hostname   OptString
+	Clientid   OptString
 }
 
 // NetworkGetDhcpLeasesArgs is libvirt's remote_network_get_dhcp_leases_args
 type NetworkGetDhcpLeasesArgs struct {
-	Net Network
-	Mac OptString
+	Net         Network
+	Mac         OptString
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // NetworkGetDhcpLeasesRet is libvirt's remote_network_get_dhcp_leases_ret
 type NetworkGetDhcpLeasesRet struct {
 	Leases []NetworkDhcpLease
-	Ret uint32
+	Ret    uint32
 }
 
 // DomainStatsRecord is libvirt's remote_domain_stats_record
 type DomainStatsRecord struct {
-	Dom Domain
+	Dom    Domain
 	Params []TypedParam
 }
 
 // ConnectGetAllDomainStatsArgs is libvirt's remote_connect_get_all_domain_stats_args
 type ConnectGetAllDomainStatsArgs struct {
-	Doms []Domain
+	Doms  []Domain
 	Stats uint32
 	Flags ConnectGetAllDomainStatsFlags
 }
@@ -3594,9 +6665,9 @@ type ConnectGetAllDomainStatsArgs struct {
 // DomainEventCallbackAgentLifecycleMsg is libvirt's remote_domain_event_callback_agent_lifecycle_msg
 type DomainEventCallbackAgentLifecycleMsg struct {
 	CallbackID int32
-	Dom Domain
-	State int32
-	Reason int32
+	Dom        Domain
+	State      int32
+	Reason     int32
 }
 
 // ConnectGetAllDomainStatsRet is libvirt's remote_connect_get_all_domain_stats_ret
@@ -3607,42 +6678,42 @@ type ConnectGetAllDomainStatsRet struct {
 // DomainFsinfo is libvirt's remote_domain_fsinfo
 type DomainFsinfo struct {
 	Mountpoint string
-	Name string
-	Fstype string
+	Name       string
+	Fstype     string
 	DevAliases []string
 }
 
 // DomainGetFsinfoArgs is libvirt's remote_domain_get_fsinfo_args
 type DomainGetFsinfoArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainGetFsinfoRet is libvirt's remote_domain_get_fsinfo_ret
 type DomainGetFsinfoRet struct {
 	Info []DomainFsinfo
-	Ret uint32
+	Ret  uint32
 }
 
 // DomainIPAddr is libvirt's remote_domain_ip_addr
 type DomainIPAddr struct {
-	Type int32
-	Addr string
+	Type   int32
+	Addr   string
 	Prefix uint32
 }
 
 // DomainInterface is libvirt's remote_domain_interface
 type DomainInterface struct {
-	Name string
+	Name   string
 	Hwaddr OptString
-	Addrs []DomainIPAddr
+	Addrs  []DomainIPAddr
 }
 
 // DomainInterfaceAddressesArgs is libvirt's remote_domain_interface_addresses_args
 type DomainInterfaceAddressesArgs struct {
-	Dom Domain
+	Dom    Domain
 	Source uint32
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainInterfaceAddressesRet is libvirt's remote_domain_interface_addresses_ret
@@ -3652,17 +6723,17 @@ type DomainInterfaceAddressesRet struct {
 
 // DomainSetUserPasswordArgs is libvirt's remote_domain_set_user_password_args
 type DomainSetUserPasswordArgs struct {
-	Dom Domain
-	User OptString
+	Dom      Domain
+	User     OptString
 	Password OptString
-	Flags DomainSetUserPasswordFlags
+	Flags    DomainSetUserPasswordFlags
 }
 
 // DomainRenameArgs is libvirt's remote_domain_rename_args
 type DomainRenameArgs struct {
-	Dom Domain
+	Dom     Domain
 	NewName OptString
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainRenameRet is libvirt's remote_domain_rename_ret
@@ -3673,33 +6744,33 @@ type DomainRenameRet struct {
 // DomainEventCallbackMigrationIterationMsg is libvirt's remote_domain_event_callback_migration_iteration_msg
 type DomainEventCallbackMigrationIterationMsg struct {
 	CallbackID int32
-	Dom Domain
-	Iteration int32
+	Dom        Domain
+	Iteration  int32
 }
 
 // DomainEventCallbackJobCompletedMsg is libvirt's remote_domain_event_callback_job_completed_msg
 type DomainEventCallbackJobCompletedMsg struct {
 	CallbackID int32
-	Dom Domain
-	Params []TypedParam
+	Dom        Domain
+	Params     []TypedParam
 }
 
 // DomainMigrateStartPostCopyArgs is libvirt's remote_domain_migrate_start_post_copy_args
 type DomainMigrateStartPostCopyArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
 // DomainEventCallbackDeviceRemovalFailedMsg is libvirt's remote_domain_event_callback_device_removal_failed_msg
 type DomainEventCallbackDeviceRemovalFailedMsg struct {
 	CallbackID int32
-	Dom Domain
-	DevAlias string
+	Dom        Domain
+	DevAlias   string
 }
 
 // DomainGetGuestVcpusArgs is libvirt's remote_domain_get_guest_vcpus_args
 type DomainGetGuestVcpusArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -3710,40 +6781,40 @@ type DomainGetGuestVcpusRet struct {
 
 // DomainSetGuestVcpusArgs is libvirt's remote_domain_set_guest_vcpus_args
 type DomainSetGuestVcpusArgs struct {
-	Dom Domain
+	Dom    Domain
 	Cpumap string
-	State int32
-	Flags uint32
+	State  int32
+	Flags  uint32
 }
 
 // DomainSetVcpuArgs is libvirt's remote_domain_set_vcpu_args
 type DomainSetVcpuArgs struct {
-	Dom Domain
+	Dom    Domain
 	Cpumap string
-	State int32
-	Flags DomainModificationImpact
+	State  int32
+	Flags  DomainModificationImpact
 }
 
 // DomainEventCallbackMetadataChangeMsg is libvirt's remote_domain_event_callback_metadata_change_msg
 type DomainEventCallbackMetadataChangeMsg struct {
 	CallbackID int32
-	Dom Domain
-	Type int32
-	Nsuri OptString
+	Dom        Domain
+	Type       int32
+	Nsuri      OptString
 }
 
 // DomainEventMemoryFailureMsg is libvirt's remote_domain_event_memory_failure_msg
 type DomainEventMemoryFailureMsg struct {
 	CallbackID int32
-	Dom Domain
-	Recipient int32
-	Action int32
-	Flags uint32
+	Dom        Domain
+	Recipient  int32
+	Action     int32
+	Flags      uint32
 }
 
 // ConnectSecretEventRegisterAnyArgs is libvirt's remote_connect_secret_event_register_any_args
 type ConnectSecretEventRegisterAnyArgs struct {
-	EventID int32
+	EventID   int32
 	OptSecret OptSecret
 }
 
@@ -3760,41 +6831,41 @@ type ConnectSecretEventDeregisterAnyArgs struct {
 // SecretEventLifecycleMsg is libvirt's remote_secret_event_lifecycle_msg
 type SecretEventLifecycleMsg struct {
 	CallbackID int32
-	OptSecret Secret
-	Event int32
-	Detail int32
+	OptSecret  Secret
+	Event      int32
+	Detail     int32
 }
 
 // SecretEventValueChangedMsg is libvirt's remote_secret_event_value_changed_msg
 type SecretEventValueChangedMsg struct {
 	CallbackID int32
-	OptSecret Secret
+	OptSecret  Secret
 }
 
 // DomainSetBlockThresholdArgs is libvirt's remote_domain_set_block_threshold_args
 type DomainSetBlockThresholdArgs struct {
-	Dom Domain
-	Dev string
+	Dom       Domain
+	Dev       string
 	Threshold uint64
-	Flags uint32
+	Flags     uint32
 }
 
 // DomainSetLifecycleActionArgs is libvirt's remote_domain_set_lifecycle_action_args
 type DomainSetLifecycleActionArgs struct {
-	Dom Domain
-	Type uint32
+	Dom    Domain
+	Type   uint32
 	Action uint32
-	Flags DomainModificationImpact
+	Flags  DomainModificationImpact
 }
 
 // ConnectCompareHypervisorCPUArgs is libvirt's remote_connect_compare_hypervisor_cpu_args
 type ConnectCompareHypervisorCPUArgs struct {
 	Emulator OptString
-	Arch OptString
-	Machine OptString
+	Arch     OptString
+	Machine  OptString
 	Virttype OptString
-	XMLCPU string
-	Flags uint32
+	XMLCPU   string
+	Flags    uint32
 }
 
 // ConnectCompareHypervisorCPURet is libvirt's remote_connect_compare_hypervisor_cpu_ret
@@ -3805,11 +6876,11 @@ type ConnectCompareHypervisorCPURet struct {
 // ConnectBaselineHypervisorCPUArgs is libvirt's remote_connect_baseline_hypervisor_cpu_args
 type ConnectBaselineHypervisorCPUArgs struct {
 	Emulator OptString
-	Arch OptString
-	Machine OptString
+	Arch     OptString
+	Machine  OptString
 	Virttype OptString
-	XMLCPUs []string
-	Flags uint32
+	XMLCPUs  []string
+	Flags    uint32
 }
 
 // ConnectBaselineHypervisorCPURet is libvirt's remote_connect_baseline_hypervisor_cpu_ret
@@ -3820,18 +6891,18 @@ type ConnectBaselineHypervisorCPURet struct {
 // NodeGetSevInfoArgs is libvirt's remote_node_get_sev_info_args
 type NodeGetSevInfoArgs struct {
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // NodeGetSevInfoRet is libvirt's remote_node_get_sev_info_ret
 type NodeGetSevInfoRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // DomainGetLaunchSecurityInfoArgs is libvirt's remote_domain_get_launch_security_info_args
 type DomainGetLaunchSecurityInfoArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -3852,7 +6923,7 @@ type NwfilterBindingLookupByPortDevRet struct {
 
 // NwfilterBindingCreateXMLArgs is libvirt's remote_nwfilter_binding_create_xml_args
 type NwfilterBindingCreateXMLArgs struct {
-	XML string
+	XML   string
 	Flags uint32
 }
 
@@ -3869,7 +6940,7 @@ type NwfilterBindingDeleteArgs struct {
 // NwfilterBindingGetXMLDescArgs is libvirt's remote_nwfilter_binding_get_xml_desc_args
 type NwfilterBindingGetXMLDescArgs struct {
 	OptNwfilter NwfilterBinding
-	Flags uint32
+	Flags       uint32
 }
 
 // NwfilterBindingGetXMLDescRet is libvirt's remote_nwfilter_binding_get_xml_desc_ret
@@ -3880,13 +6951,13 @@ type NwfilterBindingGetXMLDescRet struct {
 // ConnectListAllNwfilterBindingsArgs is libvirt's remote_connect_list_all_nwfilter_bindings_args
 type ConnectListAllNwfilterBindingsArgs struct {
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // ConnectListAllNwfilterBindingsRet is libvirt's remote_connect_list_all_nwfilter_bindings_ret
 type ConnectListAllNwfilterBindingsRet struct {
 	Bindings []NwfilterBinding
-	Ret uint32
+	Ret      uint32
 }
 
 // ConnectGetStoragePoolCapabilitiesArgs is libvirt's remote_connect_get_storage_pool_capabilities_args
@@ -3901,21 +6972,21 @@ type ConnectGetStoragePoolCapabilitiesRet struct {
 
 // NetworkListAllPortsArgs is libvirt's remote_network_list_all_ports_args
 type NetworkListAllPortsArgs struct {
-	OptNetwork Network
+	OptNetwork  Network
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // NetworkListAllPortsRet is libvirt's remote_network_list_all_ports_ret
 type NetworkListAllPortsRet struct {
 	Ports []NetworkPort
-	Ret uint32
+	Ret   uint32
 }
 
 // NetworkPortLookupByUUIDArgs is libvirt's remote_network_port_lookup_by_uuid_args
 type NetworkPortLookupByUUIDArgs struct {
 	OptNetwork Network
-	UUID UUID
+	UUID       UUID
 }
 
 // NetworkPortLookupByUUIDRet is libvirt's remote_network_port_lookup_by_uuid_ret
@@ -3926,8 +6997,8 @@ type NetworkPortLookupByUUIDRet struct {
 // NetworkPortCreateXMLArgs is libvirt's remote_network_port_create_xml_args
 type NetworkPortCreateXMLArgs struct {
 	OptNetwork Network
-	XML string
-	Flags uint32
+	XML        string
+	Flags      uint32
 }
 
 // NetworkPortCreateXMLRet is libvirt's remote_network_port_create_xml_ret
@@ -3937,27 +7008,27 @@ type NetworkPortCreateXMLRet struct {
 
 // NetworkPortSetParametersArgs is libvirt's remote_network_port_set_parameters_args
 type NetworkPortSetParametersArgs struct {
-	Port NetworkPort
+	Port   NetworkPort
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // NetworkPortGetParametersArgs is libvirt's remote_network_port_get_parameters_args
 type NetworkPortGetParametersArgs struct {
-	Port NetworkPort
+	Port    NetworkPort
 	Nparams int32
-	Flags uint32
+	Flags   uint32
 }
 
 // NetworkPortGetParametersRet is libvirt's remote_network_port_get_parameters_ret
 type NetworkPortGetParametersRet struct {
-	Params []TypedParam
+	Params  []TypedParam
 	Nparams int32
 }
 
 // NetworkPortGetXMLDescArgs is libvirt's remote_network_port_get_xml_desc_args
 type NetworkPortGetXMLDescArgs struct {
-	Port NetworkPort
+	Port  NetworkPort
 	Flags uint32
 }
 
@@ -3968,15 +7039,15 @@ type NetworkPortGetXMLDescRet struct {
 
 // NetworkPortDeleteArgs is libvirt's remote_network_port_delete_args
 type NetworkPortDeleteArgs struct {
-	Port NetworkPort
+	Port  NetworkPort
 	Flags uint32
 }
 
 // DomainCheckpointCreateXMLArgs is libvirt's remote_domain_checkpoint_create_xml_args
 type DomainCheckpointCreateXMLArgs struct {
-	Dom Domain
+	Dom     Domain
 	XMLDesc string
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainCheckpointCreateXMLRet is libvirt's remote_domain_checkpoint_create_xml_ret
@@ -3987,7 +7058,7 @@ type DomainCheckpointCreateXMLRet struct {
 // DomainCheckpointGetXMLDescArgs is libvirt's remote_domain_checkpoint_get_xml_desc_args
 type DomainCheckpointGetXMLDescArgs struct {
 	Checkpoint DomainCheckpoint
-	Flags uint32
+	Flags      uint32
 }
 
 // DomainCheckpointGetXMLDescRet is libvirt's remote_domain_checkpoint_get_xml_desc_ret
@@ -3997,34 +7068,34 @@ type DomainCheckpointGetXMLDescRet struct {
 
 // DomainListAllCheckpointsArgs is libvirt's remote_domain_list_all_checkpoints_args
 type DomainListAllCheckpointsArgs struct {
-	Dom Domain
+	Dom         Domain
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // DomainListAllCheckpointsRet is libvirt's remote_domain_list_all_checkpoints_ret
 type DomainListAllCheckpointsRet struct {
 	Checkpoints []DomainCheckpoint
-	Ret int32
+	Ret         int32
 }
 
 // DomainCheckpointListAllChildrenArgs is libvirt's remote_domain_checkpoint_list_all_children_args
 type DomainCheckpointListAllChildrenArgs struct {
-	Checkpoint DomainCheckpoint
+	Checkpoint  DomainCheckpoint
 	NeedResults int32
-	Flags uint32
+	Flags       uint32
 }
 
 // DomainCheckpointListAllChildrenRet is libvirt's remote_domain_checkpoint_list_all_children_ret
 type DomainCheckpointListAllChildrenRet struct {
 	Checkpoints []DomainCheckpoint
-	Ret int32
+	Ret         int32
 }
 
 // DomainCheckpointLookupByNameArgs is libvirt's remote_domain_checkpoint_lookup_by_name_args
 type DomainCheckpointLookupByNameArgs struct {
-	Dom Domain
-	Name string
+	Dom   Domain
+	Name  string
 	Flags uint32
 }
 
@@ -4036,7 +7107,7 @@ type DomainCheckpointLookupByNameRet struct {
 // DomainCheckpointGetParentArgs is libvirt's remote_domain_checkpoint_get_parent_args
 type DomainCheckpointGetParentArgs struct {
 	Checkpoint DomainCheckpoint
-	Flags uint32
+	Flags      uint32
 }
 
 // DomainCheckpointGetParentRet is libvirt's remote_domain_checkpoint_get_parent_ret
@@ -4047,13 +7118,13 @@ type DomainCheckpointGetParentRet struct {
 // DomainCheckpointDeleteArgs is libvirt's remote_domain_checkpoint_delete_args
 type DomainCheckpointDeleteArgs struct {
 	Checkpoint DomainCheckpoint
-	Flags DomainCheckpointDeleteFlags
+	Flags      DomainCheckpointDeleteFlags
 }
 
 // DomainGetGuestInfoArgs is libvirt's remote_domain_get_guest_info_args
 type DomainGetGuestInfoArgs struct {
-	Dom Domain
-	Types uint32
+	Dom   Domain
+	Types DomainGuestInfoTypes
 	Flags uint32
 }
 
@@ -4065,14 +7136,14 @@ type DomainGetGuestInfoRet struct {
 // ConnectSetIdentityArgs is libvirt's remote_connect_set_identity_args
 type ConnectSetIdentityArgs struct {
 	Params []TypedParam
-	Flags uint32
+	Flags  uint32
 }
 
 // DomainAgentSetResponseTimeoutArgs is libvirt's remote_domain_agent_set_response_timeout_args
 type DomainAgentSetResponseTimeoutArgs struct {
-	Dom Domain
+	Dom     Domain
 	Timeout int32
-	Flags uint32
+	Flags   uint32
 }
 
 // DomainAgentSetResponseTimeoutRet is libvirt's remote_domain_agent_set_response_timeout_ret
@@ -4082,15 +7153,15 @@ type DomainAgentSetResponseTimeoutRet struct {
 
 // DomainBackupBeginArgs is libvirt's remote_domain_backup_begin_args
 type DomainBackupBeginArgs struct {
-	Dom Domain
-	BackupXML string
+	Dom           Domain
+	BackupXML     string
 	CheckpointXML OptString
-	Flags DomainBackupBeginFlags
+	Flags         DomainBackupBeginFlags
 }
 
 // DomainBackupGetXMLDescArgs is libvirt's remote_domain_backup_get_xml_desc_args
 type DomainBackupGetXMLDescArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -4101,8 +7172,8 @@ type DomainBackupGetXMLDescRet struct {
 
 // DomainAuthorizedSshKeysGetArgs is libvirt's remote_domain_authorized_ssh_keys_get_args
 type DomainAuthorizedSshKeysGetArgs struct {
-	Dom Domain
-	User string
+	Dom   Domain
+	User  string
 	Flags uint32
 }
 
@@ -4113,15 +7184,15 @@ type DomainAuthorizedSshKeysGetRet struct {
 
 // DomainAuthorizedSshKeysSetArgs is libvirt's remote_domain_authorized_ssh_keys_set_args
 type DomainAuthorizedSshKeysSetArgs struct {
-	Dom Domain
-	User string
-	Keys []string
+	Dom   Domain
+	User  string
+	Keys  []string
 	Flags uint32
 }
 
 // DomainGetMessagesArgs is libvirt's remote_domain_get_messages_args
 type DomainGetMessagesArgs struct {
-	Dom Domain
+	Dom   Domain
 	Flags uint32
 }
 
@@ -4130,7 +7201,6 @@ type DomainGetMessagesRet struct {
 	Msgs []string
 }
 
-
 // TypedParamValue is a discriminated union.
 type TypedParamValue struct {
 	D uint32
@@ -4179,22 +7249,123 @@ func NewTypedParamValueString(v string) *TypedParamValue {
 	return &TypedParamValue{D: 7, I: v}
 }
 
+// MarshalXDR encodes the union's discriminant, followed by the value of the
+// arm it selects.
+func (u *TypedParamValue) MarshalXDR(e *xdr.Encoder) (int, error) {
+	n, err := e.EncodeUint(u.D)
+	if err != nil {
+		return n, err
+	}
+	var n2 int
+	switch u.D {
+	case 1:
+		v, ok := u.I.(int32)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not int32", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 2:
+		v, ok := u.I.(uint32)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not uint32", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 3:
+		v, ok := u.I.(int64)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not int64", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 4:
+		v, ok := u.I.(uint64)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not uint64", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 5:
+		v, ok := u.I.(float64)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not float64", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 6:
+		v, ok := u.I.(int32)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not int32", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	case 7:
+		v, ok := u.I.(string)
+		if !ok {
+			return n, fmt.Errorf("TypedParamValue arm %d holds %T, not string", u.D, u.I)
+		}
+		n2, err = e.Encode(&v)
+	default:
+		return n, fmt.Errorf("invalid TypedParamValue discriminant %d", u.D)
+	}
+	return n + n2, err
+}
+
+// UnmarshalXDR decodes the union's discriminant, followed by the value of the
+// arm it selects.
+func (u *TypedParamValue) UnmarshalXDR(d *xdr.Decoder) (int, error) {
+	disc, n, err := d.DecodeUint()
+	if err != nil {
+		return n, err
+	}
+	u.D = disc
+	var n2 int
+	switch u.D {
+	case 1:
+		var v int32
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 2:
+		var v uint32
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 3:
+		var v int64
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 4:
+		var v uint64
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 5:
+		var v float64
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 6:
+		var v int32
+		n2, err = d.Decode(&v)
+		u.I = v
+	case 7:
+		var v string
+		n2, err = d.Decode(&v)
+		u.I = v
+	default:
+		return n, fmt.Errorf("invalid TypedParamValue discriminant %d", u.D)
+	}
+	return n + n2, err
+}
 
 // ConnectOpen is the go wrapper for REMOTE_PROC_CONNECT_OPEN.
 func (l *Libvirt) ConnectOpen(Name OptString, Flags ConnectFlags) (err error) {
 	var buf []byte
 
-	args := ConnectOpenArgs {
-		Name: Name,
+	args := ConnectOpenArgs{
+		Name:  Name,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(1, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4207,7 +7378,6 @@ func (l *Libvirt) ConnectOpen(Name OptString, Flags ConnectFlags) (err error) {
 func (l *Libvirt) ConnectClose() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(2, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4227,10 +7397,8 @@ func (l *Libvirt) ConnectGetType() (rType string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: string
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -4251,10 +7419,8 @@ func (l *Libvirt) ConnectGetVersion() (rHvVer uint64, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// HvVer: uint64
 	_, err = dec.Decode(&rHvVer)
 	if err != nil {
@@ -4268,11 +7434,13 @@ func (l *Libvirt) ConnectGetVersion() (rHvVer uint64, err error) {
 func (l *Libvirt) ConnectGetMaxVcpus(Type OptString) (rMaxVcpus int32, err error) {
 	var buf []byte
 
-	args := ConnectGetMaxVcpusArgs {
+	args := ConnectGetMaxVcpusArgs{
 		Type: Type,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4284,10 +7452,8 @@ func (l *Libvirt) ConnectGetMaxVcpus(Type OptString) (rMaxVcpus int32, err error
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// MaxVcpus: int32
 	_, err = dec.Decode(&rMaxVcpus)
 	if err != nil {
@@ -4308,10 +7474,8 @@ func (l *Libvirt) NodeGetInfo() (rModel [32]int8, rMemory uint64, rCpus int32, r
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Model: [32]int8
 	_, err = dec.Decode(&rModel)
 	if err != nil {
@@ -4367,10 +7531,8 @@ func (l *Libvirt) ConnectGetCapabilities() (rCapabilities string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Capabilities: string
 	_, err = dec.Decode(&rCapabilities)
 	if err != nil {
@@ -4384,17 +7546,18 @@ func (l *Libvirt) ConnectGetCapabilities() (rCapabilities string, err error) {
 func (l *Libvirt) DomainAttachDevice(Dom Domain, XML string) (err error) {
 	var buf []byte
 
-	args := DomainAttachDeviceArgs {
+	args := DomainAttachDeviceArgs{
 		Dom: Dom,
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(8, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4407,16 +7570,17 @@ func (l *Libvirt) DomainAttachDevice(Dom Domain, XML string) (err error) {
 func (l *Libvirt) DomainCreate(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainCreateArgs {
+	args := DomainCreateArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(9, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4429,12 +7593,14 @@ func (l *Libvirt) DomainCreate(Dom Domain) (err error) {
 func (l *Libvirt) DomainCreateXML(XMLDesc string, Flags DomainCreateFlags) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainCreateXMLArgs {
+	args := DomainCreateXMLArgs{
 		XMLDesc: XMLDesc,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4446,10 +7612,8 @@ func (l *Libvirt) DomainCreateXML(XMLDesc string, Flags DomainCreateFlags) (rDom
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -4463,11 +7627,13 @@ func (l *Libvirt) DomainCreateXML(XMLDesc string, Flags DomainCreateFlags) (rDom
 func (l *Libvirt) DomainDefineXML(XML string) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainDefineXMLArgs {
+	args := DomainDefineXMLArgs{
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4479,10 +7645,8 @@ func (l *Libvirt) DomainDefineXML(XML string) (rDom Domain, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -4496,16 +7660,17 @@ func (l *Libvirt) DomainDefineXML(XML string) (rDom Domain, err error) {
 func (l *Libvirt) DomainDestroy(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainDestroyArgs {
+	args := DomainDestroyArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(12, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4518,17 +7683,18 @@ func (l *Libvirt) DomainDestroy(Dom Domain) (err error) {
 func (l *Libvirt) DomainDetachDevice(Dom Domain, XML string) (err error) {
 	var buf []byte
 
-	args := DomainDetachDeviceArgs {
+	args := DomainDetachDeviceArgs{
 		Dom: Dom,
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(13, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4541,12 +7707,14 @@ func (l *Libvirt) DomainDetachDevice(Dom Domain, XML string) (err error) {
 func (l *Libvirt) DomainGetXMLDesc(Dom Domain, Flags DomainXMLFlags) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainGetXMLDescArgs {
-		Dom: Dom,
+	args := DomainGetXMLDescArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4558,10 +7726,8 @@ func (l *Libvirt) DomainGetXMLDesc(Dom Domain, Flags DomainXMLFlags) (rXML strin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -4575,11 +7741,13 @@ func (l *Libvirt) DomainGetXMLDesc(Dom Domain, Flags DomainXMLFlags) (rXML strin
 func (l *Libvirt) DomainGetAutostart(Dom Domain) (rAutostart int32, err error) {
 	var buf []byte
 
-	args := DomainGetAutostartArgs {
+	args := DomainGetAutostartArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4591,10 +7759,8 @@ func (l *Libvirt) DomainGetAutostart(Dom Domain) (rAutostart int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Autostart: int32
 	_, err = dec.Decode(&rAutostart)
 	if err != nil {
@@ -4608,11 +7774,13 @@ func (l *Libvirt) DomainGetAutostart(Dom Domain) (rAutostart int32, err error) {
 func (l *Libvirt) DomainGetInfo(Dom Domain) (rState uint8, rMaxMem uint64, rMemory uint64, rNrVirtCPU uint16, rCPUTime uint64, err error) {
 	var buf []byte
 
-	args := DomainGetInfoArgs {
+	args := DomainGetInfoArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4624,10 +7792,8 @@ func (l *Libvirt) DomainGetInfo(Dom Domain) (rState uint8, rMaxMem uint64, rMemo
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// State: uint8
 	_, err = dec.Decode(&rState)
 	if err != nil {
@@ -4661,11 +7827,13 @@ func (l *Libvirt) DomainGetInfo(Dom Domain) (rState uint8, rMaxMem uint64, rMemo
 func (l *Libvirt) DomainGetMaxMemory(Dom Domain) (rMemory uint64, err error) {
 	var buf []byte
 
-	args := DomainGetMaxMemoryArgs {
+	args := DomainGetMaxMemoryArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4677,10 +7845,8 @@ func (l *Libvirt) DomainGetMaxMemory(Dom Domain) (rMemory uint64, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Memory: uint64
 	_, err = dec.Decode(&rMemory)
 	if err != nil {
@@ -4694,11 +7860,13 @@ func (l *Libvirt) DomainGetMaxMemory(Dom Domain) (rMemory uint64, err error) {
 func (l *Libvirt) DomainGetMaxVcpus(Dom Domain) (rNum int32, err error) {
 	var buf []byte
 
-	args := DomainGetMaxVcpusArgs {
+	args := DomainGetMaxVcpusArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4710,10 +7878,8 @@ func (l *Libvirt) DomainGetMaxVcpus(Dom Domain) (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -4727,11 +7893,13 @@ func (l *Libvirt) DomainGetMaxVcpus(Dom Domain) (rNum int32, err error) {
 func (l *Libvirt) DomainGetOsType(Dom Domain) (rType string, err error) {
 	var buf []byte
 
-	args := DomainGetOsTypeArgs {
+	args := DomainGetOsTypeArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4743,10 +7911,8 @@ func (l *Libvirt) DomainGetOsType(Dom Domain) (rType string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: string
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -4760,13 +7926,15 @@ func (l *Libvirt) DomainGetOsType(Dom Domain) (rType string, err error) {
 func (l *Libvirt) DomainGetVcpus(Dom Domain, Maxinfo int32, Maplen int32) (rInfo []VcpuInfo, rCpumaps []byte, err error) {
 	var buf []byte
 
-	args := DomainGetVcpusArgs {
-		Dom: Dom,
+	args := DomainGetVcpusArgs{
+		Dom:     Dom,
 		Maxinfo: Maxinfo,
-		Maplen: Maplen,
+		Maplen:  Maplen,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4778,10 +7946,8 @@ func (l *Libvirt) DomainGetVcpus(Dom Domain, Maxinfo int32, Maplen int32) (rInfo
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Info: []VcpuInfo
 	_, err = dec.Decode(&rInfo)
 	if err != nil {
@@ -4800,11 +7966,13 @@ func (l *Libvirt) DomainGetVcpus(Dom Domain, Maxinfo int32, Maplen int32) (rInfo
 func (l *Libvirt) ConnectListDefinedDomains(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListDefinedDomainsArgs {
+	args := ConnectListDefinedDomainsArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4816,10 +7984,8 @@ func (l *Libvirt) ConnectListDefinedDomains(Maxnames int32) (rNames []string, er
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -4833,11 +7999,13 @@ func (l *Libvirt) ConnectListDefinedDomains(Maxnames int32) (rNames []string, er
 func (l *Libvirt) DomainLookupByID(ID int32) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainLookupByIDArgs {
+	args := DomainLookupByIDArgs{
 		ID: ID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4849,10 +8017,8 @@ func (l *Libvirt) DomainLookupByID(ID int32) (rDom Domain, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -4866,11 +8032,13 @@ func (l *Libvirt) DomainLookupByID(ID int32) (rDom Domain, err error) {
 func (l *Libvirt) DomainLookupByName(Name string) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainLookupByNameArgs {
+	args := DomainLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4882,10 +8050,8 @@ func (l *Libvirt) DomainLookupByName(Name string) (rDom Domain, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -4899,11 +8065,13 @@ func (l *Libvirt) DomainLookupByName(Name string) (rDom Domain, err error) {
 func (l *Libvirt) DomainLookupByUUID(UUID UUID) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainLookupByUUIDArgs {
+	args := DomainLookupByUUIDArgs{
 		UUID: UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -4915,10 +8083,8 @@ func (l *Libvirt) DomainLookupByUUID(UUID UUID) (rDom Domain, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -4939,10 +8105,8 @@ func (l *Libvirt) ConnectNumOfDefinedDomains() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -4956,18 +8120,19 @@ func (l *Libvirt) ConnectNumOfDefinedDomains() (rNum int32, err error) {
 func (l *Libvirt) DomainPinVcpu(Dom Domain, Vcpu uint32, Cpumap []byte) (err error) {
 	var buf []byte
 
-	args := DomainPinVcpuArgs {
-		Dom: Dom,
-		Vcpu: Vcpu,
+	args := DomainPinVcpuArgs{
+		Dom:    Dom,
+		Vcpu:   Vcpu,
 		Cpumap: Cpumap,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(26, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -4980,17 +8145,18 @@ func (l *Libvirt) DomainPinVcpu(Dom Domain, Vcpu uint32, Cpumap []byte) (err err
 func (l *Libvirt) DomainReboot(Dom Domain, Flags DomainRebootFlagValues) (err error) {
 	var buf []byte
 
-	args := DomainRebootArgs {
-		Dom: Dom,
+	args := DomainRebootArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(27, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5003,16 +8169,17 @@ func (l *Libvirt) DomainReboot(Dom Domain, Flags DomainRebootFlagValues) (err er
 func (l *Libvirt) DomainResume(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainResumeArgs {
+	args := DomainResumeArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(28, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5025,17 +8192,18 @@ func (l *Libvirt) DomainResume(Dom Domain) (err error) {
 func (l *Libvirt) DomainSetAutostart(Dom Domain, Autostart int32) (err error) {
 	var buf []byte
 
-	args := DomainSetAutostartArgs {
-		Dom: Dom,
+	args := DomainSetAutostartArgs{
+		Dom:       Dom,
 		Autostart: Autostart,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(29, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5048,17 +8216,18 @@ func (l *Libvirt) DomainSetAutostart(Dom Domain, Autostart int32) (err error) {
 func (l *Libvirt) DomainSetMaxMemory(Dom Domain, Memory uint64) (err error) {
 	var buf []byte
 
-	args := DomainSetMaxMemoryArgs {
-		Dom: Dom,
+	args := DomainSetMaxMemoryArgs{
+		Dom:    Dom,
 		Memory: Memory,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(30, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5071,17 +8240,18 @@ func (l *Libvirt) DomainSetMaxMemory(Dom Domain, Memory uint64) (err error) {
 func (l *Libvirt) DomainSetMemory(Dom Domain, Memory uint64) (err error) {
 	var buf []byte
 
-	args := DomainSetMemoryArgs {
-		Dom: Dom,
+	args := DomainSetMemoryArgs{
+		Dom:    Dom,
 		Memory: Memory,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(31, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5094,17 +8264,18 @@ func (l *Libvirt) DomainSetMemory(Dom Domain, Memory uint64) (err error) {
 func (l *Libvirt) DomainSetVcpus(Dom Domain, Nvcpus uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetVcpusArgs {
-		Dom: Dom,
+	args := DomainSetVcpusArgs{
+		Dom:    Dom,
 		Nvcpus: Nvcpus,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(32, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5117,16 +8288,17 @@ func (l *Libvirt) DomainSetVcpus(Dom Domain, Nvcpus uint32) (err error) {
 func (l *Libvirt) DomainShutdown(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainShutdownArgs {
+	args := DomainShutdownArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(33, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5139,16 +8311,17 @@ func (l *Libvirt) DomainShutdown(Dom Domain) (err error) {
 func (l *Libvirt) DomainSuspend(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainSuspendArgs {
+	args := DomainSuspendArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(34, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5161,16 +8334,17 @@ func (l *Libvirt) DomainSuspend(Dom Domain) (err error) {
 func (l *Libvirt) DomainUndefine(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainUndefineArgs {
+	args := DomainUndefineArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(35, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5183,11 +8357,13 @@ func (l *Libvirt) DomainUndefine(Dom Domain) (err error) {
 func (l *Libvirt) ConnectListDefinedNetworks(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListDefinedNetworksArgs {
+	args := ConnectListDefinedNetworksArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5199,10 +8375,8 @@ func (l *Libvirt) ConnectListDefinedNetworks(Maxnames int32) (rNames []string, e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -5216,11 +8390,13 @@ func (l *Libvirt) ConnectListDefinedNetworks(Maxnames int32) (rNames []string, e
 func (l *Libvirt) ConnectListDomains(Maxids int32) (rIds []int32, err error) {
 	var buf []byte
 
-	args := ConnectListDomainsArgs {
+	args := ConnectListDomainsArgs{
 		Maxids: Maxids,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5232,10 +8408,8 @@ func (l *Libvirt) ConnectListDomains(Maxids int32) (rIds []int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ids: []int32
 	_, err = dec.Decode(&rIds)
 	if err != nil {
@@ -5249,11 +8423,13 @@ func (l *Libvirt) ConnectListDomains(Maxids int32) (rIds []int32, err error) {
 func (l *Libvirt) ConnectListNetworks(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListNetworksArgs {
+	args := ConnectListNetworksArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5265,10 +8441,8 @@ func (l *Libvirt) ConnectListNetworks(Maxnames int32) (rNames []string, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -5282,16 +8456,17 @@ func (l *Libvirt) ConnectListNetworks(Maxnames int32) (rNames []string, err erro
 func (l *Libvirt) NetworkCreate(Net Network) (err error) {
 	var buf []byte
 
-	args := NetworkCreateArgs {
+	args := NetworkCreateArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(39, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5304,11 +8479,13 @@ func (l *Libvirt) NetworkCreate(Net Network) (err error) {
 func (l *Libvirt) NetworkCreateXML(XML string) (rNet Network, err error) {
 	var buf []byte
 
-	args := NetworkCreateXMLArgs {
+	args := NetworkCreateXMLArgs{
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5320,10 +8497,8 @@ func (l *Libvirt) NetworkCreateXML(XML string) (rNet Network, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Net: Network
 	_, err = dec.Decode(&rNet)
 	if err != nil {
@@ -5337,11 +8512,13 @@ func (l *Libvirt) NetworkCreateXML(XML string) (rNet Network, err error) {
 func (l *Libvirt) NetworkDefineXML(XML string) (rNet Network, err error) {
 	var buf []byte
 
-	args := NetworkDefineXMLArgs {
+	args := NetworkDefineXMLArgs{
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5353,10 +8530,8 @@ func (l *Libvirt) NetworkDefineXML(XML string) (rNet Network, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Net: Network
 	_, err = dec.Decode(&rNet)
 	if err != nil {
@@ -5370,16 +8545,17 @@ func (l *Libvirt) NetworkDefineXML(XML string) (rNet Network, err error) {
 func (l *Libvirt) NetworkDestroy(Net Network) (err error) {
 	var buf []byte
 
-	args := NetworkDestroyArgs {
+	args := NetworkDestroyArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(42, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5392,12 +8568,14 @@ func (l *Libvirt) NetworkDestroy(Net Network) (err error) {
 func (l *Libvirt) NetworkGetXMLDesc(Net Network, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := NetworkGetXMLDescArgs {
-		Net: Net,
+	args := NetworkGetXMLDescArgs{
+		Net:   Net,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5409,10 +8587,8 @@ func (l *Libvirt) NetworkGetXMLDesc(Net Network, Flags uint32) (rXML string, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -5426,11 +8602,13 @@ func (l *Libvirt) NetworkGetXMLDesc(Net Network, Flags uint32) (rXML string, err
 func (l *Libvirt) NetworkGetAutostart(Net Network) (rAutostart int32, err error) {
 	var buf []byte
 
-	args := NetworkGetAutostartArgs {
+	args := NetworkGetAutostartArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5442,10 +8620,8 @@ func (l *Libvirt) NetworkGetAutostart(Net Network) (rAutostart int32, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Autostart: int32
 	_, err = dec.Decode(&rAutostart)
 	if err != nil {
@@ -5459,11 +8635,13 @@ func (l *Libvirt) NetworkGetAutostart(Net Network) (rAutostart int32, err error)
 func (l *Libvirt) NetworkGetBridgeName(Net Network) (rName string, err error) {
 	var buf []byte
 
-	args := NetworkGetBridgeNameArgs {
+	args := NetworkGetBridgeNameArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5475,10 +8653,8 @@ func (l *Libvirt) NetworkGetBridgeName(Net Network) (rName string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Name: string
 	_, err = dec.Decode(&rName)
 	if err != nil {
@@ -5492,11 +8668,13 @@ func (l *Libvirt) NetworkGetBridgeName(Net Network) (rName string, err error) {
 func (l *Libvirt) NetworkLookupByName(Name string) (rNet Network, err error) {
 	var buf []byte
 
-	args := NetworkLookupByNameArgs {
+	args := NetworkLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5508,10 +8686,8 @@ func (l *Libvirt) NetworkLookupByName(Name string) (rNet Network, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Net: Network
 	_, err = dec.Decode(&rNet)
 	if err != nil {
@@ -5525,11 +8701,13 @@ func (l *Libvirt) NetworkLookupByName(Name string) (rNet Network, err error) {
 func (l *Libvirt) NetworkLookupByUUID(UUID UUID) (rNet Network, err error) {
 	var buf []byte
 
-	args := NetworkLookupByUUIDArgs {
+	args := NetworkLookupByUUIDArgs{
 		UUID: UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5541,10 +8719,8 @@ func (l *Libvirt) NetworkLookupByUUID(UUID UUID) (rNet Network, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Net: Network
 	_, err = dec.Decode(&rNet)
 	if err != nil {
@@ -5558,17 +8734,18 @@ func (l *Libvirt) NetworkLookupByUUID(UUID UUID) (rNet Network, err error) {
 func (l *Libvirt) NetworkSetAutostart(Net Network, Autostart int32) (err error) {
 	var buf []byte
 
-	args := NetworkSetAutostartArgs {
-		Net: Net,
+	args := NetworkSetAutostartArgs{
+		Net:       Net,
 		Autostart: Autostart,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(48, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5581,16 +8758,17 @@ func (l *Libvirt) NetworkSetAutostart(Net Network, Autostart int32) (err error)
 func (l *Libvirt) NetworkUndefine(Net Network) (err error) {
 	var buf []byte
 
-	args := NetworkUndefineArgs {
+	args := NetworkUndefineArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(49, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5610,10 +8788,8 @@ func (l *Libvirt) ConnectNumOfDefinedNetworks() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -5634,10 +8810,8 @@ func (l *Libvirt) ConnectNumOfDomains() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -5658,10 +8832,8 @@ func (l *Libvirt) ConnectNumOfNetworks() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -5675,18 +8847,19 @@ func (l *Libvirt) ConnectNumOfNetworks() (rNum int32, err error) {
 func (l *Libvirt) DomainCoreDump(Dom Domain, To string, Flags DomainCoreDumpFlags) (err error) {
 	var buf []byte
 
-	args := DomainCoreDumpArgs {
-		Dom: Dom,
-		To: To,
+	args := DomainCoreDumpArgs{
+		Dom:   Dom,
+		To:    To,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(53, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5699,16 +8872,17 @@ func (l *Libvirt) DomainCoreDump(Dom Domain, To string, Flags DomainCoreDumpFlag
 func (l *Libvirt) DomainRestore(From string) (err error) {
 	var buf []byte
 
-	args := DomainRestoreArgs {
+	args := DomainRestoreArgs{
 		From: From,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(54, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5721,17 +8895,18 @@ func (l *Libvirt) DomainRestore(From string) (err error) {
 func (l *Libvirt) DomainSave(Dom Domain, To string) (err error) {
 	var buf []byte
 
-	args := DomainSaveArgs {
+	args := DomainSaveArgs{
 		Dom: Dom,
-		To: To,
+		To:  To,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(55, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5744,11 +8919,13 @@ func (l *Libvirt) DomainSave(Dom Domain, To string) (err error) {
 func (l *Libvirt) DomainGetSchedulerType(Dom Domain) (rType string, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetSchedulerTypeArgs {
+	args := DomainGetSchedulerTypeArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5760,10 +8937,8 @@ func (l *Libvirt) DomainGetSchedulerType(Dom Domain) (rType string, rNparams int
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: string
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -5782,12 +8957,14 @@ func (l *Libvirt) DomainGetSchedulerType(Dom Domain) (rType string, rNparams int
 func (l *Libvirt) DomainGetSchedulerParameters(Dom Domain, Nparams int32) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetSchedulerParametersArgs {
-		Dom: Dom,
+	args := DomainGetSchedulerParametersArgs{
+		Dom:     Dom,
 		Nparams: Nparams,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5799,10 +8976,8 @@ func (l *Libvirt) DomainGetSchedulerParameters(Dom Domain, Nparams int32) (rPara
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -5816,17 +8991,18 @@ func (l *Libvirt) DomainGetSchedulerParameters(Dom Domain, Nparams int32) (rPara
 func (l *Libvirt) DomainSetSchedulerParameters(Dom Domain, Params []TypedParam) (err error) {
 	var buf []byte
 
-	args := DomainSetSchedulerParametersArgs {
-		Dom: Dom,
+	args := DomainSetSchedulerParametersArgs{
+		Dom:    Dom,
 		Params: Params,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(58, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5846,10 +9022,8 @@ func (l *Libvirt) ConnectGetHostname() (rHostname string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Hostname: string
 	_, err = dec.Decode(&rHostname)
 	if err != nil {
@@ -5863,11 +9037,13 @@ func (l *Libvirt) ConnectGetHostname() (rHostname string, err error) {
 func (l *Libvirt) ConnectSupportsFeature(Feature int32) (rSupported int32, err error) {
 	var buf []byte
 
-	args := ConnectSupportsFeatureArgs {
+	args := ConnectSupportsFeatureArgs{
 		Feature: Feature,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5879,10 +9055,8 @@ func (l *Libvirt) ConnectSupportsFeature(Feature int32) (rSupported int32, err e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Supported: int32
 	_, err = dec.Decode(&rSupported)
 	if err != nil {
@@ -5896,14 +9070,16 @@ func (l *Libvirt) ConnectSupportsFeature(Feature int32) (rSupported int32, err e
 func (l *Libvirt) DomainMigratePrepare(UriIn OptString, Flags uint64, Dname OptString, Resource uint64) (rCookie []byte, rUriOut OptString, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepareArgs {
-		UriIn: UriIn,
-		Flags: Flags,
-		Dname: Dname,
+	args := DomainMigratePrepareArgs{
+		UriIn:    UriIn,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5915,10 +9091,8 @@ func (l *Libvirt) DomainMigratePrepare(UriIn OptString, Flags uint64, Dname OptS
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cookie: []byte
 	_, err = dec.Decode(&rCookie)
 	if err != nil {
@@ -5937,21 +9111,22 @@ func (l *Libvirt) DomainMigratePrepare(UriIn OptString, Flags uint64, Dname OptS
 func (l *Libvirt) DomainMigratePerform(Dom Domain, Cookie []byte, Uri string, Flags uint64, Dname OptString, Resource uint64) (err error) {
 	var buf []byte
 
-	args := DomainMigratePerformArgs {
-		Dom: Dom,
-		Cookie: Cookie,
-		Uri: Uri,
-		Flags: Flags,
-		Dname: Dname,
+	args := DomainMigratePerformArgs{
+		Dom:      Dom,
+		Cookie:   Cookie,
+		Uri:      Uri,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(62, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -5964,14 +9139,16 @@ func (l *Libvirt) DomainMigratePerform(Dom Domain, Cookie []byte, Uri string, Fl
 func (l *Libvirt) DomainMigrateFinish(Dname string, Cookie []byte, Uri string, Flags uint64) (rDdom Domain, err error) {
 	var buf []byte
 
-	args := DomainMigrateFinishArgs {
-		Dname: Dname,
+	args := DomainMigrateFinishArgs{
+		Dname:  Dname,
 		Cookie: Cookie,
-		Uri: Uri,
-		Flags: Flags,
+		Uri:    Uri,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -5983,10 +9160,8 @@ func (l *Libvirt) DomainMigrateFinish(Dname string, Cookie []byte, Uri string, F
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ddom: Domain
 	_, err = dec.Decode(&rDdom)
 	if err != nil {
@@ -6000,12 +9175,14 @@ func (l *Libvirt) DomainMigrateFinish(Dname string, Cookie []byte, Uri string, F
 func (l *Libvirt) DomainBlockStats(Dom Domain, Path string) (rRdReq int64, rRdBytes int64, rWrReq int64, rWrBytes int64, rErrs int64, err error) {
 	var buf []byte
 
-	args := DomainBlockStatsArgs {
-		Dom: Dom,
+	args := DomainBlockStatsArgs{
+		Dom:  Dom,
 		Path: Path,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6017,10 +9194,8 @@ func (l *Libvirt) DomainBlockStats(Dom Domain, Path string) (rRdReq int64, rRdBy
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// RdReq: int64
 	_, err = dec.Decode(&rRdReq)
 	if err != nil {
@@ -6054,12 +9229,14 @@ func (l *Libvirt) DomainBlockStats(Dom Domain, Path string) (rRdReq int64, rRdBy
 func (l *Libvirt) DomainInterfaceStats(Dom Domain, Device string) (rRxBytes int64, rRxPackets int64, rRxErrs int64, rRxDrop int64, rTxBytes int64, rTxPackets int64, rTxErrs int64, rTxDrop int64, err error) {
 	var buf []byte
 
-	args := DomainInterfaceStatsArgs {
-		Dom: Dom,
+	args := DomainInterfaceStatsArgs{
+		Dom:    Dom,
 		Device: Device,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6071,10 +9248,8 @@ func (l *Libvirt) DomainInterfaceStats(Dom Domain, Device string) (rRxBytes int6
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// RxBytes: int64
 	_, err = dec.Decode(&rRxBytes)
 	if err != nil {
@@ -6130,10 +9305,8 @@ func (l *Libvirt) AuthList() (rTypes []AuthType, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Types: []AuthType
 	_, err = dec.Decode(&rTypes)
 	if err != nil {
@@ -6154,10 +9327,8 @@ func (l *Libvirt) AuthSaslInit() (rMechlist string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Mechlist: string
 	_, err = dec.Decode(&rMechlist)
 	if err != nil {
@@ -6171,13 +9342,15 @@ func (l *Libvirt) AuthSaslInit() (rMechlist string, err error) {
 func (l *Libvirt) AuthSaslStart(Mech string, Nil int32, Data []int8) (rComplete int32, rNil int32, rData []int8, err error) {
 	var buf []byte
 
-	args := AuthSaslStartArgs {
+	args := AuthSaslStartArgs{
 		Mech: Mech,
-		Nil: Nil,
+		Nil:  Nil,
 		Data: Data,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6189,10 +9362,8 @@ func (l *Libvirt) AuthSaslStart(Mech string, Nil int32, Data []int8) (rComplete
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Complete: int32
 	_, err = dec.Decode(&rComplete)
 	if err != nil {
@@ -6216,12 +9387,14 @@ func (l *Libvirt) AuthSaslStart(Mech string, Nil int32, Data []int8) (rComplete
 func (l *Libvirt) AuthSaslStep(Nil int32, Data []int8) (rComplete int32, rNil int32, rData []int8, err error) {
 	var buf []byte
 
-	args := AuthSaslStepArgs {
-		Nil: Nil,
+	args := AuthSaslStepArgs{
+		Nil:  Nil,
 		Data: Data,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6233,10 +9406,8 @@ func (l *Libvirt) AuthSaslStep(Nil int32, Data []int8) (rComplete int32, rNil in
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Complete: int32
 	_, err = dec.Decode(&rComplete)
 	if err != nil {
@@ -6267,10 +9438,8 @@ func (l *Libvirt) AuthPolkit() (rComplete int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Complete: int32
 	_, err = dec.Decode(&rComplete)
 	if err != nil {
@@ -6291,10 +9460,8 @@ func (l *Libvirt) ConnectNumOfStoragePools() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -6308,11 +9475,13 @@ func (l *Libvirt) ConnectNumOfStoragePools() (rNum int32, err error) {
 func (l *Libvirt) ConnectListStoragePools(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListStoragePoolsArgs {
+	args := ConnectListStoragePoolsArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6324,10 +9493,8 @@ func (l *Libvirt) ConnectListStoragePools(Maxnames int32) (rNames []string, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -6348,10 +9515,8 @@ func (l *Libvirt) ConnectNumOfDefinedStoragePools() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -6365,11 +9530,13 @@ func (l *Libvirt) ConnectNumOfDefinedStoragePools() (rNum int32, err error) {
 func (l *Libvirt) ConnectListDefinedStoragePools(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListDefinedStoragePoolsArgs {
+	args := ConnectListDefinedStoragePoolsArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6381,10 +9548,8 @@ func (l *Libvirt) ConnectListDefinedStoragePools(Maxnames int32) (rNames []strin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -6398,13 +9563,15 @@ func (l *Libvirt) ConnectListDefinedStoragePools(Maxnames int32) (rNames []strin
 func (l *Libvirt) ConnectFindStoragePoolSources(Type string, SrcSpec OptString, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := ConnectFindStoragePoolSourcesArgs {
-		Type: Type,
+	args := ConnectFindStoragePoolSourcesArgs{
+		Type:    Type,
 		SrcSpec: SrcSpec,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6416,10 +9583,8 @@ func (l *Libvirt) ConnectFindStoragePoolSources(Type string, SrcSpec OptString,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -6433,12 +9598,14 @@ func (l *Libvirt) ConnectFindStoragePoolSources(Type string, SrcSpec OptString,
 func (l *Libvirt) StoragePoolCreateXML(XML string, Flags StoragePoolCreateFlags) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolCreateXMLArgs {
-		XML: XML,
+	args := StoragePoolCreateXMLArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6450,10 +9617,8 @@ func (l *Libvirt) StoragePoolCreateXML(XML string, Flags StoragePoolCreateFlags)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -6467,12 +9632,14 @@ func (l *Libvirt) StoragePoolCreateXML(XML string, Flags StoragePoolCreateFlags)
 func (l *Libvirt) StoragePoolDefineXML(XML string, Flags uint32) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolDefineXMLArgs {
-		XML: XML,
+	args := StoragePoolDefineXMLArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6484,10 +9651,8 @@ func (l *Libvirt) StoragePoolDefineXML(XML string, Flags uint32) (rPool StorageP
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -6501,17 +9666,18 @@ func (l *Libvirt) StoragePoolDefineXML(XML string, Flags uint32) (rPool StorageP
 func (l *Libvirt) StoragePoolCreate(Pool StoragePool, Flags StoragePoolCreateFlags) (err error) {
 	var buf []byte
 
-	args := StoragePoolCreateArgs {
-		Pool: Pool,
+	args := StoragePoolCreateArgs{
+		Pool:  Pool,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(78, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6524,17 +9690,18 @@ func (l *Libvirt) StoragePoolCreate(Pool StoragePool, Flags StoragePoolCreateFla
 func (l *Libvirt) StoragePoolBuild(Pool StoragePool, Flags StoragePoolBuildFlags) (err error) {
 	var buf []byte
 
-	args := StoragePoolBuildArgs {
-		Pool: Pool,
+	args := StoragePoolBuildArgs{
+		Pool:  Pool,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(79, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6547,16 +9714,17 @@ func (l *Libvirt) StoragePoolBuild(Pool StoragePool, Flags StoragePoolBuildFlags
 func (l *Libvirt) StoragePoolDestroy(Pool StoragePool) (err error) {
 	var buf []byte
 
-	args := StoragePoolDestroyArgs {
+	args := StoragePoolDestroyArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(80, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6569,17 +9737,18 @@ func (l *Libvirt) StoragePoolDestroy(Pool StoragePool) (err error) {
 func (l *Libvirt) StoragePoolDelete(Pool StoragePool, Flags StoragePoolDeleteFlags) (err error) {
 	var buf []byte
 
-	args := StoragePoolDeleteArgs {
-		Pool: Pool,
+	args := StoragePoolDeleteArgs{
+		Pool:  Pool,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(81, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6592,16 +9761,17 @@ func (l *Libvirt) StoragePoolDelete(Pool StoragePool, Flags StoragePoolDeleteFla
 func (l *Libvirt) StoragePoolUndefine(Pool StoragePool) (err error) {
 	var buf []byte
 
-	args := StoragePoolUndefineArgs {
+	args := StoragePoolUndefineArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(82, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6614,17 +9784,18 @@ func (l *Libvirt) StoragePoolUndefine(Pool StoragePool) (err error) {
 func (l *Libvirt) StoragePoolRefresh(Pool StoragePool, Flags uint32) (err error) {
 	var buf []byte
 
-	args := StoragePoolRefreshArgs {
-		Pool: Pool,
+	args := StoragePoolRefreshArgs{
+		Pool:  Pool,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(83, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6637,11 +9808,13 @@ func (l *Libvirt) StoragePoolRefresh(Pool StoragePool, Flags uint32) (err error)
 func (l *Libvirt) StoragePoolLookupByName(Name string) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolLookupByNameArgs {
+	args := StoragePoolLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6653,10 +9826,8 @@ func (l *Libvirt) StoragePoolLookupByName(Name string) (rPool StoragePool, err e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -6670,11 +9841,13 @@ func (l *Libvirt) StoragePoolLookupByName(Name string) (rPool StoragePool, err e
 func (l *Libvirt) StoragePoolLookupByUUID(UUID UUID) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolLookupByUUIDArgs {
+	args := StoragePoolLookupByUUIDArgs{
 		UUID: UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6686,10 +9859,8 @@ func (l *Libvirt) StoragePoolLookupByUUID(UUID UUID) (rPool StoragePool, err err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -6703,11 +9874,13 @@ func (l *Libvirt) StoragePoolLookupByUUID(UUID UUID) (rPool StoragePool, err err
 func (l *Libvirt) StoragePoolLookupByVolume(Vol StorageVol) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolLookupByVolumeArgs {
+	args := StoragePoolLookupByVolumeArgs{
 		Vol: Vol,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6719,10 +9892,8 @@ func (l *Libvirt) StoragePoolLookupByVolume(Vol StorageVol) (rPool StoragePool,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -6736,11 +9907,13 @@ func (l *Libvirt) StoragePoolLookupByVolume(Vol StorageVol) (rPool StoragePool,
 func (l *Libvirt) StoragePoolGetInfo(Pool StoragePool) (rState uint8, rCapacity uint64, rAllocation uint64, rAvailable uint64, err error) {
 	var buf []byte
 
-	args := StoragePoolGetInfoArgs {
+	args := StoragePoolGetInfoArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6752,10 +9925,8 @@ func (l *Libvirt) StoragePoolGetInfo(Pool StoragePool) (rState uint8, rCapacity
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// State: uint8
 	_, err = dec.Decode(&rState)
 	if err != nil {
@@ -6784,12 +9955,14 @@ func (l *Libvirt) StoragePoolGetInfo(Pool StoragePool) (rState uint8, rCapacity
 func (l *Libvirt) StoragePoolGetXMLDesc(Pool StoragePool, Flags StorageXMLFlags) (rXML string, err error) {
 	var buf []byte
 
-	args := StoragePoolGetXMLDescArgs {
-		Pool: Pool,
+	args := StoragePoolGetXMLDescArgs{
+		Pool:  Pool,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6801,10 +9974,8 @@ func (l *Libvirt) StoragePoolGetXMLDesc(Pool StoragePool, Flags StorageXMLFlags)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -6818,11 +9989,13 @@ func (l *Libvirt) StoragePoolGetXMLDesc(Pool StoragePool, Flags StorageXMLFlags)
 func (l *Libvirt) StoragePoolGetAutostart(Pool StoragePool) (rAutostart int32, err error) {
 	var buf []byte
 
-	args := StoragePoolGetAutostartArgs {
+	args := StoragePoolGetAutostartArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6834,10 +10007,8 @@ func (l *Libvirt) StoragePoolGetAutostart(Pool StoragePool) (rAutostart int32, e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Autostart: int32
 	_, err = dec.Decode(&rAutostart)
 	if err != nil {
@@ -6851,17 +10022,18 @@ func (l *Libvirt) StoragePoolGetAutostart(Pool StoragePool) (rAutostart int32, e
 func (l *Libvirt) StoragePoolSetAutostart(Pool StoragePool, Autostart int32) (err error) {
 	var buf []byte
 
-	args := StoragePoolSetAutostartArgs {
-		Pool: Pool,
+	args := StoragePoolSetAutostartArgs{
+		Pool:      Pool,
 		Autostart: Autostart,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(90, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6874,11 +10046,13 @@ func (l *Libvirt) StoragePoolSetAutostart(Pool StoragePool, Autostart int32) (er
 func (l *Libvirt) StoragePoolNumOfVolumes(Pool StoragePool) (rNum int32, err error) {
 	var buf []byte
 
-	args := StoragePoolNumOfVolumesArgs {
+	args := StoragePoolNumOfVolumesArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6890,10 +10064,8 @@ func (l *Libvirt) StoragePoolNumOfVolumes(Pool StoragePool) (rNum int32, err err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -6907,12 +10079,14 @@ func (l *Libvirt) StoragePoolNumOfVolumes(Pool StoragePool) (rNum int32, err err
 func (l *Libvirt) StoragePoolListVolumes(Pool StoragePool, Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := StoragePoolListVolumesArgs {
-		Pool: Pool,
+	args := StoragePoolListVolumesArgs{
+		Pool:     Pool,
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6924,10 +10098,8 @@ func (l *Libvirt) StoragePoolListVolumes(Pool StoragePool, Maxnames int32) (rNam
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -6941,13 +10113,15 @@ func (l *Libvirt) StoragePoolListVolumes(Pool StoragePool, Maxnames int32) (rNam
 func (l *Libvirt) StorageVolCreateXML(Pool StoragePool, XML string, Flags StorageVolCreateFlags) (rVol StorageVol, err error) {
 	var buf []byte
 
-	args := StorageVolCreateXMLArgs {
-		Pool: Pool,
-		XML: XML,
+	args := StorageVolCreateXMLArgs{
+		Pool:  Pool,
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -6959,10 +10133,8 @@ func (l *Libvirt) StorageVolCreateXML(Pool StoragePool, XML string, Flags Storag
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vol: StorageVol
 	_, err = dec.Decode(&rVol)
 	if err != nil {
@@ -6976,17 +10148,18 @@ func (l *Libvirt) StorageVolCreateXML(Pool StoragePool, XML string, Flags Storag
 func (l *Libvirt) StorageVolDelete(Vol StorageVol, Flags StorageVolDeleteFlags) (err error) {
 	var buf []byte
 
-	args := StorageVolDeleteArgs {
-		Vol: Vol,
+	args := StorageVolDeleteArgs{
+		Vol:   Vol,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(94, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -6999,12 +10172,14 @@ func (l *Libvirt) StorageVolDelete(Vol StorageVol, Flags StorageVolDeleteFlags)
 func (l *Libvirt) StorageVolLookupByName(Pool StoragePool, Name string) (rVol StorageVol, err error) {
 	var buf []byte
 
-	args := StorageVolLookupByNameArgs {
+	args := StorageVolLookupByNameArgs{
 		Pool: Pool,
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7016,10 +10191,8 @@ func (l *Libvirt) StorageVolLookupByName(Pool StoragePool, Name string) (rVol St
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vol: StorageVol
 	_, err = dec.Decode(&rVol)
 	if err != nil {
@@ -7033,11 +10206,13 @@ func (l *Libvirt) StorageVolLookupByName(Pool StoragePool, Name string) (rVol St
 func (l *Libvirt) StorageVolLookupByKey(Key string) (rVol StorageVol, err error) {
 	var buf []byte
 
-	args := StorageVolLookupByKeyArgs {
+	args := StorageVolLookupByKeyArgs{
 		Key: Key,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7049,10 +10224,8 @@ func (l *Libvirt) StorageVolLookupByKey(Key string) (rVol StorageVol, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vol: StorageVol
 	_, err = dec.Decode(&rVol)
 	if err != nil {
@@ -7066,11 +10239,13 @@ func (l *Libvirt) StorageVolLookupByKey(Key string) (rVol StorageVol, err error)
 func (l *Libvirt) StorageVolLookupByPath(Path string) (rVol StorageVol, err error) {
 	var buf []byte
 
-	args := StorageVolLookupByPathArgs {
+	args := StorageVolLookupByPathArgs{
 		Path: Path,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7082,10 +10257,8 @@ func (l *Libvirt) StorageVolLookupByPath(Path string) (rVol StorageVol, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vol: StorageVol
 	_, err = dec.Decode(&rVol)
 	if err != nil {
@@ -7099,11 +10272,13 @@ func (l *Libvirt) StorageVolLookupByPath(Path string) (rVol StorageVol, err erro
 func (l *Libvirt) StorageVolGetInfo(Vol StorageVol) (rType int8, rCapacity uint64, rAllocation uint64, err error) {
 	var buf []byte
 
-	args := StorageVolGetInfoArgs {
+	args := StorageVolGetInfoArgs{
 		Vol: Vol,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7115,10 +10290,8 @@ func (l *Libvirt) StorageVolGetInfo(Vol StorageVol) (rType int8, rCapacity uint6
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: int8
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -7142,12 +10315,14 @@ func (l *Libvirt) StorageVolGetInfo(Vol StorageVol) (rType int8, rCapacity uint6
 func (l *Libvirt) StorageVolGetXMLDesc(Vol StorageVol, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := StorageVolGetXMLDescArgs {
-		Vol: Vol,
+	args := StorageVolGetXMLDescArgs{
+		Vol:   Vol,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7159,10 +10334,8 @@ func (l *Libvirt) StorageVolGetXMLDesc(Vol StorageVol, Flags uint32) (rXML strin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -7176,11 +10349,13 @@ func (l *Libvirt) StorageVolGetXMLDesc(Vol StorageVol, Flags uint32) (rXML strin
 func (l *Libvirt) StorageVolGetPath(Vol StorageVol) (rName string, err error) {
 	var buf []byte
 
-	args := StorageVolGetPathArgs {
+	args := StorageVolGetPathArgs{
 		Vol: Vol,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7192,10 +10367,8 @@ func (l *Libvirt) StorageVolGetPath(Vol StorageVol) (rName string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Name: string
 	_, err = dec.Decode(&rName)
 	if err != nil {
@@ -7209,12 +10382,14 @@ func (l *Libvirt) StorageVolGetPath(Vol StorageVol) (rName string, err error) {
 func (l *Libvirt) NodeGetCellsFreeMemory(StartCell int32, Maxcells int32) (rCells []uint64, err error) {
 	var buf []byte
 
-	args := NodeGetCellsFreeMemoryArgs {
+	args := NodeGetCellsFreeMemoryArgs{
 		StartCell: StartCell,
-		Maxcells: Maxcells,
+		Maxcells:  Maxcells,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7226,10 +10401,8 @@ func (l *Libvirt) NodeGetCellsFreeMemory(StartCell int32, Maxcells int32) (rCell
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cells: []uint64
 	_, err = dec.Decode(&rCells)
 	if err != nil {
@@ -7250,10 +10423,8 @@ func (l *Libvirt) NodeGetFreeMemory() (rFreeMem uint64, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// FreeMem: uint64
 	_, err = dec.Decode(&rFreeMem)
 	if err != nil {
@@ -7267,15 +10438,17 @@ func (l *Libvirt) NodeGetFreeMemory() (rFreeMem uint64, err error) {
 func (l *Libvirt) DomainBlockPeek(Dom Domain, Path string, Offset uint64, Size uint32, Flags uint32) (rBuffer []byte, err error) {
 	var buf []byte
 
-	args := DomainBlockPeekArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockPeekArgs{
+		Dom:    Dom,
+		Path:   Path,
 		Offset: Offset,
-		Size: Size,
-		Flags: Flags,
+		Size:   Size,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7287,10 +10460,8 @@ func (l *Libvirt) DomainBlockPeek(Dom Domain, Path string, Offset uint64, Size u
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Buffer: []byte
 	_, err = dec.Decode(&rBuffer)
 	if err != nil {
@@ -7304,14 +10475,16 @@ func (l *Libvirt) DomainBlockPeek(Dom Domain, Path string, Offset uint64, Size u
 func (l *Libvirt) DomainMemoryPeek(Dom Domain, Offset uint64, Size uint32, Flags DomainMemoryFlags) (rBuffer []byte, err error) {
 	var buf []byte
 
-	args := DomainMemoryPeekArgs {
-		Dom: Dom,
+	args := DomainMemoryPeekArgs{
+		Dom:    Dom,
 		Offset: Offset,
-		Size: Size,
-		Flags: Flags,
+		Size:   Size,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7323,10 +10496,8 @@ func (l *Libvirt) DomainMemoryPeek(Dom Domain, Offset uint64, Size uint32, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Buffer: []byte
 	_, err = dec.Decode(&rBuffer)
 	if err != nil {
@@ -7347,10 +10518,8 @@ func (l *Libvirt) ConnectDomainEventRegister() (rCbRegistered int32, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CbRegistered: int32
 	_, err = dec.Decode(&rCbRegistered)
 	if err != nil {
@@ -7371,10 +10540,8 @@ func (l *Libvirt) ConnectDomainEventDeregister() (rCbRegistered int32, err error
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CbRegistered: int32
 	_, err = dec.Decode(&rCbRegistered)
 	if err != nil {
@@ -7388,7 +10555,6 @@ func (l *Libvirt) ConnectDomainEventDeregister() (rCbRegistered int32, err error
 func (l *Libvirt) DomainEventLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(107, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -7401,15 +10567,17 @@ func (l *Libvirt) DomainEventLifecycle() (err error) {
 func (l *Libvirt) DomainMigratePrepare2(UriIn OptString, Flags uint64, Dname OptString, Resource uint64, DomXML string) (rCookie []byte, rUriOut OptString, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepare2Args {
-		UriIn: UriIn,
-		Flags: Flags,
-		Dname: Dname,
+	args := DomainMigratePrepare2Args{
+		UriIn:    UriIn,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
-		DomXML: DomXML,
+		DomXML:   DomXML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7421,10 +10589,8 @@ func (l *Libvirt) DomainMigratePrepare2(UriIn OptString, Flags uint64, Dname Opt
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cookie: []byte
 	_, err = dec.Decode(&rCookie)
 	if err != nil {
@@ -7443,15 +10609,17 @@ func (l *Libvirt) DomainMigratePrepare2(UriIn OptString, Flags uint64, Dname Opt
 func (l *Libvirt) DomainMigrateFinish2(Dname string, Cookie []byte, Uri string, Flags uint64, Retcode int32) (rDdom Domain, err error) {
 	var buf []byte
 
-	args := DomainMigrateFinish2Args {
-		Dname: Dname,
-		Cookie: Cookie,
-		Uri: Uri,
-		Flags: Flags,
+	args := DomainMigrateFinish2Args{
+		Dname:   Dname,
+		Cookie:  Cookie,
+		Uri:     Uri,
+		Flags:   Flags,
 		Retcode: Retcode,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7463,10 +10631,8 @@ func (l *Libvirt) DomainMigrateFinish2(Dname string, Cookie []byte, Uri string,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ddom: Domain
 	_, err = dec.Decode(&rDdom)
 	if err != nil {
@@ -7487,10 +10653,8 @@ func (l *Libvirt) ConnectGetUri() (rUri string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Uri: string
 	_, err = dec.Decode(&rUri)
 	if err != nil {
@@ -7504,12 +10668,14 @@ func (l *Libvirt) ConnectGetUri() (rUri string, err error) {
 func (l *Libvirt) NodeNumOfDevices(Cap OptString, Flags uint32) (rNum int32, err error) {
 	var buf []byte
 
-	args := NodeNumOfDevicesArgs {
-		Cap: Cap,
+	args := NodeNumOfDevicesArgs{
+		Cap:   Cap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7521,10 +10687,8 @@ func (l *Libvirt) NodeNumOfDevices(Cap OptString, Flags uint32) (rNum int32, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -7538,13 +10702,15 @@ func (l *Libvirt) NodeNumOfDevices(Cap OptString, Flags uint32) (rNum int32, err
 func (l *Libvirt) NodeListDevices(Cap OptString, Maxnames int32, Flags uint32) (rNames []string, err error) {
 	var buf []byte
 
-	args := NodeListDevicesArgs {
-		Cap: Cap,
+	args := NodeListDevicesArgs{
+		Cap:      Cap,
 		Maxnames: Maxnames,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7556,10 +10722,8 @@ func (l *Libvirt) NodeListDevices(Cap OptString, Maxnames int32, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -7573,11 +10737,13 @@ func (l *Libvirt) NodeListDevices(Cap OptString, Maxnames int32, Flags uint32) (
 func (l *Libvirt) NodeDeviceLookupByName(Name string) (rDev NodeDevice, err error) {
 	var buf []byte
 
-	args := NodeDeviceLookupByNameArgs {
+	args := NodeDeviceLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7589,10 +10755,8 @@ func (l *Libvirt) NodeDeviceLookupByName(Name string) (rDev NodeDevice, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dev: NodeDevice
 	_, err = dec.Decode(&rDev)
 	if err != nil {
@@ -7606,12 +10770,14 @@ func (l *Libvirt) NodeDeviceLookupByName(Name string) (rDev NodeDevice, err erro
 func (l *Libvirt) NodeDeviceGetXMLDesc(Name string, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := NodeDeviceGetXMLDescArgs {
-		Name: Name,
+	args := NodeDeviceGetXMLDescArgs{
+		Name:  Name,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7623,10 +10789,8 @@ func (l *Libvirt) NodeDeviceGetXMLDesc(Name string, Flags uint32) (rXML string,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -7640,11 +10804,13 @@ func (l *Libvirt) NodeDeviceGetXMLDesc(Name string, Flags uint32) (rXML string,
 func (l *Libvirt) NodeDeviceGetParent(Name string) (rParentName OptString, err error) {
 	var buf []byte
 
-	args := NodeDeviceGetParentArgs {
+	args := NodeDeviceGetParentArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7656,10 +10822,8 @@ func (l *Libvirt) NodeDeviceGetParent(Name string) (rParentName OptString, err e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// ParentName: OptString
 	_, err = dec.Decode(&rParentName)
 	if err != nil {
@@ -7673,11 +10837,13 @@ func (l *Libvirt) NodeDeviceGetParent(Name string) (rParentName OptString, err e
 func (l *Libvirt) NodeDeviceNumOfCaps(Name string) (rNum int32, err error) {
 	var buf []byte
 
-	args := NodeDeviceNumOfCapsArgs {
+	args := NodeDeviceNumOfCapsArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7689,10 +10855,8 @@ func (l *Libvirt) NodeDeviceNumOfCaps(Name string) (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -7706,12 +10870,14 @@ func (l *Libvirt) NodeDeviceNumOfCaps(Name string) (rNum int32, err error) {
 func (l *Libvirt) NodeDeviceListCaps(Name string, Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := NodeDeviceListCapsArgs {
-		Name: Name,
+	args := NodeDeviceListCapsArgs{
+		Name:     Name,
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7723,10 +10889,8 @@ func (l *Libvirt) NodeDeviceListCaps(Name string, Maxnames int32) (rNames []stri
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -7740,16 +10904,17 @@ func (l *Libvirt) NodeDeviceListCaps(Name string, Maxnames int32) (rNames []stri
 func (l *Libvirt) NodeDeviceDettach(Name string) (err error) {
 	var buf []byte
 
-	args := NodeDeviceDettachArgs {
+	args := NodeDeviceDettachArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(118, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -7762,16 +10927,17 @@ func (l *Libvirt) NodeDeviceDettach(Name string) (err error) {
 func (l *Libvirt) NodeDeviceReAttach(Name string) (err error) {
 	var buf []byte
 
-	args := NodeDeviceReAttachArgs {
+	args := NodeDeviceReAttachArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(119, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -7784,16 +10950,17 @@ func (l *Libvirt) NodeDeviceReAttach(Name string) (err error) {
 func (l *Libvirt) NodeDeviceReset(Name string) (err error) {
 	var buf []byte
 
-	args := NodeDeviceResetArgs {
+	args := NodeDeviceResetArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(120, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -7806,11 +10973,13 @@ func (l *Libvirt) NodeDeviceReset(Name string) (err error) {
 func (l *Libvirt) DomainGetSecurityLabel(Dom Domain) (rLabel []int8, rEnforcing int32, err error) {
 	var buf []byte
 
-	args := DomainGetSecurityLabelArgs {
+	args := DomainGetSecurityLabelArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7822,10 +10991,8 @@ func (l *Libvirt) DomainGetSecurityLabel(Dom Domain) (rLabel []int8, rEnforcing
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Label: []int8
 	_, err = dec.Decode(&rLabel)
 	if err != nil {
@@ -7851,10 +11018,8 @@ func (l *Libvirt) NodeGetSecurityModel() (rModel []int8, rDoi []int8, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Model: []int8
 	_, err = dec.Decode(&rModel)
 	if err != nil {
@@ -7873,12 +11038,14 @@ func (l *Libvirt) NodeGetSecurityModel() (rModel []int8, rDoi []int8, err error)
 func (l *Libvirt) NodeDeviceCreateXML(XMLDesc string, Flags uint32) (rDev NodeDevice, err error) {
 	var buf []byte
 
-	args := NodeDeviceCreateXMLArgs {
+	args := NodeDeviceCreateXMLArgs{
 		XMLDesc: XMLDesc,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7890,10 +11057,8 @@ func (l *Libvirt) NodeDeviceCreateXML(XMLDesc string, Flags uint32) (rDev NodeDe
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dev: NodeDevice
 	_, err = dec.Decode(&rDev)
 	if err != nil {
@@ -7907,16 +11072,17 @@ func (l *Libvirt) NodeDeviceCreateXML(XMLDesc string, Flags uint32) (rDev NodeDe
 func (l *Libvirt) NodeDeviceDestroy(Name string) (err error) {
 	var buf []byte
 
-	args := NodeDeviceDestroyArgs {
+	args := NodeDeviceDestroyArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(124, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -7929,14 +11095,16 @@ func (l *Libvirt) NodeDeviceDestroy(Name string) (err error) {
 func (l *Libvirt) StorageVolCreateXMLFrom(Pool StoragePool, XML string, Clonevol StorageVol, Flags StorageVolCreateFlags) (rVol StorageVol, err error) {
 	var buf []byte
 
-	args := StorageVolCreateXMLFromArgs {
-		Pool: Pool,
-		XML: XML,
+	args := StorageVolCreateXMLFromArgs{
+		Pool:     Pool,
+		XML:      XML,
 		Clonevol: Clonevol,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -7948,10 +11116,8 @@ func (l *Libvirt) StorageVolCreateXMLFrom(Pool StoragePool, XML string, Clonevol
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vol: StorageVol
 	_, err = dec.Decode(&rVol)
 	if err != nil {
@@ -7972,10 +11138,8 @@ func (l *Libvirt) ConnectNumOfInterfaces() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -7989,11 +11153,13 @@ func (l *Libvirt) ConnectNumOfInterfaces() (rNum int32, err error) {
 func (l *Libvirt) ConnectListInterfaces(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListInterfacesArgs {
+	args := ConnectListInterfacesArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8005,10 +11171,8 @@ func (l *Libvirt) ConnectListInterfaces(Maxnames int32) (rNames []string, err er
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -8022,11 +11186,13 @@ func (l *Libvirt) ConnectListInterfaces(Maxnames int32) (rNames []string, err er
 func (l *Libvirt) InterfaceLookupByName(Name string) (rIface Interface, err error) {
 	var buf []byte
 
-	args := InterfaceLookupByNameArgs {
+	args := InterfaceLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8038,10 +11204,8 @@ func (l *Libvirt) InterfaceLookupByName(Name string) (rIface Interface, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Iface: Interface
 	_, err = dec.Decode(&rIface)
 	if err != nil {
@@ -8055,11 +11219,13 @@ func (l *Libvirt) InterfaceLookupByName(Name string) (rIface Interface, err erro
 func (l *Libvirt) InterfaceLookupByMacString(Mac string) (rIface Interface, err error) {
 	var buf []byte
 
-	args := InterfaceLookupByMacStringArgs {
+	args := InterfaceLookupByMacStringArgs{
 		Mac: Mac,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8071,10 +11237,8 @@ func (l *Libvirt) InterfaceLookupByMacString(Mac string) (rIface Interface, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Iface: Interface
 	_, err = dec.Decode(&rIface)
 	if err != nil {
@@ -8085,15 +11249,17 @@ func (l *Libvirt) InterfaceLookupByMacString(Mac string) (rIface Interface, err
 }
 
 // InterfaceGetXMLDesc is the go wrapper for REMOTE_PROC_INTERFACE_GET_XML_DESC.
-func (l *Libvirt) InterfaceGetXMLDesc(Iface Interface, Flags uint32) (rXML string, err error) {
+func (l *Libvirt) InterfaceGetXMLDesc(Iface Interface, Flags InterfaceXMLFlags) (rXML string, err error) {
 	var buf []byte
 
-	args := InterfaceGetXMLDescArgs {
+	args := InterfaceGetXMLDescArgs{
 		Iface: Iface,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8105,10 +11271,8 @@ func (l *Libvirt) InterfaceGetXMLDesc(Iface Interface, Flags uint32) (rXML strin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -8122,12 +11286,14 @@ func (l *Libvirt) InterfaceGetXMLDesc(Iface Interface, Flags uint32) (rXML strin
 func (l *Libvirt) InterfaceDefineXML(XML string, Flags uint32) (rIface Interface, err error) {
 	var buf []byte
 
-	args := InterfaceDefineXMLArgs {
-		XML: XML,
+	args := InterfaceDefineXMLArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8139,10 +11305,8 @@ func (l *Libvirt) InterfaceDefineXML(XML string, Flags uint32) (rIface Interface
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Iface: Interface
 	_, err = dec.Decode(&rIface)
 	if err != nil {
@@ -8156,16 +11320,17 @@ func (l *Libvirt) InterfaceDefineXML(XML string, Flags uint32) (rIface Interface
 func (l *Libvirt) InterfaceUndefine(Iface Interface) (err error) {
 	var buf []byte
 
-	args := InterfaceUndefineArgs {
+	args := InterfaceUndefineArgs{
 		Iface: Iface,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(132, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -8178,17 +11343,18 @@ func (l *Libvirt) InterfaceUndefine(Iface Interface) (err error) {
 func (l *Libvirt) InterfaceCreate(Iface Interface, Flags uint32) (err error) {
 	var buf []byte
 
-	args := InterfaceCreateArgs {
+	args := InterfaceCreateArgs{
 		Iface: Iface,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(133, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -8201,17 +11367,18 @@ func (l *Libvirt) InterfaceCreate(Iface Interface, Flags uint32) (err error) {
 func (l *Libvirt) InterfaceDestroy(Iface Interface, Flags uint32) (err error) {
 	var buf []byte
 
-	args := InterfaceDestroyArgs {
+	args := InterfaceDestroyArgs{
 		Iface: Iface,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(134, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -8224,13 +11391,15 @@ func (l *Libvirt) InterfaceDestroy(Iface Interface, Flags uint32) (err error) {
 func (l *Libvirt) ConnectDomainXMLFromNative(NativeFormat string, NativeConfig string, Flags uint32) (rDomainXML string, err error) {
 	var buf []byte
 
-	args := ConnectDomainXMLFromNativeArgs {
+	args := ConnectDomainXMLFromNativeArgs{
 		NativeFormat: NativeFormat,
 		NativeConfig: NativeConfig,
-		Flags: Flags,
+		Flags:        Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8242,10 +11411,8 @@ func (l *Libvirt) ConnectDomainXMLFromNative(NativeFormat string, NativeConfig s
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// DomainXML: string
 	_, err = dec.Decode(&rDomainXML)
 	if err != nil {
@@ -8259,13 +11426,15 @@ func (l *Libvirt) ConnectDomainXMLFromNative(NativeFormat string, NativeConfig s
 func (l *Libvirt) ConnectDomainXMLToNative(NativeFormat string, DomainXML string, Flags uint32) (rNativeConfig string, err error) {
 	var buf []byte
 
-	args := ConnectDomainXMLToNativeArgs {
+	args := ConnectDomainXMLToNativeArgs{
 		NativeFormat: NativeFormat,
-		DomainXML: DomainXML,
-		Flags: Flags,
+		DomainXML:    DomainXML,
+		Flags:        Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8277,10 +11446,8 @@ func (l *Libvirt) ConnectDomainXMLToNative(NativeFormat string, DomainXML string
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// NativeConfig: string
 	_, err = dec.Decode(&rNativeConfig)
 	if err != nil {
@@ -8301,10 +11468,8 @@ func (l *Libvirt) ConnectNumOfDefinedInterfaces() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -8318,11 +11483,13 @@ func (l *Libvirt) ConnectNumOfDefinedInterfaces() (rNum int32, err error) {
 func (l *Libvirt) ConnectListDefinedInterfaces(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListDefinedInterfacesArgs {
+	args := ConnectListDefinedInterfacesArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8334,10 +11501,8 @@ func (l *Libvirt) ConnectListDefinedInterfaces(Maxnames int32) (rNames []string,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -8358,10 +11523,8 @@ func (l *Libvirt) ConnectNumOfSecrets() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -8375,11 +11538,13 @@ func (l *Libvirt) ConnectNumOfSecrets() (rNum int32, err error) {
 func (l *Libvirt) ConnectListSecrets(Maxuuids int32) (rUuids []string, err error) {
 	var buf []byte
 
-	args := ConnectListSecretsArgs {
+	args := ConnectListSecretsArgs{
 		Maxuuids: Maxuuids,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8391,10 +11556,8 @@ func (l *Libvirt) ConnectListSecrets(Maxuuids int32) (rUuids []string, err error
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Uuids: []string
 	_, err = dec.Decode(&rUuids)
 	if err != nil {
@@ -8408,11 +11571,13 @@ func (l *Libvirt) ConnectListSecrets(Maxuuids int32) (rUuids []string, err error
 func (l *Libvirt) SecretLookupByUUID(UUID UUID) (rOptSecret Secret, err error) {
 	var buf []byte
 
-	args := SecretLookupByUUIDArgs {
+	args := SecretLookupByUUIDArgs{
 		UUID: UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8424,10 +11589,8 @@ func (l *Libvirt) SecretLookupByUUID(UUID UUID) (rOptSecret Secret, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptSecret: Secret
 	_, err = dec.Decode(&rOptSecret)
 	if err != nil {
@@ -8441,12 +11604,14 @@ func (l *Libvirt) SecretLookupByUUID(UUID UUID) (rOptSecret Secret, err error) {
 func (l *Libvirt) SecretDefineXML(XML string, Flags uint32) (rOptSecret Secret, err error) {
 	var buf []byte
 
-	args := SecretDefineXMLArgs {
-		XML: XML,
+	args := SecretDefineXMLArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8458,10 +11623,8 @@ func (l *Libvirt) SecretDefineXML(XML string, Flags uint32) (rOptSecret Secret,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptSecret: Secret
 	_, err = dec.Decode(&rOptSecret)
 	if err != nil {
@@ -8475,12 +11638,14 @@ func (l *Libvirt) SecretDefineXML(XML string, Flags uint32) (rOptSecret Secret,
 func (l *Libvirt) SecretGetXMLDesc(OptSecret Secret, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := SecretGetXMLDescArgs {
+	args := SecretGetXMLDescArgs{
 		OptSecret: OptSecret,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8492,10 +11657,8 @@ func (l *Libvirt) SecretGetXMLDesc(OptSecret Secret, Flags uint32) (rXML string,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -8509,18 +11672,19 @@ func (l *Libvirt) SecretGetXMLDesc(OptSecret Secret, Flags uint32) (rXML string,
 func (l *Libvirt) SecretSetValue(OptSecret Secret, Value []byte, Flags uint32) (err error) {
 	var buf []byte
 
-	args := SecretSetValueArgs {
+	args := SecretSetValueArgs{
 		OptSecret: OptSecret,
-		Value: Value,
-		Flags: Flags,
+		Value:     Value,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(144, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -8533,12 +11697,14 @@ func (l *Libvirt) SecretSetValue(OptSecret Secret, Value []byte, Flags uint32) (
 func (l *Libvirt) SecretGetValue(OptSecret Secret, Flags uint32) (rValue []byte, err error) {
 	var buf []byte
 
-	args := SecretGetValueArgs {
+	args := SecretGetValueArgs{
 		OptSecret: OptSecret,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8550,10 +11716,8 @@ func (l *Libvirt) SecretGetValue(OptSecret Secret, Flags uint32) (rValue []byte,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Value: []byte
 	_, err = dec.Decode(&rValue)
 	if err != nil {
@@ -8567,16 +11731,17 @@ func (l *Libvirt) SecretGetValue(OptSecret Secret, Flags uint32) (rValue []byte,
 func (l *Libvirt) SecretUndefine(OptSecret Secret) (err error) {
 	var buf []byte
 
-	args := SecretUndefineArgs {
+	args := SecretUndefineArgs{
 		OptSecret: OptSecret,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(146, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -8589,12 +11754,14 @@ func (l *Libvirt) SecretUndefine(OptSecret Secret) (err error) {
 func (l *Libvirt) SecretLookupByUsage(UsageType int32, UsageID string) (rOptSecret Secret, err error) {
 	var buf []byte
 
-	args := SecretLookupByUsageArgs {
+	args := SecretLookupByUsageArgs{
 		UsageType: UsageType,
-		UsageID: UsageID,
+		UsageID:   UsageID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8606,10 +11773,8 @@ func (l *Libvirt) SecretLookupByUsage(UsageType int32, UsageID string) (rOptSecr
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptSecret: Secret
 	_, err = dec.Decode(&rOptSecret)
 	if err != nil {
@@ -8623,19 +11788,20 @@ func (l *Libvirt) SecretLookupByUsage(UsageType int32, UsageID string) (rOptSecr
 func (l *Libvirt) DomainMigratePrepareTunnel(Flags uint64, outStream io.Reader, Dname OptString, Resource uint64, DomXML string) (err error) {
 	var buf []byte
 
-	args := DomainMigratePrepareTunnelArgs {
-		Flags: Flags,
-		Dname: Dname,
+	args := DomainMigratePrepareTunnelArgs{
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
-		DomXML: DomXML,
+		DomXML:   DomXML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(148, constants.Program, buf, outStream, nil)
 	if err != nil {
 		return
@@ -8655,10 +11821,8 @@ func (l *Libvirt) ConnectIsSecure() (rSecure int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Secure: int32
 	_, err = dec.Decode(&rSecure)
 	if err != nil {
@@ -8672,11 +11836,13 @@ func (l *Libvirt) ConnectIsSecure() (rSecure int32, err error) {
 func (l *Libvirt) DomainIsActive(Dom Domain) (rActive int32, err error) {
 	var buf []byte
 
-	args := DomainIsActiveArgs {
+	args := DomainIsActiveArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8688,10 +11854,8 @@ func (l *Libvirt) DomainIsActive(Dom Domain) (rActive int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Active: int32
 	_, err = dec.Decode(&rActive)
 	if err != nil {
@@ -8705,11 +11869,13 @@ func (l *Libvirt) DomainIsActive(Dom Domain) (rActive int32, err error) {
 func (l *Libvirt) DomainIsPersistent(Dom Domain) (rPersistent int32, err error) {
 	var buf []byte
 
-	args := DomainIsPersistentArgs {
+	args := DomainIsPersistentArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8721,10 +11887,8 @@ func (l *Libvirt) DomainIsPersistent(Dom Domain) (rPersistent int32, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Persistent: int32
 	_, err = dec.Decode(&rPersistent)
 	if err != nil {
@@ -8738,11 +11902,13 @@ func (l *Libvirt) DomainIsPersistent(Dom Domain) (rPersistent int32, err error)
 func (l *Libvirt) NetworkIsActive(Net Network) (rActive int32, err error) {
 	var buf []byte
 
-	args := NetworkIsActiveArgs {
+	args := NetworkIsActiveArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8754,10 +11920,8 @@ func (l *Libvirt) NetworkIsActive(Net Network) (rActive int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Active: int32
 	_, err = dec.Decode(&rActive)
 	if err != nil {
@@ -8771,11 +11935,13 @@ func (l *Libvirt) NetworkIsActive(Net Network) (rActive int32, err error) {
 func (l *Libvirt) NetworkIsPersistent(Net Network) (rPersistent int32, err error) {
 	var buf []byte
 
-	args := NetworkIsPersistentArgs {
+	args := NetworkIsPersistentArgs{
 		Net: Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8787,10 +11953,8 @@ func (l *Libvirt) NetworkIsPersistent(Net Network) (rPersistent int32, err error
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Persistent: int32
 	_, err = dec.Decode(&rPersistent)
 	if err != nil {
@@ -8804,11 +11968,13 @@ func (l *Libvirt) NetworkIsPersistent(Net Network) (rPersistent int32, err error
 func (l *Libvirt) StoragePoolIsActive(Pool StoragePool) (rActive int32, err error) {
 	var buf []byte
 
-	args := StoragePoolIsActiveArgs {
+	args := StoragePoolIsActiveArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8820,10 +11986,8 @@ func (l *Libvirt) StoragePoolIsActive(Pool StoragePool) (rActive int32, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Active: int32
 	_, err = dec.Decode(&rActive)
 	if err != nil {
@@ -8837,11 +12001,13 @@ func (l *Libvirt) StoragePoolIsActive(Pool StoragePool) (rActive int32, err erro
 func (l *Libvirt) StoragePoolIsPersistent(Pool StoragePool) (rPersistent int32, err error) {
 	var buf []byte
 
-	args := StoragePoolIsPersistentArgs {
+	args := StoragePoolIsPersistentArgs{
 		Pool: Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8853,10 +12019,8 @@ func (l *Libvirt) StoragePoolIsPersistent(Pool StoragePool) (rPersistent int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Persistent: int32
 	_, err = dec.Decode(&rPersistent)
 	if err != nil {
@@ -8870,11 +12034,13 @@ func (l *Libvirt) StoragePoolIsPersistent(Pool StoragePool) (rPersistent int32,
 func (l *Libvirt) InterfaceIsActive(Iface Interface) (rActive int32, err error) {
 	var buf []byte
 
-	args := InterfaceIsActiveArgs {
+	args := InterfaceIsActiveArgs{
 		Iface: Iface,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8886,10 +12052,8 @@ func (l *Libvirt) InterfaceIsActive(Iface Interface) (rActive int32, err error)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Active: int32
 	_, err = dec.Decode(&rActive)
 	if err != nil {
@@ -8910,10 +12074,8 @@ func (l *Libvirt) ConnectGetLibVersion() (rLibVer uint64, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// LibVer: uint64
 	_, err = dec.Decode(&rLibVer)
 	if err != nil {
@@ -8927,12 +12089,14 @@ func (l *Libvirt) ConnectGetLibVersion() (rLibVer uint64, err error) {
 func (l *Libvirt) ConnectCompareCPU(XML string, Flags ConnectCompareCPUFlags) (rResult int32, err error) {
 	var buf []byte
 
-	args := ConnectCompareCPUArgs {
-		XML: XML,
+	args := ConnectCompareCPUArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8944,10 +12108,8 @@ func (l *Libvirt) ConnectCompareCPU(XML string, Flags ConnectCompareCPUFlags) (r
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: int32
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -8961,13 +12123,15 @@ func (l *Libvirt) ConnectCompareCPU(XML string, Flags ConnectCompareCPUFlags) (r
 func (l *Libvirt) DomainMemoryStats(Dom Domain, MaxStats uint32, Flags uint32) (rStats []DomainMemoryStat, err error) {
 	var buf []byte
 
-	args := DomainMemoryStatsArgs {
-		Dom: Dom,
+	args := DomainMemoryStatsArgs{
+		Dom:      Dom,
 		MaxStats: MaxStats,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -8979,10 +12143,8 @@ func (l *Libvirt) DomainMemoryStats(Dom Domain, MaxStats uint32, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Stats: []DomainMemoryStat
 	_, err = dec.Decode(&rStats)
 	if err != nil {
@@ -8993,21 +12155,22 @@ func (l *Libvirt) DomainMemoryStats(Dom Domain, MaxStats uint32, Flags uint32) (
 }
 
 // DomainAttachDeviceFlags is the go wrapper for REMOTE_PROC_DOMAIN_ATTACH_DEVICE_FLAGS.
-func (l *Libvirt) DomainAttachDeviceFlags(Dom Domain, XML string, Flags uint32) (err error) {
+func (l *Libvirt) DomainAttachDeviceFlags(Dom Domain, XML string, Flags DomainDeviceModifyFlags) (err error) {
 	var buf []byte
 
-	args := DomainAttachDeviceFlagsArgs {
-		Dom: Dom,
-		XML: XML,
+	args := DomainAttachDeviceFlagsArgs{
+		Dom:   Dom,
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(160, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9017,21 +12180,22 @@ func (l *Libvirt) DomainAttachDeviceFlags(Dom Domain, XML string, Flags uint32)
 }
 
 // DomainDetachDeviceFlags is the go wrapper for REMOTE_PROC_DOMAIN_DETACH_DEVICE_FLAGS.
-func (l *Libvirt) DomainDetachDeviceFlags(Dom Domain, XML string, Flags uint32) (err error) {
+func (l *Libvirt) DomainDetachDeviceFlags(Dom Domain, XML string, Flags DomainDeviceModifyFlags) (err error) {
 	var buf []byte
 
-	args := DomainDetachDeviceFlagsArgs {
-		Dom: Dom,
-		XML: XML,
+	args := DomainDetachDeviceFlagsArgs{
+		Dom:   Dom,
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(161, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9044,12 +12208,14 @@ func (l *Libvirt) DomainDetachDeviceFlags(Dom Domain, XML string, Flags uint32)
 func (l *Libvirt) ConnectBaselineCPU(XMLCPUs []string, Flags ConnectBaselineCPUFlags) (rCPU string, err error) {
 	var buf []byte
 
-	args := ConnectBaselineCPUArgs {
+	args := ConnectBaselineCPUArgs{
 		XMLCPUs: XMLCPUs,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9061,10 +12227,8 @@ func (l *Libvirt) ConnectBaselineCPU(XMLCPUs []string, Flags ConnectBaselineCPUF
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CPU: string
 	_, err = dec.Decode(&rCPU)
 	if err != nil {
@@ -9078,11 +12242,13 @@ func (l *Libvirt) ConnectBaselineCPU(XMLCPUs []string, Flags ConnectBaselineCPUF
 func (l *Libvirt) DomainGetJobInfo(Dom Domain) (rType int32, rTimeElapsed uint64, rTimeRemaining uint64, rDataTotal uint64, rDataProcessed uint64, rDataRemaining uint64, rMemTotal uint64, rMemProcessed uint64, rMemRemaining uint64, rFileTotal uint64, rFileProcessed uint64, rFileRemaining uint64, err error) {
 	var buf []byte
 
-	args := DomainGetJobInfoArgs {
+	args := DomainGetJobInfoArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9094,10 +12260,8 @@ func (l *Libvirt) DomainGetJobInfo(Dom Domain) (rType int32, rTimeElapsed uint64
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: int32
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -9166,16 +12330,17 @@ func (l *Libvirt) DomainGetJobInfo(Dom Domain) (rType int32, rTimeElapsed uint64
 func (l *Libvirt) DomainAbortJob(Dom Domain) (err error) {
 	var buf []byte
 
-	args := DomainAbortJobArgs {
+	args := DomainAbortJobArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(164, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9188,17 +12353,18 @@ func (l *Libvirt) DomainAbortJob(Dom Domain) (err error) {
 func (l *Libvirt) StorageVolWipe(Vol StorageVol, Flags uint32) (err error) {
 	var buf []byte
 
-	args := StorageVolWipeArgs {
-		Vol: Vol,
+	args := StorageVolWipeArgs{
+		Vol:   Vol,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(165, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9211,18 +12377,19 @@ func (l *Libvirt) StorageVolWipe(Vol StorageVol, Flags uint32) (err error) {
 func (l *Libvirt) DomainMigrateSetMaxDowntime(Dom Domain, Downtime uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainMigrateSetMaxDowntimeArgs {
-		Dom: Dom,
+	args := DomainMigrateSetMaxDowntimeArgs{
+		Dom:      Dom,
 		Downtime: Downtime,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(166, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9235,16 +12402,17 @@ func (l *Libvirt) DomainMigrateSetMaxDowntime(Dom Domain, Downtime uint64, Flags
 func (l *Libvirt) ConnectDomainEventRegisterAny(EventID int32) (err error) {
 	var buf []byte
 
-	args := ConnectDomainEventRegisterAnyArgs {
+	args := ConnectDomainEventRegisterAnyArgs{
 		EventID: EventID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(167, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9257,16 +12425,17 @@ func (l *Libvirt) ConnectDomainEventRegisterAny(EventID int32) (err error) {
 func (l *Libvirt) ConnectDomainEventDeregisterAny(EventID int32) (err error) {
 	var buf []byte
 
-	args := ConnectDomainEventDeregisterAnyArgs {
+	args := ConnectDomainEventDeregisterAnyArgs{
 		EventID: EventID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(168, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9279,7 +12448,6 @@ func (l *Libvirt) ConnectDomainEventDeregisterAny(EventID int32) (err error) {
 func (l *Libvirt) DomainEventReboot() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(169, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9292,7 +12460,6 @@ func (l *Libvirt) DomainEventReboot() (err error) {
 func (l *Libvirt) DomainEventRtcChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(170, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9305,7 +12472,6 @@ func (l *Libvirt) DomainEventRtcChange() (err error) {
 func (l *Libvirt) DomainEventWatchdog() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(171, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9318,7 +12484,6 @@ func (l *Libvirt) DomainEventWatchdog() (err error) {
 func (l *Libvirt) DomainEventIOError() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(172, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9331,7 +12496,6 @@ func (l *Libvirt) DomainEventIOError() (err error) {
 func (l *Libvirt) DomainEventGraphics() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(173, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9344,18 +12508,19 @@ func (l *Libvirt) DomainEventGraphics() (err error) {
 func (l *Libvirt) DomainUpdateDeviceFlags(Dom Domain, XML string, Flags DomainDeviceModifyFlags) (err error) {
 	var buf []byte
 
-	args := DomainUpdateDeviceFlagsArgs {
-		Dom: Dom,
-		XML: XML,
+	args := DomainUpdateDeviceFlagsArgs{
+		Dom:   Dom,
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(174, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9368,11 +12533,13 @@ func (l *Libvirt) DomainUpdateDeviceFlags(Dom Domain, XML string, Flags DomainDe
 func (l *Libvirt) NwfilterLookupByName(Name string) (rOptNwfilter Nwfilter, err error) {
 	var buf []byte
 
-	args := NwfilterLookupByNameArgs {
+	args := NwfilterLookupByNameArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9384,10 +12551,8 @@ func (l *Libvirt) NwfilterLookupByName(Name string) (rOptNwfilter Nwfilter, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptNwfilter: Nwfilter
 	_, err = dec.Decode(&rOptNwfilter)
 	if err != nil {
@@ -9401,11 +12566,13 @@ func (l *Libvirt) NwfilterLookupByName(Name string) (rOptNwfilter Nwfilter, err
 func (l *Libvirt) NwfilterLookupByUUID(UUID UUID) (rOptNwfilter Nwfilter, err error) {
 	var buf []byte
 
-	args := NwfilterLookupByUUIDArgs {
+	args := NwfilterLookupByUUIDArgs{
 		UUID: UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9417,10 +12584,8 @@ func (l *Libvirt) NwfilterLookupByUUID(UUID UUID) (rOptNwfilter Nwfilter, err er
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptNwfilter: Nwfilter
 	_, err = dec.Decode(&rOptNwfilter)
 	if err != nil {
@@ -9434,12 +12599,14 @@ func (l *Libvirt) NwfilterLookupByUUID(UUID UUID) (rOptNwfilter Nwfilter, err er
 func (l *Libvirt) NwfilterGetXMLDesc(OptNwfilter Nwfilter, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := NwfilterGetXMLDescArgs {
+	args := NwfilterGetXMLDescArgs{
 		OptNwfilter: OptNwfilter,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9451,10 +12618,8 @@ func (l *Libvirt) NwfilterGetXMLDesc(OptNwfilter Nwfilter, Flags uint32) (rXML s
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -9475,10 +12640,8 @@ func (l *Libvirt) ConnectNumOfNwfilters() (rNum int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -9492,11 +12655,13 @@ func (l *Libvirt) ConnectNumOfNwfilters() (rNum int32, err error) {
 func (l *Libvirt) ConnectListNwfilters(Maxnames int32) (rNames []string, err error) {
 	var buf []byte
 
-	args := ConnectListNwfiltersArgs {
+	args := ConnectListNwfiltersArgs{
 		Maxnames: Maxnames,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9508,10 +12673,8 @@ func (l *Libvirt) ConnectListNwfilters(Maxnames int32) (rNames []string, err err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -9525,11 +12688,13 @@ func (l *Libvirt) ConnectListNwfilters(Maxnames int32) (rNames []string, err err
 func (l *Libvirt) NwfilterDefineXML(XML string) (rOptNwfilter Nwfilter, err error) {
 	var buf []byte
 
-	args := NwfilterDefineXMLArgs {
+	args := NwfilterDefineXMLArgs{
 		XML: XML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9541,10 +12706,8 @@ func (l *Libvirt) NwfilterDefineXML(XML string) (rOptNwfilter Nwfilter, err erro
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptNwfilter: Nwfilter
 	_, err = dec.Decode(&rOptNwfilter)
 	if err != nil {
@@ -9558,16 +12721,17 @@ func (l *Libvirt) NwfilterDefineXML(XML string) (rOptNwfilter Nwfilter, err erro
 func (l *Libvirt) NwfilterUndefine(OptNwfilter Nwfilter) (err error) {
 	var buf []byte
 
-	args := NwfilterUndefineArgs {
+	args := NwfilterUndefineArgs{
 		OptNwfilter: OptNwfilter,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(181, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9577,20 +12741,21 @@ func (l *Libvirt) NwfilterUndefine(OptNwfilter Nwfilter) (err error) {
 }
 
 // DomainManagedSave is the go wrapper for REMOTE_PROC_DOMAIN_MANAGED_SAVE.
-func (l *Libvirt) DomainManagedSave(Dom Domain, Flags uint32) (err error) {
+func (l *Libvirt) DomainManagedSave(Dom Domain, Flags DomainSaveRestoreFlags) (err error) {
 	var buf []byte
 
-	args := DomainManagedSaveArgs {
-		Dom: Dom,
+	args := DomainManagedSaveArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(182, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9603,12 +12768,14 @@ func (l *Libvirt) DomainManagedSave(Dom Domain, Flags uint32) (err error) {
 func (l *Libvirt) DomainHasManagedSaveImage(Dom Domain, Flags uint32) (rResult int32, err error) {
 	var buf []byte
 
-	args := DomainHasManagedSaveImageArgs {
-		Dom: Dom,
+	args := DomainHasManagedSaveImageArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9620,10 +12787,8 @@ func (l *Libvirt) DomainHasManagedSaveImage(Dom Domain, Flags uint32) (rResult i
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: int32
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -9637,17 +12802,18 @@ func (l *Libvirt) DomainHasManagedSaveImage(Dom Domain, Flags uint32) (rResult i
 func (l *Libvirt) DomainManagedSaveRemove(Dom Domain, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainManagedSaveRemoveArgs {
-		Dom: Dom,
+	args := DomainManagedSaveRemoveArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(184, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9657,16 +12823,18 @@ func (l *Libvirt) DomainManagedSaveRemove(Dom Domain, Flags uint32) (err error)
 }
 
 // DomainSnapshotCreateXML is the go wrapper for REMOTE_PROC_DOMAIN_SNAPSHOT_CREATE_XML.
-func (l *Libvirt) DomainSnapshotCreateXML(Dom Domain, XMLDesc string, Flags uint32) (rSnap DomainSnapshot, err error) {
+func (l *Libvirt) DomainSnapshotCreateXML(Dom Domain, XMLDesc string, Flags DomainSnapshotCreateFlags) (rSnap DomainSnapshot, err error) {
 	var buf []byte
 
-	args := DomainSnapshotCreateXMLArgs {
-		Dom: Dom,
+	args := DomainSnapshotCreateXMLArgs{
+		Dom:     Dom,
 		XMLDesc: XMLDesc,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9678,10 +12846,8 @@ func (l *Libvirt) DomainSnapshotCreateXML(Dom Domain, XMLDesc string, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snap: DomainSnapshot
 	_, err = dec.Decode(&rSnap)
 	if err != nil {
@@ -9692,15 +12858,17 @@ func (l *Libvirt) DomainSnapshotCreateXML(Dom Domain, XMLDesc string, Flags uint
 }
 
 // DomainSnapshotGetXMLDesc is the go wrapper for REMOTE_PROC_DOMAIN_SNAPSHOT_GET_XML_DESC.
-func (l *Libvirt) DomainSnapshotGetXMLDesc(Snap DomainSnapshot, Flags uint32) (rXML string, err error) {
+func (l *Libvirt) DomainSnapshotGetXMLDesc(Snap DomainSnapshot, Flags DomainSnapshotXMLFlags) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainSnapshotGetXMLDescArgs {
-		Snap: Snap,
+	args := DomainSnapshotGetXMLDescArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9712,10 +12880,8 @@ func (l *Libvirt) DomainSnapshotGetXMLDesc(Snap DomainSnapshot, Flags uint32) (r
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -9729,12 +12895,14 @@ func (l *Libvirt) DomainSnapshotGetXMLDesc(Snap DomainSnapshot, Flags uint32) (r
 func (l *Libvirt) DomainSnapshotNum(Dom Domain, Flags uint32) (rNum int32, err error) {
 	var buf []byte
 
-	args := DomainSnapshotNumArgs {
-		Dom: Dom,
+	args := DomainSnapshotNumArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9746,10 +12914,8 @@ func (l *Libvirt) DomainSnapshotNum(Dom Domain, Flags uint32) (rNum int32, err e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -9763,13 +12929,15 @@ func (l *Libvirt) DomainSnapshotNum(Dom Domain, Flags uint32) (rNum int32, err e
 func (l *Libvirt) DomainSnapshotListNames(Dom Domain, Maxnames int32, Flags uint32) (rNames []string, err error) {
 	var buf []byte
 
-	args := DomainSnapshotListNamesArgs {
-		Dom: Dom,
+	args := DomainSnapshotListNamesArgs{
+		Dom:      Dom,
 		Maxnames: Maxnames,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9781,10 +12949,8 @@ func (l *Libvirt) DomainSnapshotListNames(Dom Domain, Maxnames int32, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -9798,13 +12964,15 @@ func (l *Libvirt) DomainSnapshotListNames(Dom Domain, Maxnames int32, Flags uint
 func (l *Libvirt) DomainSnapshotLookupByName(Dom Domain, Name string, Flags uint32) (rSnap DomainSnapshot, err error) {
 	var buf []byte
 
-	args := DomainSnapshotLookupByNameArgs {
-		Dom: Dom,
-		Name: Name,
+	args := DomainSnapshotLookupByNameArgs{
+		Dom:   Dom,
+		Name:  Name,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9816,10 +12984,8 @@ func (l *Libvirt) DomainSnapshotLookupByName(Dom Domain, Name string, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snap: DomainSnapshot
 	_, err = dec.Decode(&rSnap)
 	if err != nil {
@@ -9833,12 +12999,14 @@ func (l *Libvirt) DomainSnapshotLookupByName(Dom Domain, Name string, Flags uint
 func (l *Libvirt) DomainHasCurrentSnapshot(Dom Domain, Flags uint32) (rResult int32, err error) {
 	var buf []byte
 
-	args := DomainHasCurrentSnapshotArgs {
-		Dom: Dom,
+	args := DomainHasCurrentSnapshotArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9850,10 +13018,8 @@ func (l *Libvirt) DomainHasCurrentSnapshot(Dom Domain, Flags uint32) (rResult in
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: int32
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -9867,12 +13033,14 @@ func (l *Libvirt) DomainHasCurrentSnapshot(Dom Domain, Flags uint32) (rResult in
 func (l *Libvirt) DomainSnapshotCurrent(Dom Domain, Flags uint32) (rSnap DomainSnapshot, err error) {
 	var buf []byte
 
-	args := DomainSnapshotCurrentArgs {
-		Dom: Dom,
+	args := DomainSnapshotCurrentArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9884,10 +13052,8 @@ func (l *Libvirt) DomainSnapshotCurrent(Dom Domain, Flags uint32) (rSnap DomainS
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snap: DomainSnapshot
 	_, err = dec.Decode(&rSnap)
 	if err != nil {
@@ -9898,20 +13064,21 @@ func (l *Libvirt) DomainSnapshotCurrent(Dom Domain, Flags uint32) (rSnap DomainS
 }
 
 // DomainRevertToSnapshot is the go wrapper for REMOTE_PROC_DOMAIN_REVERT_TO_SNAPSHOT.
-func (l *Libvirt) DomainRevertToSnapshot(Snap DomainSnapshot, Flags uint32) (err error) {
+func (l *Libvirt) DomainRevertToSnapshot(Snap DomainSnapshot, Flags DomainSnapshotRevertFlags) (err error) {
 	var buf []byte
 
-	args := DomainRevertToSnapshotArgs {
-		Snap: Snap,
+	args := DomainRevertToSnapshotArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(192, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9924,17 +13091,18 @@ func (l *Libvirt) DomainRevertToSnapshot(Snap DomainSnapshot, Flags uint32) (err
 func (l *Libvirt) DomainSnapshotDelete(Snap DomainSnapshot, Flags DomainSnapshotDeleteFlags) (err error) {
 	var buf []byte
 
-	args := DomainSnapshotDeleteArgs {
-		Snap: Snap,
+	args := DomainSnapshotDeleteArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(193, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -9947,13 +13115,15 @@ func (l *Libvirt) DomainSnapshotDelete(Snap DomainSnapshot, Flags DomainSnapshot
 func (l *Libvirt) DomainGetBlockInfo(Dom Domain, Path string, Flags uint32) (rAllocation uint64, rCapacity uint64, rPhysical uint64, err error) {
 	var buf []byte
 
-	args := DomainGetBlockInfoArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainGetBlockInfoArgs{
+		Dom:   Dom,
+		Path:  Path,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -9965,10 +13135,8 @@ func (l *Libvirt) DomainGetBlockInfo(Dom Domain, Path string, Flags uint32) (rAl
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Allocation: uint64
 	_, err = dec.Decode(&rAllocation)
 	if err != nil {
@@ -9992,7 +13160,6 @@ func (l *Libvirt) DomainGetBlockInfo(Dom Domain, Path string, Flags uint32) (rAl
 func (l *Libvirt) DomainEventIOErrorReason() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(195, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10002,15 +13169,17 @@ func (l *Libvirt) DomainEventIOErrorReason() (err error) {
 }
 
 // DomainCreateWithFlags is the go wrapper for REMOTE_PROC_DOMAIN_CREATE_WITH_FLAGS.
-func (l *Libvirt) DomainCreateWithFlags(Dom Domain, Flags uint32) (rDom Domain, err error) {
+func (l *Libvirt) DomainCreateWithFlags(Dom Domain, Flags DomainCreateFlags) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainCreateWithFlagsArgs {
-		Dom: Dom,
+	args := DomainCreateWithFlagsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10022,10 +13191,8 @@ func (l *Libvirt) DomainCreateWithFlags(Dom Domain, Flags uint32) (rDom Domain,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -10039,18 +13206,19 @@ func (l *Libvirt) DomainCreateWithFlags(Dom Domain, Flags uint32) (rDom Domain,
 func (l *Libvirt) DomainSetMemoryParameters(Dom Domain, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetMemoryParametersArgs {
-		Dom: Dom,
+	args := DomainSetMemoryParametersArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(197, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10063,13 +13231,15 @@ func (l *Libvirt) DomainSetMemoryParameters(Dom Domain, Params []TypedParam, Fla
 func (l *Libvirt) DomainGetMemoryParameters(Dom Domain, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetMemoryParametersArgs {
-		Dom: Dom,
+	args := DomainGetMemoryParametersArgs{
+		Dom:     Dom,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10081,10 +13251,8 @@ func (l *Libvirt) DomainGetMemoryParameters(Dom Domain, Nparams int32, Flags uin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -10100,21 +13268,22 @@ func (l *Libvirt) DomainGetMemoryParameters(Dom Domain, Nparams int32, Flags uin
 }
 
 // DomainSetVcpusFlags is the go wrapper for REMOTE_PROC_DOMAIN_SET_VCPUS_FLAGS.
-func (l *Libvirt) DomainSetVcpusFlags(Dom Domain, Nvcpus uint32, Flags uint32) (err error) {
+func (l *Libvirt) DomainSetVcpusFlags(Dom Domain, Nvcpus uint32, Flags DomainVCPUFlags) (err error) {
 	var buf []byte
 
-	args := DomainSetVcpusFlagsArgs {
-		Dom: Dom,
+	args := DomainSetVcpusFlagsArgs{
+		Dom:    Dom,
 		Nvcpus: Nvcpus,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(199, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10124,15 +13293,17 @@ func (l *Libvirt) DomainSetVcpusFlags(Dom Domain, Nvcpus uint32, Flags uint32) (
 }
 
 // DomainGetVcpusFlags is the go wrapper for REMOTE_PROC_DOMAIN_GET_VCPUS_FLAGS.
-func (l *Libvirt) DomainGetVcpusFlags(Dom Domain, Flags uint32) (rNum int32, err error) {
+func (l *Libvirt) DomainGetVcpusFlags(Dom Domain, Flags DomainVCPUFlags) (rNum int32, err error) {
 	var buf []byte
 
-	args := DomainGetVcpusFlagsArgs {
-		Dom: Dom,
+	args := DomainGetVcpusFlagsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10144,10 +13315,8 @@ func (l *Libvirt) DomainGetVcpusFlags(Dom Domain, Flags uint32) (rNum int32, err
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -10161,18 +13330,19 @@ func (l *Libvirt) DomainGetVcpusFlags(Dom Domain, Flags uint32) (rNum int32, err
 func (l *Libvirt) DomainOpenConsole(Dom Domain, DevName OptString, inStream io.Writer, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainOpenConsoleArgs {
-		Dom: Dom,
+	args := DomainOpenConsoleArgs{
+		Dom:     Dom,
 		DevName: DevName,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(201, constants.Program, buf, nil, inStream)
 	if err != nil {
 		return
@@ -10185,11 +13355,13 @@ func (l *Libvirt) DomainOpenConsole(Dom Domain, DevName OptString, inStream io.W
 func (l *Libvirt) DomainIsUpdated(Dom Domain) (rUpdated int32, err error) {
 	var buf []byte
 
-	args := DomainIsUpdatedArgs {
+	args := DomainIsUpdatedArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10201,10 +13373,8 @@ func (l *Libvirt) DomainIsUpdated(Dom Domain) (rUpdated int32, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Updated: int32
 	_, err = dec.Decode(&rUpdated)
 	if err != nil {
@@ -10218,11 +13388,13 @@ func (l *Libvirt) DomainIsUpdated(Dom Domain) (rUpdated int32, err error) {
 func (l *Libvirt) ConnectGetSysinfo(Flags uint32) (rSysinfo string, err error) {
 	var buf []byte
 
-	args := ConnectGetSysinfoArgs {
+	args := ConnectGetSysinfoArgs{
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10234,10 +13406,8 @@ func (l *Libvirt) ConnectGetSysinfo(Flags uint32) (rSysinfo string, err error) {
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Sysinfo: string
 	_, err = dec.Decode(&rSysinfo)
 	if err != nil {
@@ -10251,18 +13421,19 @@ func (l *Libvirt) ConnectGetSysinfo(Flags uint32) (rSysinfo string, err error) {
 func (l *Libvirt) DomainSetMemoryFlags(Dom Domain, Memory uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetMemoryFlagsArgs {
-		Dom: Dom,
+	args := DomainSetMemoryFlagsArgs{
+		Dom:    Dom,
 		Memory: Memory,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(204, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10275,18 +13446,19 @@ func (l *Libvirt) DomainSetMemoryFlags(Dom Domain, Memory uint64, Flags uint32)
 func (l *Libvirt) DomainSetBlkioParameters(Dom Domain, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetBlkioParametersArgs {
-		Dom: Dom,
+	args := DomainSetBlkioParametersArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(205, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10299,13 +13471,15 @@ func (l *Libvirt) DomainSetBlkioParameters(Dom Domain, Params []TypedParam, Flag
 func (l *Libvirt) DomainGetBlkioParameters(Dom Domain, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetBlkioParametersArgs {
-		Dom: Dom,
+	args := DomainGetBlkioParametersArgs{
+		Dom:     Dom,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10317,10 +13491,8 @@ func (l *Libvirt) DomainGetBlkioParameters(Dom Domain, Nparams int32, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -10336,21 +13508,22 @@ func (l *Libvirt) DomainGetBlkioParameters(Dom Domain, Nparams int32, Flags uint
 }
 
 // DomainMigrateSetMaxSpeed is the go wrapper for REMOTE_PROC_DOMAIN_MIGRATE_SET_MAX_SPEED.
-func (l *Libvirt) DomainMigrateSetMaxSpeed(Dom Domain, Bandwidth uint64, Flags uint32) (err error) {
+func (l *Libvirt) DomainMigrateSetMaxSpeed(Dom Domain, Bandwidth uint64, Flags DomainMigrateMaxSpeedFlags) (err error) {
 	var buf []byte
 
-	args := DomainMigrateSetMaxSpeedArgs {
-		Dom: Dom,
+	args := DomainMigrateSetMaxSpeedArgs{
+		Dom:       Dom,
 		Bandwidth: Bandwidth,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(207, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10363,19 +13536,20 @@ func (l *Libvirt) DomainMigrateSetMaxSpeed(Dom Domain, Bandwidth uint64, Flags u
 func (l *Libvirt) StorageVolUpload(Vol StorageVol, outStream io.Reader, Offset uint64, Length uint64, Flags StorageVolUploadFlags) (err error) {
 	var buf []byte
 
-	args := StorageVolUploadArgs {
-		Vol: Vol,
+	args := StorageVolUploadArgs{
+		Vol:    Vol,
 		Offset: Offset,
 		Length: Length,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(208, constants.Program, buf, outStream, nil)
 	if err != nil {
 		return
@@ -10388,19 +13562,20 @@ func (l *Libvirt) StorageVolUpload(Vol StorageVol, outStream io.Reader, Offset u
 func (l *Libvirt) StorageVolDownload(Vol StorageVol, inStream io.Writer, Offset uint64, Length uint64, Flags StorageVolDownloadFlags) (err error) {
 	var buf []byte
 
-	args := StorageVolDownloadArgs {
-		Vol: Vol,
+	args := StorageVolDownloadArgs{
+		Vol:    Vol,
 		Offset: Offset,
 		Length: Length,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(209, constants.Program, buf, nil, inStream)
 	if err != nil {
 		return
@@ -10413,17 +13588,18 @@ func (l *Libvirt) StorageVolDownload(Vol StorageVol, inStream io.Writer, Offset
 func (l *Libvirt) DomainInjectNmi(Dom Domain, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainInjectNmiArgs {
-		Dom: Dom,
+	args := DomainInjectNmiArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(210, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10436,13 +13612,15 @@ func (l *Libvirt) DomainInjectNmi(Dom Domain, Flags uint32) (err error) {
 func (l *Libvirt) DomainScreenshot(Dom Domain, inStream io.Writer, Screen uint32, Flags uint32) (rMime OptString, err error) {
 	var buf []byte
 
-	args := DomainScreenshotArgs {
-		Dom: Dom,
+	args := DomainScreenshotArgs{
+		Dom:    Dom,
 		Screen: Screen,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10454,10 +13632,8 @@ func (l *Libvirt) DomainScreenshot(Dom Domain, inStream io.Writer, Screen uint32
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Mime: OptString
 	_, err = dec.Decode(&rMime)
 	if err != nil {
@@ -10471,12 +13647,14 @@ func (l *Libvirt) DomainScreenshot(Dom Domain, inStream io.Writer, Screen uint32
 func (l *Libvirt) DomainGetState(Dom Domain, Flags uint32) (rState int32, rReason int32, err error) {
 	var buf []byte
 
-	args := DomainGetStateArgs {
-		Dom: Dom,
+	args := DomainGetStateArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10488,10 +13666,8 @@ func (l *Libvirt) DomainGetState(Dom Domain, Flags uint32) (rState int32, rReaso
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// State: int32
 	_, err = dec.Decode(&rState)
 	if err != nil {
@@ -10510,15 +13686,17 @@ func (l *Libvirt) DomainGetState(Dom Domain, Flags uint32) (rState int32, rReaso
 func (l *Libvirt) DomainMigrateBegin3(Dom Domain, Xmlin OptString, Flags uint64, Dname OptString, Resource uint64) (rCookieOut []byte, rXML string, err error) {
 	var buf []byte
 
-	args := DomainMigrateBegin3Args {
-		Dom: Dom,
-		Xmlin: Xmlin,
-		Flags: Flags,
-		Dname: Dname,
+	args := DomainMigrateBegin3Args{
+		Dom:      Dom,
+		Xmlin:    Xmlin,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10530,10 +13708,8 @@ func (l *Libvirt) DomainMigrateBegin3(Dom Domain, Xmlin OptString, Flags uint64,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -10552,16 +13728,18 @@ func (l *Libvirt) DomainMigrateBegin3(Dom Domain, Xmlin OptString, Flags uint64,
 func (l *Libvirt) DomainMigratePrepare3(CookieIn []byte, UriIn OptString, Flags uint64, Dname OptString, Resource uint64, DomXML string) (rCookieOut []byte, rUriOut OptString, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepare3Args {
+	args := DomainMigratePrepare3Args{
 		CookieIn: CookieIn,
-		UriIn: UriIn,
-		Flags: Flags,
-		Dname: Dname,
+		UriIn:    UriIn,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
-		DomXML: DomXML,
+		DomXML:   DomXML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10573,10 +13751,8 @@ func (l *Libvirt) DomainMigratePrepare3(CookieIn []byte, UriIn OptString, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -10595,15 +13771,17 @@ func (l *Libvirt) DomainMigratePrepare3(CookieIn []byte, UriIn OptString, Flags
 func (l *Libvirt) DomainMigratePrepareTunnel3(CookieIn []byte, outStream io.Reader, Flags uint64, Dname OptString, Resource uint64, DomXML string) (rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepareTunnel3Args {
+	args := DomainMigratePrepareTunnel3Args{
 		CookieIn: CookieIn,
-		Flags: Flags,
-		Dname: Dname,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
-		DomXML: DomXML,
+		DomXML:   DomXML,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10615,10 +13793,8 @@ func (l *Libvirt) DomainMigratePrepareTunnel3(CookieIn []byte, outStream io.Read
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -10632,18 +13808,20 @@ func (l *Libvirt) DomainMigratePrepareTunnel3(CookieIn []byte, outStream io.Read
 func (l *Libvirt) DomainMigratePerform3(Dom Domain, Xmlin OptString, CookieIn []byte, Dconnuri OptString, Uri OptString, Flags uint64, Dname OptString, Resource uint64) (rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigratePerform3Args {
-		Dom: Dom,
-		Xmlin: Xmlin,
+	args := DomainMigratePerform3Args{
+		Dom:      Dom,
+		Xmlin:    Xmlin,
 		CookieIn: CookieIn,
 		Dconnuri: Dconnuri,
-		Uri: Uri,
-		Flags: Flags,
-		Dname: Dname,
+		Uri:      Uri,
+		Flags:    Flags,
+		Dname:    Dname,
 		Resource: Resource,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10655,10 +13833,8 @@ func (l *Libvirt) DomainMigratePerform3(Dom Domain, Xmlin OptString, CookieIn []
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -10672,16 +13848,18 @@ func (l *Libvirt) DomainMigratePerform3(Dom Domain, Xmlin OptString, CookieIn []
 func (l *Libvirt) DomainMigrateFinish3(Dname string, CookieIn []byte, Dconnuri OptString, Uri OptString, Flags uint64, Cancelled int32) (rDom Domain, rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigrateFinish3Args {
-		Dname: Dname,
-		CookieIn: CookieIn,
-		Dconnuri: Dconnuri,
-		Uri: Uri,
-		Flags: Flags,
+	args := DomainMigrateFinish3Args{
+		Dname:     Dname,
+		CookieIn:  CookieIn,
+		Dconnuri:  Dconnuri,
+		Uri:       Uri,
+		Flags:     Flags,
 		Cancelled: Cancelled,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10693,10 +13871,8 @@ func (l *Libvirt) DomainMigrateFinish3(Dname string, CookieIn []byte, Dconnuri O
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -10715,19 +13891,20 @@ func (l *Libvirt) DomainMigrateFinish3(Dname string, CookieIn []byte, Dconnuri O
 func (l *Libvirt) DomainMigrateConfirm3(Dom Domain, CookieIn []byte, Flags uint64, Cancelled int32) (err error) {
 	var buf []byte
 
-	args := DomainMigrateConfirm3Args {
-		Dom: Dom,
-		CookieIn: CookieIn,
-		Flags: Flags,
+	args := DomainMigrateConfirm3Args{
+		Dom:       Dom,
+		CookieIn:  CookieIn,
+		Flags:     Flags,
 		Cancelled: Cancelled,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(218, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10737,21 +13914,22 @@ func (l *Libvirt) DomainMigrateConfirm3(Dom Domain, CookieIn []byte, Flags uint6
 }
 
 // DomainSetSchedulerParametersFlags is the go wrapper for REMOTE_PROC_DOMAIN_SET_SCHEDULER_PARAMETERS_FLAGS.
-func (l *Libvirt) DomainSetSchedulerParametersFlags(Dom Domain, Params []TypedParam, Flags uint32) (err error) {
+func (l *Libvirt) DomainSetSchedulerParametersFlags(Dom Domain, Params []TypedParam, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainSetSchedulerParametersFlagsArgs {
-		Dom: Dom,
+	args := DomainSetSchedulerParametersFlagsArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(219, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10764,16 +13942,17 @@ func (l *Libvirt) DomainSetSchedulerParametersFlags(Dom Domain, Params []TypedPa
 func (l *Libvirt) InterfaceChangeBegin(Flags uint32) (err error) {
 	var buf []byte
 
-	args := InterfaceChangeBeginArgs {
+	args := InterfaceChangeBeginArgs{
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(220, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10786,16 +13965,17 @@ func (l *Libvirt) InterfaceChangeBegin(Flags uint32) (err error) {
 func (l *Libvirt) InterfaceChangeCommit(Flags uint32) (err error) {
 	var buf []byte
 
-	args := InterfaceChangeCommitArgs {
+	args := InterfaceChangeCommitArgs{
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(221, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10808,16 +13988,17 @@ func (l *Libvirt) InterfaceChangeCommit(Flags uint32) (err error) {
 func (l *Libvirt) InterfaceChangeRollback(Flags uint32) (err error) {
 	var buf []byte
 
-	args := InterfaceChangeRollbackArgs {
+	args := InterfaceChangeRollbackArgs{
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(222, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10827,16 +14008,18 @@ func (l *Libvirt) InterfaceChangeRollback(Flags uint32) (err error) {
 }
 
 // DomainGetSchedulerParametersFlags is the go wrapper for REMOTE_PROC_DOMAIN_GET_SCHEDULER_PARAMETERS_FLAGS.
-func (l *Libvirt) DomainGetSchedulerParametersFlags(Dom Domain, Nparams int32, Flags uint32) (rParams []TypedParam, err error) {
+func (l *Libvirt) DomainGetSchedulerParametersFlags(Dom Domain, Nparams int32, Flags DomainModificationImpact) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetSchedulerParametersFlagsArgs {
-		Dom: Dom,
+	args := DomainGetSchedulerParametersFlagsArgs{
+		Dom:     Dom,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10848,10 +14031,8 @@ func (l *Libvirt) DomainGetSchedulerParametersFlags(Dom Domain, Nparams int32, F
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -10865,7 +14046,6 @@ func (l *Libvirt) DomainGetSchedulerParametersFlags(Dom Domain, Nparams int32, F
 func (l *Libvirt) DomainEventControlError() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(224, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10878,19 +14058,20 @@ func (l *Libvirt) DomainEventControlError() (err error) {
 func (l *Libvirt) DomainPinVcpuFlags(Dom Domain, Vcpu uint32, Cpumap []byte, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainPinVcpuFlagsArgs {
-		Dom: Dom,
-		Vcpu: Vcpu,
+	args := DomainPinVcpuFlagsArgs{
+		Dom:    Dom,
+		Vcpu:   Vcpu,
 		Cpumap: Cpumap,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(225, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10903,20 +14084,21 @@ func (l *Libvirt) DomainPinVcpuFlags(Dom Domain, Vcpu uint32, Cpumap []byte, Fla
 func (l *Libvirt) DomainSendKey(Dom Domain, Codeset uint32, Holdtime uint32, Keycodes []uint32, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSendKeyArgs {
-		Dom: Dom,
-		Codeset: Codeset,
+	args := DomainSendKeyArgs{
+		Dom:      Dom,
+		Codeset:  Codeset,
 		Holdtime: Holdtime,
 		Keycodes: Keycodes,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(226, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -10929,13 +14111,15 @@ func (l *Libvirt) DomainSendKey(Dom Domain, Codeset uint32, Holdtime uint32, Key
 func (l *Libvirt) NodeGetCPUStats(CPUNum int32, Nparams int32, Flags uint32) (rParams []NodeGetCPUStats, rNparams int32, err error) {
 	var buf []byte
 
-	args := NodeGetCPUStatsArgs {
-		CPUNum: CPUNum,
+	args := NodeGetCPUStatsArgs{
+		CPUNum:  CPUNum,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10947,10 +14131,8 @@ func (l *Libvirt) NodeGetCPUStats(CPUNum int32, Nparams int32, Flags uint32) (rP
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []NodeGetCPUStats
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -10969,13 +14151,15 @@ func (l *Libvirt) NodeGetCPUStats(CPUNum int32, Nparams int32, Flags uint32) (rP
 func (l *Libvirt) NodeGetMemoryStats(Nparams int32, CellNum int32, Flags uint32) (rParams []NodeGetMemoryStats, rNparams int32, err error) {
 	var buf []byte
 
-	args := NodeGetMemoryStatsArgs {
+	args := NodeGetMemoryStatsArgs{
 		Nparams: Nparams,
 		CellNum: CellNum,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -10987,10 +14171,8 @@ func (l *Libvirt) NodeGetMemoryStats(Nparams int32, CellNum int32, Flags uint32)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []NodeGetMemoryStats
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -11009,12 +14191,14 @@ func (l *Libvirt) NodeGetMemoryStats(Nparams int32, CellNum int32, Flags uint32)
 func (l *Libvirt) DomainGetControlInfo(Dom Domain, Flags uint32) (rState uint32, rDetails uint32, rStateTime uint64, err error) {
 	var buf []byte
 
-	args := DomainGetControlInfoArgs {
-		Dom: Dom,
+	args := DomainGetControlInfoArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11026,10 +14210,8 @@ func (l *Libvirt) DomainGetControlInfo(Dom Domain, Flags uint32) (rState uint32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// State: uint32
 	_, err = dec.Decode(&rState)
 	if err != nil {
@@ -11053,14 +14235,16 @@ func (l *Libvirt) DomainGetControlInfo(Dom Domain, Flags uint32) (rState uint32,
 func (l *Libvirt) DomainGetVcpuPinInfo(Dom Domain, Ncpumaps int32, Maplen int32, Flags uint32) (rCpumaps []byte, rNum int32, err error) {
 	var buf []byte
 
-	args := DomainGetVcpuPinInfoArgs {
-		Dom: Dom,
+	args := DomainGetVcpuPinInfoArgs{
+		Dom:      Dom,
 		Ncpumaps: Ncpumaps,
-		Maplen: Maplen,
-		Flags: Flags,
+		Maplen:   Maplen,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11072,10 +14256,8 @@ func (l *Libvirt) DomainGetVcpuPinInfo(Dom Domain, Ncpumaps int32, Maplen int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cpumaps: []byte
 	_, err = dec.Decode(&rCpumaps)
 	if err != nil {
@@ -11094,17 +14276,18 @@ func (l *Libvirt) DomainGetVcpuPinInfo(Dom Domain, Ncpumaps int32, Maplen int32,
 func (l *Libvirt) DomainUndefineFlags(Dom Domain, Flags DomainUndefineFlagsValues) (err error) {
 	var buf []byte
 
-	args := DomainUndefineFlagsArgs {
-		Dom: Dom,
+	args := DomainUndefineFlagsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(231, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11114,22 +14297,23 @@ func (l *Libvirt) DomainUndefineFlags(Dom Domain, Flags DomainUndefineFlagsValue
 }
 
 // DomainSaveFlags is the go wrapper for REMOTE_PROC_DOMAIN_SAVE_FLAGS.
-func (l *Libvirt) DomainSaveFlags(Dom Domain, To string, Dxml OptString, Flags uint32) (err error) {
+func (l *Libvirt) DomainSaveFlags(Dom Domain, To string, Dxml OptString, Flags DomainSaveRestoreFlags) (err error) {
 	var buf []byte
 
-	args := DomainSaveFlagsArgs {
-		Dom: Dom,
-		To: To,
-		Dxml: Dxml,
+	args := DomainSaveFlagsArgs{
+		Dom:   Dom,
+		To:    To,
+		Dxml:  Dxml,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(232, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11139,21 +14323,22 @@ func (l *Libvirt) DomainSaveFlags(Dom Domain, To string, Dxml OptString, Flags u
 }
 
 // DomainRestoreFlags is the go wrapper for REMOTE_PROC_DOMAIN_RESTORE_FLAGS.
-func (l *Libvirt) DomainRestoreFlags(From string, Dxml OptString, Flags uint32) (err error) {
+func (l *Libvirt) DomainRestoreFlags(From string, Dxml OptString, Flags DomainSaveRestoreFlags) (err error) {
 	var buf []byte
 
-	args := DomainRestoreFlagsArgs {
-		From: From,
-		Dxml: Dxml,
+	args := DomainRestoreFlagsArgs{
+		From:  From,
+		Dxml:  Dxml,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(233, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11166,17 +14351,18 @@ func (l *Libvirt) DomainRestoreFlags(From string, Dxml OptString, Flags uint32)
 func (l *Libvirt) DomainDestroyFlags(Dom Domain, Flags DomainDestroyFlagsValues) (err error) {
 	var buf []byte
 
-	args := DomainDestroyFlagsArgs {
-		Dom: Dom,
+	args := DomainDestroyFlagsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(234, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11189,12 +14375,14 @@ func (l *Libvirt) DomainDestroyFlags(Dom Domain, Flags DomainDestroyFlagsValues)
 func (l *Libvirt) DomainSaveImageGetXMLDesc(File string, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainSaveImageGetXMLDescArgs {
-		File: File,
+	args := DomainSaveImageGetXMLDescArgs{
+		File:  File,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11206,10 +14394,8 @@ func (l *Libvirt) DomainSaveImageGetXMLDesc(File string, Flags uint32) (rXML str
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -11223,18 +14409,19 @@ func (l *Libvirt) DomainSaveImageGetXMLDesc(File string, Flags uint32) (rXML str
 func (l *Libvirt) DomainSaveImageDefineXML(File string, Dxml string, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSaveImageDefineXMLArgs {
-		File: File,
-		Dxml: Dxml,
+	args := DomainSaveImageDefineXMLArgs{
+		File:  File,
+		Dxml:  Dxml,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(236, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11247,18 +14434,19 @@ func (l *Libvirt) DomainSaveImageDefineXML(File string, Dxml string, Flags uint3
 func (l *Libvirt) DomainBlockJobAbort(Dom Domain, Path string, Flags DomainBlockJobAbortFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockJobAbortArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockJobAbortArgs{
+		Dom:   Dom,
+		Path:  Path,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(237, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11271,13 +14459,15 @@ func (l *Libvirt) DomainBlockJobAbort(Dom Domain, Path string, Flags DomainBlock
 func (l *Libvirt) DomainGetBlockJobInfo(Dom Domain, Path string, Flags uint32) (rFound int32, rType int32, rBandwidth uint64, rCur uint64, rEnd uint64, err error) {
 	var buf []byte
 
-	args := DomainGetBlockJobInfoArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainGetBlockJobInfoArgs{
+		Dom:   Dom,
+		Path:  Path,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11289,10 +14479,8 @@ func (l *Libvirt) DomainGetBlockJobInfo(Dom Domain, Path string, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Found: int32
 	_, err = dec.Decode(&rFound)
 	if err != nil {
@@ -11326,19 +14514,20 @@ func (l *Libvirt) DomainGetBlockJobInfo(Dom Domain, Path string, Flags uint32) (
 func (l *Libvirt) DomainBlockJobSetSpeed(Dom Domain, Path string, Bandwidth uint64, Flags DomainBlockJobSetSpeedFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockJobSetSpeedArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockJobSetSpeedArgs{
+		Dom:       Dom,
+		Path:      Path,
 		Bandwidth: Bandwidth,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(239, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11351,19 +14540,20 @@ func (l *Libvirt) DomainBlockJobSetSpeed(Dom Domain, Path string, Bandwidth uint
 func (l *Libvirt) DomainBlockPull(Dom Domain, Path string, Bandwidth uint64, Flags DomainBlockPullFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockPullArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockPullArgs{
+		Dom:       Dom,
+		Path:      Path,
 		Bandwidth: Bandwidth,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(240, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11376,7 +14566,6 @@ func (l *Libvirt) DomainBlockPull(Dom Domain, Path string, Bandwidth uint64, Fla
 func (l *Libvirt) DomainEventBlockJob() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(241, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11386,15 +14575,17 @@ func (l *Libvirt) DomainEventBlockJob() (err error) {
 }
 
 // DomainMigrateGetMaxSpeed is the go wrapper for REMOTE_PROC_DOMAIN_MIGRATE_GET_MAX_SPEED.
-func (l *Libvirt) DomainMigrateGetMaxSpeed(Dom Domain, Flags uint32) (rBandwidth uint64, err error) {
+func (l *Libvirt) DomainMigrateGetMaxSpeed(Dom Domain, Flags DomainMigrateMaxSpeedFlags) (rBandwidth uint64, err error) {
 	var buf []byte
 
-	args := DomainMigrateGetMaxSpeedArgs {
-		Dom: Dom,
+	args := DomainMigrateGetMaxSpeedArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11406,10 +14597,8 @@ func (l *Libvirt) DomainMigrateGetMaxSpeed(Dom Domain, Flags uint32) (rBandwidth
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Bandwidth: uint64
 	_, err = dec.Decode(&rBandwidth)
 	if err != nil {
@@ -11423,14 +14612,16 @@ func (l *Libvirt) DomainMigrateGetMaxSpeed(Dom Domain, Flags uint32) (rBandwidth
 func (l *Libvirt) DomainBlockStatsFlags(Dom Domain, Path string, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainBlockStatsFlagsArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockStatsFlagsArgs{
+		Dom:     Dom,
+		Path:    Path,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11442,10 +14633,8 @@ func (l *Libvirt) DomainBlockStatsFlags(Dom Domain, Path string, Nparams int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -11464,12 +14653,14 @@ func (l *Libvirt) DomainBlockStatsFlags(Dom Domain, Path string, Nparams int32,
 func (l *Libvirt) DomainSnapshotGetParent(Snap DomainSnapshot, Flags uint32) (rSnap DomainSnapshot, err error) {
 	var buf []byte
 
-	args := DomainSnapshotGetParentArgs {
-		Snap: Snap,
+	args := DomainSnapshotGetParentArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11481,10 +14672,8 @@ func (l *Libvirt) DomainSnapshotGetParent(Snap DomainSnapshot, Flags uint32) (rS
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snap: DomainSnapshot
 	_, err = dec.Decode(&rSnap)
 	if err != nil {
@@ -11498,17 +14687,18 @@ func (l *Libvirt) DomainSnapshotGetParent(Snap DomainSnapshot, Flags uint32) (rS
 func (l *Libvirt) DomainReset(Dom Domain, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainResetArgs {
-		Dom: Dom,
+	args := DomainResetArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(245, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11521,12 +14711,14 @@ func (l *Libvirt) DomainReset(Dom Domain, Flags uint32) (err error) {
 func (l *Libvirt) DomainSnapshotNumChildren(Snap DomainSnapshot, Flags uint32) (rNum int32, err error) {
 	var buf []byte
 
-	args := DomainSnapshotNumChildrenArgs {
-		Snap: Snap,
+	args := DomainSnapshotNumChildrenArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11538,10 +14730,8 @@ func (l *Libvirt) DomainSnapshotNumChildren(Snap DomainSnapshot, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Num: int32
 	_, err = dec.Decode(&rNum)
 	if err != nil {
@@ -11555,13 +14745,15 @@ func (l *Libvirt) DomainSnapshotNumChildren(Snap DomainSnapshot, Flags uint32) (
 func (l *Libvirt) DomainSnapshotListChildrenNames(Snap DomainSnapshot, Maxnames int32, Flags uint32) (rNames []string, err error) {
 	var buf []byte
 
-	args := DomainSnapshotListChildrenNamesArgs {
-		Snap: Snap,
+	args := DomainSnapshotListChildrenNamesArgs{
+		Snap:     Snap,
 		Maxnames: Maxnames,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11573,10 +14765,8 @@ func (l *Libvirt) DomainSnapshotListChildrenNames(Snap DomainSnapshot, Maxnames
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Names: []string
 	_, err = dec.Decode(&rNames)
 	if err != nil {
@@ -11590,7 +14780,6 @@ func (l *Libvirt) DomainSnapshotListChildrenNames(Snap DomainSnapshot, Maxnames
 func (l *Libvirt) DomainEventDiskChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(248, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11603,18 +14792,19 @@ func (l *Libvirt) DomainEventDiskChange() (err error) {
 func (l *Libvirt) DomainOpenGraphics(Dom Domain, Idx uint32, Flags DomainOpenGraphicsFlags) (err error) {
 	var buf []byte
 
-	args := DomainOpenGraphicsArgs {
-		Dom: Dom,
-		Idx: Idx,
+	args := DomainOpenGraphicsArgs{
+		Dom:   Dom,
+		Idx:   Idx,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(249, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11627,18 +14817,19 @@ func (l *Libvirt) DomainOpenGraphics(Dom Domain, Idx uint32, Flags DomainOpenGra
 func (l *Libvirt) NodeSuspendForDuration(Target uint32, Duration uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := NodeSuspendForDurationArgs {
-		Target: Target,
+	args := NodeSuspendForDurationArgs{
+		Target:   Target,
 		Duration: Duration,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(250, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11651,19 +14842,20 @@ func (l *Libvirt) NodeSuspendForDuration(Target uint32, Duration uint64, Flags u
 func (l *Libvirt) DomainBlockResize(Dom Domain, Disk string, Size uint64, Flags DomainBlockResizeFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockResizeArgs {
-		Dom: Dom,
-		Disk: Disk,
-		Size: Size,
+	args := DomainBlockResizeArgs{
+		Dom:   Dom,
+		Disk:  Disk,
+		Size:  Size,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(251, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11676,19 +14868,20 @@ func (l *Libvirt) DomainBlockResize(Dom Domain, Disk string, Size uint64, Flags
 func (l *Libvirt) DomainSetBlockIOTune(Dom Domain, Disk string, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetBlockIOTuneArgs {
-		Dom: Dom,
-		Disk: Disk,
+	args := DomainSetBlockIOTuneArgs{
+		Dom:    Dom,
+		Disk:   Disk,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(252, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11701,14 +14894,16 @@ func (l *Libvirt) DomainSetBlockIOTune(Dom Domain, Disk string, Params []TypedPa
 func (l *Libvirt) DomainGetBlockIOTune(Dom Domain, Disk OptString, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetBlockIOTuneArgs {
-		Dom: Dom,
-		Disk: Disk,
+	args := DomainGetBlockIOTuneArgs{
+		Dom:     Dom,
+		Disk:    Disk,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11720,10 +14915,8 @@ func (l *Libvirt) DomainGetBlockIOTune(Dom Domain, Disk OptString, Nparams int32
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -11742,18 +14935,19 @@ func (l *Libvirt) DomainGetBlockIOTune(Dom Domain, Disk OptString, Nparams int32
 func (l *Libvirt) DomainSetNumaParameters(Dom Domain, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetNumaParametersArgs {
-		Dom: Dom,
+	args := DomainSetNumaParametersArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(254, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11766,13 +14960,15 @@ func (l *Libvirt) DomainSetNumaParameters(Dom Domain, Params []TypedParam, Flags
 func (l *Libvirt) DomainGetNumaParameters(Dom Domain, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetNumaParametersArgs {
-		Dom: Dom,
+	args := DomainGetNumaParametersArgs{
+		Dom:     Dom,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11784,10 +14980,8 @@ func (l *Libvirt) DomainGetNumaParameters(Dom Domain, Nparams int32, Flags uint3
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -11806,19 +15000,20 @@ func (l *Libvirt) DomainGetNumaParameters(Dom Domain, Nparams int32, Flags uint3
 func (l *Libvirt) DomainSetInterfaceParameters(Dom Domain, Device string, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetInterfaceParametersArgs {
-		Dom: Dom,
+	args := DomainSetInterfaceParametersArgs{
+		Dom:    Dom,
 		Device: Device,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(256, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11831,14 +15026,16 @@ func (l *Libvirt) DomainSetInterfaceParameters(Dom Domain, Device string, Params
 func (l *Libvirt) DomainGetInterfaceParameters(Dom Domain, Device string, Nparams int32, Flags DomainModificationImpact) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetInterfaceParametersArgs {
-		Dom: Dom,
-		Device: Device,
+	args := DomainGetInterfaceParametersArgs{
+		Dom:     Dom,
+		Device:  Device,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11850,10 +15047,8 @@ func (l *Libvirt) DomainGetInterfaceParameters(Dom Domain, Device string, Nparam
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -11872,17 +15067,18 @@ func (l *Libvirt) DomainGetInterfaceParameters(Dom Domain, Device string, Nparam
 func (l *Libvirt) DomainShutdownFlags(Dom Domain, Flags DomainShutdownFlagValues) (err error) {
 	var buf []byte
 
-	args := DomainShutdownFlagsArgs {
-		Dom: Dom,
+	args := DomainShutdownFlagsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(258, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11895,18 +15091,19 @@ func (l *Libvirt) DomainShutdownFlags(Dom Domain, Flags DomainShutdownFlagValues
 func (l *Libvirt) StorageVolWipePattern(Vol StorageVol, Algorithm uint32, Flags uint32) (err error) {
 	var buf []byte
 
-	args := StorageVolWipePatternArgs {
-		Vol: Vol,
+	args := StorageVolWipePatternArgs{
+		Vol:       Vol,
 		Algorithm: Algorithm,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(259, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11919,18 +15116,19 @@ func (l *Libvirt) StorageVolWipePattern(Vol StorageVol, Algorithm uint32, Flags
 func (l *Libvirt) StorageVolResize(Vol StorageVol, Capacity uint64, Flags StorageVolResizeFlags) (err error) {
 	var buf []byte
 
-	args := StorageVolResizeArgs {
-		Vol: Vol,
+	args := StorageVolResizeArgs{
+		Vol:      Vol,
 		Capacity: Capacity,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(260, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11943,19 +15141,20 @@ func (l *Libvirt) StorageVolResize(Vol StorageVol, Capacity uint64, Flags Storag
 func (l *Libvirt) DomainPmSuspendForDuration(Dom Domain, Target uint32, Duration uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainPmSuspendForDurationArgs {
-		Dom: Dom,
-		Target: Target,
+	args := DomainPmSuspendForDurationArgs{
+		Dom:      Dom,
+		Target:   Target,
 		Duration: Duration,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(261, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -11968,15 +15167,17 @@ func (l *Libvirt) DomainPmSuspendForDuration(Dom Domain, Target uint32, Duration
 func (l *Libvirt) DomainGetCPUStats(Dom Domain, Nparams uint32, StartCPU int32, Ncpus uint32, Flags TypedParameterFlags) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := DomainGetCPUStatsArgs {
-		Dom: Dom,
-		Nparams: Nparams,
+	args := DomainGetCPUStatsArgs{
+		Dom:      Dom,
+		Nparams:  Nparams,
 		StartCPU: StartCPU,
-		Ncpus: Ncpus,
-		Flags: Flags,
+		Ncpus:    Ncpus,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -11988,10 +15189,8 @@ func (l *Libvirt) DomainGetCPUStats(Dom Domain, Nparams uint32, StartCPU int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -12010,13 +15209,15 @@ func (l *Libvirt) DomainGetCPUStats(Dom Domain, Nparams uint32, StartCPU int32,
 func (l *Libvirt) DomainGetDiskErrors(Dom Domain, Maxerrors uint32, Flags uint32) (rErrors []DomainDiskError, rNerrors int32, err error) {
 	var buf []byte
 
-	args := DomainGetDiskErrorsArgs {
-		Dom: Dom,
+	args := DomainGetDiskErrorsArgs{
+		Dom:       Dom,
 		Maxerrors: Maxerrors,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12028,10 +15229,8 @@ func (l *Libvirt) DomainGetDiskErrors(Dom Domain, Maxerrors uint32, Flags uint32
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Errors: []DomainDiskError
 	_, err = dec.Decode(&rErrors)
 	if err != nil {
@@ -12050,21 +15249,22 @@ func (l *Libvirt) DomainGetDiskErrors(Dom Domain, Maxerrors uint32, Flags uint32
 func (l *Libvirt) DomainSetMetadata(Dom Domain, Type int32, Metadata OptString, Key OptString, Uri OptString, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainSetMetadataArgs {
-		Dom: Dom,
-		Type: Type,
+	args := DomainSetMetadataArgs{
+		Dom:      Dom,
+		Type:     Type,
 		Metadata: Metadata,
-		Key: Key,
-		Uri: Uri,
-		Flags: Flags,
+		Key:      Key,
+		Uri:      Uri,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(264, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12077,14 +15277,16 @@ func (l *Libvirt) DomainSetMetadata(Dom Domain, Type int32, Metadata OptString,
 func (l *Libvirt) DomainGetMetadata(Dom Domain, Type int32, Uri OptString, Flags DomainModificationImpact) (rMetadata string, err error) {
 	var buf []byte
 
-	args := DomainGetMetadataArgs {
-		Dom: Dom,
-		Type: Type,
-		Uri: Uri,
+	args := DomainGetMetadataArgs{
+		Dom:   Dom,
+		Type:  Type,
+		Uri:   Uri,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12096,10 +15298,8 @@ func (l *Libvirt) DomainGetMetadata(Dom Domain, Type int32, Uri OptString, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Metadata: string
 	_, err = dec.Decode(&rMetadata)
 	if err != nil {
@@ -12113,20 +15313,21 @@ func (l *Libvirt) DomainGetMetadata(Dom Domain, Type int32, Uri OptString, Flags
 func (l *Libvirt) DomainBlockRebase(Dom Domain, Path string, Base OptString, Bandwidth uint64, Flags DomainBlockRebaseFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockRebaseArgs {
-		Dom: Dom,
-		Path: Path,
-		Base: Base,
+	args := DomainBlockRebaseArgs{
+		Dom:       Dom,
+		Path:      Path,
+		Base:      Base,
 		Bandwidth: Bandwidth,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(266, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12139,17 +15340,18 @@ func (l *Libvirt) DomainBlockRebase(Dom Domain, Path string, Base OptString, Ban
 func (l *Libvirt) DomainPmWakeup(Dom Domain, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainPmWakeupArgs {
-		Dom: Dom,
+	args := DomainPmWakeupArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(267, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12162,7 +15364,6 @@ func (l *Libvirt) DomainPmWakeup(Dom Domain, Flags uint32) (err error) {
 func (l *Libvirt) DomainEventTrayChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(268, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12175,7 +15376,6 @@ func (l *Libvirt) DomainEventTrayChange() (err error) {
 func (l *Libvirt) DomainEventPmwakeup() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(269, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12188,7 +15388,6 @@ func (l *Libvirt) DomainEventPmwakeup() (err error) {
 func (l *Libvirt) DomainEventPmsuspend() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(270, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12201,12 +15400,14 @@ func (l *Libvirt) DomainEventPmsuspend() (err error) {
 func (l *Libvirt) DomainSnapshotIsCurrent(Snap DomainSnapshot, Flags uint32) (rCurrent int32, err error) {
 	var buf []byte
 
-	args := DomainSnapshotIsCurrentArgs {
-		Snap: Snap,
+	args := DomainSnapshotIsCurrentArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12218,10 +15419,8 @@ func (l *Libvirt) DomainSnapshotIsCurrent(Snap DomainSnapshot, Flags uint32) (rC
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Current: int32
 	_, err = dec.Decode(&rCurrent)
 	if err != nil {
@@ -12235,12 +15434,14 @@ func (l *Libvirt) DomainSnapshotIsCurrent(Snap DomainSnapshot, Flags uint32) (rC
 func (l *Libvirt) DomainSnapshotHasMetadata(Snap DomainSnapshot, Flags uint32) (rMetadata int32, err error) {
 	var buf []byte
 
-	args := DomainSnapshotHasMetadataArgs {
-		Snap: Snap,
+	args := DomainSnapshotHasMetadataArgs{
+		Snap:  Snap,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12252,10 +15453,8 @@ func (l *Libvirt) DomainSnapshotHasMetadata(Snap DomainSnapshot, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Metadata: int32
 	_, err = dec.Decode(&rMetadata)
 	if err != nil {
@@ -12269,12 +15468,14 @@ func (l *Libvirt) DomainSnapshotHasMetadata(Snap DomainSnapshot, Flags uint32) (
 func (l *Libvirt) ConnectListAllDomains(NeedResults int32, Flags ConnectListAllDomainsFlags) (rDomains []Domain, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllDomainsArgs {
+	args := ConnectListAllDomainsArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12286,10 +15487,8 @@ func (l *Libvirt) ConnectListAllDomains(NeedResults int32, Flags ConnectListAllD
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Domains: []Domain
 	_, err = dec.Decode(&rDomains)
 	if err != nil {
@@ -12305,16 +15504,18 @@ func (l *Libvirt) ConnectListAllDomains(NeedResults int32, Flags ConnectListAllD
 }
 
 // DomainListAllSnapshots is the go wrapper for REMOTE_PROC_DOMAIN_LIST_ALL_SNAPSHOTS.
-func (l *Libvirt) DomainListAllSnapshots(Dom Domain, NeedResults int32, Flags uint32) (rSnapshots []DomainSnapshot, rRet int32, err error) {
+func (l *Libvirt) DomainListAllSnapshots(Dom Domain, NeedResults int32, Flags DomainSnapshotListFlags) (rSnapshots []DomainSnapshot, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainListAllSnapshotsArgs {
-		Dom: Dom,
+	args := DomainListAllSnapshotsArgs{
+		Dom:         Dom,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12326,10 +15527,8 @@ func (l *Libvirt) DomainListAllSnapshots(Dom Domain, NeedResults int32, Flags ui
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snapshots: []DomainSnapshot
 	_, err = dec.Decode(&rSnapshots)
 	if err != nil {
@@ -12348,13 +15547,15 @@ func (l *Libvirt) DomainListAllSnapshots(Dom Domain, NeedResults int32, Flags ui
 func (l *Libvirt) DomainSnapshotListAllChildren(Snapshot DomainSnapshot, NeedResults int32, Flags uint32) (rSnapshots []DomainSnapshot, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainSnapshotListAllChildrenArgs {
-		Snapshot: Snapshot,
+	args := DomainSnapshotListAllChildrenArgs{
+		Snapshot:    Snapshot,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12366,10 +15567,8 @@ func (l *Libvirt) DomainSnapshotListAllChildren(Snapshot DomainSnapshot, NeedRes
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Snapshots: []DomainSnapshot
 	_, err = dec.Decode(&rSnapshots)
 	if err != nil {
@@ -12388,7 +15587,6 @@ func (l *Libvirt) DomainSnapshotListAllChildren(Snapshot DomainSnapshot, NeedRes
 func (l *Libvirt) DomainEventBalloonChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(276, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12401,12 +15599,14 @@ func (l *Libvirt) DomainEventBalloonChange() (err error) {
 func (l *Libvirt) DomainGetHostname(Dom Domain, Flags DomainGetHostnameFlags) (rHostname string, err error) {
 	var buf []byte
 
-	args := DomainGetHostnameArgs {
-		Dom: Dom,
+	args := DomainGetHostnameArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12418,10 +15618,8 @@ func (l *Libvirt) DomainGetHostname(Dom Domain, Flags DomainGetHostnameFlags) (r
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Hostname: string
 	_, err = dec.Decode(&rHostname)
 	if err != nil {
@@ -12435,11 +15633,13 @@ func (l *Libvirt) DomainGetHostname(Dom Domain, Flags DomainGetHostnameFlags) (r
 func (l *Libvirt) DomainGetSecurityLabelList(Dom Domain) (rLabels []DomainGetSecurityLabelRet, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainGetSecurityLabelListArgs {
+	args := DomainGetSecurityLabelListArgs{
 		Dom: Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12451,10 +15651,8 @@ func (l *Libvirt) DomainGetSecurityLabelList(Dom Domain) (rLabels []DomainGetSec
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Labels: []DomainGetSecurityLabelRet
 	_, err = dec.Decode(&rLabels)
 	if err != nil {
@@ -12473,18 +15671,19 @@ func (l *Libvirt) DomainGetSecurityLabelList(Dom Domain) (rLabels []DomainGetSec
 func (l *Libvirt) DomainPinEmulator(Dom Domain, Cpumap []byte, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainPinEmulatorArgs {
-		Dom: Dom,
+	args := DomainPinEmulatorArgs{
+		Dom:    Dom,
 		Cpumap: Cpumap,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(279, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12497,13 +15696,15 @@ func (l *Libvirt) DomainPinEmulator(Dom Domain, Cpumap []byte, Flags DomainModif
 func (l *Libvirt) DomainGetEmulatorPinInfo(Dom Domain, Maplen int32, Flags DomainModificationImpact) (rCpumaps []byte, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainGetEmulatorPinInfoArgs {
-		Dom: Dom,
+	args := DomainGetEmulatorPinInfoArgs{
+		Dom:    Dom,
 		Maplen: Maplen,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12515,10 +15716,8 @@ func (l *Libvirt) DomainGetEmulatorPinInfo(Dom Domain, Maplen int32, Flags Domai
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cpumaps: []byte
 	_, err = dec.Decode(&rCpumaps)
 	if err != nil {
@@ -12537,12 +15736,14 @@ func (l *Libvirt) DomainGetEmulatorPinInfo(Dom Domain, Maplen int32, Flags Domai
 func (l *Libvirt) ConnectListAllStoragePools(NeedResults int32, Flags ConnectListAllStoragePoolsFlags) (rPools []StoragePool, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllStoragePoolsArgs {
+	args := ConnectListAllStoragePoolsArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12554,10 +15755,8 @@ func (l *Libvirt) ConnectListAllStoragePools(NeedResults int32, Flags ConnectLis
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pools: []StoragePool
 	_, err = dec.Decode(&rPools)
 	if err != nil {
@@ -12576,13 +15775,15 @@ func (l *Libvirt) ConnectListAllStoragePools(NeedResults int32, Flags ConnectLis
 func (l *Libvirt) StoragePoolListAllVolumes(Pool StoragePool, NeedResults int32, Flags uint32) (rVols []StorageVol, rRet uint32, err error) {
 	var buf []byte
 
-	args := StoragePoolListAllVolumesArgs {
-		Pool: Pool,
+	args := StoragePoolListAllVolumesArgs{
+		Pool:        Pool,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12594,10 +15795,8 @@ func (l *Libvirt) StoragePoolListAllVolumes(Pool StoragePool, NeedResults int32,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Vols: []StorageVol
 	_, err = dec.Decode(&rVols)
 	if err != nil {
@@ -12616,12 +15815,14 @@ func (l *Libvirt) StoragePoolListAllVolumes(Pool StoragePool, NeedResults int32,
 func (l *Libvirt) ConnectListAllNetworks(NeedResults int32, Flags ConnectListAllNetworksFlags) (rNets []Network, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllNetworksArgs {
+	args := ConnectListAllNetworksArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12633,10 +15834,8 @@ func (l *Libvirt) ConnectListAllNetworks(NeedResults int32, Flags ConnectListAll
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Nets: []Network
 	_, err = dec.Decode(&rNets)
 	if err != nil {
@@ -12655,12 +15854,14 @@ func (l *Libvirt) ConnectListAllNetworks(NeedResults int32, Flags ConnectListAll
 func (l *Libvirt) ConnectListAllInterfaces(NeedResults int32, Flags ConnectListAllInterfacesFlags) (rIfaces []Interface, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllInterfacesArgs {
+	args := ConnectListAllInterfacesArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12672,10 +15873,8 @@ func (l *Libvirt) ConnectListAllInterfaces(NeedResults int32, Flags ConnectListA
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ifaces: []Interface
 	_, err = dec.Decode(&rIfaces)
 	if err != nil {
@@ -12691,15 +15890,17 @@ func (l *Libvirt) ConnectListAllInterfaces(NeedResults int32, Flags ConnectListA
 }
 
 // ConnectListAllNodeDevices is the go wrapper for REMOTE_PROC_CONNECT_LIST_ALL_NODE_DEVICES.
-func (l *Libvirt) ConnectListAllNodeDevices(NeedResults int32, Flags uint32) (rDevices []NodeDevice, rRet uint32, err error) {
+func (l *Libvirt) ConnectListAllNodeDevices(NeedResults int32, Flags ConnectListAllNodeDeviceFlags) (rDevices []NodeDevice, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllNodeDevicesArgs {
+	args := ConnectListAllNodeDevicesArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12711,10 +15912,8 @@ func (l *Libvirt) ConnectListAllNodeDevices(NeedResults int32, Flags uint32) (rD
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Devices: []NodeDevice
 	_, err = dec.Decode(&rDevices)
 	if err != nil {
@@ -12733,12 +15932,14 @@ func (l *Libvirt) ConnectListAllNodeDevices(NeedResults int32, Flags uint32) (rD
 func (l *Libvirt) ConnectListAllNwfilters(NeedResults int32, Flags uint32) (rFilters []Nwfilter, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllNwfiltersArgs {
+	args := ConnectListAllNwfiltersArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12750,10 +15951,8 @@ func (l *Libvirt) ConnectListAllNwfilters(NeedResults int32, Flags uint32) (rFil
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Filters: []Nwfilter
 	_, err = dec.Decode(&rFilters)
 	if err != nil {
@@ -12772,12 +15971,14 @@ func (l *Libvirt) ConnectListAllNwfilters(NeedResults int32, Flags uint32) (rFil
 func (l *Libvirt) ConnectListAllSecrets(NeedResults int32, Flags ConnectListAllSecretsFlags) (rSecrets []Secret, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllSecretsArgs {
+	args := ConnectListAllSecretsArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12789,10 +15990,8 @@ func (l *Libvirt) ConnectListAllSecrets(NeedResults int32, Flags ConnectListAllS
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Secrets: []Secret
 	_, err = dec.Decode(&rSecrets)
 	if err != nil {
@@ -12811,17 +16010,18 @@ func (l *Libvirt) ConnectListAllSecrets(NeedResults int32, Flags ConnectListAllS
 func (l *Libvirt) NodeSetMemoryParameters(Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := NodeSetMemoryParametersArgs {
+	args := NodeSetMemoryParametersArgs{
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(288, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12834,12 +16034,14 @@ func (l *Libvirt) NodeSetMemoryParameters(Params []TypedParam, Flags uint32) (er
 func (l *Libvirt) NodeGetMemoryParameters(Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := NodeGetMemoryParametersArgs {
+	args := NodeGetMemoryParametersArgs{
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12851,10 +16053,8 @@ func (l *Libvirt) NodeGetMemoryParameters(Nparams int32, Flags uint32) (rParams
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -12873,21 +16073,22 @@ func (l *Libvirt) NodeGetMemoryParameters(Nparams int32, Flags uint32) (rParams
 func (l *Libvirt) DomainBlockCommit(Dom Domain, Disk string, Base OptString, Top OptString, Bandwidth uint64, Flags DomainBlockCommitFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockCommitArgs {
-		Dom: Dom,
-		Disk: Disk,
-		Base: Base,
-		Top: Top,
+	args := DomainBlockCommitArgs{
+		Dom:       Dom,
+		Disk:      Disk,
+		Base:      Base,
+		Top:       Top,
 		Bandwidth: Bandwidth,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(290, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12900,21 +16101,22 @@ func (l *Libvirt) DomainBlockCommit(Dom Domain, Disk string, Base OptString, Top
 func (l *Libvirt) NetworkUpdate(Net Network, Command uint32, Section uint32, ParentIndex int32, XML string, Flags NetworkUpdateFlags) (err error) {
 	var buf []byte
 
-	args := NetworkUpdateArgs {
-		Net: Net,
-		Command: Command,
-		Section: Section,
+	args := NetworkUpdateArgs{
+		Net:         Net,
+		Command:     Command,
+		Section:     Section,
 		ParentIndex: ParentIndex,
-		XML: XML,
-		Flags: Flags,
+		XML:         XML,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(291, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12927,7 +16129,6 @@ func (l *Libvirt) NetworkUpdate(Net Network, Command uint32, Section uint32, Par
 func (l *Libvirt) DomainEventPmsuspendDisk() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(292, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -12940,13 +16141,15 @@ func (l *Libvirt) DomainEventPmsuspendDisk() (err error) {
 func (l *Libvirt) NodeGetCPUMap(NeedMap int32, NeedOnline int32, Flags uint32) (rCpumap []byte, rOnline uint32, rRet int32, err error) {
 	var buf []byte
 
-	args := NodeGetCPUMapArgs {
-		NeedMap: NeedMap,
+	args := NodeGetCPUMapArgs{
+		NeedMap:    NeedMap,
 		NeedOnline: NeedOnline,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -12958,10 +16161,8 @@ func (l *Libvirt) NodeGetCPUMap(NeedMap int32, NeedOnline int32, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Cpumap: []byte
 	_, err = dec.Decode(&rCpumap)
 	if err != nil {
@@ -12985,19 +16186,20 @@ func (l *Libvirt) NodeGetCPUMap(NeedMap int32, NeedOnline int32, Flags uint32) (
 func (l *Libvirt) DomainFstrim(Dom Domain, MountPoint OptString, Minimum uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainFstrimArgs {
-		Dom: Dom,
+	args := DomainFstrimArgs{
+		Dom:        Dom,
 		MountPoint: MountPoint,
-		Minimum: Minimum,
-		Flags: Flags,
+		Minimum:    Minimum,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(294, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13010,19 +16212,20 @@ func (l *Libvirt) DomainFstrim(Dom Domain, MountPoint OptString, Minimum uint64,
 func (l *Libvirt) DomainSendProcessSignal(Dom Domain, PidValue int64, Signum uint32, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSendProcessSignalArgs {
-		Dom: Dom,
+	args := DomainSendProcessSignalArgs{
+		Dom:      Dom,
 		PidValue: PidValue,
-		Signum: Signum,
-		Flags: Flags,
+		Signum:   Signum,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(295, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13035,18 +16238,19 @@ func (l *Libvirt) DomainSendProcessSignal(Dom Domain, PidValue int64, Signum uin
 func (l *Libvirt) DomainOpenChannel(Dom Domain, Name OptString, inStream io.Writer, Flags DomainChannelFlags) (err error) {
 	var buf []byte
 
-	args := DomainOpenChannelArgs {
-		Dom: Dom,
-		Name: Name,
+	args := DomainOpenChannelArgs{
+		Dom:   Dom,
+		Name:  Name,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(296, constants.Program, buf, nil, inStream)
 	if err != nil {
 		return
@@ -13059,13 +16263,15 @@ func (l *Libvirt) DomainOpenChannel(Dom Domain, Name OptString, inStream io.Writ
 func (l *Libvirt) NodeDeviceLookupScsiHostByWwn(Wwnn string, Wwpn string, Flags uint32) (rDev NodeDevice, err error) {
 	var buf []byte
 
-	args := NodeDeviceLookupScsiHostByWwnArgs {
-		Wwnn: Wwnn,
-		Wwpn: Wwpn,
+	args := NodeDeviceLookupScsiHostByWwnArgs{
+		Wwnn:  Wwnn,
+		Wwpn:  Wwpn,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13077,10 +16283,8 @@ func (l *Libvirt) NodeDeviceLookupScsiHostByWwn(Wwnn string, Wwpn string, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dev: NodeDevice
 	_, err = dec.Decode(&rDev)
 	if err != nil {
@@ -13094,12 +16298,14 @@ func (l *Libvirt) NodeDeviceLookupScsiHostByWwn(Wwnn string, Wwpn string, Flags
 func (l *Libvirt) DomainGetJobStats(Dom Domain, Flags DomainGetJobStatsFlags) (rType int32, rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetJobStatsArgs {
-		Dom: Dom,
+	args := DomainGetJobStatsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13111,10 +16317,8 @@ func (l *Libvirt) DomainGetJobStats(Dom Domain, Flags DomainGetJobStatsFlags) (r
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: int32
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -13133,12 +16337,14 @@ func (l *Libvirt) DomainGetJobStats(Dom Domain, Flags DomainGetJobStatsFlags) (r
 func (l *Libvirt) DomainMigrateGetCompressionCache(Dom Domain, Flags uint32) (rCacheSize uint64, err error) {
 	var buf []byte
 
-	args := DomainMigrateGetCompressionCacheArgs {
-		Dom: Dom,
+	args := DomainMigrateGetCompressionCacheArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13150,10 +16356,8 @@ func (l *Libvirt) DomainMigrateGetCompressionCache(Dom Domain, Flags uint32) (rC
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CacheSize: uint64
 	_, err = dec.Decode(&rCacheSize)
 	if err != nil {
@@ -13167,18 +16371,19 @@ func (l *Libvirt) DomainMigrateGetCompressionCache(Dom Domain, Flags uint32) (rC
 func (l *Libvirt) DomainMigrateSetCompressionCache(Dom Domain, CacheSize uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainMigrateSetCompressionCacheArgs {
-		Dom: Dom,
+	args := DomainMigrateSetCompressionCacheArgs{
+		Dom:       Dom,
 		CacheSize: CacheSize,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(300, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13191,18 +16396,19 @@ func (l *Libvirt) DomainMigrateSetCompressionCache(Dom Domain, CacheSize uint64,
 func (l *Libvirt) NodeDeviceDetachFlags(Name string, DriverName OptString, Flags uint32) (err error) {
 	var buf []byte
 
-	args := NodeDeviceDetachFlagsArgs {
-		Name: Name,
+	args := NodeDeviceDetachFlagsArgs{
+		Name:       Name,
 		DriverName: DriverName,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(301, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13215,13 +16421,15 @@ func (l *Libvirt) NodeDeviceDetachFlags(Name string, DriverName OptString, Flags
 func (l *Libvirt) DomainMigrateBegin3Params(Dom Domain, Params []TypedParam, Flags uint32) (rCookieOut []byte, rXML string, err error) {
 	var buf []byte
 
-	args := DomainMigrateBegin3ParamsArgs {
-		Dom: Dom,
+	args := DomainMigrateBegin3ParamsArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13233,10 +16441,8 @@ func (l *Libvirt) DomainMigrateBegin3Params(Dom Domain, Params []TypedParam, Fla
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -13255,13 +16461,15 @@ func (l *Libvirt) DomainMigrateBegin3Params(Dom Domain, Params []TypedParam, Fla
 func (l *Libvirt) DomainMigratePrepare3Params(Params []TypedParam, CookieIn []byte, Flags uint32) (rCookieOut []byte, rUriOut OptString, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepare3ParamsArgs {
-		Params: Params,
+	args := DomainMigratePrepare3ParamsArgs{
+		Params:   Params,
 		CookieIn: CookieIn,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13273,10 +16481,8 @@ func (l *Libvirt) DomainMigratePrepare3Params(Params []TypedParam, CookieIn []by
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -13295,13 +16501,15 @@ func (l *Libvirt) DomainMigratePrepare3Params(Params []TypedParam, CookieIn []by
 func (l *Libvirt) DomainMigratePrepareTunnel3Params(Params []TypedParam, CookieIn []byte, Flags uint32) (rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigratePrepareTunnel3ParamsArgs {
-		Params: Params,
+	args := DomainMigratePrepareTunnel3ParamsArgs{
+		Params:   Params,
 		CookieIn: CookieIn,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13313,10 +16521,8 @@ func (l *Libvirt) DomainMigratePrepareTunnel3Params(Params []TypedParam, CookieI
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -13330,15 +16536,17 @@ func (l *Libvirt) DomainMigratePrepareTunnel3Params(Params []TypedParam, CookieI
 func (l *Libvirt) DomainMigratePerform3Params(Dom Domain, Dconnuri OptString, Params []TypedParam, CookieIn []byte, Flags DomainMigrateFlags) (rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigratePerform3ParamsArgs {
-		Dom: Dom,
+	args := DomainMigratePerform3ParamsArgs{
+		Dom:      Dom,
 		Dconnuri: Dconnuri,
-		Params: Params,
+		Params:   Params,
 		CookieIn: CookieIn,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13350,10 +16558,8 @@ func (l *Libvirt) DomainMigratePerform3Params(Dom Domain, Dconnuri OptString, Pa
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CookieOut: []byte
 	_, err = dec.Decode(&rCookieOut)
 	if err != nil {
@@ -13367,14 +16573,16 @@ func (l *Libvirt) DomainMigratePerform3Params(Dom Domain, Dconnuri OptString, Pa
 func (l *Libvirt) DomainMigrateFinish3Params(Params []TypedParam, CookieIn []byte, Flags uint32, Cancelled int32) (rDom Domain, rCookieOut []byte, err error) {
 	var buf []byte
 
-	args := DomainMigrateFinish3ParamsArgs {
-		Params: Params,
-		CookieIn: CookieIn,
-		Flags: Flags,
+	args := DomainMigrateFinish3ParamsArgs{
+		Params:    Params,
+		CookieIn:  CookieIn,
+		Flags:     Flags,
 		Cancelled: Cancelled,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13386,10 +16594,8 @@ func (l *Libvirt) DomainMigrateFinish3Params(Params []TypedParam, CookieIn []byt
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -13408,20 +16614,21 @@ func (l *Libvirt) DomainMigrateFinish3Params(Params []TypedParam, CookieIn []byt
 func (l *Libvirt) DomainMigrateConfirm3Params(Dom Domain, Params []TypedParam, CookieIn []byte, Flags uint32, Cancelled int32) (err error) {
 	var buf []byte
 
-	args := DomainMigrateConfirm3ParamsArgs {
-		Dom: Dom,
-		Params: Params,
-		CookieIn: CookieIn,
-		Flags: Flags,
+	args := DomainMigrateConfirm3ParamsArgs{
+		Dom:       Dom,
+		Params:    Params,
+		CookieIn:  CookieIn,
+		Flags:     Flags,
 		Cancelled: Cancelled,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(307, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13434,18 +16641,19 @@ func (l *Libvirt) DomainMigrateConfirm3Params(Dom Domain, Params []TypedParam, C
 func (l *Libvirt) DomainSetMemoryStatsPeriod(Dom Domain, Period int32, Flags DomainMemoryModFlags) (err error) {
 	var buf []byte
 
-	args := DomainSetMemoryStatsPeriodArgs {
-		Dom: Dom,
+	args := DomainSetMemoryStatsPeriodArgs{
+		Dom:    Dom,
 		Period: Period,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(308, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13458,12 +16666,14 @@ func (l *Libvirt) DomainSetMemoryStatsPeriod(Dom Domain, Period int32, Flags Dom
 func (l *Libvirt) DomainCreateXMLWithFiles(XMLDesc string, Flags DomainCreateFlags) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainCreateXMLWithFilesArgs {
+	args := DomainCreateXMLWithFilesArgs{
 		XMLDesc: XMLDesc,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13475,10 +16685,8 @@ func (l *Libvirt) DomainCreateXMLWithFiles(XMLDesc string, Flags DomainCreateFla
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -13492,12 +16700,14 @@ func (l *Libvirt) DomainCreateXMLWithFiles(XMLDesc string, Flags DomainCreateFla
 func (l *Libvirt) DomainCreateWithFiles(Dom Domain, Flags DomainCreateFlags) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainCreateWithFilesArgs {
-		Dom: Dom,
+	args := DomainCreateWithFilesArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13509,10 +16719,8 @@ func (l *Libvirt) DomainCreateWithFiles(Dom Domain, Flags DomainCreateFlags) (rD
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -13526,7 +16734,6 @@ func (l *Libvirt) DomainCreateWithFiles(Dom Domain, Flags DomainCreateFlags) (rD
 func (l *Libvirt) DomainEventDeviceRemoved() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(311, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13539,13 +16746,15 @@ func (l *Libvirt) DomainEventDeviceRemoved() (err error) {
 func (l *Libvirt) ConnectGetCPUModelNames(Arch string, NeedResults int32, Flags uint32) (rModels []string, rRet int32, err error) {
 	var buf []byte
 
-	args := ConnectGetCPUModelNamesArgs {
-		Arch: Arch,
+	args := ConnectGetCPUModelNamesArgs{
+		Arch:        Arch,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13557,10 +16766,8 @@ func (l *Libvirt) ConnectGetCPUModelNames(Arch string, NeedResults int32, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Models: []string
 	_, err = dec.Decode(&rModels)
 	if err != nil {
@@ -13579,12 +16786,14 @@ func (l *Libvirt) ConnectGetCPUModelNames(Arch string, NeedResults int32, Flags
 func (l *Libvirt) ConnectNetworkEventRegisterAny(EventID int32, Net OptNetwork) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := ConnectNetworkEventRegisterAnyArgs {
+	args := ConnectNetworkEventRegisterAnyArgs{
 		EventID: EventID,
-		Net: Net,
+		Net:     Net,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13596,10 +16805,8 @@ func (l *Libvirt) ConnectNetworkEventRegisterAny(EventID int32, Net OptNetwork)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -13613,16 +16820,17 @@ func (l *Libvirt) ConnectNetworkEventRegisterAny(EventID int32, Net OptNetwork)
 func (l *Libvirt) ConnectNetworkEventDeregisterAny(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := ConnectNetworkEventDeregisterAnyArgs {
+	args := ConnectNetworkEventDeregisterAnyArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(314, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13635,7 +16843,6 @@ func (l *Libvirt) ConnectNetworkEventDeregisterAny(CallbackID int32) (err error)
 func (l *Libvirt) NetworkEventLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(315, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13648,12 +16855,14 @@ func (l *Libvirt) NetworkEventLifecycle() (err error) {
 func (l *Libvirt) ConnectDomainEventCallbackRegisterAny(EventID int32, Dom OptDomain) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := ConnectDomainEventCallbackRegisterAnyArgs {
+	args := ConnectDomainEventCallbackRegisterAnyArgs{
 		EventID: EventID,
-		Dom: Dom,
+		Dom:     Dom,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13665,10 +16874,8 @@ func (l *Libvirt) ConnectDomainEventCallbackRegisterAny(EventID int32, Dom OptDo
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -13682,16 +16889,17 @@ func (l *Libvirt) ConnectDomainEventCallbackRegisterAny(EventID int32, Dom OptDo
 func (l *Libvirt) ConnectDomainEventCallbackDeregisterAny(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := ConnectDomainEventCallbackDeregisterAnyArgs {
+	args := ConnectDomainEventCallbackDeregisterAnyArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(317, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13704,7 +16912,6 @@ func (l *Libvirt) ConnectDomainEventCallbackDeregisterAny(CallbackID int32) (err
 func (l *Libvirt) DomainEventCallbackLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(318, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13717,7 +16924,6 @@ func (l *Libvirt) DomainEventCallbackLifecycle() (err error) {
 func (l *Libvirt) DomainEventCallbackReboot() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(319, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13730,7 +16936,6 @@ func (l *Libvirt) DomainEventCallbackReboot() (err error) {
 func (l *Libvirt) DomainEventCallbackRtcChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(320, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13743,7 +16948,6 @@ func (l *Libvirt) DomainEventCallbackRtcChange() (err error) {
 func (l *Libvirt) DomainEventCallbackWatchdog() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(321, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13756,7 +16960,6 @@ func (l *Libvirt) DomainEventCallbackWatchdog() (err error) {
 func (l *Libvirt) DomainEventCallbackIOError() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(322, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13769,7 +16972,6 @@ func (l *Libvirt) DomainEventCallbackIOError() (err error) {
 func (l *Libvirt) DomainEventCallbackGraphics() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(323, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13782,7 +16984,6 @@ func (l *Libvirt) DomainEventCallbackGraphics() (err error) {
 func (l *Libvirt) DomainEventCallbackIOErrorReason() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(324, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13795,7 +16996,6 @@ func (l *Libvirt) DomainEventCallbackIOErrorReason() (err error) {
 func (l *Libvirt) DomainEventCallbackControlError() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(325, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13808,7 +17008,6 @@ func (l *Libvirt) DomainEventCallbackControlError() (err error) {
 func (l *Libvirt) DomainEventCallbackBlockJob() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(326, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13821,7 +17020,6 @@ func (l *Libvirt) DomainEventCallbackBlockJob() (err error) {
 func (l *Libvirt) DomainEventCallbackDiskChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(327, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13834,7 +17032,6 @@ func (l *Libvirt) DomainEventCallbackDiskChange() (err error) {
 func (l *Libvirt) DomainEventCallbackTrayChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(328, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13847,7 +17044,6 @@ func (l *Libvirt) DomainEventCallbackTrayChange() (err error) {
 func (l *Libvirt) DomainEventCallbackPmwakeup() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(329, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13860,7 +17056,6 @@ func (l *Libvirt) DomainEventCallbackPmwakeup() (err error) {
 func (l *Libvirt) DomainEventCallbackPmsuspend() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(330, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13873,7 +17068,6 @@ func (l *Libvirt) DomainEventCallbackPmsuspend() (err error) {
 func (l *Libvirt) DomainEventCallbackBalloonChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(331, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13886,7 +17080,6 @@ func (l *Libvirt) DomainEventCallbackBalloonChange() (err error) {
 func (l *Libvirt) DomainEventCallbackPmsuspendDisk() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(332, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13899,7 +17092,6 @@ func (l *Libvirt) DomainEventCallbackPmsuspendDisk() (err error) {
 func (l *Libvirt) DomainEventCallbackDeviceRemoved() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(333, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13909,22 +17101,23 @@ func (l *Libvirt) DomainEventCallbackDeviceRemoved() (err error) {
 }
 
 // DomainCoreDumpWithFormat is the go wrapper for REMOTE_PROC_DOMAIN_CORE_DUMP_WITH_FORMAT.
-func (l *Libvirt) DomainCoreDumpWithFormat(Dom Domain, To string, Dumpformat uint32, Flags DomainCoreDumpFlags) (err error) {
+func (l *Libvirt) DomainCoreDumpWithFormat(Dom Domain, To string, Dumpformat DomainCoreDumpFormat, Flags DomainCoreDumpFlags) (err error) {
 	var buf []byte
 
-	args := DomainCoreDumpWithFormatArgs {
-		Dom: Dom,
-		To: To,
+	args := DomainCoreDumpWithFormatArgs{
+		Dom:        Dom,
+		To:         To,
 		Dumpformat: Dumpformat,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(334, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -13933,17 +17126,19 @@ func (l *Libvirt) DomainCoreDumpWithFormat(Dom Domain, To string, Dumpformat uin
 	return
 }
 
-// DomainFsfreeze is the go wrapper for REMOTE_PROC_DOMAIN_FSFREEZE.
-func (l *Libvirt) DomainFsfreeze(Dom Domain, Mountpoints []string, Flags uint32) (rFilesystems int32, err error) {
+// DomainFSFreeze is the go wrapper for REMOTE_PROC_DOMAIN_FSFREEZE.
+func (l *Libvirt) DomainFSFreeze(Dom Domain, Mountpoints []string, Flags uint32) (rFilesystems int32, err error) {
 	var buf []byte
 
-	args := DomainFsfreezeArgs {
-		Dom: Dom,
+	args := DomainFsfreezeArgs{
+		Dom:         Dom,
 		Mountpoints: Mountpoints,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13955,10 +17150,8 @@ func (l *Libvirt) DomainFsfreeze(Dom Domain, Mountpoints []string, Flags uint32)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Filesystems: int32
 	_, err = dec.Decode(&rFilesystems)
 	if err != nil {
@@ -13968,17 +17161,19 @@ func (l *Libvirt) DomainFsfreeze(Dom Domain, Mountpoints []string, Flags uint32)
 	return
 }
 
-// DomainFsthaw is the go wrapper for REMOTE_PROC_DOMAIN_FSTHAW.
-func (l *Libvirt) DomainFsthaw(Dom Domain, Mountpoints []string, Flags uint32) (rFilesystems int32, err error) {
+// DomainFSThaw is the go wrapper for REMOTE_PROC_DOMAIN_FSTHAW.
+func (l *Libvirt) DomainFSThaw(Dom Domain, Mountpoints []string, Flags uint32) (rFilesystems int32, err error) {
 	var buf []byte
 
-	args := DomainFsthawArgs {
-		Dom: Dom,
+	args := DomainFsthawArgs{
+		Dom:         Dom,
 		Mountpoints: Mountpoints,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -13990,10 +17185,8 @@ func (l *Libvirt) DomainFsthaw(Dom Domain, Mountpoints []string, Flags uint32) (
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Filesystems: int32
 	_, err = dec.Decode(&rFilesystems)
 	if err != nil {
@@ -14007,12 +17200,14 @@ func (l *Libvirt) DomainFsthaw(Dom Domain, Mountpoints []string, Flags uint32) (
 func (l *Libvirt) DomainGetTime(Dom Domain, Flags uint32) (rSeconds int64, rNseconds uint32, err error) {
 	var buf []byte
 
-	args := DomainGetTimeArgs {
-		Dom: Dom,
+	args := DomainGetTimeArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14024,10 +17219,8 @@ func (l *Libvirt) DomainGetTime(Dom Domain, Flags uint32) (rSeconds int64, rNsec
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Seconds: int64
 	_, err = dec.Decode(&rSeconds)
 	if err != nil {
@@ -14046,19 +17239,20 @@ func (l *Libvirt) DomainGetTime(Dom Domain, Flags uint32) (rSeconds int64, rNsec
 func (l *Libvirt) DomainSetTime(Dom Domain, Seconds int64, Nseconds uint32, Flags DomainSetTimeFlags) (err error) {
 	var buf []byte
 
-	args := DomainSetTimeArgs {
-		Dom: Dom,
-		Seconds: Seconds,
+	args := DomainSetTimeArgs{
+		Dom:      Dom,
+		Seconds:  Seconds,
 		Nseconds: Nseconds,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(338, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14071,7 +17265,6 @@ func (l *Libvirt) DomainSetTime(Dom Domain, Seconds int64, Nseconds uint32, Flag
 func (l *Libvirt) DomainEventBlockJob2() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(339, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14084,14 +17277,16 @@ func (l *Libvirt) DomainEventBlockJob2() (err error) {
 func (l *Libvirt) NodeGetFreePages(Pages []uint32, StartCell int32, CellCount uint32, Flags uint32) (rCounts []uint64, err error) {
 	var buf []byte
 
-	args := NodeGetFreePagesArgs {
-		Pages: Pages,
+	args := NodeGetFreePagesArgs{
+		Pages:     Pages,
 		StartCell: StartCell,
 		CellCount: CellCount,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14103,10 +17298,8 @@ func (l *Libvirt) NodeGetFreePages(Pages []uint32, StartCell int32, CellCount ui
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Counts: []uint64
 	_, err = dec.Decode(&rCounts)
 	if err != nil {
@@ -14120,14 +17313,16 @@ func (l *Libvirt) NodeGetFreePages(Pages []uint32, StartCell int32, CellCount ui
 func (l *Libvirt) NetworkGetDhcpLeases(Net Network, Mac OptString, NeedResults int32, Flags uint32) (rLeases []NetworkDhcpLease, rRet uint32, err error) {
 	var buf []byte
 
-	args := NetworkGetDhcpLeasesArgs {
-		Net: Net,
-		Mac: Mac,
+	args := NetworkGetDhcpLeasesArgs{
+		Net:         Net,
+		Mac:         Mac,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14139,10 +17334,8 @@ func (l *Libvirt) NetworkGetDhcpLeases(Net Network, Mac OptString, NeedResults i
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Leases: []NetworkDhcpLease
 	_, err = dec.Decode(&rLeases)
 	if err != nil {
@@ -14161,15 +17354,17 @@ func (l *Libvirt) NetworkGetDhcpLeases(Net Network, Mac OptString, NeedResults i
 func (l *Libvirt) ConnectGetDomainCapabilities(Emulatorbin OptString, Arch OptString, Machine OptString, Virttype OptString, Flags uint32) (rCapabilities string, err error) {
 	var buf []byte
 
-	args := ConnectGetDomainCapabilitiesArgs {
+	args := ConnectGetDomainCapabilitiesArgs{
 		Emulatorbin: Emulatorbin,
-		Arch: Arch,
-		Machine: Machine,
-		Virttype: Virttype,
-		Flags: Flags,
+		Arch:        Arch,
+		Machine:     Machine,
+		Virttype:    Virttype,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14181,10 +17376,8 @@ func (l *Libvirt) ConnectGetDomainCapabilities(Emulatorbin OptString, Arch OptSt
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Capabilities: string
 	_, err = dec.Decode(&rCapabilities)
 	if err != nil {
@@ -14198,18 +17391,19 @@ func (l *Libvirt) ConnectGetDomainCapabilities(Emulatorbin OptString, Arch OptSt
 func (l *Libvirt) DomainOpenGraphicsFd(Dom Domain, Idx uint32, Flags DomainOpenGraphicsFlags) (err error) {
 	var buf []byte
 
-	args := DomainOpenGraphicsFdArgs {
-		Dom: Dom,
-		Idx: Idx,
+	args := DomainOpenGraphicsFdArgs{
+		Dom:   Dom,
+		Idx:   Idx,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(343, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14222,13 +17416,15 @@ func (l *Libvirt) DomainOpenGraphicsFd(Dom Domain, Idx uint32, Flags DomainOpenG
 func (l *Libvirt) ConnectGetAllDomainStats(Doms []Domain, Stats uint32, Flags ConnectGetAllDomainStatsFlags) (rRetStats []DomainStatsRecord, err error) {
 	var buf []byte
 
-	args := ConnectGetAllDomainStatsArgs {
-		Doms: Doms,
+	args := ConnectGetAllDomainStatsArgs{
+		Doms:  Doms,
 		Stats: Stats,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14240,10 +17436,8 @@ func (l *Libvirt) ConnectGetAllDomainStats(Doms []Domain, Stats uint32, Flags Co
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// RetStats: []DomainStatsRecord
 	_, err = dec.Decode(&rRetStats)
 	if err != nil {
@@ -14257,20 +17451,21 @@ func (l *Libvirt) ConnectGetAllDomainStats(Doms []Domain, Stats uint32, Flags Co
 func (l *Libvirt) DomainBlockCopy(Dom Domain, Path string, Destxml string, Params []TypedParam, Flags DomainBlockCopyFlags) (err error) {
 	var buf []byte
 
-	args := DomainBlockCopyArgs {
-		Dom: Dom,
-		Path: Path,
+	args := DomainBlockCopyArgs{
+		Dom:     Dom,
+		Path:    Path,
 		Destxml: Destxml,
-		Params: Params,
-		Flags: Flags,
+		Params:  Params,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(345, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14283,7 +17478,6 @@ func (l *Libvirt) DomainBlockCopy(Dom Domain, Path string, Destxml string, Param
 func (l *Libvirt) DomainEventCallbackTunable() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(346, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14296,15 +17490,17 @@ func (l *Libvirt) DomainEventCallbackTunable() (err error) {
 func (l *Libvirt) NodeAllocPages(PageSizes []uint32, PageCounts []uint64, StartCell int32, CellCount uint32, Flags NodeAllocPagesFlags) (rRet int32, err error) {
 	var buf []byte
 
-	args := NodeAllocPagesArgs {
-		PageSizes: PageSizes,
+	args := NodeAllocPagesArgs{
+		PageSizes:  PageSizes,
 		PageCounts: PageCounts,
-		StartCell: StartCell,
-		CellCount: CellCount,
-		Flags: Flags,
+		StartCell:  StartCell,
+		CellCount:  CellCount,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14316,10 +17512,8 @@ func (l *Libvirt) NodeAllocPages(PageSizes []uint32, PageCounts []uint64, StartC
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ret: int32
 	_, err = dec.Decode(&rRet)
 	if err != nil {
@@ -14333,7 +17527,6 @@ func (l *Libvirt) NodeAllocPages(PageSizes []uint32, PageCounts []uint64, StartC
 func (l *Libvirt) DomainEventCallbackAgentLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(348, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14346,12 +17539,14 @@ func (l *Libvirt) DomainEventCallbackAgentLifecycle() (err error) {
 func (l *Libvirt) DomainGetFsinfo(Dom Domain, Flags uint32) (rInfo []DomainFsinfo, rRet uint32, err error) {
 	var buf []byte
 
-	args := DomainGetFsinfoArgs {
-		Dom: Dom,
+	args := DomainGetFsinfoArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14363,10 +17558,8 @@ func (l *Libvirt) DomainGetFsinfo(Dom Domain, Flags uint32) (rInfo []DomainFsinf
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Info: []DomainFsinfo
 	_, err = dec.Decode(&rInfo)
 	if err != nil {
@@ -14385,12 +17578,14 @@ func (l *Libvirt) DomainGetFsinfo(Dom Domain, Flags uint32) (rInfo []DomainFsinf
 func (l *Libvirt) DomainDefineXMLFlags(XML string, Flags DomainDefineFlags) (rDom Domain, err error) {
 	var buf []byte
 
-	args := DomainDefineXMLFlagsArgs {
-		XML: XML,
+	args := DomainDefineXMLFlagsArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14402,10 +17597,8 @@ func (l *Libvirt) DomainDefineXMLFlags(XML string, Flags DomainDefineFlags) (rDo
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Dom: Domain
 	_, err = dec.Decode(&rDom)
 	if err != nil {
@@ -14419,12 +17612,14 @@ func (l *Libvirt) DomainDefineXMLFlags(XML string, Flags DomainDefineFlags) (rDo
 func (l *Libvirt) DomainGetIothreadInfo(Dom Domain, Flags DomainModificationImpact) (rInfo []DomainIothreadInfo, rRet uint32, err error) {
 	var buf []byte
 
-	args := DomainGetIothreadInfoArgs {
-		Dom: Dom,
+	args := DomainGetIothreadInfoArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14436,10 +17631,8 @@ func (l *Libvirt) DomainGetIothreadInfo(Dom Domain, Flags DomainModificationImpa
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Info: []DomainIothreadInfo
 	_, err = dec.Decode(&rInfo)
 	if err != nil {
@@ -14458,19 +17651,20 @@ func (l *Libvirt) DomainGetIothreadInfo(Dom Domain, Flags DomainModificationImpa
 func (l *Libvirt) DomainPinIothread(Dom Domain, IothreadsID uint32, Cpumap []byte, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainPinIothreadArgs {
-		Dom: Dom,
+	args := DomainPinIothreadArgs{
+		Dom:         Dom,
 		IothreadsID: IothreadsID,
-		Cpumap: Cpumap,
-		Flags: Flags,
+		Cpumap:      Cpumap,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(352, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14483,13 +17677,15 @@ func (l *Libvirt) DomainPinIothread(Dom Domain, IothreadsID uint32, Cpumap []byt
 func (l *Libvirt) DomainInterfaceAddresses(Dom Domain, Source uint32, Flags uint32) (rIfaces []DomainInterface, err error) {
 	var buf []byte
 
-	args := DomainInterfaceAddressesArgs {
-		Dom: Dom,
+	args := DomainInterfaceAddressesArgs{
+		Dom:    Dom,
 		Source: Source,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14501,10 +17697,8 @@ func (l *Libvirt) DomainInterfaceAddresses(Dom Domain, Source uint32, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ifaces: []DomainInterface
 	_, err = dec.Decode(&rIfaces)
 	if err != nil {
@@ -14518,7 +17712,6 @@ func (l *Libvirt) DomainInterfaceAddresses(Dom Domain, Source uint32, Flags uint
 func (l *Libvirt) DomainEventCallbackDeviceAdded() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(354, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14531,18 +17724,19 @@ func (l *Libvirt) DomainEventCallbackDeviceAdded() (err error) {
 func (l *Libvirt) DomainAddIothread(Dom Domain, IothreadID uint32, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainAddIothreadArgs {
-		Dom: Dom,
+	args := DomainAddIothreadArgs{
+		Dom:        Dom,
 		IothreadID: IothreadID,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(355, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14555,18 +17749,19 @@ func (l *Libvirt) DomainAddIothread(Dom Domain, IothreadID uint32, Flags DomainM
 func (l *Libvirt) DomainDelIothread(Dom Domain, IothreadID uint32, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainDelIothreadArgs {
-		Dom: Dom,
+	args := DomainDelIothreadArgs{
+		Dom:        Dom,
 		IothreadID: IothreadID,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(356, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14579,19 +17774,20 @@ func (l *Libvirt) DomainDelIothread(Dom Domain, IothreadID uint32, Flags DomainM
 func (l *Libvirt) DomainSetUserPassword(Dom Domain, User OptString, Password OptString, Flags DomainSetUserPasswordFlags) (err error) {
 	var buf []byte
 
-	args := DomainSetUserPasswordArgs {
-		Dom: Dom,
-		User: User,
+	args := DomainSetUserPasswordArgs{
+		Dom:      Dom,
+		User:     User,
 		Password: Password,
-		Flags: Flags,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(357, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14604,13 +17800,15 @@ func (l *Libvirt) DomainSetUserPassword(Dom Domain, User OptString, Password Opt
 func (l *Libvirt) DomainRename(Dom Domain, NewName OptString, Flags uint32) (rRetcode int32, err error) {
 	var buf []byte
 
-	args := DomainRenameArgs {
-		Dom: Dom,
+	args := DomainRenameArgs{
+		Dom:     Dom,
 		NewName: NewName,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14622,10 +17820,8 @@ func (l *Libvirt) DomainRename(Dom Domain, NewName OptString, Flags uint32) (rRe
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Retcode: int32
 	_, err = dec.Decode(&rRetcode)
 	if err != nil {
@@ -14639,7 +17835,6 @@ func (l *Libvirt) DomainRename(Dom Domain, NewName OptString, Flags uint32) (rRe
 func (l *Libvirt) DomainEventCallbackMigrationIteration() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(359, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14652,7 +17847,6 @@ func (l *Libvirt) DomainEventCallbackMigrationIteration() (err error) {
 func (l *Libvirt) ConnectRegisterCloseCallback() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(360, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14665,7 +17859,6 @@ func (l *Libvirt) ConnectRegisterCloseCallback() (err error) {
 func (l *Libvirt) ConnectUnregisterCloseCallback() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(361, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14678,7 +17871,6 @@ func (l *Libvirt) ConnectUnregisterCloseCallback() (err error) {
 func (l *Libvirt) ConnectEventConnectionClosed() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(362, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14691,7 +17883,6 @@ func (l *Libvirt) ConnectEventConnectionClosed() (err error) {
 func (l *Libvirt) DomainEventCallbackJobCompleted() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(363, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14704,17 +17895,18 @@ func (l *Libvirt) DomainEventCallbackJobCompleted() (err error) {
 func (l *Libvirt) DomainMigrateStartPostCopy(Dom Domain, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainMigrateStartPostCopyArgs {
-		Dom: Dom,
+	args := DomainMigrateStartPostCopyArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(364, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14727,12 +17919,14 @@ func (l *Libvirt) DomainMigrateStartPostCopy(Dom Domain, Flags uint32) (err erro
 func (l *Libvirt) DomainGetPerfEvents(Dom Domain, Flags DomainModificationImpact) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetPerfEventsArgs {
-		Dom: Dom,
+	args := DomainGetPerfEventsArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14744,10 +17938,8 @@ func (l *Libvirt) DomainGetPerfEvents(Dom Domain, Flags DomainModificationImpact
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -14761,18 +17953,19 @@ func (l *Libvirt) DomainGetPerfEvents(Dom Domain, Flags DomainModificationImpact
 func (l *Libvirt) DomainSetPerfEvents(Dom Domain, Params []TypedParam, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainSetPerfEventsArgs {
-		Dom: Dom,
+	args := DomainSetPerfEventsArgs{
+		Dom:    Dom,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(366, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14785,7 +17978,6 @@ func (l *Libvirt) DomainSetPerfEvents(Dom Domain, Params []TypedParam, Flags Dom
 func (l *Libvirt) DomainEventCallbackDeviceRemovalFailed() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(367, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14798,12 +17990,14 @@ func (l *Libvirt) DomainEventCallbackDeviceRemovalFailed() (err error) {
 func (l *Libvirt) ConnectStoragePoolEventRegisterAny(EventID int32, Pool OptStoragePool) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := ConnectStoragePoolEventRegisterAnyArgs {
+	args := ConnectStoragePoolEventRegisterAnyArgs{
 		EventID: EventID,
-		Pool: Pool,
+		Pool:    Pool,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14815,10 +18009,8 @@ func (l *Libvirt) ConnectStoragePoolEventRegisterAny(EventID int32, Pool OptStor
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -14832,16 +18024,17 @@ func (l *Libvirt) ConnectStoragePoolEventRegisterAny(EventID int32, Pool OptStor
 func (l *Libvirt) ConnectStoragePoolEventDeregisterAny(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := ConnectStoragePoolEventDeregisterAnyArgs {
+	args := ConnectStoragePoolEventDeregisterAnyArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(369, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14854,7 +18047,6 @@ func (l *Libvirt) ConnectStoragePoolEventDeregisterAny(CallbackID int32) (err er
 func (l *Libvirt) StoragePoolEventLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(370, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14867,12 +18059,14 @@ func (l *Libvirt) StoragePoolEventLifecycle() (err error) {
 func (l *Libvirt) DomainGetGuestVcpus(Dom Domain, Flags uint32) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetGuestVcpusArgs {
-		Dom: Dom,
+	args := DomainGetGuestVcpusArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14884,10 +18078,8 @@ func (l *Libvirt) DomainGetGuestVcpus(Dom Domain, Flags uint32) (rParams []Typed
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -14901,19 +18093,20 @@ func (l *Libvirt) DomainGetGuestVcpus(Dom Domain, Flags uint32) (rParams []Typed
 func (l *Libvirt) DomainSetGuestVcpus(Dom Domain, Cpumap string, State int32, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetGuestVcpusArgs {
-		Dom: Dom,
+	args := DomainSetGuestVcpusArgs{
+		Dom:    Dom,
 		Cpumap: Cpumap,
-		State: State,
-		Flags: Flags,
+		State:  State,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(372, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14926,7 +18119,6 @@ func (l *Libvirt) DomainSetGuestVcpus(Dom Domain, Cpumap string, State int32, Fl
 func (l *Libvirt) StoragePoolEventRefresh() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(373, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14939,12 +18131,14 @@ func (l *Libvirt) StoragePoolEventRefresh() (err error) {
 func (l *Libvirt) ConnectNodeDeviceEventRegisterAny(EventID int32, Dev OptNodeDevice) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := ConnectNodeDeviceEventRegisterAnyArgs {
+	args := ConnectNodeDeviceEventRegisterAnyArgs{
 		EventID: EventID,
-		Dev: Dev,
+		Dev:     Dev,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -14956,10 +18150,8 @@ func (l *Libvirt) ConnectNodeDeviceEventRegisterAny(EventID int32, Dev OptNodeDe
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -14973,16 +18165,17 @@ func (l *Libvirt) ConnectNodeDeviceEventRegisterAny(EventID int32, Dev OptNodeDe
 func (l *Libvirt) ConnectNodeDeviceEventDeregisterAny(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := ConnectNodeDeviceEventDeregisterAnyArgs {
+	args := ConnectNodeDeviceEventDeregisterAnyArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(375, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -14995,7 +18188,6 @@ func (l *Libvirt) ConnectNodeDeviceEventDeregisterAny(CallbackID int32) (err err
 func (l *Libvirt) NodeDeviceEventLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(376, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15008,7 +18200,6 @@ func (l *Libvirt) NodeDeviceEventLifecycle() (err error) {
 func (l *Libvirt) NodeDeviceEventUpdate() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(377, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15021,12 +18212,14 @@ func (l *Libvirt) NodeDeviceEventUpdate() (err error) {
 func (l *Libvirt) StorageVolGetInfoFlags(Vol StorageVol, Flags uint32) (rType int8, rCapacity uint64, rAllocation uint64, err error) {
 	var buf []byte
 
-	args := StorageVolGetInfoFlagsArgs {
-		Vol: Vol,
+	args := StorageVolGetInfoFlagsArgs{
+		Vol:   Vol,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15038,10 +18231,8 @@ func (l *Libvirt) StorageVolGetInfoFlags(Vol StorageVol, Flags uint32) (rType in
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Type: int8
 	_, err = dec.Decode(&rType)
 	if err != nil {
@@ -15065,7 +18256,6 @@ func (l *Libvirt) StorageVolGetInfoFlags(Vol StorageVol, Flags uint32) (rType in
 func (l *Libvirt) DomainEventCallbackMetadataChange() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(379, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15078,12 +18268,14 @@ func (l *Libvirt) DomainEventCallbackMetadataChange() (err error) {
 func (l *Libvirt) ConnectSecretEventRegisterAny(EventID int32, OptSecret OptSecret) (rCallbackID int32, err error) {
 	var buf []byte
 
-	args := ConnectSecretEventRegisterAnyArgs {
-		EventID: EventID,
+	args := ConnectSecretEventRegisterAnyArgs{
+		EventID:   EventID,
 		OptSecret: OptSecret,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15095,10 +18287,8 @@ func (l *Libvirt) ConnectSecretEventRegisterAny(EventID int32, OptSecret OptSecr
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CallbackID: int32
 	_, err = dec.Decode(&rCallbackID)
 	if err != nil {
@@ -15112,16 +18302,17 @@ func (l *Libvirt) ConnectSecretEventRegisterAny(EventID int32, OptSecret OptSecr
 func (l *Libvirt) ConnectSecretEventDeregisterAny(CallbackID int32) (err error) {
 	var buf []byte
 
-	args := ConnectSecretEventDeregisterAnyArgs {
+	args := ConnectSecretEventDeregisterAnyArgs{
 		CallbackID: CallbackID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(381, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15134,7 +18325,6 @@ func (l *Libvirt) ConnectSecretEventDeregisterAny(CallbackID int32) (err error)
 func (l *Libvirt) SecretEventLifecycle() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(382, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15147,7 +18337,6 @@ func (l *Libvirt) SecretEventLifecycle() (err error) {
 func (l *Libvirt) SecretEventValueChanged() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(383, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15160,19 +18349,20 @@ func (l *Libvirt) SecretEventValueChanged() (err error) {
 func (l *Libvirt) DomainSetVcpu(Dom Domain, Cpumap string, State int32, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainSetVcpuArgs {
-		Dom: Dom,
+	args := DomainSetVcpuArgs{
+		Dom:    Dom,
 		Cpumap: Cpumap,
-		State: State,
-		Flags: Flags,
+		State:  State,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(384, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15185,7 +18375,6 @@ func (l *Libvirt) DomainSetVcpu(Dom Domain, Cpumap string, State int32, Flags Do
 func (l *Libvirt) DomainEventBlockThreshold() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(385, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15198,19 +18387,20 @@ func (l *Libvirt) DomainEventBlockThreshold() (err error) {
 func (l *Libvirt) DomainSetBlockThreshold(Dom Domain, Dev string, Threshold uint64, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetBlockThresholdArgs {
-		Dom: Dom,
-		Dev: Dev,
+	args := DomainSetBlockThresholdArgs{
+		Dom:       Dom,
+		Dev:       Dev,
 		Threshold: Threshold,
-		Flags: Flags,
+		Flags:     Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(386, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15223,12 +18413,14 @@ func (l *Libvirt) DomainSetBlockThreshold(Dom Domain, Dev string, Threshold uint
 func (l *Libvirt) DomainMigrateGetMaxDowntime(Dom Domain, Flags uint32) (rDowntime uint64, err error) {
 	var buf []byte
 
-	args := DomainMigrateGetMaxDowntimeArgs {
-		Dom: Dom,
+	args := DomainMigrateGetMaxDowntimeArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15240,10 +18432,8 @@ func (l *Libvirt) DomainMigrateGetMaxDowntime(Dom Domain, Flags uint32) (rDownti
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Downtime: uint64
 	_, err = dec.Decode(&rDowntime)
 	if err != nil {
@@ -15257,12 +18447,14 @@ func (l *Libvirt) DomainMigrateGetMaxDowntime(Dom Domain, Flags uint32) (rDownti
 func (l *Libvirt) DomainManagedSaveGetXMLDesc(Dom Domain, Flags DomainXMLFlags) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainManagedSaveGetXMLDescArgs {
-		Dom: Dom,
+	args := DomainManagedSaveGetXMLDescArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15274,10 +18466,8 @@ func (l *Libvirt) DomainManagedSaveGetXMLDesc(Dom Domain, Flags DomainXMLFlags)
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -15291,18 +18481,19 @@ func (l *Libvirt) DomainManagedSaveGetXMLDesc(Dom Domain, Flags DomainXMLFlags)
 func (l *Libvirt) DomainManagedSaveDefineXML(Dom Domain, Dxml OptString, Flags DomainSaveRestoreFlags) (err error) {
 	var buf []byte
 
-	args := DomainManagedSaveDefineXMLArgs {
-		Dom: Dom,
-		Dxml: Dxml,
+	args := DomainManagedSaveDefineXMLArgs{
+		Dom:   Dom,
+		Dxml:  Dxml,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(389, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15315,19 +18506,20 @@ func (l *Libvirt) DomainManagedSaveDefineXML(Dom Domain, Dxml OptString, Flags D
 func (l *Libvirt) DomainSetLifecycleAction(Dom Domain, Type uint32, Action uint32, Flags DomainModificationImpact) (err error) {
 	var buf []byte
 
-	args := DomainSetLifecycleActionArgs {
-		Dom: Dom,
-		Type: Type,
+	args := DomainSetLifecycleActionArgs{
+		Dom:    Dom,
+		Type:   Type,
 		Action: Action,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(390, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15340,11 +18532,13 @@ func (l *Libvirt) DomainSetLifecycleAction(Dom Domain, Type uint32, Action uint3
 func (l *Libvirt) StoragePoolLookupByTargetPath(Path string) (rPool StoragePool, err error) {
 	var buf []byte
 
-	args := StoragePoolLookupByTargetPathArgs {
+	args := StoragePoolLookupByTargetPathArgs{
 		Path: Path,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15356,10 +18550,8 @@ func (l *Libvirt) StoragePoolLookupByTargetPath(Path string) (rPool StoragePool,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Pool: StoragePool
 	_, err = dec.Decode(&rPool)
 	if err != nil {
@@ -15370,21 +18562,22 @@ func (l *Libvirt) StoragePoolLookupByTargetPath(Path string) (rPool StoragePool,
 }
 
 // DomainDetachDeviceAlias is the go wrapper for REMOTE_PROC_DOMAIN_DETACH_DEVICE_ALIAS.
-func (l *Libvirt) DomainDetachDeviceAlias(Dom Domain, Alias string, Flags uint32) (err error) {
+func (l *Libvirt) DomainDetachDeviceAlias(Dom Domain, Alias string, Flags DomainDeviceModifyFlags) (err error) {
 	var buf []byte
 
-	args := DomainDetachDeviceAliasArgs {
-		Dom: Dom,
+	args := DomainDetachDeviceAliasArgs{
+		Dom:   Dom,
 		Alias: Alias,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(392, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15397,16 +18590,18 @@ func (l *Libvirt) DomainDetachDeviceAlias(Dom Domain, Alias string, Flags uint32
 func (l *Libvirt) ConnectCompareHypervisorCPU(Emulator OptString, Arch OptString, Machine OptString, Virttype OptString, XMLCPU string, Flags uint32) (rResult int32, err error) {
 	var buf []byte
 
-	args := ConnectCompareHypervisorCPUArgs {
+	args := ConnectCompareHypervisorCPUArgs{
 		Emulator: Emulator,
-		Arch: Arch,
-		Machine: Machine,
+		Arch:     Arch,
+		Machine:  Machine,
 		Virttype: Virttype,
-		XMLCPU: XMLCPU,
-		Flags: Flags,
+		XMLCPU:   XMLCPU,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15418,10 +18613,8 @@ func (l *Libvirt) ConnectCompareHypervisorCPU(Emulator OptString, Arch OptString
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: int32
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -15435,16 +18628,18 @@ func (l *Libvirt) ConnectCompareHypervisorCPU(Emulator OptString, Arch OptString
 func (l *Libvirt) ConnectBaselineHypervisorCPU(Emulator OptString, Arch OptString, Machine OptString, Virttype OptString, XMLCPUs []string, Flags uint32) (rCPU string, err error) {
 	var buf []byte
 
-	args := ConnectBaselineHypervisorCPUArgs {
+	args := ConnectBaselineHypervisorCPUArgs{
 		Emulator: Emulator,
-		Arch: Arch,
-		Machine: Machine,
+		Arch:     Arch,
+		Machine:  Machine,
 		Virttype: Virttype,
-		XMLCPUs: XMLCPUs,
-		Flags: Flags,
+		XMLCPUs:  XMLCPUs,
+		Flags:    Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15456,10 +18651,8 @@ func (l *Libvirt) ConnectBaselineHypervisorCPU(Emulator OptString, Arch OptStrin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// CPU: string
 	_, err = dec.Decode(&rCPU)
 	if err != nil {
@@ -15473,12 +18666,14 @@ func (l *Libvirt) ConnectBaselineHypervisorCPU(Emulator OptString, Arch OptStrin
 func (l *Libvirt) NodeGetSevInfo(Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := NodeGetSevInfoArgs {
+	args := NodeGetSevInfoArgs{
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15490,10 +18685,8 @@ func (l *Libvirt) NodeGetSevInfo(Nparams int32, Flags uint32) (rParams []TypedPa
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -15512,12 +18705,14 @@ func (l *Libvirt) NodeGetSevInfo(Nparams int32, Flags uint32) (rParams []TypedPa
 func (l *Libvirt) DomainGetLaunchSecurityInfo(Dom Domain, Flags uint32) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetLaunchSecurityInfoArgs {
-		Dom: Dom,
+	args := DomainGetLaunchSecurityInfoArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15529,10 +18724,8 @@ func (l *Libvirt) DomainGetLaunchSecurityInfo(Dom Domain, Flags uint32) (rParams
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -15546,11 +18739,13 @@ func (l *Libvirt) DomainGetLaunchSecurityInfo(Dom Domain, Flags uint32) (rParams
 func (l *Libvirt) NwfilterBindingLookupByPortDev(Name string) (rOptNwfilter NwfilterBinding, err error) {
 	var buf []byte
 
-	args := NwfilterBindingLookupByPortDevArgs {
+	args := NwfilterBindingLookupByPortDevArgs{
 		Name: Name,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15562,10 +18757,8 @@ func (l *Libvirt) NwfilterBindingLookupByPortDev(Name string) (rOptNwfilter Nwfi
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptNwfilter: NwfilterBinding
 	_, err = dec.Decode(&rOptNwfilter)
 	if err != nil {
@@ -15579,12 +18772,14 @@ func (l *Libvirt) NwfilterBindingLookupByPortDev(Name string) (rOptNwfilter Nwfi
 func (l *Libvirt) NwfilterBindingGetXMLDesc(OptNwfilter NwfilterBinding, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := NwfilterBindingGetXMLDescArgs {
+	args := NwfilterBindingGetXMLDescArgs{
 		OptNwfilter: OptNwfilter,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15596,10 +18791,8 @@ func (l *Libvirt) NwfilterBindingGetXMLDesc(OptNwfilter NwfilterBinding, Flags u
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -15613,12 +18806,14 @@ func (l *Libvirt) NwfilterBindingGetXMLDesc(OptNwfilter NwfilterBinding, Flags u
 func (l *Libvirt) NwfilterBindingCreateXML(XML string, Flags uint32) (rOptNwfilter NwfilterBinding, err error) {
 	var buf []byte
 
-	args := NwfilterBindingCreateXMLArgs {
-		XML: XML,
+	args := NwfilterBindingCreateXMLArgs{
+		XML:   XML,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15630,10 +18825,8 @@ func (l *Libvirt) NwfilterBindingCreateXML(XML string, Flags uint32) (rOptNwfilt
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// OptNwfilter: NwfilterBinding
 	_, err = dec.Decode(&rOptNwfilter)
 	if err != nil {
@@ -15647,16 +18840,17 @@ func (l *Libvirt) NwfilterBindingCreateXML(XML string, Flags uint32) (rOptNwfilt
 func (l *Libvirt) NwfilterBindingDelete(OptNwfilter NwfilterBinding) (err error) {
 	var buf []byte
 
-	args := NwfilterBindingDeleteArgs {
+	args := NwfilterBindingDeleteArgs{
 		OptNwfilter: OptNwfilter,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(400, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15669,12 +18863,14 @@ func (l *Libvirt) NwfilterBindingDelete(OptNwfilter NwfilterBinding) (err error)
 func (l *Libvirt) ConnectListAllNwfilterBindings(NeedResults int32, Flags uint32) (rBindings []NwfilterBinding, rRet uint32, err error) {
 	var buf []byte
 
-	args := ConnectListAllNwfilterBindingsArgs {
+	args := ConnectListAllNwfilterBindingsArgs{
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15686,10 +18882,8 @@ func (l *Libvirt) ConnectListAllNwfilterBindings(NeedResults int32, Flags uint32
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Bindings: []NwfilterBinding
 	_, err = dec.Decode(&rBindings)
 	if err != nil {
@@ -15708,19 +18902,20 @@ func (l *Libvirt) ConnectListAllNwfilterBindings(NeedResults int32, Flags uint32
 func (l *Libvirt) DomainSetIothreadParams(Dom Domain, IothreadID uint32, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainSetIothreadParamsArgs {
-		Dom: Dom,
+	args := DomainSetIothreadParamsArgs{
+		Dom:        Dom,
 		IothreadID: IothreadID,
-		Params: Params,
-		Flags: Flags,
+		Params:     Params,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(402, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15733,11 +18928,13 @@ func (l *Libvirt) DomainSetIothreadParams(Dom Domain, IothreadID uint32, Params
 func (l *Libvirt) ConnectGetStoragePoolCapabilities(Flags uint32) (rCapabilities string, err error) {
 	var buf []byte
 
-	args := ConnectGetStoragePoolCapabilitiesArgs {
+	args := ConnectGetStoragePoolCapabilitiesArgs{
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15749,10 +18946,8 @@ func (l *Libvirt) ConnectGetStoragePoolCapabilities(Flags uint32) (rCapabilities
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Capabilities: string
 	_, err = dec.Decode(&rCapabilities)
 	if err != nil {
@@ -15766,13 +18961,15 @@ func (l *Libvirt) ConnectGetStoragePoolCapabilities(Flags uint32) (rCapabilities
 func (l *Libvirt) NetworkListAllPorts(OptNetwork Network, NeedResults int32, Flags uint32) (rPorts []NetworkPort, rRet uint32, err error) {
 	var buf []byte
 
-	args := NetworkListAllPortsArgs {
-		OptNetwork: OptNetwork,
+	args := NetworkListAllPortsArgs{
+		OptNetwork:  OptNetwork,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15784,10 +18981,8 @@ func (l *Libvirt) NetworkListAllPorts(OptNetwork Network, NeedResults int32, Fla
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Ports: []NetworkPort
 	_, err = dec.Decode(&rPorts)
 	if err != nil {
@@ -15806,12 +19001,14 @@ func (l *Libvirt) NetworkListAllPorts(OptNetwork Network, NeedResults int32, Fla
 func (l *Libvirt) NetworkPortLookupByUUID(OptNetwork Network, UUID UUID) (rPort NetworkPort, err error) {
 	var buf []byte
 
-	args := NetworkPortLookupByUUIDArgs {
+	args := NetworkPortLookupByUUIDArgs{
 		OptNetwork: OptNetwork,
-		UUID: UUID,
+		UUID:       UUID,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15823,10 +19020,8 @@ func (l *Libvirt) NetworkPortLookupByUUID(OptNetwork Network, UUID UUID) (rPort
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Port: NetworkPort
 	_, err = dec.Decode(&rPort)
 	if err != nil {
@@ -15840,13 +19035,15 @@ func (l *Libvirt) NetworkPortLookupByUUID(OptNetwork Network, UUID UUID) (rPort
 func (l *Libvirt) NetworkPortCreateXML(OptNetwork Network, XML string, Flags uint32) (rPort NetworkPort, err error) {
 	var buf []byte
 
-	args := NetworkPortCreateXMLArgs {
+	args := NetworkPortCreateXMLArgs{
 		OptNetwork: OptNetwork,
-		XML: XML,
-		Flags: Flags,
+		XML:        XML,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15858,10 +19055,8 @@ func (l *Libvirt) NetworkPortCreateXML(OptNetwork Network, XML string, Flags uin
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Port: NetworkPort
 	_, err = dec.Decode(&rPort)
 	if err != nil {
@@ -15875,13 +19070,15 @@ func (l *Libvirt) NetworkPortCreateXML(OptNetwork Network, XML string, Flags uin
 func (l *Libvirt) NetworkPortGetParameters(Port NetworkPort, Nparams int32, Flags uint32) (rParams []TypedParam, rNparams int32, err error) {
 	var buf []byte
 
-	args := NetworkPortGetParametersArgs {
-		Port: Port,
+	args := NetworkPortGetParametersArgs{
+		Port:    Port,
 		Nparams: Nparams,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15893,10 +19090,8 @@ func (l *Libvirt) NetworkPortGetParameters(Port NetworkPort, Nparams int32, Flag
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -15915,18 +19110,19 @@ func (l *Libvirt) NetworkPortGetParameters(Port NetworkPort, Nparams int32, Flag
 func (l *Libvirt) NetworkPortSetParameters(Port NetworkPort, Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := NetworkPortSetParametersArgs {
-		Port: Port,
+	args := NetworkPortSetParametersArgs{
+		Port:   Port,
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(408, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15939,12 +19135,14 @@ func (l *Libvirt) NetworkPortSetParameters(Port NetworkPort, Params []TypedParam
 func (l *Libvirt) NetworkPortGetXMLDesc(Port NetworkPort, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := NetworkPortGetXMLDescArgs {
-		Port: Port,
+	args := NetworkPortGetXMLDescArgs{
+		Port:  Port,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -15956,10 +19154,8 @@ func (l *Libvirt) NetworkPortGetXMLDesc(Port NetworkPort, Flags uint32) (rXML st
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -15973,17 +19169,18 @@ func (l *Libvirt) NetworkPortGetXMLDesc(Port NetworkPort, Flags uint32) (rXML st
 func (l *Libvirt) NetworkPortDelete(Port NetworkPort, Flags uint32) (err error) {
 	var buf []byte
 
-	args := NetworkPortDeleteArgs {
-		Port: Port,
+	args := NetworkPortDeleteArgs{
+		Port:  Port,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(410, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -15996,13 +19193,15 @@ func (l *Libvirt) NetworkPortDelete(Port NetworkPort, Flags uint32) (err error)
 func (l *Libvirt) DomainCheckpointCreateXML(Dom Domain, XMLDesc string, Flags uint32) (rCheckpoint DomainCheckpoint, err error) {
 	var buf []byte
 
-	args := DomainCheckpointCreateXMLArgs {
-		Dom: Dom,
+	args := DomainCheckpointCreateXMLArgs{
+		Dom:     Dom,
 		XMLDesc: XMLDesc,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16014,10 +19213,8 @@ func (l *Libvirt) DomainCheckpointCreateXML(Dom Domain, XMLDesc string, Flags ui
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Checkpoint: DomainCheckpoint
 	_, err = dec.Decode(&rCheckpoint)
 	if err != nil {
@@ -16031,12 +19228,14 @@ func (l *Libvirt) DomainCheckpointCreateXML(Dom Domain, XMLDesc string, Flags ui
 func (l *Libvirt) DomainCheckpointGetXMLDesc(Checkpoint DomainCheckpoint, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainCheckpointGetXMLDescArgs {
+	args := DomainCheckpointGetXMLDescArgs{
 		Checkpoint: Checkpoint,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16048,10 +19247,8 @@ func (l *Libvirt) DomainCheckpointGetXMLDesc(Checkpoint DomainCheckpoint, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -16065,13 +19262,15 @@ func (l *Libvirt) DomainCheckpointGetXMLDesc(Checkpoint DomainCheckpoint, Flags
 func (l *Libvirt) DomainListAllCheckpoints(Dom Domain, NeedResults int32, Flags uint32) (rCheckpoints []DomainCheckpoint, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainListAllCheckpointsArgs {
-		Dom: Dom,
+	args := DomainListAllCheckpointsArgs{
+		Dom:         Dom,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16083,10 +19282,8 @@ func (l *Libvirt) DomainListAllCheckpoints(Dom Domain, NeedResults int32, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Checkpoints: []DomainCheckpoint
 	_, err = dec.Decode(&rCheckpoints)
 	if err != nil {
@@ -16105,13 +19302,15 @@ func (l *Libvirt) DomainListAllCheckpoints(Dom Domain, NeedResults int32, Flags
 func (l *Libvirt) DomainCheckpointListAllChildren(Checkpoint DomainCheckpoint, NeedResults int32, Flags uint32) (rCheckpoints []DomainCheckpoint, rRet int32, err error) {
 	var buf []byte
 
-	args := DomainCheckpointListAllChildrenArgs {
-		Checkpoint: Checkpoint,
+	args := DomainCheckpointListAllChildrenArgs{
+		Checkpoint:  Checkpoint,
 		NeedResults: NeedResults,
-		Flags: Flags,
+		Flags:       Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16123,10 +19322,8 @@ func (l *Libvirt) DomainCheckpointListAllChildren(Checkpoint DomainCheckpoint, N
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Checkpoints: []DomainCheckpoint
 	_, err = dec.Decode(&rCheckpoints)
 	if err != nil {
@@ -16145,13 +19342,15 @@ func (l *Libvirt) DomainCheckpointListAllChildren(Checkpoint DomainCheckpoint, N
 func (l *Libvirt) DomainCheckpointLookupByName(Dom Domain, Name string, Flags uint32) (rCheckpoint DomainCheckpoint, err error) {
 	var buf []byte
 
-	args := DomainCheckpointLookupByNameArgs {
-		Dom: Dom,
-		Name: Name,
+	args := DomainCheckpointLookupByNameArgs{
+		Dom:   Dom,
+		Name:  Name,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16163,10 +19362,8 @@ func (l *Libvirt) DomainCheckpointLookupByName(Dom Domain, Name string, Flags ui
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Checkpoint: DomainCheckpoint
 	_, err = dec.Decode(&rCheckpoint)
 	if err != nil {
@@ -16180,12 +19377,14 @@ func (l *Libvirt) DomainCheckpointLookupByName(Dom Domain, Name string, Flags ui
 func (l *Libvirt) DomainCheckpointGetParent(Checkpoint DomainCheckpoint, Flags uint32) (rParent DomainCheckpoint, err error) {
 	var buf []byte
 
-	args := DomainCheckpointGetParentArgs {
+	args := DomainCheckpointGetParentArgs{
 		Checkpoint: Checkpoint,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16197,10 +19396,8 @@ func (l *Libvirt) DomainCheckpointGetParent(Checkpoint DomainCheckpoint, Flags u
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Parent: DomainCheckpoint
 	_, err = dec.Decode(&rParent)
 	if err != nil {
@@ -16214,17 +19411,18 @@ func (l *Libvirt) DomainCheckpointGetParent(Checkpoint DomainCheckpoint, Flags u
 func (l *Libvirt) DomainCheckpointDelete(Checkpoint DomainCheckpoint, Flags DomainCheckpointDeleteFlags) (err error) {
 	var buf []byte
 
-	args := DomainCheckpointDeleteArgs {
+	args := DomainCheckpointDeleteArgs{
 		Checkpoint: Checkpoint,
-		Flags: Flags,
+		Flags:      Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(417, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -16234,16 +19432,18 @@ func (l *Libvirt) DomainCheckpointDelete(Checkpoint DomainCheckpoint, Flags Doma
 }
 
 // DomainGetGuestInfo is the go wrapper for REMOTE_PROC_DOMAIN_GET_GUEST_INFO.
-func (l *Libvirt) DomainGetGuestInfo(Dom Domain, Types uint32, Flags uint32) (rParams []TypedParam, err error) {
+func (l *Libvirt) DomainGetGuestInfo(Dom Domain, Types DomainGuestInfoTypes, Flags uint32) (rParams []TypedParam, err error) {
 	var buf []byte
 
-	args := DomainGetGuestInfoArgs {
-		Dom: Dom,
+	args := DomainGetGuestInfoArgs{
+		Dom:   Dom,
 		Types: Types,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16255,10 +19455,8 @@ func (l *Libvirt) DomainGetGuestInfo(Dom Domain, Types uint32, Flags uint32) (rP
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Params: []TypedParam
 	_, err = dec.Decode(&rParams)
 	if err != nil {
@@ -16272,17 +19470,18 @@ func (l *Libvirt) DomainGetGuestInfo(Dom Domain, Types uint32, Flags uint32) (rP
 func (l *Libvirt) ConnectSetIdentity(Params []TypedParam, Flags uint32) (err error) {
 	var buf []byte
 
-	args := ConnectSetIdentityArgs {
+	args := ConnectSetIdentityArgs{
 		Params: Params,
-		Flags: Flags,
+		Flags:  Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(419, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -16295,13 +19494,15 @@ func (l *Libvirt) ConnectSetIdentity(Params []TypedParam, Flags uint32) (err err
 func (l *Libvirt) DomainAgentSetResponseTimeout(Dom Domain, Timeout int32, Flags uint32) (rResult int32, err error) {
 	var buf []byte
 
-	args := DomainAgentSetResponseTimeoutArgs {
-		Dom: Dom,
+	args := DomainAgentSetResponseTimeoutArgs{
+		Dom:     Dom,
 		Timeout: Timeout,
-		Flags: Flags,
+		Flags:   Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16313,10 +19514,8 @@ func (l *Libvirt) DomainAgentSetResponseTimeout(Dom Domain, Timeout int32, Flags
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Result: int32
 	_, err = dec.Decode(&rResult)
 	if err != nil {
@@ -16330,19 +19529,20 @@ func (l *Libvirt) DomainAgentSetResponseTimeout(Dom Domain, Timeout int32, Flags
 func (l *Libvirt) DomainBackupBegin(Dom Domain, BackupXML string, CheckpointXML OptString, Flags DomainBackupBeginFlags) (err error) {
 	var buf []byte
 
-	args := DomainBackupBeginArgs {
-		Dom: Dom,
-		BackupXML: BackupXML,
+	args := DomainBackupBeginArgs{
+		Dom:           Dom,
+		BackupXML:     BackupXML,
 		CheckpointXML: CheckpointXML,
-		Flags: Flags,
+		Flags:         Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(421, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -16355,12 +19555,14 @@ func (l *Libvirt) DomainBackupBegin(Dom Domain, BackupXML string, CheckpointXML
 func (l *Libvirt) DomainBackupGetXMLDesc(Dom Domain, Flags uint32) (rXML string, err error) {
 	var buf []byte
 
-	args := DomainBackupGetXMLDescArgs {
-		Dom: Dom,
+	args := DomainBackupGetXMLDescArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16372,10 +19574,8 @@ func (l *Libvirt) DomainBackupGetXMLDesc(Dom Domain, Flags uint32) (rXML string,
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// XML: string
 	_, err = dec.Decode(&rXML)
 	if err != nil {
@@ -16389,7 +19589,6 @@ func (l *Libvirt) DomainBackupGetXMLDesc(Dom Domain, Flags uint32) (rXML string,
 func (l *Libvirt) DomainEventMemoryFailure() (err error) {
 	var buf []byte
 
-
 	_, err = l.requestStream(423, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -16402,13 +19601,15 @@ func (l *Libvirt) DomainEventMemoryFailure() (err error) {
 func (l *Libvirt) DomainAuthorizedSshKeysGet(Dom Domain, User string, Flags uint32) (rKeys []string, err error) {
 	var buf []byte
 
-	args := DomainAuthorizedSshKeysGetArgs {
-		Dom: Dom,
-		User: User,
+	args := DomainAuthorizedSshKeysGetArgs{
+		Dom:   Dom,
+		User:  User,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16420,10 +19621,8 @@ func (l *Libvirt) DomainAuthorizedSshKeysGet(Dom Domain, User string, Flags uint
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Keys: []string
 	_, err = dec.Decode(&rKeys)
 	if err != nil {
@@ -16437,19 +19636,20 @@ func (l *Libvirt) DomainAuthorizedSshKeysGet(Dom Domain, User string, Flags uint
 func (l *Libvirt) DomainAuthorizedSshKeysSet(Dom Domain, User string, Keys []string, Flags uint32) (err error) {
 	var buf []byte
 
-	args := DomainAuthorizedSshKeysSetArgs {
-		Dom: Dom,
-		User: User,
-		Keys: Keys,
+	args := DomainAuthorizedSshKeysSetArgs{
+		Dom:   Dom,
+		User:  User,
+		Keys:  Keys,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
 
-
 	_, err = l.requestStream(425, constants.Program, buf, nil, nil)
 	if err != nil {
 		return
@@ -16462,12 +19662,14 @@ func (l *Libvirt) DomainAuthorizedSshKeysSet(Dom Domain, User string, Keys []str
 func (l *Libvirt) DomainGetMessages(Dom Domain, Flags uint32) (rMsgs []string, err error) {
 	var buf []byte
 
-	args := DomainGetMessagesArgs {
-		Dom: Dom,
+	args := DomainGetMessagesArgs{
+		Dom:   Dom,
 		Flags: Flags,
 	}
 
-	buf, err = encode(&args)
+	enc := newEncodeBuffer()
+	defer enc.free()
+	buf, err = enc.encode(&args)
 	if err != nil {
 		return
 	}
@@ -16479,10 +19681,8 @@ func (l *Libvirt) DomainGetMessages(Dom Domain, Flags uint32) (rMsgs []string, e
 	}
 
 	// Return value unmarshaling
-	tpd := typedParamDecoder{}
-	ct := map[string]xdr.TypeDecoder{"libvirt.TypedParam": tpd}
 	rdr := bytes.NewReader(r.Payload)
-	dec := xdr.NewDecoderCustomTypes(rdr, 0, ct)
+	dec := xdr.NewDecoderCustomTypes(rdr, l.maxDecodeSize(), customTypes)
 	// Msgs: []string
 	_, err = dec.Decode(&rMsgs)
 	if err != nil {
@@ -16492,3 +19692,433 @@ func (l *Libvirt) DomainGetMessages(Dom Domain, Flags uint32) (rMsgs []string, e
 	return
 }
 
+// ProcedureTypes maps each remote_procedure value to the method which calls it
+// and the types of its arguments and return values.
+var ProcedureTypes = map[uint32]ProcInfo{
+	constants.ProcConnectOpen:                             {Method: "ConnectOpen", Args: reflect.TypeOf(ConnectOpenArgs{})},
+	constants.ProcConnectClose:                            {Method: "ConnectClose"},
+	constants.ProcConnectGetType:                          {Method: "ConnectGetType", Ret: reflect.TypeOf(ConnectGetTypeRet{})},
+	constants.ProcConnectGetVersion:                       {Method: "ConnectGetVersion", Ret: reflect.TypeOf(ConnectGetVersionRet{})},
+	constants.ProcConnectGetMaxVcpus:                      {Method: "ConnectGetMaxVcpus", Args: reflect.TypeOf(ConnectGetMaxVcpusArgs{}), Ret: reflect.TypeOf(ConnectGetMaxVcpusRet{})},
+	constants.ProcNodeGetInfo:                             {Method: "NodeGetInfo", Ret: reflect.TypeOf(NodeGetInfoRet{})},
+	constants.ProcConnectGetCapabilities:                  {Method: "ConnectGetCapabilities", Ret: reflect.TypeOf(ConnectGetCapabilitiesRet{})},
+	constants.ProcDomainAttachDevice:                      {Method: "DomainAttachDevice", Args: reflect.TypeOf(DomainAttachDeviceArgs{})},
+	constants.ProcDomainCreate:                            {Method: "DomainCreate", Args: reflect.TypeOf(DomainCreateArgs{})},
+	constants.ProcDomainCreateXML:                         {Method: "DomainCreateXML", Args: reflect.TypeOf(DomainCreateXMLArgs{}), Ret: reflect.TypeOf(DomainCreateXMLRet{})},
+	constants.ProcDomainDefineXML:                         {Method: "DomainDefineXML", Args: reflect.TypeOf(DomainDefineXMLArgs{}), Ret: reflect.TypeOf(DomainDefineXMLRet{})},
+	constants.ProcDomainDestroy:                           {Method: "DomainDestroy", Args: reflect.TypeOf(DomainDestroyArgs{})},
+	constants.ProcDomainDetachDevice:                      {Method: "DomainDetachDevice", Args: reflect.TypeOf(DomainDetachDeviceArgs{})},
+	constants.ProcDomainGetXMLDesc:                        {Method: "DomainGetXMLDesc", Args: reflect.TypeOf(DomainGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainGetXMLDescRet{})},
+	constants.ProcDomainGetAutostart:                      {Method: "DomainGetAutostart", Args: reflect.TypeOf(DomainGetAutostartArgs{}), Ret: reflect.TypeOf(DomainGetAutostartRet{})},
+	constants.ProcDomainGetInfo:                           {Method: "DomainGetInfo", Args: reflect.TypeOf(DomainGetInfoArgs{}), Ret: reflect.TypeOf(DomainGetInfoRet{})},
+	constants.ProcDomainGetMaxMemory:                      {Method: "DomainGetMaxMemory", Args: reflect.TypeOf(DomainGetMaxMemoryArgs{}), Ret: reflect.TypeOf(DomainGetMaxMemoryRet{})},
+	constants.ProcDomainGetMaxVcpus:                       {Method: "DomainGetMaxVcpus", Args: reflect.TypeOf(DomainGetMaxVcpusArgs{}), Ret: reflect.TypeOf(DomainGetMaxVcpusRet{})},
+	constants.ProcDomainGetOsType:                         {Method: "DomainGetOsType", Args: reflect.TypeOf(DomainGetOsTypeArgs{}), Ret: reflect.TypeOf(DomainGetOsTypeRet{})},
+	constants.ProcDomainGetVcpus:                          {Method: "DomainGetVcpus", Args: reflect.TypeOf(DomainGetVcpusArgs{}), Ret: reflect.TypeOf(DomainGetVcpusRet{})},
+	constants.ProcConnectListDefinedDomains:               {Method: "ConnectListDefinedDomains", Args: reflect.TypeOf(ConnectListDefinedDomainsArgs{}), Ret: reflect.TypeOf(ConnectListDefinedDomainsRet{})},
+	constants.ProcDomainLookupByID:                        {Method: "DomainLookupByID", Args: reflect.TypeOf(DomainLookupByIDArgs{}), Ret: reflect.TypeOf(DomainLookupByIDRet{})},
+	constants.ProcDomainLookupByName:                      {Method: "DomainLookupByName", Args: reflect.TypeOf(DomainLookupByNameArgs{}), Ret: reflect.TypeOf(DomainLookupByNameRet{})},
+	constants.ProcDomainLookupByUUID:                      {Method: "DomainLookupByUUID", Args: reflect.TypeOf(DomainLookupByUUIDArgs{}), Ret: reflect.TypeOf(DomainLookupByUUIDRet{})},
+	constants.ProcConnectNumOfDefinedDomains:              {Method: "ConnectNumOfDefinedDomains", Ret: reflect.TypeOf(ConnectNumOfDefinedDomainsRet{})},
+	constants.ProcDomainPinVcpu:                           {Method: "DomainPinVcpu", Args: reflect.TypeOf(DomainPinVcpuArgs{})},
+	constants.ProcDomainReboot:                            {Method: "DomainReboot", Args: reflect.TypeOf(DomainRebootArgs{})},
+	constants.ProcDomainResume:                            {Method: "DomainResume", Args: reflect.TypeOf(DomainResumeArgs{})},
+	constants.ProcDomainSetAutostart:                      {Method: "DomainSetAutostart", Args: reflect.TypeOf(DomainSetAutostartArgs{})},
+	constants.ProcDomainSetMaxMemory:                      {Method: "DomainSetMaxMemory", Args: reflect.TypeOf(DomainSetMaxMemoryArgs{})},
+	constants.ProcDomainSetMemory:                         {Method: "DomainSetMemory", Args: reflect.TypeOf(DomainSetMemoryArgs{})},
+	constants.ProcDomainSetVcpus:                          {Method: "DomainSetVcpus", Args: reflect.TypeOf(DomainSetVcpusArgs{})},
+	constants.ProcDomainShutdown:                          {Method: "DomainShutdown", Args: reflect.TypeOf(DomainShutdownArgs{})},
+	constants.ProcDomainSuspend:                           {Method: "DomainSuspend", Args: reflect.TypeOf(DomainSuspendArgs{})},
+	constants.ProcDomainUndefine:                          {Method: "DomainUndefine", Args: reflect.TypeOf(DomainUndefineArgs{})},
+	constants.ProcConnectListDefinedNetworks:              {Method: "ConnectListDefinedNetworks", Args: reflect.TypeOf(ConnectListDefinedNetworksArgs{}), Ret: reflect.TypeOf(ConnectListDefinedNetworksRet{})},
+	constants.ProcConnectListDomains:                      {Method: "ConnectListDomains", Args: reflect.TypeOf(ConnectListDomainsArgs{}), Ret: reflect.TypeOf(ConnectListDomainsRet{})},
+	constants.ProcConnectListNetworks:                     {Method: "ConnectListNetworks", Args: reflect.TypeOf(ConnectListNetworksArgs{}), Ret: reflect.TypeOf(ConnectListNetworksRet{})},
+	constants.ProcNetworkCreate:                           {Method: "NetworkCreate", Args: reflect.TypeOf(NetworkCreateArgs{})},
+	constants.ProcNetworkCreateXML:                        {Method: "NetworkCreateXML", Args: reflect.TypeOf(NetworkCreateXMLArgs{}), Ret: reflect.TypeOf(NetworkCreateXMLRet{})},
+	constants.ProcNetworkDefineXML:                        {Method: "NetworkDefineXML", Args: reflect.TypeOf(NetworkDefineXMLArgs{}), Ret: reflect.TypeOf(NetworkDefineXMLRet{})},
+	constants.ProcNetworkDestroy:                          {Method: "NetworkDestroy", Args: reflect.TypeOf(NetworkDestroyArgs{})},
+	constants.ProcNetworkGetXMLDesc:                       {Method: "NetworkGetXMLDesc", Args: reflect.TypeOf(NetworkGetXMLDescArgs{}), Ret: reflect.TypeOf(NetworkGetXMLDescRet{})},
+	constants.ProcNetworkGetAutostart:                     {Method: "NetworkGetAutostart", Args: reflect.TypeOf(NetworkGetAutostartArgs{}), Ret: reflect.TypeOf(NetworkGetAutostartRet{})},
+	constants.ProcNetworkGetBridgeName:                    {Method: "NetworkGetBridgeName", Args: reflect.TypeOf(NetworkGetBridgeNameArgs{}), Ret: reflect.TypeOf(NetworkGetBridgeNameRet{})},
+	constants.ProcNetworkLookupByName:                     {Method: "NetworkLookupByName", Args: reflect.TypeOf(NetworkLookupByNameArgs{}), Ret: reflect.TypeOf(NetworkLookupByNameRet{})},
+	constants.ProcNetworkLookupByUUID:                     {Method: "NetworkLookupByUUID", Args: reflect.TypeOf(NetworkLookupByUUIDArgs{}), Ret: reflect.TypeOf(NetworkLookupByUUIDRet{})},
+	constants.ProcNetworkSetAutostart:                     {Method: "NetworkSetAutostart", Args: reflect.TypeOf(NetworkSetAutostartArgs{})},
+	constants.ProcNetworkUndefine:                         {Method: "NetworkUndefine", Args: reflect.TypeOf(NetworkUndefineArgs{})},
+	constants.ProcConnectNumOfDefinedNetworks:             {Method: "ConnectNumOfDefinedNetworks", Ret: reflect.TypeOf(ConnectNumOfDefinedNetworksRet{})},
+	constants.ProcConnectNumOfDomains:                     {Method: "ConnectNumOfDomains", Ret: reflect.TypeOf(ConnectNumOfDomainsRet{})},
+	constants.ProcConnectNumOfNetworks:                    {Method: "ConnectNumOfNetworks", Ret: reflect.TypeOf(ConnectNumOfNetworksRet{})},
+	constants.ProcDomainCoreDump:                          {Method: "DomainCoreDump", Args: reflect.TypeOf(DomainCoreDumpArgs{})},
+	constants.ProcDomainRestore:                           {Method: "DomainRestore", Args: reflect.TypeOf(DomainRestoreArgs{})},
+	constants.ProcDomainSave:                              {Method: "DomainSave", Args: reflect.TypeOf(DomainSaveArgs{})},
+	constants.ProcDomainGetSchedulerType:                  {Method: "DomainGetSchedulerType", Args: reflect.TypeOf(DomainGetSchedulerTypeArgs{}), Ret: reflect.TypeOf(DomainGetSchedulerTypeRet{})},
+	constants.ProcDomainGetSchedulerParameters:            {Method: "DomainGetSchedulerParameters", Args: reflect.TypeOf(DomainGetSchedulerParametersArgs{}), Ret: reflect.TypeOf(DomainGetSchedulerParametersRet{})},
+	constants.ProcDomainSetSchedulerParameters:            {Method: "DomainSetSchedulerParameters", Args: reflect.TypeOf(DomainSetSchedulerParametersArgs{})},
+	constants.ProcConnectGetHostname:                      {Method: "ConnectGetHostname", Ret: reflect.TypeOf(ConnectGetHostnameRet{})},
+	constants.ProcConnectSupportsFeature:                  {Method: "ConnectSupportsFeature", Args: reflect.TypeOf(ConnectSupportsFeatureArgs{}), Ret: reflect.TypeOf(ConnectSupportsFeatureRet{})},
+	constants.ProcDomainMigratePrepare:                    {Method: "DomainMigratePrepare", Args: reflect.TypeOf(DomainMigratePrepareArgs{}), Ret: reflect.TypeOf(DomainMigratePrepareRet{})},
+	constants.ProcDomainMigratePerform:                    {Method: "DomainMigratePerform", Args: reflect.TypeOf(DomainMigratePerformArgs{})},
+	constants.ProcDomainMigrateFinish:                     {Method: "DomainMigrateFinish", Args: reflect.TypeOf(DomainMigrateFinishArgs{}), Ret: reflect.TypeOf(DomainMigrateFinishRet{})},
+	constants.ProcDomainBlockStats:                        {Method: "DomainBlockStats", Args: reflect.TypeOf(DomainBlockStatsArgs{}), Ret: reflect.TypeOf(DomainBlockStatsRet{})},
+	constants.ProcDomainInterfaceStats:                    {Method: "DomainInterfaceStats", Args: reflect.TypeOf(DomainInterfaceStatsArgs{}), Ret: reflect.TypeOf(DomainInterfaceStatsRet{})},
+	constants.ProcAuthList:                                {Method: "AuthList", Ret: reflect.TypeOf(AuthListRet{})},
+	constants.ProcAuthSaslInit:                            {Method: "AuthSaslInit", Ret: reflect.TypeOf(AuthSaslInitRet{})},
+	constants.ProcAuthSaslStart:                           {Method: "AuthSaslStart", Args: reflect.TypeOf(AuthSaslStartArgs{}), Ret: reflect.TypeOf(AuthSaslStartRet{})},
+	constants.ProcAuthSaslStep:                            {Method: "AuthSaslStep", Args: reflect.TypeOf(AuthSaslStepArgs{}), Ret: reflect.TypeOf(AuthSaslStepRet{})},
+	constants.ProcAuthPolkit:                              {Method: "AuthPolkit", Ret: reflect.TypeOf(AuthPolkitRet{})},
+	constants.ProcConnectNumOfStoragePools:                {Method: "ConnectNumOfStoragePools", Ret: reflect.TypeOf(ConnectNumOfStoragePoolsRet{})},
+	constants.ProcConnectListStoragePools:                 {Method: "ConnectListStoragePools", Args: reflect.TypeOf(ConnectListStoragePoolsArgs{}), Ret: reflect.TypeOf(ConnectListStoragePoolsRet{})},
+	constants.ProcConnectNumOfDefinedStoragePools:         {Method: "ConnectNumOfDefinedStoragePools", Ret: reflect.TypeOf(ConnectNumOfDefinedStoragePoolsRet{})},
+	constants.ProcConnectListDefinedStoragePools:          {Method: "ConnectListDefinedStoragePools", Args: reflect.TypeOf(ConnectListDefinedStoragePoolsArgs{}), Ret: reflect.TypeOf(ConnectListDefinedStoragePoolsRet{})},
+	constants.ProcConnectFindStoragePoolSources:           {Method: "ConnectFindStoragePoolSources", Args: reflect.TypeOf(ConnectFindStoragePoolSourcesArgs{}), Ret: reflect.TypeOf(ConnectFindStoragePoolSourcesRet{})},
+	constants.ProcStoragePoolCreateXML:                    {Method: "StoragePoolCreateXML", Args: reflect.TypeOf(StoragePoolCreateXMLArgs{}), Ret: reflect.TypeOf(StoragePoolCreateXMLRet{})},
+	constants.ProcStoragePoolDefineXML:                    {Method: "StoragePoolDefineXML", Args: reflect.TypeOf(StoragePoolDefineXMLArgs{}), Ret: reflect.TypeOf(StoragePoolDefineXMLRet{})},
+	constants.ProcStoragePoolCreate:                       {Method: "StoragePoolCreate", Args: reflect.TypeOf(StoragePoolCreateArgs{})},
+	constants.ProcStoragePoolBuild:                        {Method: "StoragePoolBuild", Args: reflect.TypeOf(StoragePoolBuildArgs{})},
+	constants.ProcStoragePoolDestroy:                      {Method: "StoragePoolDestroy", Args: reflect.TypeOf(StoragePoolDestroyArgs{})},
+	constants.ProcStoragePoolDelete:                       {Method: "StoragePoolDelete", Args: reflect.TypeOf(StoragePoolDeleteArgs{})},
+	constants.ProcStoragePoolUndefine:                     {Method: "StoragePoolUndefine", Args: reflect.TypeOf(StoragePoolUndefineArgs{})},
+	constants.ProcStoragePoolRefresh:                      {Method: "StoragePoolRefresh", Args: reflect.TypeOf(StoragePoolRefreshArgs{})},
+	constants.ProcStoragePoolLookupByName:                 {Method: "StoragePoolLookupByName", Args: reflect.TypeOf(StoragePoolLookupByNameArgs{}), Ret: reflect.TypeOf(StoragePoolLookupByNameRet{})},
+	constants.ProcStoragePoolLookupByUUID:                 {Method: "StoragePoolLookupByUUID", Args: reflect.TypeOf(StoragePoolLookupByUUIDArgs{}), Ret: reflect.TypeOf(StoragePoolLookupByUUIDRet{})},
+	constants.ProcStoragePoolLookupByVolume:               {Method: "StoragePoolLookupByVolume", Args: reflect.TypeOf(StoragePoolLookupByVolumeArgs{}), Ret: reflect.TypeOf(StoragePoolLookupByVolumeRet{})},
+	constants.ProcStoragePoolGetInfo:                      {Method: "StoragePoolGetInfo", Args: reflect.TypeOf(StoragePoolGetInfoArgs{}), Ret: reflect.TypeOf(StoragePoolGetInfoRet{})},
+	constants.ProcStoragePoolGetXMLDesc:                   {Method: "StoragePoolGetXMLDesc", Args: reflect.TypeOf(StoragePoolGetXMLDescArgs{}), Ret: reflect.TypeOf(StoragePoolGetXMLDescRet{})},
+	constants.ProcStoragePoolGetAutostart:                 {Method: "StoragePoolGetAutostart", Args: reflect.TypeOf(StoragePoolGetAutostartArgs{}), Ret: reflect.TypeOf(StoragePoolGetAutostartRet{})},
+	constants.ProcStoragePoolSetAutostart:                 {Method: "StoragePoolSetAutostart", Args: reflect.TypeOf(StoragePoolSetAutostartArgs{})},
+	constants.ProcStoragePoolNumOfVolumes:                 {Method: "StoragePoolNumOfVolumes", Args: reflect.TypeOf(StoragePoolNumOfVolumesArgs{}), Ret: reflect.TypeOf(StoragePoolNumOfVolumesRet{})},
+	constants.ProcStoragePoolListVolumes:                  {Method: "StoragePoolListVolumes", Args: reflect.TypeOf(StoragePoolListVolumesArgs{}), Ret: reflect.TypeOf(StoragePoolListVolumesRet{})},
+	constants.ProcStorageVolCreateXML:                     {Method: "StorageVolCreateXML", Args: reflect.TypeOf(StorageVolCreateXMLArgs{}), Ret: reflect.TypeOf(StorageVolCreateXMLRet{})},
+	constants.ProcStorageVolDelete:                        {Method: "StorageVolDelete", Args: reflect.TypeOf(StorageVolDeleteArgs{})},
+	constants.ProcStorageVolLookupByName:                  {Method: "StorageVolLookupByName", Args: reflect.TypeOf(StorageVolLookupByNameArgs{}), Ret: reflect.TypeOf(StorageVolLookupByNameRet{})},
+	constants.ProcStorageVolLookupByKey:                   {Method: "StorageVolLookupByKey", Args: reflect.TypeOf(StorageVolLookupByKeyArgs{}), Ret: reflect.TypeOf(StorageVolLookupByKeyRet{})},
+	constants.ProcStorageVolLookupByPath:                  {Method: "StorageVolLookupByPath", Args: reflect.TypeOf(StorageVolLookupByPathArgs{}), Ret: reflect.TypeOf(StorageVolLookupByPathRet{})},
+	constants.ProcStorageVolGetInfo:                       {Method: "StorageVolGetInfo", Args: reflect.TypeOf(StorageVolGetInfoArgs{}), Ret: reflect.TypeOf(StorageVolGetInfoRet{})},
+	constants.ProcStorageVolGetXMLDesc:                    {Method: "StorageVolGetXMLDesc", Args: reflect.TypeOf(StorageVolGetXMLDescArgs{}), Ret: reflect.TypeOf(StorageVolGetXMLDescRet{})},
+	constants.ProcStorageVolGetPath:                       {Method: "StorageVolGetPath", Args: reflect.TypeOf(StorageVolGetPathArgs{}), Ret: reflect.TypeOf(StorageVolGetPathRet{})},
+	constants.ProcNodeGetCellsFreeMemory:                  {Method: "NodeGetCellsFreeMemory", Args: reflect.TypeOf(NodeGetCellsFreeMemoryArgs{}), Ret: reflect.TypeOf(NodeGetCellsFreeMemoryRet{})},
+	constants.ProcNodeGetFreeMemory:                       {Method: "NodeGetFreeMemory", Ret: reflect.TypeOf(NodeGetFreeMemoryRet{})},
+	constants.ProcDomainBlockPeek:                         {Method: "DomainBlockPeek", Args: reflect.TypeOf(DomainBlockPeekArgs{}), Ret: reflect.TypeOf(DomainBlockPeekRet{})},
+	constants.ProcDomainMemoryPeek:                        {Method: "DomainMemoryPeek", Args: reflect.TypeOf(DomainMemoryPeekArgs{}), Ret: reflect.TypeOf(DomainMemoryPeekRet{})},
+	constants.ProcConnectDomainEventRegister:              {Method: "ConnectDomainEventRegister", Ret: reflect.TypeOf(ConnectDomainEventRegisterRet{})},
+	constants.ProcConnectDomainEventDeregister:            {Method: "ConnectDomainEventDeregister", Ret: reflect.TypeOf(ConnectDomainEventDeregisterRet{})},
+	constants.ProcDomainEventLifecycle:                    {Method: "DomainEventLifecycle"},
+	constants.ProcDomainMigratePrepare2:                   {Method: "DomainMigratePrepare2", Args: reflect.TypeOf(DomainMigratePrepare2Args{}), Ret: reflect.TypeOf(DomainMigratePrepare2Ret{})},
+	constants.ProcDomainMigrateFinish2:                    {Method: "DomainMigrateFinish2", Args: reflect.TypeOf(DomainMigrateFinish2Args{}), Ret: reflect.TypeOf(DomainMigrateFinish2Ret{})},
+	constants.ProcConnectGetUri:                           {Method: "ConnectGetUri", Ret: reflect.TypeOf(ConnectGetUriRet{})},
+	constants.ProcNodeNumOfDevices:                        {Method: "NodeNumOfDevices", Args: reflect.TypeOf(NodeNumOfDevicesArgs{}), Ret: reflect.TypeOf(NodeNumOfDevicesRet{})},
+	constants.ProcNodeListDevices:                         {Method: "NodeListDevices", Args: reflect.TypeOf(NodeListDevicesArgs{}), Ret: reflect.TypeOf(NodeListDevicesRet{})},
+	constants.ProcNodeDeviceLookupByName:                  {Method: "NodeDeviceLookupByName", Args: reflect.TypeOf(NodeDeviceLookupByNameArgs{}), Ret: reflect.TypeOf(NodeDeviceLookupByNameRet{})},
+	constants.ProcNodeDeviceGetXMLDesc:                    {Method: "NodeDeviceGetXMLDesc", Args: reflect.TypeOf(NodeDeviceGetXMLDescArgs{}), Ret: reflect.TypeOf(NodeDeviceGetXMLDescRet{})},
+	constants.ProcNodeDeviceGetParent:                     {Method: "NodeDeviceGetParent", Args: reflect.TypeOf(NodeDeviceGetParentArgs{}), Ret: reflect.TypeOf(NodeDeviceGetParentRet{})},
+	constants.ProcNodeDeviceNumOfCaps:                     {Method: "NodeDeviceNumOfCaps", Args: reflect.TypeOf(NodeDeviceNumOfCapsArgs{}), Ret: reflect.TypeOf(NodeDeviceNumOfCapsRet{})},
+	constants.ProcNodeDeviceListCaps:                      {Method: "NodeDeviceListCaps", Args: reflect.TypeOf(NodeDeviceListCapsArgs{}), Ret: reflect.TypeOf(NodeDeviceListCapsRet{})},
+	constants.ProcNodeDeviceDettach:                       {Method: "NodeDeviceDettach", Args: reflect.TypeOf(NodeDeviceDettachArgs{})},
+	constants.ProcNodeDeviceReAttach:                      {Method: "NodeDeviceReAttach", Args: reflect.TypeOf(NodeDeviceReAttachArgs{})},
+	constants.ProcNodeDeviceReset:                         {Method: "NodeDeviceReset", Args: reflect.TypeOf(NodeDeviceResetArgs{})},
+	constants.ProcDomainGetSecurityLabel:                  {Method: "DomainGetSecurityLabel", Args: reflect.TypeOf(DomainGetSecurityLabelArgs{}), Ret: reflect.TypeOf(DomainGetSecurityLabelRet{})},
+	constants.ProcNodeGetSecurityModel:                    {Method: "NodeGetSecurityModel", Ret: reflect.TypeOf(NodeGetSecurityModelRet{})},
+	constants.ProcNodeDeviceCreateXML:                     {Method: "NodeDeviceCreateXML", Args: reflect.TypeOf(NodeDeviceCreateXMLArgs{}), Ret: reflect.TypeOf(NodeDeviceCreateXMLRet{})},
+	constants.ProcNodeDeviceDestroy:                       {Method: "NodeDeviceDestroy", Args: reflect.TypeOf(NodeDeviceDestroyArgs{})},
+	constants.ProcStorageVolCreateXMLFrom:                 {Method: "StorageVolCreateXMLFrom", Args: reflect.TypeOf(StorageVolCreateXMLFromArgs{}), Ret: reflect.TypeOf(StorageVolCreateXMLFromRet{})},
+	constants.ProcConnectNumOfInterfaces:                  {Method: "ConnectNumOfInterfaces", Ret: reflect.TypeOf(ConnectNumOfInterfacesRet{})},
+	constants.ProcConnectListInterfaces:                   {Method: "ConnectListInterfaces", Args: reflect.TypeOf(ConnectListInterfacesArgs{}), Ret: reflect.TypeOf(ConnectListInterfacesRet{})},
+	constants.ProcInterfaceLookupByName:                   {Method: "InterfaceLookupByName", Args: reflect.TypeOf(InterfaceLookupByNameArgs{}), Ret: reflect.TypeOf(InterfaceLookupByNameRet{})},
+	constants.ProcInterfaceLookupByMacString:              {Method: "InterfaceLookupByMacString", Args: reflect.TypeOf(InterfaceLookupByMacStringArgs{}), Ret: reflect.TypeOf(InterfaceLookupByMacStringRet{})},
+	constants.ProcInterfaceGetXMLDesc:                     {Method: "InterfaceGetXMLDesc", Args: reflect.TypeOf(InterfaceGetXMLDescArgs{}), Ret: reflect.TypeOf(InterfaceGetXMLDescRet{})},
+	constants.ProcInterfaceDefineXML:                      {Method: "InterfaceDefineXML", Args: reflect.TypeOf(InterfaceDefineXMLArgs{}), Ret: reflect.TypeOf(InterfaceDefineXMLRet{})},
+	constants.ProcInterfaceUndefine:                       {Method: "InterfaceUndefine", Args: reflect.TypeOf(InterfaceUndefineArgs{})},
+	constants.ProcInterfaceCreate:                         {Method: "InterfaceCreate", Args: reflect.TypeOf(InterfaceCreateArgs{})},
+	constants.ProcInterfaceDestroy:                        {Method: "InterfaceDestroy", Args: reflect.TypeOf(InterfaceDestroyArgs{})},
+	constants.ProcConnectDomainXMLFromNative:              {Method: "ConnectDomainXMLFromNative", Args: reflect.TypeOf(ConnectDomainXMLFromNativeArgs{}), Ret: reflect.TypeOf(ConnectDomainXMLFromNativeRet{})},
+	constants.ProcConnectDomainXMLToNative:                {Method: "ConnectDomainXMLToNative", Args: reflect.TypeOf(ConnectDomainXMLToNativeArgs{}), Ret: reflect.TypeOf(ConnectDomainXMLToNativeRet{})},
+	constants.ProcConnectNumOfDefinedInterfaces:           {Method: "ConnectNumOfDefinedInterfaces", Ret: reflect.TypeOf(ConnectNumOfDefinedInterfacesRet{})},
+	constants.ProcConnectListDefinedInterfaces:            {Method: "ConnectListDefinedInterfaces", Args: reflect.TypeOf(ConnectListDefinedInterfacesArgs{}), Ret: reflect.TypeOf(ConnectListDefinedInterfacesRet{})},
+	constants.ProcConnectNumOfSecrets:                     {Method: "ConnectNumOfSecrets", Ret: reflect.TypeOf(ConnectNumOfSecretsRet{})},
+	constants.ProcConnectListSecrets:                      {Method: "ConnectListSecrets", Args: reflect.TypeOf(ConnectListSecretsArgs{}), Ret: reflect.TypeOf(ConnectListSecretsRet{})},
+	constants.ProcSecretLookupByUUID:                      {Method: "SecretLookupByUUID", Args: reflect.TypeOf(SecretLookupByUUIDArgs{}), Ret: reflect.TypeOf(SecretLookupByUUIDRet{})},
+	constants.ProcSecretDefineXML:                         {Method: "SecretDefineXML", Args: reflect.TypeOf(SecretDefineXMLArgs{}), Ret: reflect.TypeOf(SecretDefineXMLRet{})},
+	constants.ProcSecretGetXMLDesc:                        {Method: "SecretGetXMLDesc", Args: reflect.TypeOf(SecretGetXMLDescArgs{}), Ret: reflect.TypeOf(SecretGetXMLDescRet{})},
+	constants.ProcSecretSetValue:                          {Method: "SecretSetValue", Args: reflect.TypeOf(SecretSetValueArgs{})},
+	constants.ProcSecretGetValue:                          {Method: "SecretGetValue", Args: reflect.TypeOf(SecretGetValueArgs{}), Ret: reflect.TypeOf(SecretGetValueRet{})},
+	constants.ProcSecretUndefine:                          {Method: "SecretUndefine", Args: reflect.TypeOf(SecretUndefineArgs{})},
+	constants.ProcSecretLookupByUsage:                     {Method: "SecretLookupByUsage", Args: reflect.TypeOf(SecretLookupByUsageArgs{}), Ret: reflect.TypeOf(SecretLookupByUsageRet{})},
+	constants.ProcDomainMigratePrepareTunnel:              {Method: "DomainMigratePrepareTunnel", Args: reflect.TypeOf(DomainMigratePrepareTunnelArgs{})},
+	constants.ProcConnectIsSecure:                         {Method: "ConnectIsSecure", Ret: reflect.TypeOf(ConnectIsSecureRet{})},
+	constants.ProcDomainIsActive:                          {Method: "DomainIsActive", Args: reflect.TypeOf(DomainIsActiveArgs{}), Ret: reflect.TypeOf(DomainIsActiveRet{})},
+	constants.ProcDomainIsPersistent:                      {Method: "DomainIsPersistent", Args: reflect.TypeOf(DomainIsPersistentArgs{}), Ret: reflect.TypeOf(DomainIsPersistentRet{})},
+	constants.ProcNetworkIsActive:                         {Method: "NetworkIsActive", Args: reflect.TypeOf(NetworkIsActiveArgs{}), Ret: reflect.TypeOf(NetworkIsActiveRet{})},
+	constants.ProcNetworkIsPersistent:                     {Method: "NetworkIsPersistent", Args: reflect.TypeOf(NetworkIsPersistentArgs{}), Ret: reflect.TypeOf(NetworkIsPersistentRet{})},
+	constants.ProcStoragePoolIsActive:                     {Method: "StoragePoolIsActive", Args: reflect.TypeOf(StoragePoolIsActiveArgs{}), Ret: reflect.TypeOf(StoragePoolIsActiveRet{})},
+	constants.ProcStoragePoolIsPersistent:                 {Method: "StoragePoolIsPersistent", Args: reflect.TypeOf(StoragePoolIsPersistentArgs{}), Ret: reflect.TypeOf(StoragePoolIsPersistentRet{})},
+	constants.ProcInterfaceIsActive:                       {Method: "InterfaceIsActive", Args: reflect.TypeOf(InterfaceIsActiveArgs{}), Ret: reflect.TypeOf(InterfaceIsActiveRet{})},
+	constants.ProcConnectGetLibVersion:                    {Method: "ConnectGetLibVersion", Ret: reflect.TypeOf(ConnectGetLibVersionRet{})},
+	constants.ProcConnectCompareCPU:                       {Method: "ConnectCompareCPU", Args: reflect.TypeOf(ConnectCompareCPUArgs{}), Ret: reflect.TypeOf(ConnectCompareCPURet{})},
+	constants.ProcDomainMemoryStats:                       {Method: "DomainMemoryStats", Args: reflect.TypeOf(DomainMemoryStatsArgs{}), Ret: reflect.TypeOf(DomainMemoryStatsRet{})},
+	constants.ProcDomainAttachDeviceFlags:                 {Method: "DomainAttachDeviceFlags", Args: reflect.TypeOf(DomainAttachDeviceFlagsArgs{})},
+	constants.ProcDomainDetachDeviceFlags:                 {Method: "DomainDetachDeviceFlags", Args: reflect.TypeOf(DomainDetachDeviceFlagsArgs{})},
+	constants.ProcConnectBaselineCPU:                      {Method: "ConnectBaselineCPU", Args: reflect.TypeOf(ConnectBaselineCPUArgs{}), Ret: reflect.TypeOf(ConnectBaselineCPURet{})},
+	constants.ProcDomainGetJobInfo:                        {Method: "DomainGetJobInfo", Args: reflect.TypeOf(DomainGetJobInfoArgs{}), Ret: reflect.TypeOf(DomainGetJobInfoRet{})},
+	constants.ProcDomainAbortJob:                          {Method: "DomainAbortJob", Args: reflect.TypeOf(DomainAbortJobArgs{})},
+	constants.ProcStorageVolWipe:                          {Method: "StorageVolWipe", Args: reflect.TypeOf(StorageVolWipeArgs{})},
+	constants.ProcDomainMigrateSetMaxDowntime:             {Method: "DomainMigrateSetMaxDowntime", Args: reflect.TypeOf(DomainMigrateSetMaxDowntimeArgs{})},
+	constants.ProcConnectDomainEventRegisterAny:           {Method: "ConnectDomainEventRegisterAny", Args: reflect.TypeOf(ConnectDomainEventRegisterAnyArgs{})},
+	constants.ProcConnectDomainEventDeregisterAny:         {Method: "ConnectDomainEventDeregisterAny", Args: reflect.TypeOf(ConnectDomainEventDeregisterAnyArgs{})},
+	constants.ProcDomainEventReboot:                       {Method: "DomainEventReboot"},
+	constants.ProcDomainEventRtcChange:                    {Method: "DomainEventRtcChange"},
+	constants.ProcDomainEventWatchdog:                     {Method: "DomainEventWatchdog"},
+	constants.ProcDomainEventIOError:                      {Method: "DomainEventIOError"},
+	constants.ProcDomainEventGraphics:                     {Method: "DomainEventGraphics"},
+	constants.ProcDomainUpdateDeviceFlags:                 {Method: "DomainUpdateDeviceFlags", Args: reflect.TypeOf(DomainUpdateDeviceFlagsArgs{})},
+	constants.ProcNwfilterLookupByName:                    {Method: "NwfilterLookupByName", Args: reflect.TypeOf(NwfilterLookupByNameArgs{}), Ret: reflect.TypeOf(NwfilterLookupByNameRet{})},
+	constants.ProcNwfilterLookupByUUID:                    {Method: "NwfilterLookupByUUID", Args: reflect.TypeOf(NwfilterLookupByUUIDArgs{}), Ret: reflect.TypeOf(NwfilterLookupByUUIDRet{})},
+	constants.ProcNwfilterGetXMLDesc:                      {Method: "NwfilterGetXMLDesc", Args: reflect.TypeOf(NwfilterGetXMLDescArgs{}), Ret: reflect.TypeOf(NwfilterGetXMLDescRet{})},
+	constants.ProcConnectNumOfNwfilters:                   {Method: "ConnectNumOfNwfilters", Ret: reflect.TypeOf(ConnectNumOfNwfiltersRet{})},
+	constants.ProcConnectListNwfilters:                    {Method: "ConnectListNwfilters", Args: reflect.TypeOf(ConnectListNwfiltersArgs{}), Ret: reflect.TypeOf(ConnectListNwfiltersRet{})},
+	constants.ProcNwfilterDefineXML:                       {Method: "NwfilterDefineXML", Args: reflect.TypeOf(NwfilterDefineXMLArgs{}), Ret: reflect.TypeOf(NwfilterDefineXMLRet{})},
+	constants.ProcNwfilterUndefine:                        {Method: "NwfilterUndefine", Args: reflect.TypeOf(NwfilterUndefineArgs{})},
+	constants.ProcDomainManagedSave:                       {Method: "DomainManagedSave", Args: reflect.TypeOf(DomainManagedSaveArgs{})},
+	constants.ProcDomainHasManagedSaveImage:               {Method: "DomainHasManagedSaveImage", Args: reflect.TypeOf(DomainHasManagedSaveImageArgs{}), Ret: reflect.TypeOf(DomainHasManagedSaveImageRet{})},
+	constants.ProcDomainManagedSaveRemove:                 {Method: "DomainManagedSaveRemove", Args: reflect.TypeOf(DomainManagedSaveRemoveArgs{})},
+	constants.ProcDomainSnapshotCreateXML:                 {Method: "DomainSnapshotCreateXML", Args: reflect.TypeOf(DomainSnapshotCreateXMLArgs{}), Ret: reflect.TypeOf(DomainSnapshotCreateXMLRet{})},
+	constants.ProcDomainSnapshotGetXMLDesc:                {Method: "DomainSnapshotGetXMLDesc", Args: reflect.TypeOf(DomainSnapshotGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainSnapshotGetXMLDescRet{})},
+	constants.ProcDomainSnapshotNum:                       {Method: "DomainSnapshotNum", Args: reflect.TypeOf(DomainSnapshotNumArgs{}), Ret: reflect.TypeOf(DomainSnapshotNumRet{})},
+	constants.ProcDomainSnapshotListNames:                 {Method: "DomainSnapshotListNames", Args: reflect.TypeOf(DomainSnapshotListNamesArgs{}), Ret: reflect.TypeOf(DomainSnapshotListNamesRet{})},
+	constants.ProcDomainSnapshotLookupByName:              {Method: "DomainSnapshotLookupByName", Args: reflect.TypeOf(DomainSnapshotLookupByNameArgs{}), Ret: reflect.TypeOf(DomainSnapshotLookupByNameRet{})},
+	constants.ProcDomainHasCurrentSnapshot:                {Method: "DomainHasCurrentSnapshot", Args: reflect.TypeOf(DomainHasCurrentSnapshotArgs{}), Ret: reflect.TypeOf(DomainHasCurrentSnapshotRet{})},
+	constants.ProcDomainSnapshotCurrent:                   {Method: "DomainSnapshotCurrent", Args: reflect.TypeOf(DomainSnapshotCurrentArgs{}), Ret: reflect.TypeOf(DomainSnapshotCurrentRet{})},
+	constants.ProcDomainRevertToSnapshot:                  {Method: "DomainRevertToSnapshot", Args: reflect.TypeOf(DomainRevertToSnapshotArgs{})},
+	constants.ProcDomainSnapshotDelete:                    {Method: "DomainSnapshotDelete", Args: reflect.TypeOf(DomainSnapshotDeleteArgs{})},
+	constants.ProcDomainGetBlockInfo:                      {Method: "DomainGetBlockInfo", Args: reflect.TypeOf(DomainGetBlockInfoArgs{}), Ret: reflect.TypeOf(DomainGetBlockInfoRet{})},
+	constants.ProcDomainEventIOErrorReason:                {Method: "DomainEventIOErrorReason"},
+	constants.ProcDomainCreateWithFlags:                   {Method: "DomainCreateWithFlags", Args: reflect.TypeOf(DomainCreateWithFlagsArgs{}), Ret: reflect.TypeOf(DomainCreateWithFlagsRet{})},
+	constants.ProcDomainSetMemoryParameters:               {Method: "DomainSetMemoryParameters", Args: reflect.TypeOf(DomainSetMemoryParametersArgs{})},
+	constants.ProcDomainGetMemoryParameters:               {Method: "DomainGetMemoryParameters", Args: reflect.TypeOf(DomainGetMemoryParametersArgs{}), Ret: reflect.TypeOf(DomainGetMemoryParametersRet{})},
+	constants.ProcDomainSetVcpusFlags:                     {Method: "DomainSetVcpusFlags", Args: reflect.TypeOf(DomainSetVcpusFlagsArgs{})},
+	constants.ProcDomainGetVcpusFlags:                     {Method: "DomainGetVcpusFlags", Args: reflect.TypeOf(DomainGetVcpusFlagsArgs{}), Ret: reflect.TypeOf(DomainGetVcpusFlagsRet{})},
+	constants.ProcDomainOpenConsole:                       {Method: "DomainOpenConsole", Args: reflect.TypeOf(DomainOpenConsoleArgs{})},
+	constants.ProcDomainIsUpdated:                         {Method: "DomainIsUpdated", Args: reflect.TypeOf(DomainIsUpdatedArgs{}), Ret: reflect.TypeOf(DomainIsUpdatedRet{})},
+	constants.ProcConnectGetSysinfo:                       {Method: "ConnectGetSysinfo", Args: reflect.TypeOf(ConnectGetSysinfoArgs{}), Ret: reflect.TypeOf(ConnectGetSysinfoRet{})},
+	constants.ProcDomainSetMemoryFlags:                    {Method: "DomainSetMemoryFlags", Args: reflect.TypeOf(DomainSetMemoryFlagsArgs{})},
+	constants.ProcDomainSetBlkioParameters:                {Method: "DomainSetBlkioParameters", Args: reflect.TypeOf(DomainSetBlkioParametersArgs{})},
+	constants.ProcDomainGetBlkioParameters:                {Method: "DomainGetBlkioParameters", Args: reflect.TypeOf(DomainGetBlkioParametersArgs{}), Ret: reflect.TypeOf(DomainGetBlkioParametersRet{})},
+	constants.ProcDomainMigrateSetMaxSpeed:                {Method: "DomainMigrateSetMaxSpeed", Args: reflect.TypeOf(DomainMigrateSetMaxSpeedArgs{})},
+	constants.ProcStorageVolUpload:                        {Method: "StorageVolUpload", Args: reflect.TypeOf(StorageVolUploadArgs{})},
+	constants.ProcStorageVolDownload:                      {Method: "StorageVolDownload", Args: reflect.TypeOf(StorageVolDownloadArgs{})},
+	constants.ProcDomainInjectNmi:                         {Method: "DomainInjectNmi", Args: reflect.TypeOf(DomainInjectNmiArgs{})},
+	constants.ProcDomainScreenshot:                        {Method: "DomainScreenshot", Args: reflect.TypeOf(DomainScreenshotArgs{}), Ret: reflect.TypeOf(DomainScreenshotRet{})},
+	constants.ProcDomainGetState:                          {Method: "DomainGetState", Args: reflect.TypeOf(DomainGetStateArgs{}), Ret: reflect.TypeOf(DomainGetStateRet{})},
+	constants.ProcDomainMigrateBegin3:                     {Method: "DomainMigrateBegin3", Args: reflect.TypeOf(DomainMigrateBegin3Args{}), Ret: reflect.TypeOf(DomainMigrateBegin3Ret{})},
+	constants.ProcDomainMigratePrepare3:                   {Method: "DomainMigratePrepare3", Args: reflect.TypeOf(DomainMigratePrepare3Args{}), Ret: reflect.TypeOf(DomainMigratePrepare3Ret{})},
+	constants.ProcDomainMigratePrepareTunnel3:             {Method: "DomainMigratePrepareTunnel3", Args: reflect.TypeOf(DomainMigratePrepareTunnel3Args{}), Ret: reflect.TypeOf(DomainMigratePrepareTunnel3Ret{})},
+	constants.ProcDomainMigratePerform3:                   {Method: "DomainMigratePerform3", Args: reflect.TypeOf(DomainMigratePerform3Args{}), Ret: reflect.TypeOf(DomainMigratePerform3Ret{})},
+	constants.ProcDomainMigrateFinish3:                    {Method: "DomainMigrateFinish3", Args: reflect.TypeOf(DomainMigrateFinish3Args{}), Ret: reflect.TypeOf(DomainMigrateFinish3Ret{})},
+	constants.ProcDomainMigrateConfirm3:                   {Method: "DomainMigrateConfirm3", Args: reflect.TypeOf(DomainMigrateConfirm3Args{})},
+	constants.ProcDomainSetSchedulerParametersFlags:       {Method: "DomainSetSchedulerParametersFlags", Args: reflect.TypeOf(DomainSetSchedulerParametersFlagsArgs{})},
+	constants.ProcInterfaceChangeBegin:                    {Method: "InterfaceChangeBegin", Args: reflect.TypeOf(InterfaceChangeBeginArgs{})},
+	constants.ProcInterfaceChangeCommit:                   {Method: "InterfaceChangeCommit", Args: reflect.TypeOf(InterfaceChangeCommitArgs{})},
+	constants.ProcInterfaceChangeRollback:                 {Method: "InterfaceChangeRollback", Args: reflect.TypeOf(InterfaceChangeRollbackArgs{})},
+	constants.ProcDomainGetSchedulerParametersFlags:       {Method: "DomainGetSchedulerParametersFlags", Args: reflect.TypeOf(DomainGetSchedulerParametersFlagsArgs{}), Ret: reflect.TypeOf(DomainGetSchedulerParametersFlagsRet{})},
+	constants.ProcDomainEventControlError:                 {Method: "DomainEventControlError"},
+	constants.ProcDomainPinVcpuFlags:                      {Method: "DomainPinVcpuFlags", Args: reflect.TypeOf(DomainPinVcpuFlagsArgs{})},
+	constants.ProcDomainSendKey:                           {Method: "DomainSendKey", Args: reflect.TypeOf(DomainSendKeyArgs{})},
+	constants.ProcNodeGetCPUStats:                         {Method: "NodeGetCPUStats", Args: reflect.TypeOf(NodeGetCPUStatsArgs{}), Ret: reflect.TypeOf(NodeGetCPUStatsRet{})},
+	constants.ProcNodeGetMemoryStats:                      {Method: "NodeGetMemoryStats", Args: reflect.TypeOf(NodeGetMemoryStatsArgs{}), Ret: reflect.TypeOf(NodeGetMemoryStatsRet{})},
+	constants.ProcDomainGetControlInfo:                    {Method: "DomainGetControlInfo", Args: reflect.TypeOf(DomainGetControlInfoArgs{}), Ret: reflect.TypeOf(DomainGetControlInfoRet{})},
+	constants.ProcDomainGetVcpuPinInfo:                    {Method: "DomainGetVcpuPinInfo", Args: reflect.TypeOf(DomainGetVcpuPinInfoArgs{}), Ret: reflect.TypeOf(DomainGetVcpuPinInfoRet{})},
+	constants.ProcDomainUndefineFlags:                     {Method: "DomainUndefineFlags", Args: reflect.TypeOf(DomainUndefineFlagsArgs{})},
+	constants.ProcDomainSaveFlags:                         {Method: "DomainSaveFlags", Args: reflect.TypeOf(DomainSaveFlagsArgs{})},
+	constants.ProcDomainRestoreFlags:                      {Method: "DomainRestoreFlags", Args: reflect.TypeOf(DomainRestoreFlagsArgs{})},
+	constants.ProcDomainDestroyFlags:                      {Method: "DomainDestroyFlags", Args: reflect.TypeOf(DomainDestroyFlagsArgs{})},
+	constants.ProcDomainSaveImageGetXMLDesc:               {Method: "DomainSaveImageGetXMLDesc", Args: reflect.TypeOf(DomainSaveImageGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainSaveImageGetXMLDescRet{})},
+	constants.ProcDomainSaveImageDefineXML:                {Method: "DomainSaveImageDefineXML", Args: reflect.TypeOf(DomainSaveImageDefineXMLArgs{})},
+	constants.ProcDomainBlockJobAbort:                     {Method: "DomainBlockJobAbort", Args: reflect.TypeOf(DomainBlockJobAbortArgs{})},
+	constants.ProcDomainGetBlockJobInfo:                   {Method: "DomainGetBlockJobInfo", Args: reflect.TypeOf(DomainGetBlockJobInfoArgs{}), Ret: reflect.TypeOf(DomainGetBlockJobInfoRet{})},
+	constants.ProcDomainBlockJobSetSpeed:                  {Method: "DomainBlockJobSetSpeed", Args: reflect.TypeOf(DomainBlockJobSetSpeedArgs{})},
+	constants.ProcDomainBlockPull:                         {Method: "DomainBlockPull", Args: reflect.TypeOf(DomainBlockPullArgs{})},
+	constants.ProcDomainEventBlockJob:                     {Method: "DomainEventBlockJob"},
+	constants.ProcDomainMigrateGetMaxSpeed:                {Method: "DomainMigrateGetMaxSpeed", Args: reflect.TypeOf(DomainMigrateGetMaxSpeedArgs{}), Ret: reflect.TypeOf(DomainMigrateGetMaxSpeedRet{})},
+	constants.ProcDomainBlockStatsFlags:                   {Method: "DomainBlockStatsFlags", Args: reflect.TypeOf(DomainBlockStatsFlagsArgs{}), Ret: reflect.TypeOf(DomainBlockStatsFlagsRet{})},
+	constants.ProcDomainSnapshotGetParent:                 {Method: "DomainSnapshotGetParent", Args: reflect.TypeOf(DomainSnapshotGetParentArgs{}), Ret: reflect.TypeOf(DomainSnapshotGetParentRet{})},
+	constants.ProcDomainReset:                             {Method: "DomainReset", Args: reflect.TypeOf(DomainResetArgs{})},
+	constants.ProcDomainSnapshotNumChildren:               {Method: "DomainSnapshotNumChildren", Args: reflect.TypeOf(DomainSnapshotNumChildrenArgs{}), Ret: reflect.TypeOf(DomainSnapshotNumChildrenRet{})},
+	constants.ProcDomainSnapshotListChildrenNames:         {Method: "DomainSnapshotListChildrenNames", Args: reflect.TypeOf(DomainSnapshotListChildrenNamesArgs{}), Ret: reflect.TypeOf(DomainSnapshotListChildrenNamesRet{})},
+	constants.ProcDomainEventDiskChange:                   {Method: "DomainEventDiskChange"},
+	constants.ProcDomainOpenGraphics:                      {Method: "DomainOpenGraphics", Args: reflect.TypeOf(DomainOpenGraphicsArgs{})},
+	constants.ProcNodeSuspendForDuration:                  {Method: "NodeSuspendForDuration", Args: reflect.TypeOf(NodeSuspendForDurationArgs{})},
+	constants.ProcDomainBlockResize:                       {Method: "DomainBlockResize", Args: reflect.TypeOf(DomainBlockResizeArgs{})},
+	constants.ProcDomainSetBlockIOTune:                    {Method: "DomainSetBlockIOTune", Args: reflect.TypeOf(DomainSetBlockIOTuneArgs{})},
+	constants.ProcDomainGetBlockIOTune:                    {Method: "DomainGetBlockIOTune", Args: reflect.TypeOf(DomainGetBlockIOTuneArgs{}), Ret: reflect.TypeOf(DomainGetBlockIOTuneRet{})},
+	constants.ProcDomainSetNumaParameters:                 {Method: "DomainSetNumaParameters", Args: reflect.TypeOf(DomainSetNumaParametersArgs{})},
+	constants.ProcDomainGetNumaParameters:                 {Method: "DomainGetNumaParameters", Args: reflect.TypeOf(DomainGetNumaParametersArgs{}), Ret: reflect.TypeOf(DomainGetNumaParametersRet{})},
+	constants.ProcDomainSetInterfaceParameters:            {Method: "DomainSetInterfaceParameters", Args: reflect.TypeOf(DomainSetInterfaceParametersArgs{})},
+	constants.ProcDomainGetInterfaceParameters:            {Method: "DomainGetInterfaceParameters", Args: reflect.TypeOf(DomainGetInterfaceParametersArgs{}), Ret: reflect.TypeOf(DomainGetInterfaceParametersRet{})},
+	constants.ProcDomainShutdownFlags:                     {Method: "DomainShutdownFlags", Args: reflect.TypeOf(DomainShutdownFlagsArgs{})},
+	constants.ProcStorageVolWipePattern:                   {Method: "StorageVolWipePattern", Args: reflect.TypeOf(StorageVolWipePatternArgs{})},
+	constants.ProcStorageVolResize:                        {Method: "StorageVolResize", Args: reflect.TypeOf(StorageVolResizeArgs{})},
+	constants.ProcDomainPmSuspendForDuration:              {Method: "DomainPmSuspendForDuration", Args: reflect.TypeOf(DomainPmSuspendForDurationArgs{})},
+	constants.ProcDomainGetCPUStats:                       {Method: "DomainGetCPUStats", Args: reflect.TypeOf(DomainGetCPUStatsArgs{}), Ret: reflect.TypeOf(DomainGetCPUStatsRet{})},
+	constants.ProcDomainGetDiskErrors:                     {Method: "DomainGetDiskErrors", Args: reflect.TypeOf(DomainGetDiskErrorsArgs{}), Ret: reflect.TypeOf(DomainGetDiskErrorsRet{})},
+	constants.ProcDomainSetMetadata:                       {Method: "DomainSetMetadata", Args: reflect.TypeOf(DomainSetMetadataArgs{})},
+	constants.ProcDomainGetMetadata:                       {Method: "DomainGetMetadata", Args: reflect.TypeOf(DomainGetMetadataArgs{}), Ret: reflect.TypeOf(DomainGetMetadataRet{})},
+	constants.ProcDomainBlockRebase:                       {Method: "DomainBlockRebase", Args: reflect.TypeOf(DomainBlockRebaseArgs{})},
+	constants.ProcDomainPmWakeup:                          {Method: "DomainPmWakeup", Args: reflect.TypeOf(DomainPmWakeupArgs{})},
+	constants.ProcDomainEventTrayChange:                   {Method: "DomainEventTrayChange"},
+	constants.ProcDomainEventPmwakeup:                     {Method: "DomainEventPmwakeup"},
+	constants.ProcDomainEventPmsuspend:                    {Method: "DomainEventPmsuspend"},
+	constants.ProcDomainSnapshotIsCurrent:                 {Method: "DomainSnapshotIsCurrent", Args: reflect.TypeOf(DomainSnapshotIsCurrentArgs{}), Ret: reflect.TypeOf(DomainSnapshotIsCurrentRet{})},
+	constants.ProcDomainSnapshotHasMetadata:               {Method: "DomainSnapshotHasMetadata", Args: reflect.TypeOf(DomainSnapshotHasMetadataArgs{}), Ret: reflect.TypeOf(DomainSnapshotHasMetadataRet{})},
+	constants.ProcConnectListAllDomains:                   {Method: "ConnectListAllDomains", Args: reflect.TypeOf(ConnectListAllDomainsArgs{}), Ret: reflect.TypeOf(ConnectListAllDomainsRet{})},
+	constants.ProcDomainListAllSnapshots:                  {Method: "DomainListAllSnapshots", Args: reflect.TypeOf(DomainListAllSnapshotsArgs{}), Ret: reflect.TypeOf(DomainListAllSnapshotsRet{})},
+	constants.ProcDomainSnapshotListAllChildren:           {Method: "DomainSnapshotListAllChildren", Args: reflect.TypeOf(DomainSnapshotListAllChildrenArgs{}), Ret: reflect.TypeOf(DomainSnapshotListAllChildrenRet{})},
+	constants.ProcDomainEventBalloonChange:                {Method: "DomainEventBalloonChange"},
+	constants.ProcDomainGetHostname:                       {Method: "DomainGetHostname", Args: reflect.TypeOf(DomainGetHostnameArgs{}), Ret: reflect.TypeOf(DomainGetHostnameRet{})},
+	constants.ProcDomainGetSecurityLabelList:              {Method: "DomainGetSecurityLabelList", Args: reflect.TypeOf(DomainGetSecurityLabelListArgs{}), Ret: reflect.TypeOf(DomainGetSecurityLabelListRet{})},
+	constants.ProcDomainPinEmulator:                       {Method: "DomainPinEmulator", Args: reflect.TypeOf(DomainPinEmulatorArgs{})},
+	constants.ProcDomainGetEmulatorPinInfo:                {Method: "DomainGetEmulatorPinInfo", Args: reflect.TypeOf(DomainGetEmulatorPinInfoArgs{}), Ret: reflect.TypeOf(DomainGetEmulatorPinInfoRet{})},
+	constants.ProcConnectListAllStoragePools:              {Method: "ConnectListAllStoragePools", Args: reflect.TypeOf(ConnectListAllStoragePoolsArgs{}), Ret: reflect.TypeOf(ConnectListAllStoragePoolsRet{})},
+	constants.ProcStoragePoolListAllVolumes:               {Method: "StoragePoolListAllVolumes", Args: reflect.TypeOf(StoragePoolListAllVolumesArgs{}), Ret: reflect.TypeOf(StoragePoolListAllVolumesRet{})},
+	constants.ProcConnectListAllNetworks:                  {Method: "ConnectListAllNetworks", Args: reflect.TypeOf(ConnectListAllNetworksArgs{}), Ret: reflect.TypeOf(ConnectListAllNetworksRet{})},
+	constants.ProcConnectListAllInterfaces:                {Method: "ConnectListAllInterfaces", Args: reflect.TypeOf(ConnectListAllInterfacesArgs{}), Ret: reflect.TypeOf(ConnectListAllInterfacesRet{})},
+	constants.ProcConnectListAllNodeDevices:               {Method: "ConnectListAllNodeDevices", Args: reflect.TypeOf(ConnectListAllNodeDevicesArgs{}), Ret: reflect.TypeOf(ConnectListAllNodeDevicesRet{})},
+	constants.ProcConnectListAllNwfilters:                 {Method: "ConnectListAllNwfilters", Args: reflect.TypeOf(ConnectListAllNwfiltersArgs{}), Ret: reflect.TypeOf(ConnectListAllNwfiltersRet{})},
+	constants.ProcConnectListAllSecrets:                   {Method: "ConnectListAllSecrets", Args: reflect.TypeOf(ConnectListAllSecretsArgs{}), Ret: reflect.TypeOf(ConnectListAllSecretsRet{})},
+	constants.ProcNodeSetMemoryParameters:                 {Method: "NodeSetMemoryParameters", Args: reflect.TypeOf(NodeSetMemoryParametersArgs{})},
+	constants.ProcNodeGetMemoryParameters:                 {Method: "NodeGetMemoryParameters", Args: reflect.TypeOf(NodeGetMemoryParametersArgs{}), Ret: reflect.TypeOf(NodeGetMemoryParametersRet{})},
+	constants.ProcDomainBlockCommit:                       {Method: "DomainBlockCommit", Args: reflect.TypeOf(DomainBlockCommitArgs{})},
+	constants.ProcNetworkUpdate:                           {Method: "NetworkUpdate", Args: reflect.TypeOf(NetworkUpdateArgs{})},
+	constants.ProcDomainEventPmsuspendDisk:                {Method: "DomainEventPmsuspendDisk"},
+	constants.ProcNodeGetCPUMap:                           {Method: "NodeGetCPUMap", Args: reflect.TypeOf(NodeGetCPUMapArgs{}), Ret: reflect.TypeOf(NodeGetCPUMapRet{})},
+	constants.ProcDomainFstrim:                            {Method: "DomainFstrim", Args: reflect.TypeOf(DomainFstrimArgs{})},
+	constants.ProcDomainSendProcessSignal:                 {Method: "DomainSendProcessSignal", Args: reflect.TypeOf(DomainSendProcessSignalArgs{})},
+	constants.ProcDomainOpenChannel:                       {Method: "DomainOpenChannel", Args: reflect.TypeOf(DomainOpenChannelArgs{})},
+	constants.ProcNodeDeviceLookupScsiHostByWwn:           {Method: "NodeDeviceLookupScsiHostByWwn", Args: reflect.TypeOf(NodeDeviceLookupScsiHostByWwnArgs{}), Ret: reflect.TypeOf(NodeDeviceLookupScsiHostByWwnRet{})},
+	constants.ProcDomainGetJobStats:                       {Method: "DomainGetJobStats", Args: reflect.TypeOf(DomainGetJobStatsArgs{}), Ret: reflect.TypeOf(DomainGetJobStatsRet{})},
+	constants.ProcDomainMigrateGetCompressionCache:        {Method: "DomainMigrateGetCompressionCache", Args: reflect.TypeOf(DomainMigrateGetCompressionCacheArgs{}), Ret: reflect.TypeOf(DomainMigrateGetCompressionCacheRet{})},
+	constants.ProcDomainMigrateSetCompressionCache:        {Method: "DomainMigrateSetCompressionCache", Args: reflect.TypeOf(DomainMigrateSetCompressionCacheArgs{})},
+	constants.ProcNodeDeviceDetachFlags:                   {Method: "NodeDeviceDetachFlags", Args: reflect.TypeOf(NodeDeviceDetachFlagsArgs{})},
+	constants.ProcDomainMigrateBegin3Params:               {Method: "DomainMigrateBegin3Params", Args: reflect.TypeOf(DomainMigrateBegin3ParamsArgs{}), Ret: reflect.TypeOf(DomainMigrateBegin3ParamsRet{})},
+	constants.ProcDomainMigratePrepare3Params:             {Method: "DomainMigratePrepare3Params", Args: reflect.TypeOf(DomainMigratePrepare3ParamsArgs{}), Ret: reflect.TypeOf(DomainMigratePrepare3ParamsRet{})},
+	constants.ProcDomainMigratePrepareTunnel3Params:       {Method: "DomainMigratePrepareTunnel3Params", Args: reflect.TypeOf(DomainMigratePrepareTunnel3ParamsArgs{}), Ret: reflect.TypeOf(DomainMigratePrepareTunnel3ParamsRet{})},
+	constants.ProcDomainMigratePerform3Params:             {Method: "DomainMigratePerform3Params", Args: reflect.TypeOf(DomainMigratePerform3ParamsArgs{}), Ret: reflect.TypeOf(DomainMigratePerform3ParamsRet{})},
+	constants.ProcDomainMigrateFinish3Params:              {Method: "DomainMigrateFinish3Params", Args: reflect.TypeOf(DomainMigrateFinish3ParamsArgs{}), Ret: reflect.TypeOf(DomainMigrateFinish3ParamsRet{})},
+	constants.ProcDomainMigrateConfirm3Params:             {Method: "DomainMigrateConfirm3Params", Args: reflect.TypeOf(DomainMigrateConfirm3ParamsArgs{})},
+	constants.ProcDomainSetMemoryStatsPeriod:              {Method: "DomainSetMemoryStatsPeriod", Args: reflect.TypeOf(DomainSetMemoryStatsPeriodArgs{})},
+	constants.ProcDomainCreateXMLWithFiles:                {Method: "DomainCreateXMLWithFiles", Args: reflect.TypeOf(DomainCreateXMLWithFilesArgs{}), Ret: reflect.TypeOf(DomainCreateXMLWithFilesRet{})},
+	constants.ProcDomainCreateWithFiles:                   {Method: "DomainCreateWithFiles", Args: reflect.TypeOf(DomainCreateWithFilesArgs{}), Ret: reflect.TypeOf(DomainCreateWithFilesRet{})},
+	constants.ProcDomainEventDeviceRemoved:                {Method: "DomainEventDeviceRemoved"},
+	constants.ProcConnectGetCPUModelNames:                 {Method: "ConnectGetCPUModelNames", Args: reflect.TypeOf(ConnectGetCPUModelNamesArgs{}), Ret: reflect.TypeOf(ConnectGetCPUModelNamesRet{})},
+	constants.ProcConnectNetworkEventRegisterAny:          {Method: "ConnectNetworkEventRegisterAny", Args: reflect.TypeOf(ConnectNetworkEventRegisterAnyArgs{}), Ret: reflect.TypeOf(ConnectNetworkEventRegisterAnyRet{})},
+	constants.ProcConnectNetworkEventDeregisterAny:        {Method: "ConnectNetworkEventDeregisterAny", Args: reflect.TypeOf(ConnectNetworkEventDeregisterAnyArgs{})},
+	constants.ProcNetworkEventLifecycle:                   {Method: "NetworkEventLifecycle"},
+	constants.ProcConnectDomainEventCallbackRegisterAny:   {Method: "ConnectDomainEventCallbackRegisterAny", Args: reflect.TypeOf(ConnectDomainEventCallbackRegisterAnyArgs{}), Ret: reflect.TypeOf(ConnectDomainEventCallbackRegisterAnyRet{})},
+	constants.ProcConnectDomainEventCallbackDeregisterAny: {Method: "ConnectDomainEventCallbackDeregisterAny", Args: reflect.TypeOf(ConnectDomainEventCallbackDeregisterAnyArgs{})},
+	constants.ProcDomainEventCallbackLifecycle:            {Method: "DomainEventCallbackLifecycle"},
+	constants.ProcDomainEventCallbackReboot:               {Method: "DomainEventCallbackReboot"},
+	constants.ProcDomainEventCallbackRtcChange:            {Method: "DomainEventCallbackRtcChange"},
+	constants.ProcDomainEventCallbackWatchdog:             {Method: "DomainEventCallbackWatchdog"},
+	constants.ProcDomainEventCallbackIOError:              {Method: "DomainEventCallbackIOError"},
+	constants.ProcDomainEventCallbackGraphics:             {Method: "DomainEventCallbackGraphics"},
+	constants.ProcDomainEventCallbackIOErrorReason:        {Method: "DomainEventCallbackIOErrorReason"},
+	constants.ProcDomainEventCallbackControlError:         {Method: "DomainEventCallbackControlError"},
+	constants.ProcDomainEventCallbackBlockJob:             {Method: "DomainEventCallbackBlockJob"},
+	constants.ProcDomainEventCallbackDiskChange:           {Method: "DomainEventCallbackDiskChange"},
+	constants.ProcDomainEventCallbackTrayChange:           {Method: "DomainEventCallbackTrayChange"},
+	constants.ProcDomainEventCallbackPmwakeup:             {Method: "DomainEventCallbackPmwakeup"},
+	constants.ProcDomainEventCallbackPmsuspend:            {Method: "DomainEventCallbackPmsuspend"},
+	constants.ProcDomainEventCallbackBalloonChange:        {Method: "DomainEventCallbackBalloonChange"},
+	constants.ProcDomainEventCallbackPmsuspendDisk:        {Method: "DomainEventCallbackPmsuspendDisk"},
+	constants.ProcDomainEventCallbackDeviceRemoved:        {Method: "DomainEventCallbackDeviceRemoved"},
+	constants.ProcDomainCoreDumpWithFormat:                {Method: "DomainCoreDumpWithFormat", Args: reflect.TypeOf(DomainCoreDumpWithFormatArgs{})},
+	constants.ProcDomainFsfreeze:                          {Method: "DomainFSFreeze", Args: reflect.TypeOf(DomainFsfreezeArgs{}), Ret: reflect.TypeOf(DomainFsfreezeRet{})},
+	constants.ProcDomainFsthaw:                            {Method: "DomainFSThaw", Args: reflect.TypeOf(DomainFsthawArgs{}), Ret: reflect.TypeOf(DomainFsthawRet{})},
+	constants.ProcDomainGetTime:                           {Method: "DomainGetTime", Args: reflect.TypeOf(DomainGetTimeArgs{}), Ret: reflect.TypeOf(DomainGetTimeRet{})},
+	constants.ProcDomainSetTime:                           {Method: "DomainSetTime", Args: reflect.TypeOf(DomainSetTimeArgs{})},
+	constants.ProcDomainEventBlockJob2:                    {Method: "DomainEventBlockJob2"},
+	constants.ProcNodeGetFreePages:                        {Method: "NodeGetFreePages", Args: reflect.TypeOf(NodeGetFreePagesArgs{}), Ret: reflect.TypeOf(NodeGetFreePagesRet{})},
+	constants.ProcNetworkGetDhcpLeases:                    {Method: "NetworkGetDhcpLeases", Args: reflect.TypeOf(NetworkGetDhcpLeasesArgs{}), Ret: reflect.TypeOf(NetworkGetDhcpLeasesRet{})},
+	constants.ProcConnectGetDomainCapabilities:            {Method: "ConnectGetDomainCapabilities", Args: reflect.TypeOf(ConnectGetDomainCapabilitiesArgs{}), Ret: reflect.TypeOf(ConnectGetDomainCapabilitiesRet{})},
+	constants.ProcDomainOpenGraphicsFd:                    {Method: "DomainOpenGraphicsFd", Args: reflect.TypeOf(DomainOpenGraphicsFdArgs{})},
+	constants.ProcConnectGetAllDomainStats:                {Method: "ConnectGetAllDomainStats", Args: reflect.TypeOf(ConnectGetAllDomainStatsArgs{}), Ret: reflect.TypeOf(ConnectGetAllDomainStatsRet{})},
+	constants.ProcDomainBlockCopy:                         {Method: "DomainBlockCopy", Args: reflect.TypeOf(DomainBlockCopyArgs{})},
+	constants.ProcDomainEventCallbackTunable:              {Method: "DomainEventCallbackTunable"},
+	constants.ProcNodeAllocPages:                          {Method: "NodeAllocPages", Args: reflect.TypeOf(NodeAllocPagesArgs{}), Ret: reflect.TypeOf(NodeAllocPagesRet{})},
+	constants.ProcDomainEventCallbackAgentLifecycle:       {Method: "DomainEventCallbackAgentLifecycle"},
+	constants.ProcDomainGetFsinfo:                         {Method: "DomainGetFsinfo", Args: reflect.TypeOf(DomainGetFsinfoArgs{}), Ret: reflect.TypeOf(DomainGetFsinfoRet{})},
+	constants.ProcDomainDefineXMLFlags:                    {Method: "DomainDefineXMLFlags", Args: reflect.TypeOf(DomainDefineXMLFlagsArgs{}), Ret: reflect.TypeOf(DomainDefineXMLFlagsRet{})},
+	constants.ProcDomainGetIothreadInfo:                   {Method: "DomainGetIothreadInfo", Args: reflect.TypeOf(DomainGetIothreadInfoArgs{}), Ret: reflect.TypeOf(DomainGetIothreadInfoRet{})},
+	constants.ProcDomainPinIothread:                       {Method: "DomainPinIothread", Args: reflect.TypeOf(DomainPinIothreadArgs{})},
+	constants.ProcDomainInterfaceAddresses:                {Method: "DomainInterfaceAddresses", Args: reflect.TypeOf(DomainInterfaceAddressesArgs{}), Ret: reflect.TypeOf(DomainInterfaceAddressesRet{})},
+	constants.ProcDomainEventCallbackDeviceAdded:          {Method: "DomainEventCallbackDeviceAdded"},
+	constants.ProcDomainAddIothread:                       {Method: "DomainAddIothread", Args: reflect.TypeOf(DomainAddIothreadArgs{})},
+	constants.ProcDomainDelIothread:                       {Method: "DomainDelIothread", Args: reflect.TypeOf(DomainDelIothreadArgs{})},
+	constants.ProcDomainSetUserPassword:                   {Method: "DomainSetUserPassword", Args: reflect.TypeOf(DomainSetUserPasswordArgs{})},
+	constants.ProcDomainRename:                            {Method: "DomainRename", Args: reflect.TypeOf(DomainRenameArgs{}), Ret: reflect.TypeOf(DomainRenameRet{})},
+	constants.ProcDomainEventCallbackMigrationIteration:   {Method: "DomainEventCallbackMigrationIteration"},
+	constants.ProcConnectRegisterCloseCallback:            {Method: "ConnectRegisterCloseCallback"},
+	constants.ProcConnectUnregisterCloseCallback:          {Method: "ConnectUnregisterCloseCallback"},
+	constants.ProcConnectEventConnectionClosed:            {Method: "ConnectEventConnectionClosed"},
+	constants.ProcDomainEventCallbackJobCompleted:         {Method: "DomainEventCallbackJobCompleted"},
+	constants.ProcDomainMigrateStartPostCopy:              {Method: "DomainMigrateStartPostCopy", Args: reflect.TypeOf(DomainMigrateStartPostCopyArgs{})},
+	constants.ProcDomainGetPerfEvents:                     {Method: "DomainGetPerfEvents", Args: reflect.TypeOf(DomainGetPerfEventsArgs{}), Ret: reflect.TypeOf(DomainGetPerfEventsRet{})},
+	constants.ProcDomainSetPerfEvents:                     {Method: "DomainSetPerfEvents", Args: reflect.TypeOf(DomainSetPerfEventsArgs{})},
+	constants.ProcDomainEventCallbackDeviceRemovalFailed:  {Method: "DomainEventCallbackDeviceRemovalFailed"},
+	constants.ProcConnectStoragePoolEventRegisterAny:      {Method: "ConnectStoragePoolEventRegisterAny", Args: reflect.TypeOf(ConnectStoragePoolEventRegisterAnyArgs{}), Ret: reflect.TypeOf(ConnectStoragePoolEventRegisterAnyRet{})},
+	constants.ProcConnectStoragePoolEventDeregisterAny:    {Method: "ConnectStoragePoolEventDeregisterAny", Args: reflect.TypeOf(ConnectStoragePoolEventDeregisterAnyArgs{})},
+	constants.ProcStoragePoolEventLifecycle:               {Method: "StoragePoolEventLifecycle"},
+	constants.ProcDomainGetGuestVcpus:                     {Method: "DomainGetGuestVcpus", Args: reflect.TypeOf(DomainGetGuestVcpusArgs{}), Ret: reflect.TypeOf(DomainGetGuestVcpusRet{})},
+	constants.ProcDomainSetGuestVcpus:                     {Method: "DomainSetGuestVcpus", Args: reflect.TypeOf(DomainSetGuestVcpusArgs{})},
+	constants.ProcStoragePoolEventRefresh:                 {Method: "StoragePoolEventRefresh"},
+	constants.ProcConnectNodeDeviceEventRegisterAny:       {Method: "ConnectNodeDeviceEventRegisterAny", Args: reflect.TypeOf(ConnectNodeDeviceEventRegisterAnyArgs{}), Ret: reflect.TypeOf(ConnectNodeDeviceEventRegisterAnyRet{})},
+	constants.ProcConnectNodeDeviceEventDeregisterAny:     {Method: "ConnectNodeDeviceEventDeregisterAny", Args: reflect.TypeOf(ConnectNodeDeviceEventDeregisterAnyArgs{})},
+	constants.ProcNodeDeviceEventLifecycle:                {Method: "NodeDeviceEventLifecycle"},
+	constants.ProcNodeDeviceEventUpdate:                   {Method: "NodeDeviceEventUpdate"},
+	constants.ProcStorageVolGetInfoFlags:                  {Method: "StorageVolGetInfoFlags", Args: reflect.TypeOf(StorageVolGetInfoFlagsArgs{}), Ret: reflect.TypeOf(StorageVolGetInfoFlagsRet{})},
+	constants.ProcDomainEventCallbackMetadataChange:       {Method: "DomainEventCallbackMetadataChange"},
+	constants.ProcConnectSecretEventRegisterAny:           {Method: "ConnectSecretEventRegisterAny", Args: reflect.TypeOf(ConnectSecretEventRegisterAnyArgs{}), Ret: reflect.TypeOf(ConnectSecretEventRegisterAnyRet{})},
+	constants.ProcConnectSecretEventDeregisterAny:         {Method: "ConnectSecretEventDeregisterAny", Args: reflect.TypeOf(ConnectSecretEventDeregisterAnyArgs{})},
+	constants.ProcSecretEventLifecycle:                    {Method: "SecretEventLifecycle"},
+	constants.ProcSecretEventValueChanged:                 {Method: "SecretEventValueChanged"},
+	constants.ProcDomainSetVcpu:                           {Method: "DomainSetVcpu", Args: reflect.TypeOf(DomainSetVcpuArgs{})},
+	constants.ProcDomainEventBlockThreshold:               {Method: "DomainEventBlockThreshold"},
+	constants.ProcDomainSetBlockThreshold:                 {Method: "DomainSetBlockThreshold", Args: reflect.TypeOf(DomainSetBlockThresholdArgs{})},
+	constants.ProcDomainMigrateGetMaxDowntime:             {Method: "DomainMigrateGetMaxDowntime", Args: reflect.TypeOf(DomainMigrateGetMaxDowntimeArgs{}), Ret: reflect.TypeOf(DomainMigrateGetMaxDowntimeRet{})},
+	constants.ProcDomainManagedSaveGetXMLDesc:             {Method: "DomainManagedSaveGetXMLDesc", Args: reflect.TypeOf(DomainManagedSaveGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainManagedSaveGetXMLDescRet{})},
+	constants.ProcDomainManagedSaveDefineXML:              {Method: "DomainManagedSaveDefineXML", Args: reflect.TypeOf(DomainManagedSaveDefineXMLArgs{})},
+	constants.ProcDomainSetLifecycleAction:                {Method: "DomainSetLifecycleAction", Args: reflect.TypeOf(DomainSetLifecycleActionArgs{})},
+	constants.ProcStoragePoolLookupByTargetPath:           {Method: "StoragePoolLookupByTargetPath", Args: reflect.TypeOf(StoragePoolLookupByTargetPathArgs{}), Ret: reflect.TypeOf(StoragePoolLookupByTargetPathRet{})},
+	constants.ProcDomainDetachDeviceAlias:                 {Method: "DomainDetachDeviceAlias", Args: reflect.TypeOf(DomainDetachDeviceAliasArgs{})},
+	constants.ProcConnectCompareHypervisorCPU:             {Method: "ConnectCompareHypervisorCPU", Args: reflect.TypeOf(ConnectCompareHypervisorCPUArgs{}), Ret: reflect.TypeOf(ConnectCompareHypervisorCPURet{})},
+	constants.ProcConnectBaselineHypervisorCPU:            {Method: "ConnectBaselineHypervisorCPU", Args: reflect.TypeOf(ConnectBaselineHypervisorCPUArgs{}), Ret: reflect.TypeOf(ConnectBaselineHypervisorCPURet{})},
+	constants.ProcNodeGetSevInfo:                          {Method: "NodeGetSevInfo", Args: reflect.TypeOf(NodeGetSevInfoArgs{}), Ret: reflect.TypeOf(NodeGetSevInfoRet{})},
+	constants.ProcDomainGetLaunchSecurityInfo:             {Method: "DomainGetLaunchSecurityInfo", Args: reflect.TypeOf(DomainGetLaunchSecurityInfoArgs{}), Ret: reflect.TypeOf(DomainGetLaunchSecurityInfoRet{})},
+	constants.ProcNwfilterBindingLookupByPortDev:          {Method: "NwfilterBindingLookupByPortDev", Args: reflect.TypeOf(NwfilterBindingLookupByPortDevArgs{}), Ret: reflect.TypeOf(NwfilterBindingLookupByPortDevRet{})},
+	constants.ProcNwfilterBindingGetXMLDesc:               {Method: "NwfilterBindingGetXMLDesc", Args: reflect.TypeOf(NwfilterBindingGetXMLDescArgs{}), Ret: reflect.TypeOf(NwfilterBindingGetXMLDescRet{})},
+	constants.ProcNwfilterBindingCreateXML:                {Method: "NwfilterBindingCreateXML", Args: reflect.TypeOf(NwfilterBindingCreateXMLArgs{}), Ret: reflect.TypeOf(NwfilterBindingCreateXMLRet{})},
+	constants.ProcNwfilterBindingDelete:                   {Method: "NwfilterBindingDelete", Args: reflect.TypeOf(NwfilterBindingDeleteArgs{})},
+	constants.ProcConnectListAllNwfilterBindings:          {Method: "ConnectListAllNwfilterBindings", Args: reflect.TypeOf(ConnectListAllNwfilterBindingsArgs{}), Ret: reflect.TypeOf(ConnectListAllNwfilterBindingsRet{})},
+	constants.ProcDomainSetIothreadParams:                 {Method: "DomainSetIothreadParams", Args: reflect.TypeOf(DomainSetIothreadParamsArgs{})},
+	constants.ProcConnectGetStoragePoolCapabilities:       {Method: "ConnectGetStoragePoolCapabilities", Args: reflect.TypeOf(ConnectGetStoragePoolCapabilitiesArgs{}), Ret: reflect.TypeOf(ConnectGetStoragePoolCapabilitiesRet{})},
+	constants.ProcNetworkListAllPorts:                     {Method: "NetworkListAllPorts", Args: reflect.TypeOf(NetworkListAllPortsArgs{}), Ret: reflect.TypeOf(NetworkListAllPortsRet{})},
+	constants.ProcNetworkPortLookupByUUID:                 {Method: "NetworkPortLookupByUUID", Args: reflect.TypeOf(NetworkPortLookupByUUIDArgs{}), Ret: reflect.TypeOf(NetworkPortLookupByUUIDRet{})},
+	constants.ProcNetworkPortCreateXML:                    {Method: "NetworkPortCreateXML", Args: reflect.TypeOf(NetworkPortCreateXMLArgs{}), Ret: reflect.TypeOf(NetworkPortCreateXMLRet{})},
+	constants.ProcNetworkPortGetParameters:                {Method: "NetworkPortGetParameters", Args: reflect.TypeOf(NetworkPortGetParametersArgs{}), Ret: reflect.TypeOf(NetworkPortGetParametersRet{})},
+	constants.ProcNetworkPortSetParameters:                {Method: "NetworkPortSetParameters", Args: reflect.TypeOf(NetworkPortSetParametersArgs{})},
+	constants.ProcNetworkPortGetXMLDesc:                   {Method: "NetworkPortGetXMLDesc", Args: reflect.TypeOf(NetworkPortGetXMLDescArgs{}), Ret: reflect.TypeOf(NetworkPortGetXMLDescRet{})},
+	constants.ProcNetworkPortDelete:                       {Method: "NetworkPortDelete", Args: reflect.TypeOf(NetworkPortDeleteArgs{})},
+	constants.ProcDomainCheckpointCreateXML:               {Method: "DomainCheckpointCreateXML", Args: reflect.TypeOf(DomainCheckpointCreateXMLArgs{}), Ret: reflect.TypeOf(DomainCheckpointCreateXMLRet{})},
+	constants.ProcDomainCheckpointGetXMLDesc:              {Method: "DomainCheckpointGetXMLDesc", Args: reflect.TypeOf(DomainCheckpointGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainCheckpointGetXMLDescRet{})},
+	constants.ProcDomainListAllCheckpoints:                {Method: "DomainListAllCheckpoints", Args: reflect.TypeOf(DomainListAllCheckpointsArgs{}), Ret: reflect.TypeOf(DomainListAllCheckpointsRet{})},
+	constants.ProcDomainCheckpointListAllChildren:         {Method: "DomainCheckpointListAllChildren", Args: reflect.TypeOf(DomainCheckpointListAllChildrenArgs{}), Ret: reflect.TypeOf(DomainCheckpointListAllChildrenRet{})},
+	constants.ProcDomainCheckpointLookupByName:            {Method: "DomainCheckpointLookupByName", Args: reflect.TypeOf(DomainCheckpointLookupByNameArgs{}), Ret: reflect.TypeOf(DomainCheckpointLookupByNameRet{})},
+	constants.ProcDomainCheckpointGetParent:               {Method: "DomainCheckpointGetParent", Args: reflect.TypeOf(DomainCheckpointGetParentArgs{}), Ret: reflect.TypeOf(DomainCheckpointGetParentRet{})},
+	constants.ProcDomainCheckpointDelete:                  {Method: "DomainCheckpointDelete", Args: reflect.TypeOf(DomainCheckpointDeleteArgs{})},
+	constants.ProcDomainGetGuestInfo:                      {Method: "DomainGetGuestInfo", Args: reflect.TypeOf(DomainGetGuestInfoArgs{}), Ret: reflect.TypeOf(DomainGetGuestInfoRet{})},
+	constants.ProcConnectSetIdentity:                      {Method: "ConnectSetIdentity", Args: reflect.TypeOf(ConnectSetIdentityArgs{})},
+	constants.ProcDomainAgentSetResponseTimeout:           {Method: "DomainAgentSetResponseTimeout", Args: reflect.TypeOf(DomainAgentSetResponseTimeoutArgs{}), Ret: reflect.TypeOf(DomainAgentSetResponseTimeoutRet{})},
+	constants.ProcDomainBackupBegin:                       {Method: "DomainBackupBegin", Args: reflect.TypeOf(DomainBackupBeginArgs{})},
+	constants.ProcDomainBackupGetXMLDesc:                  {Method: "DomainBackupGetXMLDesc", Args: reflect.TypeOf(DomainBackupGetXMLDescArgs{}), Ret: reflect.TypeOf(DomainBackupGetXMLDescRet{})},
+	constants.ProcDomainEventMemoryFailure:                {Method: "DomainEventMemoryFailure"},
+	constants.ProcDomainAuthorizedSshKeysGet:              {Method: "DomainAuthorizedSshKeysGet", Args: reflect.TypeOf(DomainAuthorizedSshKeysGetArgs{}), Ret: reflect.TypeOf(DomainAuthorizedSshKeysGetRet{})},
+	constants.ProcDomainAuthorizedSshKeysSet:              {Method: "DomainAuthorizedSshKeysSet", Args: reflect.TypeOf(DomainAuthorizedSshKeysSetArgs{})},
+	constants.ProcDomainGetMessages:                       {Method: "DomainGetMessages", Args: reflect.TypeOf(DomainGetMessagesArgs{}), Ret: reflect.TypeOf(DomainGetMessagesRet{})},
+}
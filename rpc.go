@@ -16,12 +16,15 @@ package libvirt
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
 	"github.com/digitalocean/go-libvirt/internal/event"
@@ -29,19 +32,41 @@ import (
 	"github.com/digitalocean/go-libvirt/socket"
 )
 
-// ErrUnsupported is returned if a procedure is not supported by libvirt
+// ErrUnsupported is returned if a procedure is not supported by libvirt: the
+// daemon doesn't know the procedure, or the program it belongs to, which is the
+// case for calls added in a newer libvirt than the daemon's.
 var ErrUnsupported = errors.New("unsupported procedure requested")
 
+// ErrTimeout is returned by calls which gave up waiting for libvirt to reply
+// once the timeout set by SetTimeout passed.
+var ErrTimeout = errors.New("timed out waiting for libvirt to reply")
+
 // internal rpc response
 type response struct {
 	Payload []byte
 	Status  uint32
+	Type    uint32
 }
 
 // Error reponse from libvirt
 type Error struct {
-	Code    uint32
+	// Code is the libvirt error number, see ErrorNumber.
+	Code uint32
+	// Domain is the part of libvirt which raised the error.
+	Domain ErrorDomain
+	// Level is the severity of the error.
+	Level   ErrorLevel
 	Message string
+
+	// Str1, Str2, Str3, Int1 and Int2 carry extra information about some
+	// errors, depending on the code.
+	Str1, Str2, Str3 string
+	Int1, Int2       int32
+}
+
+// Number returns the error's code.
+func (e Error) Number() ErrorNumber {
+	return ErrorNumber(e.Code)
 }
 
 func (e Error) Error() string {
@@ -67,29 +92,141 @@ func IsNotFound(err error) bool {
 	return checkError(err, ErrNoDomain)
 }
 
-// callback sends RPC responses to respective callers.
-func (l *Libvirt) callback(id int32, res response) {
+// IsErrorCode reports whether err is a libvirt Error with the given code, or
+// wraps one.
+func IsErrorCode(err error, code ErrorNumber) bool {
+	return checkError(err, code)
+}
+
+// replyBuffer is the capacity of the channels replies are routed to. With
+// calls from many goroutines in flight, routing a reply then doesn't wait for
+// its caller to be scheduled, holding up the replies to other calls.
+const replyBuffer = 1
+
+// UnmatchedError is an error packet received from libvirt which doesn't
+// answer a call in progress, as passed to the function set by
+// SetErrorHandler. libvirt sends these for failures outside of a call, and
+// replies to calls which were abandoned, e.g. after timing out, arrive as them
+// too.
+type UnmatchedError struct {
+	// Program, Procedure and Serial are the fields of the packet's header.
+	Program   uint32
+	Procedure uint32
+	Serial    int32
+	// Err is the error the packet carries, usually an Error, or the error
+	// decoding it.
+	Err error
+}
+
+func (e UnmatchedError) Error() string {
+	return fmt.Sprintf("%v serial=%d: %v",
+		constants.ProcName(e.Program, e.Procedure), e.Serial, e.Err)
+}
+
+// Unwrap returns the error the packet carries.
+func (e UnmatchedError) Unwrap() error {
+	return e.Err
+}
+
+// SetErrorHandler sets a function to be called with the error packets libvirt
+// sends which don't answer a call in progress, as an UnmatchedError. Without
+// one they're discarded. Like the function set by SetLogger, it's called from
+// the goroutine receiving packets, so it must not block or make calls itself.
+// A nil function discards them again.
+func (l *Libvirt) SetErrorHandler(f func(error)) {
+	r := l.root()
+	r.cmux.Lock()
+	defer r.cmux.Unlock()
+
+	r.errHandler = f
+}
+
+// callback sends RPC responses to respective callers. It reports whether the
+// response had a caller waiting for it.
+func (l *Libvirt) callback(id int32, res response) bool {
 	l.cmux.Lock()
 	defer l.cmux.Unlock()
 
 	c, ok := l.callbacks[id]
 	if !ok {
-		return
+		return false
 	}
 
 	c <- res
+	return true
+}
+
+// assemble gathers the packets of a reply split over several packets, which
+// all but the last send with StatusContinue. It returns the whole reply's
+// payload once the last packet arrives, or false if more are to follow. An
+// error ends the reply with just the error.
+func (l *Libvirt) assemble(h *socket.Header, buf []byte) ([]byte, bool) {
+	l.cmux.Lock()
+	defer l.cmux.Unlock()
+
+	if h.Status == socket.StatusContinue {
+		// Nobody is waiting for replies without a callback, so don't keep
+		// them.
+		if _, ok := l.callbacks[h.Serial]; ok {
+			if l.partial == nil {
+				l.partial = make(map[int32][]byte)
+			}
+			l.partial[h.Serial] = append(l.partial[h.Serial], buf...)
+		}
+		return nil, false
+	}
+
+	p, ok := l.partial[h.Serial]
+	if !ok {
+		return buf, true
+	}
+	delete(l.partial, h.Serial)
+	if h.Status == socket.StatusError {
+		return buf, true
+	}
+	return append(p, buf...), true
+}
+
+// unmatched passes an error packet which had no caller waiting for it to the
+// function set by SetErrorHandler.
+func (l *Libvirt) unmatched(h *socket.Header, buf []byte) {
+	l.cmux.RLock()
+	f := l.errHandler
+	l.cmux.RUnlock()
+
+	if f == nil {
+		return
+	}
+
+	err := decodeError(buf)
+	if err == nil {
+		return
+	}
+	f(UnmatchedError{
+		Program:   h.Program,
+		Procedure: h.Procedure,
+		Serial:    h.Serial,
+		Err:       err,
+	})
 }
 
 // Route sends incoming packets to their listeners.
 func (l *Libvirt) Route(h *socket.Header, buf []byte) {
+	if h.Program == constants.KeepAliveProgram {
+		l.routeKeepAlive(h)
+		return
+	}
+
 	// Route events to their respective listener
 	var event event.Event
 
 	switch {
 	case h.Program == constants.QEMUProgram && h.Procedure == constants.QEMUProcDomainMonitorEvent:
 		event = &DomainEvent{}
-	case h.Program == constants.Program && h.Procedure == constants.ProcDomainEventCallbackLifecycle:
-		event = &DomainEventCallbackLifecycleMsg{}
+	case h.Program == constants.Program:
+		if newEvent, ok := domainEvents[h.Procedure]; ok {
+			event = newEvent()
+		}
 	}
 
 	if event != nil {
@@ -102,8 +239,18 @@ func (l *Libvirt) Route(h *socket.Header, buf []byte) {
 		return
 	}
 
+	if h.Type == socket.Reply {
+		var complete bool
+		if buf, complete = l.assemble(h, buf); !complete {
+			return
+		}
+	}
+
 	// send response to caller
-	l.callback(h.Serial, response{Payload: buf, Status: h.Status})
+	ok := l.callback(h.Serial, response{Payload: buf, Status: h.Status, Type: h.Type})
+	if !ok && h.Status == socket.StatusError {
+		l.unmatched(h, buf)
+	}
 }
 
 // serial provides atomic access to the next sequential request serial number.
@@ -111,31 +258,99 @@ func (l *Libvirt) serial() int32 {
 	return atomic.AddInt32(&l.s, 1)
 }
 
+// maxPendingEvents limits the number of events held for streams which haven't
+// been added yet.
+const maxPendingEvents = 64
+
 // stream decodes and relays domain events to their respective listener.
+//
+// libvirt may send events as soon as it has replied to the registration call,
+// before the caller has had a chance to add a stream with the callback ID it
+// was given. Events with an unknown callback ID are therefore kept until the
+// stream is added, up to maxPendingEvents, after which the oldest are dropped.
 func (l *Libvirt) stream(e event.Event) {
-	l.emux.RLock()
-	defer l.emux.RUnlock()
+	l.emux.Lock()
+	defer l.emux.Unlock()
 
 	q, ok := l.events[e.GetCallbackID()]
 	if !ok {
+		if len(l.pending) == maxPendingEvents {
+			l.pending = l.pending[1:]
+		}
+		l.pending = append(l.pending, e)
 		return
 	}
 
 	q.Push(e)
 }
 
-// addStream configures the routing for an event stream.
-func (l *Libvirt) addStream(s *event.Stream) {
+// registerFunc registers for events with libvirt, returning the callback ID
+// of the new registration.
+type registerFunc func(l *Libvirt) (int32, error)
+
+// subscribe registers for events using register, and adds a stream for them.
+// If the connection is re-established, register is called again and the
+// stream is moved to the new callback ID. With timestamp set, the stream
+// relays its events as event.Received, with the time each arrived.
+func (l *Libvirt) subscribe(program uint32, register registerFunc, timestamp bool) (*event.Stream, error) {
+	callbackID, err := register(l)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := event.NewStream(program, callbackID)
+	stream.Timestamp = timestamp
+	l.addStream(stream, register)
+
+	return stream, nil
+}
+
+// addStream configures the routing for an event stream, and relays any events
+// which arrived for it before it was added.
+func (l *Libvirt) addStream(s *event.Stream, register registerFunc) {
+	l = l.root()
 	l.emux.Lock()
 	defer l.emux.Unlock()
 
 	l.events[s.CallbackID] = s
+	if register != nil {
+		if l.registers == nil {
+			l.registers = make(map[int32]registerFunc)
+		}
+		l.registers[s.CallbackID] = register
+	}
+	l.flushPending(s)
+}
+
+// streamID returns the callback ID of an event stream, which changes if the
+// connection is re-established.
+func (l *Libvirt) streamID(s *event.Stream) int32 {
+	l = l.root()
+	l.emux.RLock()
+	defer l.emux.RUnlock()
+
+	return s.CallbackID
+}
+
+// flushPending relays the events which arrived for a stream before it was
+// added. The caller must hold emux.
+func (l *Libvirt) flushPending(s *event.Stream) {
+	pending := l.pending[:0]
+	for _, e := range l.pending {
+		if e.GetCallbackID() == s.CallbackID {
+			s.Push(e)
+		} else {
+			pending = append(pending, e)
+		}
+	}
+	l.pending = pending
 }
 
 // removeStream deletes an event stream. The caller should first notify libvirt
 // to stop sending events for this stream. Subsequent calls to removeStream are
 // idempotent and return nil.
 func (l *Libvirt) removeStream(id int32) error {
+	l = l.root()
 	l.emux.Lock()
 	defer l.emux.Unlock()
 
@@ -143,6 +358,7 @@ func (l *Libvirt) removeStream(id int32) error {
 	q, ok := l.events[id]
 	if ok {
 		delete(l.events, id)
+		delete(l.registers, id)
 		q.Shutdown()
 	}
 
@@ -159,7 +375,9 @@ func (l *Libvirt) removeAllStreams() {
 	for _, ev := range l.events {
 		ev.Shutdown()
 		delete(l.events, ev.CallbackID)
+		delete(l.registers, ev.CallbackID)
 	}
+	l.pending = nil
 }
 
 // register configures a method response callback
@@ -180,6 +398,7 @@ func (l *Libvirt) deregister(id int32) {
 
 	close(l.callbacks[id])
 	delete(l.callbacks, id)
+	delete(l.partial, id)
 }
 
 // deregisterAll closes all waiting callback channels. This is used to clean up
@@ -202,15 +421,71 @@ func (l *Libvirt) request(proc uint32, program uint32, payload []byte) (response
 	return l.requestStream(proc, program, payload, nil, nil)
 }
 
+// Call calls the procedure numbered proc in libvirt's remote protocol, such as
+// constants.ProcDomainGetInfo, with payload as its XDR encoded arguments, and
+// returns the XDR encoded reply. It's for calling procedures which a newer
+// libvirt has and go-libvirt doesn't wrap yet; the generated methods are
+// simpler and safer for the rest.
+//
+// Call is low level: nothing checks that the payload matches the procedure's
+// arguments, and a mismatch can make libvirt close the connection. Its
+// signature is stable, but the procedures and their encoding are libvirt's.
+// Errors from libvirt are returned as for the generated methods.
+func (l *Libvirt) Call(proc uint32, payload []byte) ([]byte, error) {
+	return l.CallStream(proc, payload, nil, nil)
+}
+
+// CallStream is Call for procedures which send or receive a stream of data,
+// such as uploads and downloads; out supplies the data sent, and in receives
+// the data read. Either may be nil if the procedure doesn't use it.
+func (l *Libvirt) CallStream(proc uint32, payload []byte, out io.Reader, in io.Writer) ([]byte, error) {
+	resp, err := l.requestStream(proc, constants.Program, payload, out, in)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
 // requestStream performs a libvirt RPC request. The `out` and `in` parameters
 // are optional, and should be nil when RPC endpoints don't return a stream.
 func (l *Libvirt) requestStream(proc uint32, program uint32, payload []byte,
 	out io.Reader, in io.Writer) (response, error) {
+	ctx := l.requestContext()
+	internal := l.internal
+	l = l.root()
+
+	var next <-chan struct{}
+	if !internal {
+		if err := l.beginCall(); err != nil {
+			return response{}, err
+		}
+		defer l.endCall()
+
+		var reconnecting bool
+		next, reconnecting = l.reconnectState()
+		if reconnecting {
+			if err := l.awaitReconnect(ctx, next); err != nil {
+				return response{}, err
+			}
+			next, _ = l.reconnectState()
+		}
+	}
+
 	serial := l.serial()
-	c := make(chan response)
+	c := make(chan response, replyBuffer)
 
 	l.register(serial, c)
+	var timedOut bool
 	defer func() {
+		if ctx.Err() != nil || timedOut {
+			// We may have given up before libvirt replied. A late reply would
+			// block the callback while it holds cmux, so drain the channel
+			// until it's closed by deregister.
+			go func() {
+				for range c {
+				}
+			}()
+		}
 		l.cmux.Lock()
 		defer l.cmux.Unlock()
 
@@ -219,12 +494,30 @@ func (l *Libvirt) requestStream(proc uint32, program uint32, payload []byte,
 
 	err := l.socket.SendPacket(serial, proc, program, payload, socket.Call,
 		socket.StatusOK)
+	if err != nil && next != nil {
+		// The connection was lost before the call was sent; wait for the
+		// client to reconnect and try again. Callbacks are deregistered when
+		// the connection is lost, so register again.
+		if err = l.awaitReconnect(ctx, next); err != nil {
+			return response{}, err
+		}
+		c = make(chan response, replyBuffer)
+		l.register(serial, c)
+		err = l.socket.SendPacket(serial, proc, program, payload, socket.Call,
+			socket.StatusOK)
+	}
 	if err != nil {
 		return response{}, err
 	}
 
-	resp, err := l.getResponse(c)
+	timeout, stop := l.replyTimeout()
+	resp, err := l.getResponse(ctx, c, timeout)
+	stop()
 	if err != nil {
+		timedOut = err == ErrTimeout
+		if err == ErrUnsupported {
+			l.markUnsupported(program, proc)
+		}
 		return resp, err
 	}
 
@@ -236,7 +529,7 @@ func (l *Libvirt) requestStream(proc uint32, program uint32, payload []byte,
 		}()
 
 		// Even without incoming stream server sends confirmation once all data is received
-		resp, err = l.processIncomingStream(c, in)
+		resp, err = l.processIncomingStream(ctx, c, in)
 		if err != nil {
 			abort <- true
 			return resp, err
@@ -252,16 +545,17 @@ func (l *Libvirt) requestStream(proc uint32, program uint32, payload []byte,
 	case nil:
 		return resp, nil
 	default:
-		return l.processIncomingStream(c, in)
+		return l.processIncomingStream(ctx, c, in)
 	}
 }
 
 // processIncomingStream is called once we've successfully sent a request to
 // libvirt. It writes the responses back to the stream passed by the caller
 // until libvirt sends a packet with statusOK or an error.
-func (l *Libvirt) processIncomingStream(c chan response, inStream io.Writer) (response, error) {
+func (l *Libvirt) processIncomingStream(ctx context.Context, c chan response,
+	inStream io.Writer) (response, error) {
 	for {
-		resp, err := l.getResponse(c)
+		resp, err := l.getResponse(ctx, c, nil)
 		if err != nil {
 			return resp, err
 		}
@@ -288,8 +582,23 @@ func (l *Libvirt) processIncomingStream(c chan response, inStream io.Writer) (re
 	}
 }
 
-func (l *Libvirt) getResponse(c chan response) (response, error) {
-	resp := <-c
+// getResponse waits for the next response on c, giving up if ctx is done or
+// timeout fires first. A nil timeout never fires.
+func (l *Libvirt) getResponse(ctx context.Context, c chan response,
+	timeout <-chan time.Time) (response, error) {
+	var resp response
+	var ok bool
+	select {
+	case resp, ok = <-c:
+		if !ok {
+			// deregistered, as the connection was closed or lost
+			return response{}, ErrConnectionClosed
+		}
+	case <-ctx.Done():
+		return response{}, ctx.Err()
+	case <-timeout:
+		return response{}, ErrTimeout
+	}
 	if resp.Status == socket.StatusError {
 		return resp, decodeError(resp.Payload)
 	}
@@ -305,6 +614,45 @@ func encode(data interface{}) ([]byte, error) {
 	return buf.Bytes(), err
 }
 
+// maxPooledBuffer is the capacity above which an encodeBuffer isn't reused, so
+// that the occasional large call, such as defining a domain from a large XML
+// document, doesn't hold on to its memory.
+const maxPooledBuffer = 64 * 1024
+
+// encodeBuffers holds the encodeBuffers not in use.
+var encodeBuffers = sync.Pool{
+	New: func() interface{} { return new(encodeBuffer) },
+}
+
+// encodeBuffer is a reusable buffer for encoding a call's arguments. The
+// generated procedures take one with newEncodeBuffer and free it once the call
+// has returned, by when the encoded arguments have been sent. Unlike encode,
+// the bytes returned by its encode method must not be kept after that.
+type encodeBuffer struct {
+	bytes.Buffer
+}
+
+// newEncodeBuffer returns an encodeBuffer from the pool.
+func newEncodeBuffer() *encodeBuffer {
+	return encodeBuffers.Get().(*encodeBuffer)
+}
+
+// encode XDR encodes data into the buffer, replacing anything encoded before.
+func (b *encodeBuffer) encode(data interface{}) ([]byte, error) {
+	b.Reset()
+	_, err := xdr.Marshal(&b.Buffer, data)
+	return b.Bytes(), err
+}
+
+// free returns the buffer to the pool. The buffer and the bytes it returned
+// must not be used afterwards.
+func (b *encodeBuffer) free() {
+	if b.Cap() > maxPooledBuffer {
+		return
+	}
+	encodeBuffers.Put(b)
+}
+
 // decodeError extracts an error message from the provider buffer.
 func decodeError(buf []byte) error {
 	dec := xdr.NewDecoder(bytes.NewReader(buf))
@@ -321,7 +669,7 @@ func decodeError(buf []byte) error {
 		return err
 	}
 
-	if strings.Contains(e.Message, "unknown procedure") {
+	if unsupportedError(ErrorNumber(e.Code), ErrorDomain(e.DomainID), e.Message) {
 		return ErrUnsupported
 	}
 
@@ -330,16 +678,74 @@ func decodeError(buf []byte) error {
 		return nil
 	}
 
-	return Error{Code: uint32(e.Code), Message: e.Message}
+	lverr := Error{
+		Code:    uint32(e.Code),
+		Domain:  ErrorDomain(e.DomainID),
+		Level:   ErrorLevel(e.Level),
+		Message: e.Message,
+	}
+
+	// The rest of the error is optional extra information; stop at the first
+	// field which can't be decoded, since older servers may not send it all.
+	extra := struct {
+		Dom              OptDomain
+		Str1, Str2, Str3 OptString
+		Int1, Int2       int32
+	}{}
+	fields := []interface{}{&extra.Dom, &extra.Str1, &extra.Str2, &extra.Str3,
+		&extra.Int1, &extra.Int2}
+	for _, f := range fields {
+		if _, err := dec.Decode(f); err != nil {
+			break
+		}
+	}
+	lverr.Str1 = optString(extra.Str1)
+	lverr.Str2 = optString(extra.Str2)
+	lverr.Str3 = optString(extra.Str3)
+	lverr.Int1, lverr.Int2 = extra.Int1, extra.Int2
+
+	return lverr
+}
+
+// optString returns the value of an optional string, or "" if it is unset.
+func optString(s OptString) string {
+	if len(s) == 0 {
+		return ""
+	}
+	return s[0]
+}
+
+// unsupportedError reports whether an error from the daemon means it doesn't
+// implement the procedure called. The daemon's dispatcher reports unknown
+// procedures as unsupported and unknown programs as rpc errors, both from the
+// rpc domain; drivers report their unsupported calls from their own domains.
+// Daemons which used another code for unknown procedures are matched by the
+// message.
+func unsupportedError(code ErrorNumber, domain ErrorDomain, message string) bool {
+	if domain != fromRPC {
+		return false
+	}
+	switch code {
+	case ErrNoSupport:
+		return true
+	case ErrRPC:
+		return strings.HasPrefix(message, "Cannot find program")
+	}
+	return strings.HasPrefix(message, "unknown procedure")
 }
 
 // eventDecoder decodes an event from a xdr buffer.
 func eventDecoder(buf []byte, e interface{}) error {
-	dec := xdr.NewDecoder(bytes.NewReader(buf))
+	// Events such as tunable and job completed events carry typed params.
+	dec := xdr.NewDecoderCustomTypes(bytes.NewReader(buf), 0, customTypes)
 	_, err := dec.Decode(e)
 	return err
 }
 
+// customTypes holds the decoders for the types in the protocol which the xdr
+// package can't decode by itself. Decoders only read it, so it's shared.
+var customTypes = map[string]xdr.TypeDecoder{"libvirt.TypedParam": typedParamDecoder{}}
+
 type typedParamDecoder struct{}
 
 // Decode decodes a TypedParam. These are part of the libvirt spec, and not xdr
@@ -16,9 +16,16 @@ package libvirt
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
 	"github.com/digitalocean/go-libvirt/internal/event"
@@ -174,6 +181,9 @@ func TestDecodeEvent(t *testing.T) {
 	if e.Microseconds != expMs {
 		t.Errorf("expected microseconds to be %d, got %d", expMs, e.Microseconds)
 	}
+	if want := time.Unix(1462211891, 931791000); !e.Time().Equal(want) {
+		t.Errorf("expected time %v, got %v", want, e.Time())
+	}
 
 	expDetails := []byte(`{"device":"drive-ide0-0-0","len":0,"offset":0,"speed":0,"type":"commit"}`)
 	if e.Domain.ID != expID {
@@ -195,6 +205,87 @@ func TestDecodeError(t *testing.T) {
 	}
 }
 
+func TestDecodeErrorDetails(t *testing.T) {
+	buf := []byte{
+		0x00, 0x00, 0x00, 0x2a, // code (42, ERR_NO_DOMAIN)
+		0x00, 0x00, 0x00, 0x0a, // domain (10, VIR_FROM_QEMU)
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 'o', 'o', 'p', 0x00, // message
+		0x00, 0x00, 0x00, 0x02, // level (error)
+		0x00, 0x00, 0x00, 0x00, // dom
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 's', '1', 0x00, 0x00, // str1
+		0x00, 0x00, 0x00, 0x00, // str2
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 's', '3', 0x00, 0x00, // str3
+		0x00, 0x00, 0x00, 0x05, // int1
+		0xff, 0xff, 0xff, 0xff, // int2
+		0x00, 0x00, 0x00, 0x00, // net
+	}
+
+	err := decodeError(buf)
+	e, ok := err.(Error)
+	if !ok {
+		t.Fatalf("expected an Error, got %v", err)
+	}
+
+	expected := Error{
+		Code:    uint32(ErrNoDomain),
+		Domain:  ErrorDomain(10),
+		Level:   ErrError,
+		Message: "oop",
+		Str1:    "s1",
+		Str3:    "s3",
+		Int1:    5,
+		Int2:    -1,
+	}
+	if e != expected {
+		t.Errorf("expected %+v, got %+v", expected, e)
+	}
+	if e.Number() != ErrNoDomain {
+		t.Errorf("expected number %v, got %v", ErrNoDomain, e.Number())
+	}
+	if !IsErrorCode(fmt.Errorf("wrapped: %w", err), ErrNoDomain) {
+		t.Error("IsErrorCode didn't find the wrapped error")
+	}
+	if IsErrorCode(err, ErrOperationInvalid) {
+		t.Error("IsErrorCode matched the wrong code")
+	}
+}
+
+func TestDecodeErrorUnsupported(t *testing.T) {
+	tests := []struct {
+		code        ErrorNumber
+		domain      ErrorDomain
+		message     string
+		unsupported bool
+	}{
+		{ErrNoSupport, fromRPC, "unknown procedure: 365", true},
+		{ErrRPC, fromRPC, "Cannot find program 536903814 version 1", true},
+		{ErrCallFailed, fromRPC, "unknown procedure: 365", true},
+		{ErrRPC, fromRPC, "cannot encode message length", false},
+		{ErrNoSupport, fromDomain, "this function is not supported by the connection driver", false},
+		{ErrOperationInvalid, fromQemu, "unknown procedure in guest", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			buf, err := encode(&struct {
+				Code     uint32
+				DomainID uint32
+				Padding  uint8
+				Message  string
+				Level    uint32
+			}{uint32(tt.code), uint32(tt.domain), 1, tt.message, 2})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = decodeError(buf)
+			if got := err == ErrUnsupported; got != tt.unsupported {
+				t.Errorf("expected unsupported %v, got error %v", tt.unsupported, err)
+			}
+		})
+	}
+}
+
 func TestErrNotFound(t *testing.T) {
 	err := decodeError(testErrorNotFoundMessage)
 	ok := IsNotFound(err)
@@ -227,6 +318,76 @@ func TestEncode(t *testing.T) {
 	}
 }
 
+func TestEncodeIntegerWidths(t *testing.T) {
+	// XDR int and unsigned int are 4 bytes on the wire; hyper and unsigned
+	// hyper are 8.
+	type counters struct {
+		Int    int32
+		Uint   uint32
+		Hyper  int64
+		Uhyper uint64
+	}
+	data := counters{-2, 0xfffffffe, -3, 0xfffffffffffffffd}
+
+	buf, err := encode(&data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{
+		0xff, 0xff, 0xff, 0xfe,
+		0xff, 0xff, 0xff, 0xfe,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfd,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfd,
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("expected %x, got %x", expected, buf)
+	}
+
+	var res counters
+	dec := xdr.NewDecoder(bytes.NewReader(buf))
+	if _, err = dec.Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if res != data {
+		t.Errorf("expected %+v, got %+v", data, res)
+	}
+}
+
+func TestEncodeOpaque(t *testing.T) {
+	// A fixed-length opaque such as a UUID is sent as its bytes alone, while
+	// a variable-length opaque is prefixed with its length. Both are padded
+	// to a multiple of 4 bytes.
+	data := SecretSetValueArgs{
+		OptSecret: Secret{UUID: testUUID, UsageType: 1, UsageID: "a"},
+		Value:     []byte{0x01, 0x02, 0x03, 0x04, 0x05},
+		Flags:     7,
+	}
+
+	buf, err := encode(&data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := append(testUUID[:],
+		0x00, 0x00, 0x00, 0x01, // usage type
+		0x00, 0x00, 0x00, 0x01, 'a', 0x00, 0x00, 0x00, // usage id
+		0x00, 0x00, 0x00, 0x05, // value length
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x00, 0x00, 0x00, // value
+		0x00, 0x00, 0x00, 0x07, // flags
+	)
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("expected %x, got %x", expected, buf)
+	}
+
+	var res SecretSetValueArgs
+	dec := xdr.NewDecoder(bytes.NewReader(buf))
+	if _, err = dec.Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, data, res)
+}
+
 func TestRegister(t *testing.T) {
 	l := &Libvirt{}
 	l.callbacks = make(map[int32]chan response)
@@ -262,7 +423,7 @@ func TestAddStream(t *testing.T) {
 	stream := event.NewStream(0, id)
 	defer stream.Shutdown()
 
-	l.addStream(stream)
+	l.addStream(stream, nil)
 	if _, ok := l.events[id]; !ok {
 		t.Error("expected event stream to exist")
 	}
@@ -438,7 +599,7 @@ func TestRouteDeadlock(t *testing.T) {
 	}
 	stream := event.NewStream(constants.Program, id)
 
-	l.addStream(stream)
+	l.addStream(stream, nil)
 
 	respHeader := &socket.Header{
 		Program: constants.Program,
@@ -489,3 +650,396 @@ func TestRouteDeadlock(t *testing.T) {
 	fmt.Println("checking for deadlock after context cancellation")
 	send(0, 50)
 }
+
+func TestRouteUnmatchedError(t *testing.T) {
+	id := int32(1)
+	rch := make(chan response, 1)
+
+	l := &Libvirt{
+		callbacks: map[int32]chan response{
+			id: rch,
+		},
+	}
+
+	unmatched := &socket.Header{
+		Program:   constants.Program,
+		Procedure: constants.ProcDomainLookupByName,
+		Serial:    7,
+		Type:      socket.Reply,
+		Status:    socket.StatusError,
+	}
+
+	// Without a handler the packet is dropped.
+	l.Route(unmatched, testNoDomainError)
+
+	var errs []error
+	l.SetErrorHandler(func(err error) {
+		errs = append(errs, err)
+	})
+
+	// Errors with a caller waiting go to the caller only.
+	matched := *unmatched
+	matched.Serial = id
+	l.Route(&matched, testNoDomainError)
+	if r := <-rch; r.Status != socket.StatusError {
+		t.Errorf("expected an error reply, got status %v", r.Status)
+	}
+	if len(errs) != 0 {
+		t.Fatalf("expected no unmatched errors, got %v", errs)
+	}
+
+	l.Route(unmatched, testNoDomainError)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 unmatched error, got %v", errs)
+	}
+	var ue UnmatchedError
+	if !errors.As(errs[0], &ue) {
+		t.Fatalf("expected an UnmatchedError, got %T", errs[0])
+	}
+	if ue.Serial != 7 || ue.Procedure != constants.ProcDomainLookupByName {
+		t.Errorf("unexpected header in %+v", ue)
+	}
+	if !IsNotFound(errs[0]) {
+		t.Errorf("expected a not found error, got %v", errs[0])
+	}
+
+	// Successful replies without a caller are still dropped.
+	reply := *unmatched
+	reply.Status = socket.StatusOK
+	l.Route(&reply, nil)
+	if len(errs) != 1 {
+		t.Errorf("expected no more unmatched errors, got %v", errs)
+	}
+}
+
+func TestRouteContinuedReply(t *testing.T) {
+	id := int32(1)
+	rch := make(chan response, 1)
+
+	l := &Libvirt{
+		callbacks: map[int32]chan response{
+			id: rch,
+		},
+	}
+
+	payload, err := encode(&ConnectGetCapabilitiesRet{Capabilities: "<capabilities/>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The reply arrives in two packets, the first continued.
+	h := &socket.Header{
+		Program:   constants.Program,
+		Procedure: constants.ProcConnectGetCapabilities,
+		Type:      socket.Reply,
+		Serial:    id,
+		Status:    socket.StatusContinue,
+	}
+	l.Route(h, payload[:6])
+	select {
+	case r := <-rch:
+		t.Fatalf("expected no reply before the last packet, got %+v", r)
+	default:
+	}
+
+	last := *h
+	last.Status = socket.StatusOK
+	l.Route(&last, payload[6:])
+
+	r := <-rch
+	if r.Status != socket.StatusOK {
+		t.Errorf("expected status %v, got %v", socket.StatusOK, r.Status)
+	}
+	var ret ConnectGetCapabilitiesRet
+	if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &ret); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if ret.Capabilities != "<capabilities/>" {
+		t.Errorf("expected the whole reply, got %q", ret.Capabilities)
+	}
+	if len(l.partial) != 0 {
+		t.Errorf("expected no partial replies left, got %v", l.partial)
+	}
+
+	// Continued replies nobody waits for aren't kept.
+	other := *h
+	other.Serial = 2
+	l.Route(&other, payload)
+	if len(l.partial) != 0 {
+		t.Errorf("expected unmatched partial replies to be dropped, got %v", l.partial)
+	}
+}
+
+// swapDialer wraps the mock's connection so that, once swap is set, the next
+// two replies are delivered in the opposite order, as libvirt may do when its
+// workers complete calls out of order.
+type swapDialer struct {
+	*libvirttest.MockLibvirt
+	swap int32
+}
+
+func (d *swapDialer) Dial() (net.Conn, error) {
+	conn, err := d.MockLibvirt.Dial()
+	if err != nil {
+		return nil, err
+	}
+	return &swapConn{Conn: conn, d: d}, nil
+}
+
+type swapConn struct {
+	net.Conn
+	d   *swapDialer
+	buf []byte
+}
+
+func (c *swapConn) Read(b []byte) (int, error) {
+	if len(c.buf) == 0 {
+		first, err := c.readPacket()
+		if err != nil {
+			return 0, err
+		}
+		c.buf = first
+		if atomic.CompareAndSwapInt32(&c.d.swap, 1, 0) {
+			second, err := c.readPacket()
+			if err != nil {
+				return 0, err
+			}
+			c.buf = append(second, first...)
+		}
+	}
+	n := copy(b, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *swapConn) readPacket() ([]byte, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(c.Conn, length[:]); err != nil {
+		return nil, err
+	}
+	p := make([]byte, binary.BigEndian.Uint32(length[:]))
+	copy(p, length[:])
+	if _, err := io.ReadFull(c.Conn, p[4:]); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func TestConcurrentCallsOutOfOrder(t *testing.T) {
+	dialer := &swapDialer{MockLibvirt: libvirttest.New()}
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	mems := []uint64{1 << 20, 2 << 20}
+	for _, mem := range mems {
+		payload, err := encode(&DomainGetInfoRet{MaxMem: mem})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, constants.ProcDomainGetInfo, payload)
+	}
+	atomic.StoreInt32(&dialer.swap, 1)
+
+	var mu sync.Mutex
+	got := make(map[string]uint64)
+	var wg sync.WaitGroup
+	for _, name := range []string{"one", "two"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			_, maxMem, _, _, _, err := l.DomainGetInfo(Domain{Name: name})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			got[name] = maxMem
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+
+	// The queued replies are used in the order the calls reached the mock.
+	want := make(map[string]uint64)
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcDomainGetInfo {
+			continue
+		}
+		var args DomainGetInfoArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		want[args.Dom.Name] = mems[len(want)]
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dom := Domain{Name: fmt.Sprintf("dom%d", i)}
+			for j := 0; j < 20; j++ {
+				_, maxMem, _, nrVirtCPU, _, err := l.DomainGetInfo(dom)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if maxMem != 1<<20 || nrVirtCPU != 2 {
+					t.Errorf("unexpected reply: max memory %d, %d cpus", maxMem, nrVirtCPU)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestEncodeBuffer(t *testing.T) {
+	enc := newEncodeBuffer()
+	first, err := enc.encode(&DomainGetInfoArgs{Dom: Domain{Name: "first"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := encode(&DomainGetInfoArgs{Dom: Domain{Name: "first"}})
+	if !bytes.Equal(first, want) {
+		t.Errorf("expected %x, got %x", want, first)
+	}
+
+	// Encoding again replaces what was there before.
+	second, err := enc.encode(&DomainGetInfoArgs{Dom: Domain{Name: "second"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ = encode(&DomainGetInfoArgs{Dom: Domain{Name: "second"}})
+	if !bytes.Equal(second, want) {
+		t.Errorf("expected %x, got %x", want, second)
+	}
+	enc.free()
+
+	// Large buffers aren't kept.
+	big := newEncodeBuffer()
+	if _, err := big.encode(&DomainDefineXMLArgs{XML: strings.Repeat("x", 2*maxPooledBuffer)}); err != nil {
+		t.Fatal(err)
+	}
+	big.free()
+	for i := 0; i < 10; i++ {
+		if b := newEncodeBuffer(); b.Cap() > maxPooledBuffer {
+			t.Fatalf("expected large buffers to be dropped, got one of %d bytes", b.Cap())
+		}
+	}
+}
+
+func TestCall(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	reply, err := encode(&DomainGetInfoRet{State: uint8(DomainRunning), MaxMem: 4096})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainGetInfo, reply)
+
+	args, err := encode(&DomainGetInfoArgs{Dom: Domain{Name: "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := l.Call(constants.ProcDomainGetInfo, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ret DomainGetInfoRet
+	if _, err := xdr.Unmarshal(bytes.NewReader(buf), &ret); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if ret.State != uint8(DomainRunning) || ret.MaxMem != 4096 {
+		t.Errorf("unexpected reply %+v", ret)
+	}
+
+	dialer.QueueError(constants.Program, constants.ProcDomainGetInfo, testNoDomainError)
+	if _, err := l.Call(constants.ProcDomainGetInfo, args); !IsErrorCode(err, ErrNoDomain) {
+		t.Errorf("expected a no domain error, got %v", err)
+	}
+}
+
+func TestCallStream(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	args, err := encode(&StorageVolDownloadArgs{Vol: StorageVol{Pool: "default", Name: "test"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data bytes.Buffer
+	if _, err := l.CallStream(constants.ProcStorageVolDownload, args, nil, &data); err != nil {
+		t.Fatal(err)
+	}
+
+	// The data is written as for the generated method.
+	var expected bytes.Buffer
+	if err := l.StorageVolDownload(StorageVol{Pool: "default", Name: "test"}, &expected, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(data.Bytes(), []byte("abc")) || !bytes.Equal(data.Bytes(), expected.Bytes()) {
+		t.Errorf("expected %q, got %q", expected.Bytes(), data.Bytes())
+	}
+}
+
+func BenchmarkDomainGetInfo(b *testing.B) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		b.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test"}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, _, _, _, err := l.DomainGetInfo(dom); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDomainGetInfoParallel(b *testing.B) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		b.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var n int32
+	b.RunParallel(func(pb *testing.PB) {
+		dom := Domain{Name: fmt.Sprintf("dom%d", atomic.AddInt32(&n, 1))}
+		for pb.Next() {
+			if _, _, _, _, _, err := l.DomainGetInfo(dom); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
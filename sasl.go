@@ -0,0 +1,479 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// ErrSASLMechanismUnavailable is returned when connecting if libvirt requires
+// SASL authentication, but doesn't offer the mechanism of the configured
+// SASLClient.
+var ErrSASLMechanismUnavailable = errors.New("sasl mechanism not offered by libvirt")
+
+// ErrSASLClientRequired is returned when connecting if libvirt requires SASL
+// authentication, but no SASLClient has been set with SetSASLClient.
+var ErrSASLClientRequired = errors.New("libvirt requires sasl authentication, but no sasl client is set")
+
+// ErrSASLSecurityLayer is returned by SASL mechanisms when the server requires
+// a security layer the mechanism doesn't support.
+var ErrSASLSecurityLayer = errors.New("libvirt requires a sasl security layer the mechanism does not support; connect using TLS instead")
+
+// SASLClient is implemented by SASL mechanisms used to authenticate with
+// libvirt. An implementation handles a single authentication exchange; it is
+// used from the start of the exchange until the server reports that it is
+// complete.
+//
+// When libvirtd is not using TLS it requires a security layer, which protects
+// everything sent once authentication completes. Mechanisms which can
+// negotiate one also implement SASLSecurityLayer; others should return
+// ErrSASLSecurityLayer if the server will only accept one.
+type SASLClient interface {
+	// Mechanism returns the name of the SASL mechanism, e.g. "GSSAPI".
+	Mechanism() string
+	// Start returns the client's initial response. A nil response means the
+	// mechanism doesn't send one.
+	Start() ([]byte, error)
+	// Next is called with each challenge sent by the server, and returns the
+	// response to send back.
+	Next(challenge []byte) ([]byte, error)
+}
+
+// SASLSecurityLayer is implemented by SASLClients which can negotiate a
+// security layer, such as GSSAPI.
+type SASLSecurityLayer interface {
+	// SecurityLayer returns the layer negotiated by the exchange that has
+	// just completed, or nil if none was.
+	SecurityLayer() socket.SecurityLayer
+}
+
+// SetSASLClient configures the client used to authenticate when libvirt
+// requires SASL authentication. It must be called before Connect.
+func (l *Libvirt) SetSASLClient(c SASLClient) {
+	l.root().sasl = c
+}
+
+// authenticateSASL runs a SASL authentication exchange with libvirt.
+func (l *Libvirt) authenticateSASL(c SASLClient) error {
+	mechlist, err := l.AuthSaslInit()
+	if err != nil {
+		return err
+	}
+	if !saslOffered(mechlist, c.Mechanism()) {
+		return fmt.Errorf("%w: %v (offered: %v)", ErrSASLMechanismUnavailable,
+			c.Mechanism(), mechlist)
+	}
+
+	data, err := c.Start()
+	if err != nil {
+		return err
+	}
+	complete, _, challenge, err := l.AuthSaslStart(c.Mechanism(), saslNil(data),
+		bytesToInt8s(data))
+	if err != nil {
+		return err
+	}
+
+	for complete == 0 {
+		data, err = c.Next(int8sToBytes(challenge))
+		if err != nil {
+			return err
+		}
+		complete, _, challenge, err = l.AuthSaslStep(saslNil(data), bytesToInt8s(data))
+		if err != nil {
+			return err
+		}
+	}
+
+	// The server may send a final message, which lets the client verify the
+	// server; DIGEST-MD5 uses this for mutual authentication.
+	if len(challenge) > 0 {
+		if _, err := c.Next(int8sToBytes(challenge)); err != nil {
+			return err
+		}
+	}
+
+	// libvirt protects everything after its final reply with the layer
+	// negotiated, if there is one.
+	if sl, ok := c.(SASLSecurityLayer); ok {
+		if layer := sl.SecurityLayer(); layer != nil {
+			l.socket.SetSecurityLayer(layer)
+		}
+	}
+	return nil
+}
+
+// saslOffered reports whether mech appears in libvirt's list of mechanisms.
+func saslOffered(mechlist, mech string) bool {
+	for _, m := range strings.FieldsFunc(mechlist, func(r rune) bool {
+		return r == ',' || r == ' '
+	}) {
+		if strings.EqualFold(m, mech) {
+			return true
+		}
+	}
+	return false
+}
+
+// saslNil returns libvirt's flag for a nil data field.
+func saslNil(data []byte) int32 {
+	if data == nil {
+		return 1
+	}
+	return 0
+}
+
+// bytesToInt8s converts SASL data to the representation used on the wire.
+func bytesToInt8s(b []byte) []int8 {
+	i := make([]int8, len(b))
+	for ix := range b {
+		i[ix] = int8(b[ix])
+	}
+	return i
+}
+
+// int8sToBytes converts SASL data from the representation used on the wire.
+func int8sToBytes(i []int8) []byte {
+	b := make([]byte, len(i))
+	for ix := range i {
+		b[ix] = byte(i[ix])
+	}
+	return b
+}
+
+// DigestMD5 implements the SASL DIGEST-MD5 mechanism (RFC 2831). Only the
+// "auth" quality of protection is supported, so this should be used over a TLS
+// connection.
+type DigestMD5 struct {
+	// Username and Password are the credentials to authenticate with.
+	Username, Password string
+	// Host is the server's hostname, used to build the digest URI.
+	Host string
+
+	// rand is the source of client nonces, crypto/rand if nil.
+	rand    io.Reader
+	cnonce  string
+	rspauth string
+}
+
+// Mechanism returns "DIGEST-MD5".
+func (d *DigestMD5) Mechanism() string {
+	return "DIGEST-MD5"
+}
+
+// Start returns nil; DIGEST-MD5 has no initial response. It picks a new client
+// nonce for the exchange.
+func (d *DigestMD5) Start() ([]byte, error) {
+	r := d.rand
+	if r == nil {
+		r = rand.Reader
+	}
+	b := make([]byte, 16)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	d.cnonce = hex.EncodeToString(b)
+	d.rspauth = ""
+	return nil, nil
+}
+
+// Next responds to the server's challenge, or verifies the server's final
+// response authentication message.
+func (d *DigestMD5) Next(challenge []byte) ([]byte, error) {
+	params := parseDigestChallenge(string(challenge))
+
+	if rspauth, ok := params["rspauth"]; ok {
+		if d.rspauth == "" || rspauth != d.rspauth {
+			return nil, errors.New("digest-md5: server failed to authenticate")
+		}
+		return []byte{}, nil
+	}
+
+	if d.cnonce == "" {
+		return nil, errors.New("digest-md5: challenge received before start")
+	}
+	nonce, ok := params["nonce"]
+	if !ok {
+		return nil, errors.New("digest-md5: challenge is missing a nonce")
+	}
+	// Without "auth", the server only allows integrity or confidentiality
+	// protection, which are security layers.
+	if qop := params["qop"]; qop != "" && !strings.Contains(","+qop+",", ",auth,") {
+		return nil, fmt.Errorf("%w: digest-md5 offered qop %q", ErrSASLSecurityLayer, qop)
+	}
+
+	realm := params["realm"]
+	uri := "libvirt/" + d.Host
+	const nc = "00000001"
+	var resp string
+	resp, d.rspauth = digestResponse(d.Username, realm, d.Password, nonce,
+		d.cnonce, nc, uri)
+
+	r := fmt.Sprintf("username=%v,realm=%v,nonce=%v,cnonce=%v,nc=%v,qop=auth,digest-uri=%v,response=%v",
+		digestQuote(d.Username), digestQuote(realm), digestQuote(nonce),
+		digestQuote(d.cnonce), nc, digestQuote(uri), resp)
+	if params["charset"] == "utf-8" {
+		r += ",charset=utf-8"
+	}
+	return []byte(r), nil
+}
+
+// digestResponse calculates the response to a DIGEST-MD5 challenge, and the
+// response authentication value the server is expected to send back.
+func digestResponse(username, realm, password, nonce, cnonce, nc, uri string) (string, string) {
+	h := md5.Sum([]byte(username + ":" + realm + ":" + password))
+	a1 := string(h[:]) + ":" + nonce + ":" + cnonce
+	ha1 := md5Hex(a1)
+
+	kd := func(a2 string) string {
+		return md5Hex(ha1 + ":" + nonce + ":" + nc + ":" + cnonce + ":auth:" + md5Hex(a2))
+	}
+	return kd("AUTHENTICATE:" + uri), kd(":" + uri)
+}
+
+// digestQuote returns s as an RFC 2831 quoted-string, where only quotes and
+// backslashes are escaped.
+func digestQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func md5Hex(s string) string {
+	h := md5.Sum([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+// parseDigestChallenge parses the comma-separated key=value pairs of a
+// DIGEST-MD5 challenge. Values may be quoted.
+func parseDigestChallenge(s string) map[string]string {
+	params := make(map[string]string)
+	for s != "" {
+		eq := strings.IndexByte(s, '=')
+		if eq == -1 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:eq]))
+		s = s[eq+1:]
+
+		var val string
+		if strings.HasPrefix(s, `"`) {
+			s = s[1:]
+			var b strings.Builder
+			for len(s) > 0 && s[0] != '"' {
+				if s[0] == '\\' && len(s) > 1 {
+					s = s[1:]
+				}
+				b.WriteByte(s[0])
+				s = s[1:]
+			}
+			s = strings.TrimPrefix(s, `"`)
+			val = b.String()
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end == -1 {
+				end = len(s)
+			}
+			val = strings.TrimSpace(s[:end])
+			s = s[end:]
+		}
+		s = strings.TrimLeft(s, ", ")
+
+		// A server may offer a choice of realms; the first will do.
+		if _, ok := params[key]; !ok {
+			params[key] = val
+		}
+	}
+	return params
+}
+
+// GSSAPIContext is the client side of a GSS-API security context, such as one
+// for Kerberos provided by gokrb5 or the system's GSS-API library. It is
+// created for the libvirt service principal of the host, "libvirt@host" in
+// GSS-API's host-based service name form.
+//
+// Once the connection is protected by a security layer, Wrap and Unwrap are
+// called concurrently, for the data sent and received.
+type GSSAPIContext interface {
+	// Step processes the server's token, nil when starting the context, and
+	// returns the token to send back, and whether the context is now
+	// established.
+	Step(token []byte) (out []byte, established bool, err error)
+	// Wrap protects a message for the server, as gss_wrap does. It always
+	// protects the message's integrity, and with conf set its
+	// confidentiality too.
+	Wrap(msg []byte, conf bool) ([]byte, error)
+	// Unwrap verifies a message from the server, and returns its contents.
+	Unwrap(msg []byte) ([]byte, error)
+}
+
+// The RFC 4752 bits for each security layer.
+const (
+	gssapiNoSecurityLayer = 0x01
+	gssapiIntegrity       = 0x02
+	gssapiConfidentiality = 0x04
+)
+
+const (
+	// gssapiMaxBuffer is the largest buffer the client accepts through a
+	// security layer, as cyrus-sasl's default.
+	gssapiMaxBuffer = 65536
+	// gssapiWrapOverhead is more than the Kerberos mechanism adds to a
+	// message when wrapping it.
+	gssapiWrapOverhead = 256
+)
+
+// GSSAPI implements the SASL GSSAPI mechanism (RFC 4752), used for Kerberos
+// authentication. If the server allows it, as libvirtd does for TLS
+// connections, no security layer is negotiated. Otherwise the connection is
+// protected with confidentiality, or integrity if that's all the server
+// offers, as libvirtd requires for plain TCP connections with auth_tcp="sasl".
+type GSSAPI struct {
+	// NewContext creates the security context for each authentication
+	// exchange.
+	NewContext func() (GSSAPIContext, error)
+	// AuthzID is the identity to act as, if it differs from the
+	// authenticated principal.
+	AuthzID string
+
+	ctx         GSSAPIContext
+	established bool
+	done        bool
+	layer       socket.SecurityLayer
+}
+
+// Mechanism returns "GSSAPI".
+func (g *GSSAPI) Mechanism() string {
+	return "GSSAPI"
+}
+
+// Start creates a new security context, and returns its initial token.
+func (g *GSSAPI) Start() ([]byte, error) {
+	ctx, err := g.NewContext()
+	if err != nil {
+		return nil, err
+	}
+	g.ctx, g.done, g.layer = ctx, false, nil
+
+	out, established, err := g.ctx.Step(nil)
+	if err != nil {
+		return nil, err
+	}
+	g.established = established
+	if out == nil {
+		out = []byte{}
+	}
+	return out, nil
+}
+
+// Next passes the server's tokens to the security context until it is
+// established, then answers the server's security layer offer.
+func (g *GSSAPI) Next(challenge []byte) ([]byte, error) {
+	switch {
+	case g.ctx == nil:
+		return nil, errors.New("gssapi: challenge received before start")
+	case !g.established:
+		out, established, err := g.ctx.Step(challenge)
+		if err != nil {
+			return nil, err
+		}
+		g.established = established
+		if out == nil {
+			out = []byte{}
+		}
+		return out, nil
+	case g.done:
+		if len(challenge) > 0 {
+			return nil, errors.New("gssapi: unexpected challenge after authentication")
+		}
+		return nil, nil
+	}
+
+	// The server offers the security layers it supports, and its maximum
+	// message size, in four bytes.
+	offer, err := g.ctx.Unwrap(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if len(offer) != 4 {
+		return nil, fmt.Errorf("gssapi: invalid security layer message of %d bytes", len(offer))
+	}
+	serverMax := int(offer[1])<<16 | int(offer[2])<<8 | int(offer[3])
+
+	// Choose no security layer if the server allows it, as it's already
+	// protected by TLS; the maximum message size is then zero.
+	reply := []byte{gssapiNoSecurityLayer, 0, 0, 0}
+	switch {
+	case offer[0]&gssapiNoSecurityLayer != 0:
+	case offer[0]&(gssapiConfidentiality|gssapiIntegrity) == 0:
+		return nil, fmt.Errorf("%w: gssapi offered layers %#x", ErrSASLSecurityLayer, offer[0])
+	case serverMax <= gssapiWrapOverhead:
+		return nil, fmt.Errorf("gssapi: server's maximum message size of %d bytes is too small", serverMax)
+	default:
+		conf := offer[0]&gssapiConfidentiality != 0
+		reply[0] = gssapiIntegrity
+		if conf {
+			reply[0] = gssapiConfidentiality
+		}
+		reply[1], reply[2], reply[3] = gssapiMaxBuffer>>16, gssapiMaxBuffer>>8&0xff, gssapiMaxBuffer&0xff
+		g.layer = &gssapiLayer{ctx: g.ctx, conf: conf, max: serverMax - gssapiWrapOverhead}
+	}
+
+	g.done = true
+	return g.ctx.Wrap(append(reply, g.AuthzID...), false)
+}
+
+// SecurityLayer returns the security layer negotiated, or nil if the server
+// allowed the connection without one.
+func (g *GSSAPI) SecurityLayer() socket.SecurityLayer {
+	return g.layer
+}
+
+// gssapiLayer is a GSSAPI security layer, wrapping messages with the security
+// context.
+type gssapiLayer struct {
+	ctx  GSSAPIContext
+	conf bool
+	// max is the most data to wrap at once, so that the wrapped message fits
+	// in the server's maximum.
+	max int
+}
+
+func (g *gssapiLayer) Wrap(b []byte) ([]byte, error) {
+	return g.ctx.Wrap(b, g.conf)
+}
+
+func (g *gssapiLayer) Unwrap(b []byte) ([]byte, error) {
+	return g.ctx.Unwrap(b)
+}
+
+func (g *gssapiLayer) MaxWrap() int {
+	return g.max
+}
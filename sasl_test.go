@@ -0,0 +1,411 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+// testCnonce is the client nonce DigestMD5 picks when reading testNonceBytes.
+const (
+	testNonceBytes = "0123456789abcdef"
+	testCnonce     = "30313233343536373839616263646566"
+)
+
+func TestDigestResponse(t *testing.T) {
+	// Example from RFC 2831, section 4.
+	resp, rspauth := digestResponse("chris", "elwood.innosoft.com", "secret",
+		"OA6MG9tEQGm2hh", "OA6MHXh6VqTrRk", "00000001", "imap/elwood.innosoft.com")
+
+	if resp != "d388dad90d4bbd760a152321f2143af7" {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if rspauth != "ea40f60335c427b5527b84dbabcdfffd" {
+		t.Errorf("unexpected rspauth %v", rspauth)
+	}
+}
+
+func TestDigestMD5(t *testing.T) {
+	d := &DigestMD5{Username: "chris", Password: "secret", Host: "elwood.innosoft.com",
+		rand: strings.NewReader(testNonceBytes)}
+
+	if _, err := d.Start(); err != nil {
+		t.Fatal(err)
+	}
+	challenge := `realm="elwood.innosoft.com",nonce="OA6MG9tEQGm2hh",qop="auth",algorithm=md5-sess,charset=utf-8`
+	resp, err := d.Next([]byte(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := parseDigestChallenge(string(resp))
+	for k, v := range map[string]string{
+		"username":   "chris",
+		"realm":      "elwood.innosoft.com",
+		"nonce":      "OA6MG9tEQGm2hh",
+		"cnonce":     testCnonce,
+		"qop":        "auth",
+		"digest-uri": "libvirt/elwood.innosoft.com",
+		"charset":    "utf-8",
+	} {
+		if params[k] != v {
+			t.Errorf("expected %v=%q, got %q", k, v, params[k])
+		}
+	}
+
+	if _, err = d.Next([]byte("rspauth=0123")); err == nil {
+		t.Error("expected an error for a bad rspauth")
+	}
+	_, rspauth := digestResponse("chris", "elwood.innosoft.com", "secret",
+		"OA6MG9tEQGm2hh", testCnonce, "00000001", "libvirt/elwood.innosoft.com")
+	if _, err = d.Next([]byte("rspauth=" + rspauth)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSASLOffered(t *testing.T) {
+	if !saslOffered("DIGEST-MD5,GSSAPI", "gssapi") {
+		t.Error("expected GSSAPI to be offered")
+	}
+	if saslOffered(strings.Join([]string{"PLAIN", "GSSAPI"}, ","), "DIGEST-MD5") {
+		t.Error("expected DIGEST-MD5 not to be offered")
+	}
+}
+
+func TestDigestMD5Nonce(t *testing.T) {
+	d := &DigestMD5{Username: "chris", Password: "secret"}
+
+	if _, err := d.Next([]byte(`nonce="abc"`)); err == nil {
+		t.Error("expected an error for a challenge before start")
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		if _, err := d.Start(); err != nil {
+			t.Fatal(err)
+		}
+		if seen[d.cnonce] {
+			t.Fatalf("cnonce %v reused", d.cnonce)
+		}
+		seen[d.cnonce] = true
+	}
+}
+
+func TestDigestMD5SecurityLayer(t *testing.T) {
+	d := &DigestMD5{Username: "chris", Password: "secret"}
+	if _, err := d.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := d.Next([]byte(`nonce="abc",qop="auth-int,auth-conf"`))
+	if !errors.Is(err, ErrSASLSecurityLayer) {
+		t.Errorf("expected ErrSASLSecurityLayer, got %v", err)
+	}
+}
+
+func TestDigestQuote(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"chris", `"chris"`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`dom\user`, `"dom\\user"`},
+		// Only quotes and backslashes are escaped; UTF-8 is sent as is.
+		{`josé\t`, `"josé\\t"`},
+	}
+
+	for _, tt := range tests {
+		if got := digestQuote(tt.in); got != tt.want {
+			t.Errorf("digestQuote(%q): expected %v, got %v", tt.in, tt.want, got)
+		}
+		if got := parseDigestChallenge("v=" + digestQuote(tt.in))["v"]; got != tt.in {
+			t.Errorf("expected %q to parse back, got %q", tt.in, got)
+		}
+	}
+}
+
+// queueSASL queues libvirt's replies for a SASL exchange, offering mechlist,
+// and replying to the start and each step with the given challenges. The last
+// reply completes the exchange.
+func queueSASL(t *testing.T, dialer *libvirttest.MockLibvirt, mechlist string, challenges ...string) {
+	t.Helper()
+	queue := func(proc uint32, v interface{}) {
+		payload, err := encode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+
+	queue(constants.ProcAuthList, &AuthListRet{Types: []AuthType{constants.AuthSasl}})
+	queue(constants.ProcAuthSaslInit, &AuthSaslInitRet{Mechlist: mechlist})
+	for i, c := range challenges {
+		ret := &AuthSaslStepRet{Data: bytesToInt8s([]byte(c))}
+		if i == len(challenges)-1 {
+			ret.Complete = 1
+		}
+		if i == 0 {
+			queue(constants.ProcAuthSaslStart, ret)
+		} else {
+			queue(constants.ProcAuthSaslStep, ret)
+		}
+	}
+}
+
+// saslSent returns the SASL data sent with each start and step call, nil for
+// those sent with the nil flag.
+func saslSent(t *testing.T, dialer *libvirttest.MockLibvirt) (mech string, sent [][]byte) {
+	t.Helper()
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcAuthSaslStart:
+			var args AuthSaslStartArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			mech = args.Mech
+			sent = append(sent, saslData(args.Nil, args.Data))
+		case constants.ProcAuthSaslStep:
+			var args AuthSaslStepArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			sent = append(sent, saslData(args.Nil, args.Data))
+		}
+	}
+	return mech, sent
+}
+
+func saslData(isNil int32, data []int8) []byte {
+	if isNil != 0 {
+		return nil
+	}
+	return int8sToBytes(data)
+}
+
+func TestSASLNoClient(t *testing.T) {
+	dialer := libvirttest.New()
+	queueSASL(t, dialer, "DIGEST-MD5")
+	l := NewWithDialer(dialer)
+
+	if err := l.Connect(); !errors.Is(err, ErrSASLClientRequired) {
+		t.Errorf("expected ErrSASLClientRequired, got %v", err)
+		l.Disconnect()
+	}
+}
+
+func TestSASLMechanismUnavailable(t *testing.T) {
+	dialer := libvirttest.New()
+	queueSASL(t, dialer, "EXTERNAL,GSSAPI")
+	l := NewWithDialer(dialer)
+	l.SetSASLClient(&DigestMD5{Username: "admin", Password: "secret"})
+
+	if err := l.Connect(); !errors.Is(err, ErrSASLMechanismUnavailable) {
+		t.Errorf("expected ErrSASLMechanismUnavailable, got %v", err)
+		l.Disconnect()
+	}
+}
+
+func TestSASLDigestMD5Exchange(t *testing.T) {
+	// The challenges are in the form cyrus-sasl sends them for libvirtd, and
+	// the digests were calculated independently of digestResponse.
+	dialer := libvirttest.New()
+	queueSASL(t, dialer, "DIGEST-MD5",
+		`nonce="7YhOlriFQa4LbaLRst+2Xyn3nFlc8HJogB7r46CAFmY=",realm="libvirt.test",qop="auth",charset=utf-8,algorithm=md5-sess`,
+		"rspauth=061a5742eaa4565af589151a64351252",
+	)
+	l := NewWithDialer(dialer)
+	l.SetSASLClient(&DigestMD5{Username: "admin", Password: "secret", Host: "libvirt.test",
+		rand: strings.NewReader(testNonceBytes)})
+
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	mech, sent := saslSent(t, dialer)
+	if mech != "DIGEST-MD5" {
+		t.Errorf("expected mechanism DIGEST-MD5, got %v", mech)
+	}
+	want := []string{
+		"",
+		`username="admin",realm="libvirt.test",nonce="7YhOlriFQa4LbaLRst+2Xyn3nFlc8HJogB7r46CAFmY=",cnonce="` + testCnonce + `",nc=00000001,qop=auth,digest-uri="libvirt/libvirt.test",response=44c931419e8e669f9ef44ade348e57e5,charset=utf-8`,
+	}
+	if len(sent) != len(want) {
+		t.Fatalf("expected %d messages, got %d", len(want), len(sent))
+	}
+	if sent[0] != nil {
+		t.Errorf("expected no initial response, got %q", sent[0])
+	}
+	if string(sent[1]) != want[1] {
+		t.Errorf("expected response\n%s\ngot\n%s", want[1], sent[1])
+	}
+}
+
+// testGSSContext is a GSS-API context which exchanges tokens from a script,
+// and wraps messages by prefixing "wrap:", or "conf:" for confidentiality.
+type testGSSContext struct {
+	tokens [][2]string
+}
+
+func (c *testGSSContext) Step(token []byte) ([]byte, bool, error) {
+	if len(c.tokens) == 0 {
+		return nil, false, errors.New("unexpected token")
+	}
+	next := c.tokens[0]
+	c.tokens = c.tokens[1:]
+	if string(token) != next[0] {
+		return nil, false, errors.New("unexpected token " + string(token))
+	}
+	return []byte(next[1]), len(c.tokens) == 0, nil
+}
+
+func (c *testGSSContext) Wrap(msg []byte, conf bool) ([]byte, error) {
+	if conf {
+		return append([]byte("conf:"), msg...), nil
+	}
+	return append([]byte("wrap:"), msg...), nil
+}
+
+func (c *testGSSContext) Unwrap(msg []byte) ([]byte, error) {
+	if !bytes.HasPrefix(msg, []byte("wrap:")) && !bytes.HasPrefix(msg, []byte("conf:")) {
+		return nil, errors.New("invalid wrapped message")
+	}
+	return msg[len("wrap:"):], nil
+}
+
+func newTestGSSAPI() *GSSAPI {
+	return &GSSAPI{
+		NewContext: func() (GSSAPIContext, error) {
+			return &testGSSContext{tokens: [][2]string{
+				{"", "client-1"},
+				{"server-1", ""},
+			}}, nil
+		},
+		AuthzID: "admin",
+	}
+}
+
+func TestGSSAPIExchange(t *testing.T) {
+	dialer := libvirttest.New()
+	queueSASL(t, dialer, "DIGEST-MD5,GSSAPI",
+		"server-1",
+		// The server supports no security layer, integrity and
+		// confidentiality, with a maximum message size of 64KiB.
+		"wrap:\x07\x01\x00\x00",
+		"",
+	)
+	l := NewWithDialer(dialer)
+	l.SetSASLClient(newTestGSSAPI())
+
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	mech, sent := saslSent(t, dialer)
+	if mech != "GSSAPI" {
+		t.Errorf("expected mechanism GSSAPI, got %v", mech)
+	}
+	want := []string{"client-1", "", "wrap:\x01\x00\x00\x00admin"}
+	if len(sent) != len(want) {
+		t.Fatalf("expected %d messages, got %d", len(want), len(sent))
+	}
+	for i := range want {
+		if string(sent[i]) != want[i] {
+			t.Errorf("message %d: expected %q, got %q", i, want[i], sent[i])
+		}
+	}
+}
+
+func TestGSSAPISecurityLayer(t *testing.T) {
+	tests := []struct {
+		name  string
+		offer string
+		reply string
+		// conf is whether the layer wraps with confidentiality, if one is
+		// negotiated.
+		layer, conf bool
+	}{
+		{"none", "\x07\x01\x00\x00", "wrap:\x01\x00\x00\x00admin", false, false},
+		{"confidentiality", "\x06\x01\x00\x00", "wrap:\x04\x01\x00\x00admin", true, true},
+		{"integrity", "\x02\x00\x10\x00", "wrap:\x02\x01\x00\x00admin", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGSSAPI()
+			if _, err := g.Start(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := g.Next([]byte("server-1")); err != nil {
+				t.Fatal(err)
+			}
+			reply, err := g.Next([]byte("wrap:" + tt.offer))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(reply) != tt.reply {
+				t.Errorf("expected reply %q, got %q", tt.reply, reply)
+			}
+
+			layer := g.SecurityLayer()
+			if !tt.layer {
+				if layer != nil {
+					t.Errorf("expected no security layer, got %v", layer)
+				}
+				return
+			}
+			if layer == nil {
+				t.Fatal("expected a security layer")
+			}
+			wrapped, err := layer.Wrap([]byte("call"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := "wrap:call"
+			if tt.conf {
+				want = "conf:call"
+			}
+			if string(wrapped) != want {
+				t.Errorf("expected %q, got %q", want, wrapped)
+			}
+			maxSize := int(tt.offer[1])<<16 | int(tt.offer[2])<<8 | int(tt.offer[3])
+			if got := layer.MaxWrap(); got <= 0 || got >= maxSize {
+				t.Errorf("expected to wrap less than %d bytes at once, got %d", maxSize, got)
+			}
+		})
+	}
+
+	// A server must offer at least one layer.
+	g := newTestGSSAPI()
+	if _, err := g.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.Next([]byte("server-1")); err != nil {
+		t.Fatal(err)
+	}
+	_, err := g.Next([]byte("wrap:\x00\x01\x00\x00"))
+	if !errors.Is(err, ErrSASLSecurityLayer) {
+		t.Errorf("expected ErrSASLSecurityLayer, got %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// DomainScheduler returns the name of the CPU scheduler a domain runs under,
+// such as "posix" for QEMU, and its parameters, such as DomainSchedulerCPUShares
+// and DomainSchedulerVCPUQuota. flags selects the live or persistent
+// configuration, as for DomainModificationImpact; with 0 it's the live
+// configuration of a running domain, and the persistent one otherwise.
+//
+// Unlike DomainGetSchedulerParametersFlags, which needs the number of
+// parameters DomainGetSchedulerType returns, this makes both calls. To change
+// parameters, pass those to change to DomainSetSchedulerParametersFlags.
+func (l *Libvirt) DomainScheduler(dom Domain, flags DomainModificationImpact) (string, TypedParams, error) {
+	name, n, err := l.DomainGetSchedulerType(dom)
+	if err != nil || n == 0 {
+		return name, nil, err
+	}
+
+	params, err := l.DomainGetSchedulerParametersFlags(dom, n, flags)
+	if err != nil {
+		return name, nil, err
+	}
+	return name, TypedParams(params), nil
+}
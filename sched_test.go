@@ -0,0 +1,93 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainScheduler(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	queue := func(proc uint32, ret interface{}) {
+		payload, err := encode(ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcDomainGetSchedulerType, &DomainGetSchedulerTypeRet{Type: "posix", Nparams: 2})
+	var want TypedParams
+	want.SetUllong(DomainSchedulerCPUShares, 1024)
+	want.SetLlong(DomainSchedulerVCPUQuota, -1)
+	queue(constants.ProcDomainGetSchedulerParametersFlags, &DomainGetSchedulerParametersFlagsRet{Params: want})
+
+	dom := Domain{Name: "test"}
+	name, params, err := l.DomainScheduler(dom, DomainAffectConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "posix" {
+		t.Errorf("expected scheduler %q, got %q", "posix", name)
+	}
+	if shares, ok := params.GetUllong(DomainSchedulerCPUShares); !ok || shares != 1024 {
+		t.Errorf("expected cpu shares 1024, got %v, %v", shares, ok)
+	}
+	if quota, ok := params.GetLlong(DomainSchedulerVCPUQuota); !ok || quota != -1 {
+		t.Errorf("expected vcpu quota -1, got %v, %v", quota, ok)
+	}
+
+	dialer.QueueReply(constants.Program, constants.ProcDomainSetSchedulerParametersFlags, nil)
+	var set TypedParams
+	set.SetLlong(DomainSchedulerVCPUQuota, 50000)
+	if err := l.DomainSetSchedulerParametersFlags(dom, set, DomainAffectLive|DomainAffectConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range dialer.Requests() {
+		switch r.Procedure {
+		case constants.ProcDomainGetSchedulerParametersFlags:
+			var args DomainGetSchedulerParametersFlagsArgs
+			if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+				t.Fatal(err)
+			}
+			if args.Nparams != 2 || args.Flags != DomainAffectConfig {
+				t.Errorf("expected 2 parameters for the config, got %+v", args)
+			}
+		case constants.ProcDomainSetSchedulerParametersFlags:
+			var args DomainSetSchedulerParametersFlagsArgs
+			dec := xdr.NewDecoderCustomTypes(bytes.NewReader(r.Payload), 0, customTypes)
+			if _, err := dec.Decode(&args); err != nil {
+				t.Fatal(err)
+			}
+			if quota, ok := TypedParams(args.Params).GetLlong(DomainSchedulerVCPUQuota); !ok || quota != 50000 {
+				t.Errorf("expected vcpu quota 50000 to be set, got %v, %v", quota, ok)
+			}
+			if want := DomainAffectLive | DomainAffectConfig; args.Flags != want {
+				t.Errorf("expected flags %v, got %v", want, args.Flags)
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// Usage returns what the secret is used for, such as SecretUsageTypeVolume for
+// the passphrase of an encrypted volume. The UsageID field identifies the
+// particular volume, Ceph client or other user.
+func (s Secret) Usage() SecretUsageType {
+	return SecretUsageType(s.UsageType)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestSecretValue(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	secret := Secret{UUID: testUUID, UsageType: int32(SecretUsageTypeVolume), UsageID: "/var/lib/libvirt/images/luks.img"}
+	if secret.Usage() != SecretUsageTypeVolume {
+		t.Errorf("expected usage %v, got %v", SecretUsageTypeVolume, secret.Usage())
+	}
+
+	value := []byte("passphrase\x00\xff")
+	dialer.QueueReply(constants.Program, constants.ProcSecretSetValue, nil)
+	if err := l.SecretSetValue(secret, value, 0); err != nil {
+		t.Fatal(err)
+	}
+	reqs := dialer.Requests()
+	var args SecretSetValueArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(reqs[len(reqs)-1].Payload), &args); err != nil {
+		t.Fatal(err)
+	}
+	if args.OptSecret != secret || !bytes.Equal(args.Value, value) {
+		t.Errorf("expected value %q for %+v, got %+v", value, secret, args)
+	}
+
+	payload, err := encode(&SecretGetValueRet{Value: value})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcSecretGetValue, payload)
+	got, err := l.SecretGetValue(secret, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, value) {
+		t.Errorf("expected value %q, got %q", value, got)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// DomainSnapshots returns a domain's snapshots, filtered by flags; 0 lists
+// them all. Each snapshot carries its name and the domain it belongs to, and
+// can be passed to DomainSnapshotGetXMLDesc, DomainRevertToSnapshot and
+// DomainSnapshotDelete.
+func (l *Libvirt) DomainSnapshots(dom Domain, flags DomainSnapshotListFlags) ([]DomainSnapshot, error) {
+	// NeedResults asks for the snapshots themselves, not just their number.
+	snaps, _, err := l.DomainListAllSnapshots(dom, 1, flags)
+	return snaps, err
+}
+
+// DomainCurrentSnapshot returns dom's current snapshot, the one most recently
+// created or reverted to, and reports whether it has one; it is the snapshot to
+// pass to DomainRevertToSnapshot to revert to the latest state. It calls
+// DomainHasCurrentSnapshot, whose result is an int, and then
+// DomainSnapshotCurrent if there is a snapshot. flags is currently unused by
+// libvirt and should be 0.
+func (l *Libvirt) DomainCurrentSnapshot(dom Domain, flags uint32) (DomainSnapshot, bool, error) {
+	has, err := l.DomainHasCurrentSnapshot(dom, flags)
+	if err != nil || has == 0 {
+		return DomainSnapshot{}, false, err
+	}
+
+	snap, err := l.DomainSnapshotCurrent(dom, flags)
+	if IsErrorCode(err, ErrNoDomainSnapshot) {
+		// The snapshot was deleted in between.
+		return DomainSnapshot{}, false, nil
+	}
+	if err != nil {
+		return DomainSnapshot{}, false, err
+	}
+	return snap, true, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestDomainSnapshots(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	dom := Domain{Name: "test", UUID: testUUID, ID: 1}
+	snaps := []DomainSnapshot{
+		{Name: "before-upgrade", Dom: dom},
+		{Name: "after-upgrade", Dom: dom},
+	}
+	payload, err := encode(&DomainListAllSnapshotsRet{Snapshots: snaps, Ret: int32(len(snaps))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainListAllSnapshots, payload)
+
+	got, err := l.DomainSnapshots(dom, DomainSnapshotListRoots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, snaps) {
+		t.Errorf("expected snapshots %v, got %v", snaps, got)
+	}
+
+	reqs := dialer.Requests()
+	var args DomainListAllSnapshotsArgs
+	if _, err := xdr.Unmarshal(bytes.NewReader(reqs[len(reqs)-1].Payload), &args); err != nil {
+		t.Fatal(err)
+	}
+	if args.NeedResults != 1 || args.Flags != DomainSnapshotListRoots {
+		t.Errorf("expected NeedResults 1 and flags %v, got %+v", DomainSnapshotListRoots, args)
+	}
+}
+
+func TestDomainCurrentSnapshot(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	queue := func(proc uint32, ret interface{}) {
+		payload, err := encode(ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	dom := Domain{Name: "test", UUID: testUUID, ID: 1}
+	snap := DomainSnapshot{Name: "after-upgrade", Dom: dom}
+
+	queue(constants.ProcDomainHasCurrentSnapshot, &DomainHasCurrentSnapshotRet{Result: 1})
+	queue(constants.ProcDomainSnapshotCurrent, &DomainSnapshotCurrentRet{Snap: snap})
+	got, ok, err := l.DomainCurrentSnapshot(dom, 0)
+	if err != nil || !ok || got != snap {
+		t.Errorf("expected snapshot %v, got %v, %v, error %v", snap, got, ok, err)
+	}
+
+	queue(constants.ProcDomainHasCurrentSnapshot, &DomainHasCurrentSnapshotRet{Result: 0})
+	if _, ok, err := l.DomainCurrentSnapshot(dom, 0); err != nil || ok {
+		t.Errorf("expected no current snapshot, got %v, error %v", ok, err)
+	}
+
+	// The snapshot is deleted after it's been found.
+	queue(constants.ProcDomainHasCurrentSnapshot, &DomainHasCurrentSnapshotRet{Result: 1})
+	buf, err := encode(&struct {
+		Code     uint32
+		DomainID uint32
+		Padding  uint8
+		Message  string
+		Level    uint32
+	}{uint32(ErrNoDomainSnapshot), uint32(fromDomainSnapshot), 1, "no domain snapshot", 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueError(constants.Program, constants.ProcDomainSnapshotCurrent, buf)
+	if _, ok, err := l.DomainCurrentSnapshot(dom, 0); err != nil || ok {
+		t.Errorf("expected no current snapshot, got %v, error %v", ok, err)
+	}
+}
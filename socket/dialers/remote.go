@@ -1,7 +1,11 @@
 package dialers
 
 import (
+	"errors"
+	"fmt"
 	"net"
+	"strings"
+	"syscall"
 	"time"
 )
 
@@ -37,12 +41,15 @@ func UsePort(port string) RemoteOption {
 }
 
 // NewRemote is a dialer for connecting to libvirt running on another server.
+// The host address is a hostname or an IP address, and IPv6 addresses may be
+// bracketed. It may also include a port, as in "[2001:db8::1]:16509", which
+// UsePort overrides.
 func NewRemote(hostAddr string, opts ...RemoteOption) *Remote {
 	r := &Remote{
 		timeout: defaultRemoteTimeout,
-		host:    hostAddr,
 		port:    defaultRemotePort,
 	}
+	r.host, r.port = splitHostAddr(hostAddr, r.port)
 
 	for _, opt := range opts {
 		opt(r)
@@ -51,11 +58,52 @@ func NewRemote(hostAddr string, opts ...RemoteOption) *Remote {
 	return r
 }
 
-// Dial connects to libvirt running on another server.
+// Host returns the host dialed, without brackets or a port. It is the name to
+// verify the server's certificate against if the connection is later upgraded
+// to tls.
+func (r *Remote) Host() string {
+	return r.host
+}
+
+// Dial connects to libvirt running on another server. If the host's name can't
+// be resolved, or the server refuses the connection, the error says so and
+// wraps the underlying *net.DNSError or syscall.ECONNREFUSED.
 func (r *Remote) Dial() (net.Conn, error) {
-	return net.DialTimeout(
-		"tcp",
-		net.JoinHostPort(r.host, r.port),
-		r.timeout,
-	)
+	return dialTCP(r.host, r.port, r.timeout)
+}
+
+// splitHostAddr splits a host address into its host, without any brackets,
+// and its port, or port if it has none.
+func splitHostAddr(hostAddr, port string) (string, string) {
+	if h, p, err := net.SplitHostPort(hostAddr); err == nil {
+		return h, p
+	}
+	// A bare IPv6 address has colons of its own, so SplitHostPort fails on it
+	// whether it is bracketed or not.
+	if strings.HasPrefix(hostAddr, "[") && strings.HasSuffix(hostAddr, "]") {
+		hostAddr = hostAddr[1 : len(hostAddr)-1]
+	}
+	return hostAddr, port
+}
+
+// dialTCP dials host and port, distinguishing the common reasons for failing.
+func dialTCP(host, port string, timeout time.Duration) (net.Conn, error) {
+	addr := net.JoinHostPort(host, port)
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return nil, dialError(addr, err)
+	}
+	return conn, nil
+}
+
+// dialError describes an error dialing addr.
+func dialError(addr string, err error) error {
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &dnsErr):
+		return fmt.Errorf("failed to resolve libvirt host %v: %w", addr, err)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return fmt.Errorf("connection to libvirt at %v refused: %w", addr, err)
+	}
+	return err
 }
@@ -0,0 +1,97 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestNewRemoteHostAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		opts []RemoteOption
+		host string
+		port string
+	}{
+		{addr: "host.example.com", host: "host.example.com", port: defaultRemotePort},
+		{addr: "192.0.2.1:1234", host: "192.0.2.1", port: "1234"},
+		{addr: "2001:db8::1", host: "2001:db8::1", port: defaultRemotePort},
+		{addr: "[2001:db8::1]", host: "2001:db8::1", port: defaultRemotePort},
+		{addr: "[2001:db8::1]:1234", host: "2001:db8::1", port: "1234"},
+		{addr: "[2001:db8::1]:1234", opts: []RemoteOption{UsePort("5678")}, host: "2001:db8::1", port: "5678"},
+	}
+
+	for _, tt := range tests {
+		r := NewRemote(tt.addr, tt.opts...)
+		if r.Host() != tt.host || r.port != tt.port {
+			t.Errorf("%q: expected host %q and port %q, got %q and %q",
+				tt.addr, tt.host, tt.port, r.Host(), r.port)
+		}
+	}
+}
+
+func TestRemoteDialIPv6(t *testing.T) {
+	ln, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("no IPv6 loopback: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		if conn, err := ln.Accept(); err == nil {
+			conn.Close()
+		}
+	}()
+
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	conn, err := NewRemote("[::1]", UsePort(port)).Dial()
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	conn.Close()
+}
+
+func TestRemoteDialRefused(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	_, err = NewRemote(addr).Dial()
+	if !errors.Is(err, syscall.ECONNREFUSED) {
+		t.Fatalf("expected connection refused, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "refused") {
+		t.Errorf("expected the error to say the connection was refused, got %v", err)
+	}
+}
+
+func TestDialErrorDNS(t *testing.T) {
+	dnsErr := &net.DNSError{Err: "no such host", Name: "missing.invalid", IsNotFound: true}
+	err := dialError("missing.invalid:16509", &net.OpError{Op: "dial", Net: "tcp", Err: dnsErr})
+
+	var got *net.DNSError
+	if !errors.As(err, &got) || got != dnsErr {
+		t.Fatalf("expected the dns error to be wrapped, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "failed to resolve") {
+		t.Errorf("expected the error to say the host wasn't resolved, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// DialWithRetry dials libvirt with dialer, making up to attempts attempts and
+// waiting backoff after each failed one, and returns the first connection
+// made. It's for starting up alongside libvirt, when its socket may not be
+// there yet; for reconnecting once the connection is lost, see the libvirt
+// package's EnableReconnect. Fewer than 1 attempts are treated as 1.
+//
+// The connection can be passed to libvirt.NewWithDialer with
+// NewAlreadyConnected, or the dialer itself used once DialWithRetry has shown
+// libvirt is up.
+func DialWithRetry(dialer socket.Dialer, attempts int, backoff time.Duration) (net.Conn, error) {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var conn net.Conn
+		if conn, err = dialer.Dial(); err == nil {
+			return conn, nil
+		}
+		if attempt == attempts {
+			break
+		}
+		time.Sleep(backoff)
+	}
+	return nil, fmt.Errorf("failed to dial libvirt after %d attempts: %w", attempts, err)
+}
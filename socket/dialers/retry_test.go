@@ -0,0 +1,65 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyDialer fails until it has been dialed fails times.
+type flakyDialer struct {
+	fails, dials int
+}
+
+func (d *flakyDialer) Dial() (net.Conn, error) {
+	d.dials++
+	if d.dials <= d.fails {
+		return nil, syscall.ENOENT
+	}
+	c, _ := net.Pipe()
+	return c, nil
+}
+
+func TestDialWithRetry(t *testing.T) {
+	d := &flakyDialer{fails: 2}
+	conn, err := DialWithRetry(d, 3, time.Millisecond)
+	if err != nil {
+		t.Fatalf("expected to connect on the last attempt, got %v", err)
+	}
+	conn.Close()
+	if d.dials != 3 {
+		t.Errorf("expected 3 dials, got %d", d.dials)
+	}
+
+	d = &flakyDialer{fails: 3}
+	if _, err := DialWithRetry(d, 3, time.Millisecond); !errors.Is(err, syscall.ENOENT) {
+		t.Errorf("expected the last dial error, got %v", err)
+	}
+	if d.dials != 3 {
+		t.Errorf("expected 3 dials, got %d", d.dials)
+	}
+
+	d = &flakyDialer{fails: 1}
+	if _, err := DialWithRetry(d, 0, time.Millisecond); err == nil {
+		t.Error("expected 0 attempts to make a single attempt")
+	}
+	if d.dials != 1 {
+		t.Errorf("expected 1 dial, got %d", d.dials)
+	}
+}
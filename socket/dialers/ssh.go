@@ -0,0 +1,158 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"net"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// defaultSSHPort specifies the default ssh port.
+const defaultSSHPort = "22"
+
+// SSH implements connecting to a remote server's libvirt by tunnelling the
+// connection over ssh, as libvirt's qemu+ssh:// URIs do. The connection to the
+// libvirt socket is made by the ssh server using a direct-streamlocal channel,
+// as "ssh -L local:/remote/socket" does, so nothing needs to be installed on
+// the remote host, but its ssh server must allow stream local forwarding.
+type SSH struct {
+	timeout time.Duration
+	host    string
+	port    string
+	socket  string
+	agent   string
+	config  *ssh.ClientConfig
+}
+
+// SSHOption is a function for setting ssh dialer options.
+type SSHOption func(*SSH)
+
+// WithSSHTimeout sets the timeout for establishing the ssh connection,
+// including the ssh handshake. It overrides any Timeout in the ssh config.
+func WithSSHTimeout(timeout time.Duration) SSHOption {
+	return func(s *SSH) {
+		s.timeout = timeout
+	}
+}
+
+// WithSSHPort sets the port the ssh server listens on.
+func WithSSHPort(port string) SSHOption {
+	return func(s *SSH) {
+		s.port = port
+	}
+}
+
+// WithRemoteSocket sets the path to the libvirt socket on the remote host.
+func WithRemoteSocket(socket string) SSHOption {
+	return func(s *SSH) {
+		s.socket = socket
+	}
+}
+
+// WithSSHAgent authenticates with the keys held by the ssh agent listening on
+// the unix socket at path, such as $SSH_AUTH_SOCK, before trying the methods
+// in the ssh config. Each connection has its own connection to the agent,
+// which is closed along with it. If the agent can't be reached, only the
+// config's methods are tried.
+func WithSSHAgent(path string) SSHOption {
+	return func(s *SSH) {
+		s.agent = path
+	}
+}
+
+// NewSSH is a dialer for connecting to libvirt running on another server by
+// tunnelling over ssh. The config sets the user to log in as, how to
+// authenticate, e.g. with ssh.PublicKeys or an ssh agent, and how to check the
+// server's host key, e.g. using golang.org/x/crypto/ssh/knownhosts. It is
+// copied, and must not be nil.
+func NewSSH(hostAddr string, config *ssh.ClientConfig, opts ...SSHOption) *SSH {
+	cfg := *config
+	s := &SSH{
+		timeout: defaultRemoteTimeout,
+		host:    hostAddr,
+		port:    defaultSSHPort,
+		socket:  defaultSocket,
+		config:  &cfg,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.config.Timeout = s.timeout
+
+	return s
+}
+
+// Dial connects to the remote host over ssh, and opens a channel to the
+// libvirt socket there.
+func (s *SSH) Dial() (net.Conn, error) {
+	config := s.config
+	var agentConn net.Conn
+	if s.agent != "" {
+		if c, err := net.Dial("unix", s.agent); err == nil {
+			agentConn = c
+			cfg := *s.config
+			cfg.Auth = append([]ssh.AuthMethod{
+				ssh.PublicKeysCallback(agent.NewClient(c).Signers),
+			}, s.config.Auth...)
+			config = &cfg
+		}
+	}
+
+	client, err := ssh.Dial("tcp", net.JoinHostPort(s.host, s.port), config)
+	if err != nil {
+		closeAgent(agentConn)
+		return nil, err
+	}
+
+	conn, err := client.Dial("unix", s.socket)
+	if err != nil {
+		client.Close()
+		closeAgent(agentConn)
+		return nil, err
+	}
+
+	return &sshConn{Conn: conn, client: client, agent: agentConn}, nil
+}
+
+// closeAgent closes the connection to the ssh agent, if there is one.
+func closeAgent(c net.Conn) {
+	if c != nil {
+		c.Close()
+	}
+}
+
+// sshConn is a connection to libvirt over an ssh channel. Each one has its own
+// ssh connection, and connection to the ssh agent if one is used, which are
+// closed along with it.
+type sshConn struct {
+	net.Conn
+	client *ssh.Client
+	agent  net.Conn
+}
+
+// Close closes the channel, the ssh connection carrying it and the
+// connection to the agent.
+func (c *sshConn) Close() error {
+	err := c.Conn.Close()
+	if cerr := c.client.Close(); err == nil {
+		err = cerr
+	}
+	closeAgent(c.agent)
+	return err
+}
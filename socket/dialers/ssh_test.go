@@ -0,0 +1,286 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+const testSSHPassword = "secret"
+
+// sshServer is an in-process ssh server which forwards direct-streamlocal
+// channels to unix sockets, as sshd does.
+type sshServer struct {
+	port  string
+	paths chan string
+}
+
+// newSSHServer starts a server which accepts the user libvirt, with the test
+// password or any of the authorized keys.
+func newSSHServer(t *testing.T, authorized ...ssh.PublicKey) *sshServer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == "libvirt" && string(pass) == testSSHPassword {
+				return nil, nil
+			}
+			return nil, io.EOF
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			for _, k := range authorized {
+				if c.User() == "libvirt" && bytes.Equal(key.Marshal(), k.Marshal()) {
+					return nil, nil
+				}
+			}
+			return nil, io.EOF
+		},
+	}
+	config.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &sshServer{paths: make(chan string, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn, config)
+		}
+	}()
+
+	_, s.port, err = net.SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func (s *sshServer) serve(conn net.Conn, config *ssh.ServerConfig) {
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	defer sconn.Close()
+	go ssh.DiscardRequests(reqs)
+
+	for nc := range chans {
+		if nc.ChannelType() != "direct-streamlocal@openssh.com" {
+			nc.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		var msg struct {
+			SocketPath string
+			Reserved0  string
+			Reserved1  uint32
+		}
+		if err := ssh.Unmarshal(nc.ExtraData(), &msg); err != nil {
+			nc.Reject(ssh.Prohibited, err.Error())
+			continue
+		}
+		s.paths <- msg.SocketPath
+
+		local, err := net.Dial("unix", msg.SocketPath)
+		if err != nil {
+			nc.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+		ch, creqs, err := nc.Accept()
+		if err != nil {
+			local.Close()
+			continue
+		}
+		go ssh.DiscardRequests(creqs)
+		go func() {
+			io.Copy(ch, local)
+			ch.CloseWrite()
+		}()
+		go func() {
+			io.Copy(local, ch)
+			local.Close()
+		}()
+	}
+}
+
+// serveEcho listens on a unix socket at path, echoing back whatever is sent.
+func serveEcho(t *testing.T, path string) {
+	t.Helper()
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+}
+
+func testSSHConfig(password string) *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User:            "libvirt",
+		Auth:            []ssh.AuthMethod{ssh.Password(password)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+}
+
+func TestSSHDial(t *testing.T) {
+	srv := newSSHServer(t)
+	// The path is passed to the server as is, so shell metacharacters in it
+	// have no special meaning.
+	socket := filepath.Join(t.TempDir(), "libvirt sock;$(false)")
+	serveEcho(t, socket)
+
+	d := NewSSH("127.0.0.1", testSSHConfig(testSSHPassword),
+		WithSSHPort(srv.port), WithRemoteSocket(socket))
+	conn, err := d.Dial()
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	defer conn.Close()
+
+	if got := <-srv.paths; got != socket {
+		t.Errorf("expected socket path %q, got %q", socket, got)
+	}
+
+	want := "hello libvirt"
+	if _, err := io.WriteString(conn, want); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, len(want))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != want {
+		t.Errorf("expected %q echoed back, got %q", want, buf)
+	}
+}
+
+func TestSSHAgent(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring := agent.NewKeyring()
+	if err := keyring.Add(agent.AddedKey{PrivateKey: key}); err != nil {
+		t.Fatal(err)
+	}
+	signers, err := keyring.Signers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The agent reports when each connection to it is closed.
+	dir := t.TempDir()
+	agentSock := filepath.Join(dir, "agent.sock")
+	ln, err := net.Listen("unix", agentSock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	closed := make(chan struct{}, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				agent.ServeAgent(keyring, conn)
+				closed <- struct{}{}
+			}()
+		}
+	}()
+
+	srv := newSSHServer(t, signers[0].PublicKey())
+	socket := filepath.Join(dir, "libvirt-sock")
+	serveEcho(t, socket)
+
+	// Only the agent can authenticate.
+	d := NewSSH("127.0.0.1", testSSHConfig("wrong"),
+		WithSSHPort(srv.port), WithRemoteSocket(socket), WithSSHAgent(agentSock))
+	conn, err := d.Dial()
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+	select {
+	case <-closed:
+		t.Fatal("expected the agent connection to stay open")
+	default:
+	}
+
+	conn.Close()
+	<-closed
+}
+
+func TestSSHDialErrors(t *testing.T) {
+	srv := newSSHServer(t)
+	socket := filepath.Join(t.TempDir(), "libvirt-sock")
+	serveEcho(t, socket)
+
+	tests := []struct {
+		name     string
+		password string
+		socket   string
+	}{
+		{"bad password", "wrong", socket},
+		{"missing socket", testSSHPassword, socket + "-missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewSSH("127.0.0.1", testSSHConfig(tt.password),
+				WithSSHPort(srv.port), WithRemoteSocket(tt.socket))
+			conn, err := d.Dial()
+			if err == nil {
+				conn.Close()
+				t.Fatal("expected dial to fail")
+			}
+		})
+	}
+}
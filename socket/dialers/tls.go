@@ -0,0 +1,198 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// defaultTLSPort specifies the default libvirtd port for TLS connections.
+	defaultTLSPort = "16514"
+
+	// defaultHandshakeTimeout specifies the default TLS handshake timeout.
+	defaultHandshakeTimeout = 10 * time.Second
+)
+
+// TLS implements connecting to a remote server's libvirt using tcp and tls
+type TLS struct {
+	timeout          time.Duration
+	handshakeTimeout time.Duration
+	host, port       string
+	config           *tls.Config
+	checkCommonName  bool
+}
+
+// TLSOption is a function for setting tls dialer options.
+type TLSOption func(*TLS)
+
+// WithTLSTimeout sets the dial timeout.
+func WithTLSTimeout(timeout time.Duration) TLSOption {
+	return func(t *TLS) {
+		t.timeout = timeout
+	}
+}
+
+// WithHandshakeTimeout sets the maximum time allowed for the tls handshake to
+// complete once the tcp connection has been established.
+func WithHandshakeTimeout(timeout time.Duration) TLSOption {
+	return func(t *TLS) {
+		t.handshakeTimeout = timeout
+	}
+}
+
+// UseTLSPort sets the port to dial for libvirt on the target host server.
+func UseTLSPort(port string) TLSOption {
+	return func(t *TLS) {
+		t.port = port
+	}
+}
+
+// WithServerName sets the server name sent to the server for SNI, and used to
+// verify its certificate. It overrides any ServerName in the tls config. By
+// default the host address passed to NewTLS is used.
+func WithServerName(name string) TLSOption {
+	return func(t *TLS) {
+		t.config.ServerName = name
+	}
+}
+
+// WithCommonNameCheck accepts a server certificate without a subject
+// alternative name if its common name matches the server name. Go's tls
+// client otherwise rejects such certificates, but libvirt's own clients accept
+// them, and certificates generated following the libvirt documentation may
+// not carry a subject alternative name. Certificates which have one are still
+// checked against it, and the certificate chain is verified as usual.
+func WithCommonNameCheck() TLSOption {
+	return func(t *TLS) {
+		t.checkCommonName = true
+	}
+}
+
+// NewTLS is a dialer for connecting to libvirt running on another server using
+// tls. The host address is as for NewRemote. The config is copied, and may be
+// nil to use the system's root CAs and no client certificate, although libvirtd
+// normally requires one.
+func NewTLS(hostAddr string, config *tls.Config, opts ...TLSOption) *TLS {
+	if config == nil {
+		config = &tls.Config{}
+	}
+	t := &TLS{
+		timeout:          defaultRemoteTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		port:             defaultTLSPort,
+		config:           config.Clone(),
+	}
+	t.host, t.port = splitHostAddr(hostAddr, t.port)
+	if t.config.ServerName == "" {
+		t.config.ServerName = t.host
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	if t.checkCommonName {
+		// The verification Go's tls client does always checks the server
+		// name against the certificate's subject alternative names, so turn
+		// it off and do our own.
+		verifyChain := !t.config.InsecureSkipVerify
+		next := t.config.VerifyPeerCertificate
+		t.config.InsecureSkipVerify = true
+		t.config.VerifyPeerCertificate = func(rawCerts [][]byte, chains [][]*x509.Certificate) error {
+			if err := t.verifyPeer(rawCerts, verifyChain); err != nil {
+				return err
+			}
+			if next != nil {
+				return next(rawCerts, chains)
+			}
+			return nil
+		}
+	}
+
+	return t
+}
+
+// Dial connects to libvirt running on another server, and completes the tls
+// handshake.
+func (t *TLS) Dial() (net.Conn, error) {
+	conn, err := dialTCP(t.host, t.port, t.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	tconn := tls.Client(conn, t.config)
+	if t.handshakeTimeout > 0 {
+		if err = tconn.SetDeadline(time.Now().Add(t.handshakeTimeout)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if err = tconn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err = tconn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return tconn, nil
+}
+
+// verifyPeer verifies the server's certificate chain, if verifyChain is set,
+// and checks the certificate was issued for the server name, falling back to
+// its common name if it has no subject alternative names.
+func (t *TLS) verifyPeer(rawCerts [][]byte, verifyChain bool) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("server presented no certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse server certificate: %v", err)
+		}
+		certs[i] = cert
+	}
+
+	if verifyChain {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		opts := x509.VerifyOptions{
+			Roots:         t.config.RootCAs,
+			Intermediates: intermediates,
+		}
+		if _, err := certs[0].Verify(opts); err != nil {
+			return err
+		}
+	}
+
+	name := t.config.ServerName
+	if len(certs[0].DNSNames) > 0 || len(certs[0].IPAddresses) > 0 {
+		return certs[0].VerifyHostname(name)
+	}
+	if cn := certs[0].Subject.CommonName; cn != name {
+		return fmt.Errorf("server certificate common name %q does not match %q",
+			cn, name)
+	}
+	return nil
+}
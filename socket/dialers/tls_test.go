@@ -0,0 +1,167 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dialers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+// testCA is a certificate authority which issues certificates for tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+// issue returns a server certificate with the given common name and DNS
+// subject alternative names.
+func (ca *testCA) issue(t *testing.T, cn string, dnsNames ...string) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// serveTLS accepts connections on a local port using cert, completing the
+// handshake on each, and returns the port.
+func serveTLS(t *testing.T, cert tls.Certificate) string {
+	t.Helper()
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			// The client reports any handshake failure.
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return port
+}
+
+func TestTLSDial(t *testing.T) {
+	ca := newTestCA(t)
+	otherCA := newTestCA(t)
+
+	tests := []struct {
+		name    string
+		cert    tls.Certificate
+		opts    []TLSOption
+		success bool
+	}{
+		{"SAN", ca.issue(t, "other", "libvirt.test"), nil, true},
+		{"SAN mismatch", ca.issue(t, "libvirt.test", "other.test"), nil, false},
+		{"CN only", ca.issue(t, "libvirt.test"), nil, false},
+		{"CN check", ca.issue(t, "libvirt.test"),
+			[]TLSOption{WithCommonNameCheck()}, true},
+		{"CN check mismatch", ca.issue(t, "other.test"),
+			[]TLSOption{WithCommonNameCheck()}, false},
+		// Subject alternative names take precedence over the common name.
+		{"CN check SAN mismatch", ca.issue(t, "libvirt.test", "other.test"),
+			[]TLSOption{WithCommonNameCheck()}, false},
+		{"CN check SAN", ca.issue(t, "other", "libvirt.test"),
+			[]TLSOption{WithCommonNameCheck()}, true},
+		{"CN check unknown CA", otherCA.issue(t, "libvirt.test"),
+			[]TLSOption{WithCommonNameCheck()}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := serveTLS(t, tt.cert)
+			opts := append([]TLSOption{
+				UseTLSPort(port),
+				WithServerName("libvirt.test"),
+			}, tt.opts...)
+			d := NewTLS("127.0.0.1", &tls.Config{RootCAs: ca.pool}, opts...)
+
+			conn, err := d.Dial()
+			if tt.success {
+				if err != nil {
+					t.Fatalf("expected dial to succeed, got %v", err)
+				}
+				conn.Close()
+			} else if err == nil {
+				conn.Close()
+				t.Fatal("expected dial to fail")
+			}
+		})
+	}
+}
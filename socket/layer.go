@@ -0,0 +1,161 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package socket
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// maxLayerBuffer is the largest buffer a SecurityLayer may send in one go. The
+// SASL mechanisms negotiate the size in three bytes.
+const maxLayerBuffer = 1<<24 - 1
+
+// SecurityLayer protects the data sent to and received from libvirt once
+// SASL authentication has negotiated a security layer, such as GSSAPI's
+// confidentiality protection. See SetSecurityLayer.
+type SecurityLayer interface {
+	// Wrap protects data to send to libvirt, which is at most MaxWrap bytes.
+	Wrap(b []byte) ([]byte, error)
+	// Unwrap returns the data in a buffer received from libvirt.
+	Unwrap(b []byte) ([]byte, error)
+	// MaxWrap returns the most data Wrap can protect at once, so that the
+	// result fits in the buffer size libvirt accepts.
+	MaxWrap() int
+}
+
+// SetSecurityLayer protects all data sent and received from now on with l, as
+// libvirt does once a SASL exchange negotiating a security layer completes.
+// Each wrapped buffer is sent prefixed with its length. It must be called
+// before anything is sent after the exchange, and applies until the
+// connection closes.
+func (s *Socket) SetSecurityLayer(l SecurityLayer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writer.Reset(layerWriter{l, retryWriter{s.conn}})
+	s.in.layer.Store(&l)
+}
+
+// layerWriter wraps the data written to it with a SecurityLayer, in pieces
+// of at most the layer's MaxWrap bytes.
+type layerWriter struct {
+	l SecurityLayer
+	w io.Writer
+}
+
+func (lw layerWriter) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		n := len(b) - written
+		max := lw.l.MaxWrap()
+		if max <= 0 {
+			return written, fmt.Errorf("security layer wraps at most %d bytes", max)
+		}
+		if n > max {
+			n = max
+		}
+		wrapped, err := lw.l.Wrap(b[written : written+n])
+		if err != nil {
+			return written, err
+		}
+		if len(wrapped) > maxLayerBuffer {
+			return written, fmt.Errorf("security layer buffer of %d bytes is too large", len(wrapped))
+		}
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], uint32(len(wrapped)))
+		if _, err := lw.w.Write(append(length[:], wrapped...)); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// layerReader reads a connection, unwrapping the data received once a
+// SecurityLayer is set.
+type layerReader struct {
+	conn io.Reader
+	// layer holds a *SecurityLayer once one is set.
+	layer atomic.Value
+	// wrapped holds data received before it was known to be wrapped, and
+	// plain data unwrapped but not yet read.
+	wrapped, plain []byte
+}
+
+// securityLayer returns the layer set, or nil.
+func (r *layerReader) securityLayer() SecurityLayer {
+	if l, _ := r.layer.Load().(*SecurityLayer); l != nil {
+		return *l
+	}
+	return nil
+}
+
+func (r *layerReader) Read(b []byte) (int, error) {
+	for len(r.plain) == 0 {
+		l := r.securityLayer()
+		if l == nil {
+			n, err := r.conn.Read(b)
+			// libvirt sends nothing after the last reply of a SASL exchange
+			// until it is sent another call, so if the layer was set while
+			// waiting, the data is already wrapped.
+			if l = r.securityLayer(); l == nil || n == 0 {
+				return n, err
+			}
+			r.wrapped = append(r.wrapped, b[:n]...)
+			if err != nil {
+				return 0, err
+			}
+		}
+		if err := r.unwrap(l); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+// unwrap reads the next buffer sent through the layer, and unwraps it.
+func (r *layerReader) unwrap(l SecurityLayer) error {
+	var length [4]byte
+	if err := r.readWrapped(length[:]); err != nil {
+		return err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > maxLayerBuffer {
+		return fmt.Errorf("security layer buffer of %d bytes is too large", size)
+	}
+	buf := make([]byte, size)
+	if err := r.readWrapped(buf); err != nil {
+		return err
+	}
+	plain, err := l.Unwrap(buf)
+	if err != nil {
+		return err
+	}
+	r.plain = plain
+	return nil
+}
+
+// readWrapped fills buf with the data received before the layer was set, and
+// then from the connection.
+func (r *layerReader) readWrapped(buf []byte) error {
+	n := copy(buf, r.wrapped)
+	r.wrapped = r.wrapped[n:]
+	return readFull(r.conn, buf[n:])
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 	"unsafe"
@@ -54,6 +55,9 @@ const (
 	// ReplyWithFDs is used by a server to indicate the request has
 	// arguments with file descriptors.
 	ReplyWithFDs
+
+	// StreamHole represents a hole in a sparse stream.
+	StreamHole
 )
 
 // Dialer is an interface for connecting to libvirt's underlying socket.
@@ -66,16 +70,28 @@ type Router interface {
 	Route(*Header, []byte)
 }
 
+// Tracer is called with the header and payload length of each packet sent or
+// received on a Socket, see SetTracer. sent is false for received packets.
+type Tracer func(sent bool, h Header, length int)
+
 // Socket represents a libvirt Socket and its connection state
 type Socket struct {
 	dialer Dialer
 	router Router
+	// tracer holds the Tracer set by SetTracer.
+	tracer atomic.Value
 
-	conn   net.Conn
+	conn net.Conn
+	// in reads conn, unwrapping what is received once a security layer is
+	// set.
+	in     *layerReader
 	reader *bufio.Reader
 	writer *bufio.Writer
 	// used to serialize any Socket writes and any updates to conn, r, or w
 	mu *sync.Mutex
+	// sendBuf is where SendPacket encodes each packet's length and header,
+	// guarded by mu.
+	sendBuf [unsafe.Sizeof(_p)]byte
 
 	// disconnected is closed when the listen goroutine associated with a
 	// Socket connection has returned.
@@ -93,6 +109,10 @@ type packet struct {
 // Global packet instance, for use with unsafe.Sizeof()
 var _p packet
 
+// maxPacketSize is the largest packet libvirt sends, its VIR_NET_MESSAGE_MAX.
+// A length outside the valid range means the connection is corrupt.
+const maxPacketSize = 32 * MiB
+
 // Header is a libvirt rpc packet header
 type Header struct {
 	// Program identifier
@@ -129,6 +149,40 @@ func New(dialer Dialer, router Router) *Socket {
 	return s
 }
 
+// SetDialer replaces the dialer used by Connect.
+func (s *Socket) SetDialer(dialer Dialer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dialer = dialer
+}
+
+// SetTracer sets a function to be called with every packet sent to and
+// received from libvirt. The function is called from the goroutine reading
+// the connection, and while holding the lock used to send packets, so it must
+// not block or use the Socket. A nil tracer stops tracing.
+func (s *Socket) SetTracer(t Tracer) {
+	s.tracer.Store(t)
+}
+
+// trace passes a packet to the tracer, if there is one.
+func (s *Socket) trace(sent bool, h Header, length int) {
+	if t, _ := s.tracer.Load().(Tracer); t != nil {
+		t(sent, h, length)
+	}
+}
+
+// tracingRouter routes packets received on a Socket, tracing each one.
+type tracingRouter struct {
+	s *Socket
+}
+
+// Route traces a received packet before passing it to the router.
+func (t tracingRouter) Route(h *Header, buf []byte) {
+	t.s.trace(false, *h, len(buf))
+	t.s.router.Route(h, buf)
+}
+
 // Connect uses the dialer provided on creation to establish
 // underlying physical connection to the desired libvirt.
 func (s *Socket) Connect() error {
@@ -144,8 +198,9 @@ func (s *Socket) Connect() error {
 	}
 
 	s.conn = conn
-	s.reader = bufio.NewReader(conn)
-	s.writer = bufio.NewWriter(conn)
+	s.in = &layerReader{conn: conn}
+	s.reader = bufio.NewReader(s.in)
+	s.writer = bufio.NewWriter(retryWriter{conn})
 	s.disconnected = make(chan struct{})
 
 	go s.listenAndRoute()
@@ -202,7 +257,7 @@ func (s *Socket) isDisconnected() bool {
 func (s *Socket) listenAndRoute() {
 	// only returns once it detects a non-temporary error related to the
 	// underlying connection
-	listen(s.reader, s.router)
+	listen(s.reader, tracingRouter{s})
 
 	// signal any clients listening that the connection has been disconnected
 	close(s.disconnected)
@@ -210,75 +265,123 @@ func (s *Socket) listenAndRoute() {
 
 // listen processes incoming data and routes
 // responses to their respective callback handler.
+//
+// Temporary errors are retried by the reads themselves. Any other error
+// leaves a packet partly read, and there's no way to find the start of the
+// next one, so listen returns.
 func listen(s io.Reader, router Router) {
+	// The length and header are decoded as soon as they're read, so their
+	// buffer is reused for every packet. Each payload has its own, since the
+	// router passes it on.
+	buf := make([]byte, unsafe.Sizeof(_p))
 	for {
 		// response packet length
-		length, err := pktlen(s)
+		length, err := pktlen(s, buf)
 		if err != nil {
-			if isTemporary(err) {
-				continue
-			}
 			// connection is no longer valid, so shutdown
 			return
 		}
 
+		// There's no way to find the next packet once the length is wrong.
+		if length < uint32(unsafe.Sizeof(_p)) || length > maxPacketSize {
+			return
+		}
+
 		// response header
-		h, err := extractHeader(s)
+		h, err := extractHeader(s, buf)
 		if err != nil {
-			// invalid packet
-			continue
+			return
 		}
 
 		// payload: packet length minus what was previously read
 		size := int(length) - int(unsafe.Sizeof(_p))
-		buf := make([]byte, size)
-		_, err = io.ReadFull(s, buf)
-		if err != nil {
-			// invalid packet
-			continue
+		payload := make([]byte, size)
+		if err := readFull(s, payload); err != nil {
+			return
 		}
 
 		// route response to caller
-		router.Route(h, buf)
+		router.Route(h, payload)
 	}
 }
 
-// isTemporary returns true if the error returned from a read is transient.
-// If the error type is an OpError, check whether the net connection
-// error condition is temporary (which means we can keep using the
-// connection).
-// Errors not of the net.OpError type tend to be things like io.EOF,
-// syscall.EINVAL, or io.ErrClosedPipe (i.e. all things that
-// indicate the connection in use is no longer valid.)
+// isTemporary returns true if the error returned from a read or write is
+// transient. If the error type is an OpError, check whether the net
+// connection error condition is temporary (which means we can keep using the
+// connection). A bare EAGAIN, which connections not from the net package may
+// return, is temporary too.
+// Other errors tend to be things like io.EOF, syscall.EINVAL, or
+// io.ErrClosedPipe (i.e. all things that indicate the connection in use is no
+// longer valid.)
 func isTemporary(err error) bool {
 	opErr, ok := err.(*net.OpError)
 	if ok {
 		return opErr.Temporary()
 	}
-	return false
+	return errors.Is(err, syscall.EAGAIN)
+}
+
+// readFull reads exactly len(buf) bytes from r, as io.ReadFull does, but
+// retries temporary errors. Unlike calling io.ReadFull again, retrying keeps
+// the bytes already read, so a packet's framing isn't lost.
+func readFull(r io.Reader, buf []byte) error {
+	for read := 0; read < len(buf); {
+		n, err := r.Read(buf[read:])
+		read += n
+		if err == nil || read == len(buf) {
+			continue
+		}
+		if isTemporary(err) {
+			continue
+		}
+		if err == io.EOF && read > 0 {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
+
+// retryWriter writes the whole of each buffer to w, retrying after short
+// writes and temporary errors. bufio.Writer gives up on both, and would
+// otherwise leave a packet partly sent.
+type retryWriter struct {
+	w io.Writer
+}
+
+func (rw retryWriter) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		n, err := rw.w.Write(b[written:])
+		written += n
+		if err != nil && !isTemporary(err) {
+			return written, err
+		}
+	}
+	return written, nil
 }
 
 // pktlen returns the length of an incoming RPC packet.  Read errors will
-// result in a returned response length of 0 and a non-nil error.
-func pktlen(r io.Reader) (uint32, error) {
-	buf := make([]byte, unsafe.Sizeof(_p.Len))
+// result in a returned response length of 0 and a non-nil error. buf is space
+// to read into, at least the length's size.
+func pktlen(r io.Reader, buf []byte) (uint32, error) {
+	buf = buf[:unsafe.Sizeof(_p.Len)]
 
 	// extract the packet's length from the header
-	_, err := io.ReadFull(r, buf)
-	if err != nil {
+	if err := readFull(r, buf); err != nil {
 		return 0, err
 	}
 
 	return binary.BigEndian.Uint32(buf), nil
 }
 
-// extractHeader returns the decoded header from an incoming response.
-func extractHeader(r io.Reader) (*Header, error) {
-	buf := make([]byte, unsafe.Sizeof(_p.Header))
+// extractHeader returns the decoded header from an incoming response. buf is
+// space to read into, at least the header's size.
+func extractHeader(r io.Reader, buf []byte) (*Header, error) {
+	buf = buf[:unsafe.Sizeof(_p.Header)]
 
 	// extract the packet's header from r
-	_, err := io.ReadFull(r, buf)
-	if err != nil {
+	if err := readFull(r, buf); err != nil {
 		return nil, err
 	}
 
@@ -327,15 +430,26 @@ func (s *Socket) SendPacket(
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	err := binary.Write(s.writer, binary.BigEndian, p)
-	if err != nil {
+	// Trace before writing, so the packet is traced before libvirt's reply.
+	s.trace(true, p.Header, len(payload))
+
+	// Encode the length and header by hand: binary.Write would allocate for
+	// each packet.
+	buf := s.sendBuf[:]
+	binary.BigEndian.PutUint32(buf[0:4], p.Len)
+	binary.BigEndian.PutUint32(buf[4:8], p.Header.Program)
+	binary.BigEndian.PutUint32(buf[8:12], p.Header.Version)
+	binary.BigEndian.PutUint32(buf[12:16], p.Header.Procedure)
+	binary.BigEndian.PutUint32(buf[16:20], p.Header.Type)
+	binary.BigEndian.PutUint32(buf[20:24], uint32(p.Header.Serial))
+	binary.BigEndian.PutUint32(buf[24:28], p.Header.Status)
+	if _, err := s.writer.Write(buf); err != nil {
 		return err
 	}
 
 	// write payload
 	if payload != nil {
-		err = binary.Write(s.writer, binary.BigEndian, payload)
-		if err != nil {
+		if _, err := s.writer.Write(payload); err != nil {
 			return err
 		}
 	}
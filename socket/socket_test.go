@@ -2,6 +2,10 @@ package socket
 
 import (
 	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"syscall"
 	"testing"
 
 	"github.com/digitalocean/go-libvirt/internal/constants"
@@ -21,7 +25,7 @@ func TestPktLen(t *testing.T) {
 	r := bytes.NewBuffer(data)
 
 	expected := uint32(10)
-	actual, err := pktlen(r)
+	actual, err := pktlen(r, make([]byte, 4))
 	if err != nil {
 		t.Error(err)
 	}
@@ -33,7 +37,7 @@ func TestPktLen(t *testing.T) {
 
 func TestExtractHeader(t *testing.T) {
 	r := bytes.NewBuffer(testHeader)
-	h, err := extractHeader(r)
+	h, err := extractHeader(r, make([]byte, len(testHeader)))
 	if err != nil {
 		t.Error(err)
 	}
@@ -58,3 +62,199 @@ func TestExtractHeader(t *testing.T) {
 		t.Errorf("expected status %q, got %q", StatusOK, h.Status)
 	}
 }
+
+type routerFunc func(*Header, []byte)
+
+func (f routerFunc) Route(h *Header, buf []byte) { f(h, buf) }
+
+func TestListenInvalidLength(t *testing.T) {
+	for _, length := range []uint32{4, maxPacketSize + 1} {
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.BigEndian, length)
+		buf.Write(testHeader)
+
+		routed := false
+		listen(&buf, routerFunc(func(*Header, []byte) { routed = true }))
+		if routed {
+			t.Errorf("length %d: expected the packet to be dropped", length)
+		}
+		if buf.Len() != len(testHeader) {
+			t.Errorf("length %d: expected listen to stop reading", length)
+		}
+	}
+}
+
+// flakyConn is a connection which reads and writes at most 3 bytes at a time,
+// and fails every other read and write with EAGAIN.
+type flakyConn struct {
+	net.Conn
+	// reads and writes count the calls. Each is only used by one goroutine.
+	reads, writes int
+}
+
+func (c *flakyConn) Read(b []byte) (int, error) {
+	c.reads++
+	if c.reads%2 == 0 {
+		return 0, &net.OpError{Op: "read", Net: "pipe", Err: syscall.EAGAIN}
+	}
+	if len(b) > 3 {
+		b = b[:3]
+	}
+	return c.Conn.Read(b)
+}
+
+func (c *flakyConn) Write(b []byte) (int, error) {
+	c.writes++
+	if c.writes%2 == 0 {
+		return 0, syscall.EAGAIN
+	}
+	if len(b) <= 3 {
+		return c.Conn.Write(b)
+	}
+	n, err := c.Conn.Write(b[:3])
+	if err == nil {
+		err = syscall.EAGAIN
+	}
+	return n, err
+}
+
+type connDialer struct {
+	conn net.Conn
+}
+
+func (d connDialer) Dial() (net.Conn, error) { return d.conn, nil }
+
+// testPacket encodes a packet with the test header and the given payload.
+func testPacket(payload []byte) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, uint32(4+len(testHeader)+len(payload)))
+	buf.Write(testHeader)
+	buf.Write(payload)
+	return buf.Bytes()
+}
+
+func TestShortReadsAndWrites(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	routed := make(chan []byte, 2)
+	s := New(connDialer{&flakyConn{Conn: client}}, routerFunc(func(h *Header, buf []byte) {
+		routed <- buf
+	}))
+	if err := s.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Disconnect()
+
+	// Packets received in pieces, with errors in between, are reassembled.
+	payloads := [][]byte{[]byte("first payload"), []byte("second")}
+	go func() {
+		for _, p := range payloads {
+			server.Write(testPacket(p))
+		}
+	}()
+	for _, want := range payloads {
+		if got := <-routed; !bytes.Equal(got, want) {
+			t.Errorf("expected payload %q, got %q", want, got)
+		}
+	}
+
+	// A packet sent in pieces arrives whole.
+	payload := []byte("a payload longer than a few bytes")
+	sent := make(chan error, 1)
+	go func() {
+		sent <- s.SendPacket(0, constants.ProcConnectOpen, constants.Program, payload, Call, StatusOK)
+	}()
+	want := testPacket(payload)
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(server, got); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-sent; err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("expected packet %x, got %x", want, got)
+	}
+}
+
+// xorLayer is a SecurityLayer which inverts every byte, wrapping at most max
+// bytes at once.
+type xorLayer struct {
+	max int
+}
+
+func (l xorLayer) Wrap(b []byte) ([]byte, error) {
+	return xorBytes(b), nil
+}
+
+func (l xorLayer) Unwrap(b []byte) ([]byte, error) {
+	return xorBytes(b), nil
+}
+
+func (l xorLayer) MaxWrap() int { return l.max }
+
+func xorBytes(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[i] = b[i] ^ 0xff
+	}
+	return out
+}
+
+// wrapBuffers wraps b with xorLayer in buffers of at most max bytes, each
+// prefixed by its length.
+func wrapBuffers(b []byte, max int) []byte {
+	var buf bytes.Buffer
+	for len(b) > 0 {
+		n := len(b)
+		if n > max {
+			n = max
+		}
+		binary.Write(&buf, binary.BigEndian, uint32(n))
+		buf.Write(xorBytes(b[:n]))
+		b = b[n:]
+	}
+	return buf.Bytes()
+}
+
+func TestSecurityLayer(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	routed := make(chan []byte, 1)
+	s := New(connDialer{client}, routerFunc(func(h *Header, buf []byte) {
+		routed <- buf
+	}))
+	if err := s.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Disconnect()
+
+	// The layer is set while the socket is already waiting to read, as it
+	// is after the last reply of a SASL exchange.
+	s.SetSecurityLayer(xorLayer{max: 10})
+
+	payload := []byte("a payload longer than one buffer")
+	sent := make(chan error, 1)
+	go func() {
+		sent <- s.SendPacket(0, constants.ProcConnectOpen, constants.Program, payload, Call, StatusOK)
+	}()
+	want := wrapBuffers(testPacket(payload), 10)
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(server, got); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-sent; err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("expected wrapped packet %x, got %x", want, got)
+	}
+
+	reply := []byte("a reply, also in several buffers")
+	go server.Write(wrapBuffers(testPacket(reply), 7))
+	if got := <-routed; !bytes.Equal(got, reply) {
+		t.Errorf("expected payload %q, got %q", reply, got)
+	}
+}
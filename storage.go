@@ -0,0 +1,47 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+// StorageVolInfo describes a storage volume, as returned by StorageVolumeInfo.
+type StorageVolInfo struct {
+	Type StorageVolType
+	// Capacity is the volume's logical size, and Allocation the space it
+	// currently takes up in the pool, both in bytes.
+	Capacity   uint64
+	Allocation uint64
+}
+
+// StorageVolumes returns the volumes in a storage pool. flags is currently
+// unused by libvirt and should be 0.
+func (l *Libvirt) StorageVolumes(pool StoragePool, flags uint32) ([]StorageVol, error) {
+	// NeedResults asks for the volumes themselves, not just their number.
+	vols, _, err := l.StoragePoolListAllVolumes(pool, 1, flags)
+	return vols, err
+}
+
+// StorageVolumeInfo returns the type, capacity and allocation of a storage
+// volume. Use StorageVolGetPath to find its path.
+func (l *Libvirt) StorageVolumeInfo(vol StorageVol) (StorageVolInfo, error) {
+	typ, capacity, allocation, err := l.StorageVolGetInfo(vol)
+	if err != nil {
+		return StorageVolInfo{}, err
+	}
+
+	return StorageVolInfo{
+		Type:       StorageVolType(typ),
+		Capacity:   capacity,
+		Allocation: allocation,
+	}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestStorageVolumes(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	pool, err := l.StoragePoolLookupByName("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vols := []StorageVol{
+		{Pool: pool.Name, Name: "a.qcow2", Key: "/var/lib/libvirt/images/a.qcow2"},
+		{Pool: pool.Name, Name: "b.qcow2", Key: "/var/lib/libvirt/images/b.qcow2"},
+	}
+	queue := func(proc uint32, ret interface{}) {
+		payload, err := encode(ret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dialer.QueueReply(constants.Program, proc, payload)
+	}
+	queue(constants.ProcStoragePoolListAllVolumes,
+		&StoragePoolListAllVolumesRet{Vols: vols, Ret: uint32(len(vols))})
+	queue(constants.ProcStorageVolGetInfo,
+		&StorageVolGetInfoRet{Type: int8(StorageVolFile), Capacity: 10 << 30, Allocation: 2 << 30})
+
+	got, err := l.StorageVolumes(pool, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, vols) {
+		t.Errorf("expected volumes %v, got %v", vols, got)
+	}
+
+	info, err := l.StorageVolumeInfo(got[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := StorageVolInfo{Type: StorageVolFile, Capacity: 10 << 30, Allocation: 2 << 30}
+	if info != want {
+		t.Errorf("expected %+v, got %+v", want, info)
+	}
+}
+
+func TestStoragePoolLifecycle(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	want := StoragePool{Name: "images", UUID: testUUID}
+	payload, err := encode(&StoragePoolDefineXMLRet{Pool: want})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcStoragePoolDefineXML, payload)
+	for _, proc := range []uint32{
+		constants.ProcStoragePoolBuild,
+		constants.ProcStoragePoolCreate,
+		constants.ProcStoragePoolRefresh,
+		constants.ProcStoragePoolDestroy,
+		constants.ProcStoragePoolUndefine,
+	} {
+		dialer.QueueReply(constants.Program, proc, nil)
+	}
+
+	pool, err := l.StoragePoolDefineXML("<pool type='dir'><name>images</name></pool>", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(pool, want) {
+		t.Errorf("expected pool %v, got %v", want, pool)
+	}
+	if err := l.StoragePoolBuild(pool, StoragePoolBuildNoOverwrite); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.StoragePoolCreate(pool, StoragePoolCreateNormal); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.StoragePoolRefresh(pool, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.StoragePoolDestroy(pool); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.StoragePoolUndefine(pool); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range dialer.Requests() {
+		if r.Procedure != constants.ProcStoragePoolBuild {
+			continue
+		}
+		var args StoragePoolBuildArgs
+		if _, err := xdr.Unmarshal(bytes.NewReader(r.Payload), &args); err != nil {
+			t.Fatal(err)
+		}
+		if args.Pool.Name != "images" || args.Flags != StoragePoolBuildNoOverwrite {
+			t.Errorf("expected a build of %q with flags %v, got %+v", "images", StoragePoolBuildNoOverwrite, args)
+		}
+	}
+}
@@ -0,0 +1,536 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// streamChunkSize is the largest amount of data sent in a single stream
+// packet. libvirt itself sends 256KiB at a time, and older servers reject
+// larger packets.
+const streamChunkSize = 256 * 1024
+
+// sparseBlockSize is the granularity at which SendSparse looks for zeros to
+// send as holes.
+const sparseBlockSize = 4096
+
+// ErrStreamClosed is returned when using a stream which has been closed or
+// aborted.
+var ErrStreamClosed = errors.New("stream is closed")
+
+// Stream is a libvirt data stream, used by calls such as StorageVolUpload and
+// DomainOpenConsole which transfer data after the call itself has completed.
+// Data sent by libvirt is read from the stream, and data written to the
+// stream is sent to libvirt. Close must be called once the transfer is done
+// to let libvirt know it is complete, or Abort to cancel it.
+//
+// Holes in sparse streams are read as zeros; WriteHole sends one, and
+// SendSparse and RecvSparse copy data without transferring its holes. A Stream
+// isn't safe for concurrent use, except that Read may be called concurrently
+// with Write and WriteHole. Data libvirt sends is delivered in the order it
+// arrives on the connection, so a stream which isn't read promptly holds up
+// replies to other calls.
+//
+// If the connection to libvirt is lost before the transfer is done, Read,
+// Write and Close return io.ErrUnexpectedEOF, including calls which were
+// already blocked. A stream isn't carried over when the client reconnects.
+type Stream struct {
+	l       *Libvirt
+	serial  int32
+	proc    uint32
+	program uint32
+	c       chan response
+	// disconnected is closed once the connection the stream was opened on is
+	// lost.
+	disconnected <-chan struct{}
+
+	// buf is the unread part of the last data packet, and hole the number of
+	// unread bytes of the last hole.
+	buf  []byte
+	hole int64
+	// err is returned by Read once the stream's data has been consumed, and is
+	// io.EOF once libvirt has finished sending data.
+	err error
+
+	closed bool
+}
+
+// streamHole is the payload of a stream hole packet; libvirt's
+// virNetStreamHole.
+type streamHole struct {
+	Length int64
+	Flags  uint32
+}
+
+// openStream makes a call whose procedure uses a stream, returning the
+// stream along with the call's reply.
+func (l *Libvirt) openStream(proc uint32, program uint32, payload []byte) (*Stream, response, error) {
+	ctx := l.requestContext()
+	internal := l.internal
+	l = l.root()
+	if !internal {
+		if err := l.beginCall(); err != nil {
+			return nil, response{}, err
+		}
+		defer l.endCall()
+	}
+
+	serial := l.serial()
+	c := make(chan response, replyBuffer)
+	l.register(serial, c)
+
+	s := &Stream{l: l, serial: serial, proc: proc, program: program, c: c,
+		disconnected: l.socket.Disconnected()}
+
+	err := l.socket.SendPacket(serial, proc, program, payload, socket.Call,
+		socket.StatusOK)
+	if err != nil {
+		s.release()
+		return nil, response{}, err
+	}
+
+	timeout, stop := l.replyTimeout()
+	resp, err := l.getResponse(ctx, c, timeout)
+	stop()
+	if err != nil {
+		s.release()
+		return nil, resp, err
+	}
+
+	return s, resp, nil
+}
+
+// Read reads data sent by libvirt. It returns io.EOF once libvirt has finished
+// sending data.
+func (s *Stream) Read(p []byte) (int, error) {
+	if s.closed {
+		return 0, ErrStreamClosed
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	for len(s.buf) == 0 && s.hole == 0 {
+		if s.err != nil {
+			return 0, s.err
+		}
+		s.err = s.recv()
+	}
+
+	if s.hole > 0 {
+		n := len(p)
+		if int64(n) > s.hole {
+			n = int(s.hole)
+		}
+		for i := range p[:n] {
+			p[i] = 0
+		}
+		s.hole -= int64(n)
+		return n, nil
+	}
+
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+// recv waits for the next stream packet from libvirt.
+func (s *Stream) recv() error {
+	resp, ok := <-s.c
+	if !ok {
+		// the connection was lost before the stream was finished
+		return io.ErrUnexpectedEOF
+	}
+
+	switch {
+	case resp.Status == socket.StatusError:
+		return decodeError(resp.Payload)
+	case resp.Status == socket.StatusOK:
+		return io.EOF
+	case resp.Type == socket.StreamHole:
+		var h streamHole
+		if _, err := xdr.Unmarshal(bytes.NewReader(resp.Payload), &h); err != nil {
+			return err
+		}
+		if h.Length < 0 {
+			return errors.New("stream hole has negative length")
+		}
+		s.hole = h.Length
+	case len(resp.Payload) == 0:
+		// libvirtd sends an empty StatusContinue packet at the end of some
+		// streams, see processIncomingStream.
+		return io.EOF
+	default:
+		s.buf = resp.Payload
+	}
+
+	return nil
+}
+
+// Write sends data to libvirt.
+func (s *Stream) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, ErrStreamClosed
+	}
+
+	var n int
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > streamChunkSize {
+			chunk = chunk[:streamChunkSize]
+		}
+		if err := s.send(chunk, socket.Stream, socket.StatusContinue); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+
+	return n, nil
+}
+
+// WriteHole sends a hole of the given length to libvirt. Holes may only be
+// sent on streams opened with a sparse flag, such as
+// StorageVolUploadSparseStream.
+func (s *Stream) WriteHole(length int64) error {
+	if s.closed {
+		return ErrStreamClosed
+	}
+
+	buf, err := encode(&streamHole{Length: length})
+	if err != nil {
+		return err
+	}
+
+	return s.send(buf, socket.StreamHole, socket.StatusContinue)
+}
+
+// send sends a packet on the stream. It returns io.ErrUnexpectedEOF if the
+// connection the stream was opened on has been lost, rather than the socket's
+// error, and so that a stream's packets are never sent on a new connection.
+func (s *Stream) send(payload []byte, typ uint32, status uint32) error {
+	if s.lost() {
+		return io.ErrUnexpectedEOF
+	}
+	err := s.l.socket.SendPacket(s.serial, s.proc, s.program, payload, typ, status)
+	if err != nil && s.lost() {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// lost reports whether the connection the stream was opened on has been lost.
+func (s *Stream) lost() bool {
+	select {
+	case <-s.disconnected:
+		return true
+	default:
+		return false
+	}
+}
+
+// SendSparse sends the data read from r until EOF, like io.Copy, except that
+// blocks of zeros are sent as holes rather than transferred. The stream must
+// have been opened with a sparse flag, such as StorageVolUploadSparseStream.
+// It returns the number of bytes read from r, holes included.
+func (s *Stream) SendSparse(r io.Reader) (int64, error) {
+	buf := make([]byte, streamChunkSize)
+	var n, hole int64
+	for {
+		m, err := io.ReadFull(r, buf)
+		n += int64(m)
+		for p := buf[:m]; len(p) > 0; {
+			// Split off the next run of data blocks, or of zero blocks.
+			end := blockEnd(p, 0)
+			zero := isZero(p[:end])
+			for end < len(p) && isZero(p[end:blockEnd(p, end)]) == zero {
+				end = blockEnd(p, end)
+			}
+
+			if zero {
+				hole += int64(end)
+			} else {
+				if hole > 0 {
+					if err := s.WriteHole(hole); err != nil {
+						return n, err
+					}
+					hole = 0
+				}
+				if _, err := s.Write(p[:end]); err != nil {
+					return n, err
+				}
+			}
+			p = p[end:]
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+
+	if hole > 0 {
+		if err := s.WriteHole(hole); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// RecvSparse writes the data read from the stream to w until libvirt has
+// finished sending it, like io.Copy, except that holes are skipped over by
+// seeking rather than written as zeros, so that a file stays sparse. It returns
+// the number of bytes received, holes included.
+func (s *Stream) RecvSparse(w io.WriteSeeker) (int64, error) {
+	if s.closed {
+		return 0, ErrStreamClosed
+	}
+
+	var n int64
+	var hole bool
+	for {
+		switch {
+		case s.hole > 0:
+			if _, err := w.Seek(s.hole, io.SeekCurrent); err != nil {
+				return n, err
+			}
+			n += s.hole
+			s.hole = 0
+			hole = true
+		case len(s.buf) > 0:
+			m, err := w.Write(s.buf)
+			n += int64(m)
+			s.buf = s.buf[m:]
+			if err != nil {
+				return n, err
+			}
+			hole = false
+		case s.err != nil:
+			if s.err != io.EOF {
+				return n, s.err
+			}
+			if hole {
+				// Seeking past the end doesn't extend a file, so write the
+				// hole's last byte to give it its full size.
+				if _, err := w.Seek(-1, io.SeekCurrent); err != nil {
+					return n, err
+				}
+				if _, err := w.Write([]byte{0}); err != nil {
+					return n, err
+				}
+			}
+			return n, nil
+		default:
+			s.err = s.recv()
+		}
+	}
+}
+
+// blockEnd returns the end of the block of p starting at i.
+func blockEnd(p []byte, i int) int {
+	if i+sparseBlockSize < len(p) {
+		return i + sparseBlockSize
+	}
+	return len(p)
+}
+
+// isZero reports whether p holds only zeros.
+func isZero(p []byte) bool {
+	for _, b := range p {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Close tells libvirt the transfer is complete, and waits for it to confirm
+// that the stream finished successfully. Any data from libvirt which hasn't
+// been read is discarded.
+func (s *Stream) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	defer s.release()
+
+	if err := s.send(nil, socket.Stream, socket.StatusOK); err != nil {
+		return err
+	}
+
+	for {
+		resp, ok := <-s.c
+		if !ok {
+			return io.ErrUnexpectedEOF
+		}
+		switch resp.Status {
+		case socket.StatusError:
+			return decodeError(resp.Payload)
+		case socket.StatusOK:
+			return nil
+		}
+	}
+}
+
+// Abort cancels the transfer. libvirt discards any data it has been sent on
+// the stream.
+func (s *Stream) Abort() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	// Start discarding libvirt's data before sending the abort, since data
+	// waiting to be delivered holds up the connection.
+	s.discard()
+	defer s.release()
+
+	return s.send(nil, socket.Stream, socket.StatusError)
+}
+
+// discard drains the stream's packets until release closes the channel.
+func (s *Stream) discard() {
+	go func() {
+		for range s.c {
+		}
+	}()
+}
+
+// release stops routing packets to the stream. Packets which haven't been
+// read are discarded: the callback delivering one holds cmux until it's
+// received, so taking cmux here would otherwise deadlock.
+func (s *Stream) release() {
+	s.discard()
+
+	s.l.cmux.Lock()
+	defer s.l.cmux.Unlock()
+
+	s.l.deregister(s.serial)
+}
+
+// StorageVolUploadStream starts uploading data to a storage volume, returning
+// the stream to write the data to. See StorageVolUpload.
+func (l *Libvirt) StorageVolUploadStream(vol StorageVol, offset uint64, length uint64,
+	flags StorageVolUploadFlags) (*Stream, error) {
+	buf, err := encode(&StorageVolUploadArgs{
+		Vol:    vol,
+		Offset: offset,
+		Length: length,
+		Flags:  flags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := l.openStream(constants.ProcStorageVolUpload, constants.Program, buf)
+	return s, err
+}
+
+// StorageVolDownloadStream starts downloading data from a storage volume,
+// returning the stream to read the data from. See StorageVolDownload.
+func (l *Libvirt) StorageVolDownloadStream(vol StorageVol, offset uint64, length uint64,
+	flags StorageVolDownloadFlags) (*Stream, error) {
+	buf, err := encode(&StorageVolDownloadArgs{
+		Vol:    vol,
+		Offset: offset,
+		Length: length,
+		Flags:  flags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := l.openStream(constants.ProcStorageVolDownload, constants.Program, buf)
+	return s, err
+}
+
+// DomainScreenshotStream takes a screenshot of a domain's console, returning
+// the stream to read the image from and its MIME type. See DomainScreenshot.
+func (l *Libvirt) DomainScreenshotStream(dom Domain, screen uint32,
+	flags uint32) (*Stream, OptString, error) {
+	buf, err := encode(&DomainScreenshotArgs{
+		Dom:    dom,
+		Screen: screen,
+		Flags:  flags,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, r, err := l.openStream(constants.ProcDomainScreenshot, constants.Program, buf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ret DomainScreenshotRet
+	if _, err = xdr.Unmarshal(bytes.NewReader(r.Payload), &ret); err != nil {
+		s.Abort()
+		return nil, nil, err
+	}
+
+	return s, ret.Mime, nil
+}
+
+// DomainOpenConsoleStream connects to a domain's console, returning a stream
+// which reads the console's output and writes to its input; it is an
+// io.ReadWriteCloser. devName selects the console or serial device, or is
+// empty for the domain's first console. DomainConsoleForce takes the console
+// over from any other client connected to it, and DomainConsoleSafe fails
+// unless the console's driver can prevent concurrent access. See
+// DomainOpenConsole.
+func (l *Libvirt) DomainOpenConsoleStream(dom Domain, devName string,
+	flags DomainConsoleFlags) (*Stream, error) {
+	var dev OptString
+	if devName != "" {
+		dev = OptString{devName}
+	}
+	buf, err := encode(&DomainOpenConsoleArgs{
+		Dom:     dom,
+		DevName: dev,
+		Flags:   uint32(flags),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := l.openStream(constants.ProcDomainOpenConsole, constants.Program, buf)
+	return s, err
+}
+
+// DomainOpenChannelStream connects to one of a domain's channel devices,
+// returning a stream which reads from and writes to it. See
+// DomainOpenChannel.
+func (l *Libvirt) DomainOpenChannelStream(dom Domain, name OptString,
+	flags DomainChannelFlags) (*Stream, error) {
+	buf, err := encode(&DomainOpenChannelArgs{
+		Dom:   dom,
+		Name:  name,
+		Flags: flags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, _, err := l.openStream(constants.ProcDomainOpenChannel, constants.Program, buf)
+	return s, err
+}
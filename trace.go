@@ -0,0 +1,76 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+// PacketInfo describes a packet sent to or received from libvirt, as passed to
+// the function set by SetLogger.
+type PacketInfo struct {
+	// Sent is true for packets sent to libvirt, and false for packets
+	// received from it.
+	Sent bool
+	// Program, Version, Procedure, Type, Serial and Status are the fields of
+	// the packet's header.
+	Program   uint32
+	Version   uint32
+	Procedure uint32
+	Type      uint32
+	Serial    int32
+	Status    uint32
+	// Length is the length of the packet's payload, in bytes.
+	Length int
+}
+
+// String formats the packet for a log, naming its procedure.
+func (p PacketInfo) String() string {
+	dir := "recv"
+	if p.Sent {
+		dir = "send"
+	}
+	return fmt.Sprintf("%v %v serial=%d type=%d status=%d len=%d", dir,
+		constants.ProcName(p.Program, p.Procedure), p.Serial, p.Type, p.Status,
+		p.Length)
+}
+
+// SetLogger sets a function to be called with every packet sent to and
+// received from libvirt, for tracing the protocol. It's called from the
+// goroutines sending and receiving packets, so it must be safe for concurrent
+// use and must not block or make calls itself. A nil function stops tracing.
+func (l *Libvirt) SetLogger(f func(PacketInfo)) {
+	r := l.root()
+	if f == nil {
+		r.socket.SetTracer(nil)
+		return
+	}
+
+	r.socket.SetTracer(func(sent bool, h socket.Header, length int) {
+		f(PacketInfo{
+			Sent:      sent,
+			Program:   h.Program,
+			Version:   h.Version,
+			Procedure: h.Procedure,
+			Type:      h.Type,
+			Serial:    h.Serial,
+			Status:    h.Status,
+			Length:    length,
+		})
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+	"github.com/digitalocean/go-libvirt/socket"
+)
+
+func TestSetLogger(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	var mu sync.Mutex
+	var pkts []PacketInfo
+	l.SetLogger(func(p PacketInfo) {
+		mu.Lock()
+		defer mu.Unlock()
+		pkts = append(pkts, p)
+	})
+
+	if _, err := l.ConnectGetLibVersion(); err != nil {
+		t.Fatal(err)
+	}
+	l.SetLogger(nil)
+	if _, err := l.ConnectGetLibVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(pkts) != 2 {
+		t.Fatalf("expected 2 packets to be traced, got %d: %v", len(pkts), pkts)
+	}
+	call, reply := pkts[0], pkts[1]
+	if !call.Sent || call.Type != socket.Call || call.Length != 0 {
+		t.Errorf("expected an empty call to be sent, got %v", call)
+	}
+	if reply.Sent || reply.Type != socket.Reply || reply.Serial != call.Serial || reply.Length == 0 {
+		t.Errorf("expected a reply to serial %d, got %v", call.Serial, reply)
+	}
+	for _, p := range pkts {
+		if p.Program != constants.Program || p.Procedure != constants.ProcConnectGetLibVersion {
+			t.Errorf("expected the ConnectGetLibVersion procedure, got %v", p)
+		}
+	}
+
+	want := "send REMOTE_PROC_CONNECT_GET_LIB_VERSION serial=" +
+		strconv.Itoa(int(call.Serial)) + " type=0 status=0 len=0"
+	if s := call.String(); s != want {
+		t.Errorf("expected %q, got %q", want, s)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+)
+
+// TypedParams is a list of typed parameters, as taken and returned by calls
+// such as DomainGetCPUStats and DomainSetBlkioParameters. It can be converted
+// to and from the []TypedParam those calls use.
+//
+// The Get methods return a parameter's value, and whether a parameter with
+// that name and type was found. The Set methods replace the value of the
+// parameter with that name, or add it if there isn't one. A few calls take
+// lists as repeated parameters with the same name; use AddString and
+// GetStrings for those.
+type TypedParams []TypedParam
+
+// get returns the value of the named parameter if it has the type t.
+func (p TypedParams) get(name string, t TypedParameterType) (interface{}, bool) {
+	for _, tp := range p {
+		if tp.Field == name {
+			if tp.Value.D != uint32(t) {
+				return nil, false
+			}
+			return tp.Value.I, true
+		}
+	}
+	return nil, false
+}
+
+// Map returns the parameters as a map from each name to its value: an int32,
+// uint32, int64, uint64, float64, bool or string. Booleans, which libvirt
+// sends as ints, are converted. A repeated name maps to its last value.
+func (p TypedParams) Map() map[string]interface{} {
+	m := make(map[string]interface{}, len(p))
+	for _, tp := range p {
+		m[tp.Field] = paramValue(tp)
+	}
+	return m
+}
+
+// paramValue returns a parameter's value, converting booleans as Map does.
+func paramValue(tp TypedParam) interface{} {
+	if tp.Value.D == uint32(TypedParamBoolean) {
+		return tp.Value.I.(int32) != 0
+	}
+	return tp.Value.I
+}
+
+// set replaces the value of the named parameter, or adds it.
+func (p *TypedParams) set(name string, v *TypedParamValue) {
+	for i := range *p {
+		if (*p)[i].Field == name {
+			(*p)[i].Value = *v
+			return
+		}
+	}
+	*p = append(*p, TypedParam{Field: name, Value: *v})
+}
+
+// GetInt returns the value of an int parameter.
+func (p TypedParams) GetInt(name string) (int32, bool) {
+	v, ok := p.get(name, TypedParamInt)
+	if !ok {
+		return 0, false
+	}
+	return v.(int32), true
+}
+
+// GetUint returns the value of an unsigned int parameter.
+func (p TypedParams) GetUint(name string) (uint32, bool) {
+	v, ok := p.get(name, TypedParamUint)
+	if !ok {
+		return 0, false
+	}
+	return v.(uint32), true
+}
+
+// GetLlong returns the value of a long long parameter.
+func (p TypedParams) GetLlong(name string) (int64, bool) {
+	v, ok := p.get(name, TypedParamLlong)
+	if !ok {
+		return 0, false
+	}
+	return v.(int64), true
+}
+
+// GetUllong returns the value of an unsigned long long parameter.
+func (p TypedParams) GetUllong(name string) (uint64, bool) {
+	v, ok := p.get(name, TypedParamUllong)
+	if !ok {
+		return 0, false
+	}
+	return v.(uint64), true
+}
+
+// GetDouble returns the value of a double parameter.
+func (p TypedParams) GetDouble(name string) (float64, bool) {
+	v, ok := p.get(name, TypedParamDouble)
+	if !ok {
+		return 0, false
+	}
+	return v.(float64), true
+}
+
+// GetBool returns the value of a boolean parameter.
+func (p TypedParams) GetBool(name string) (bool, bool) {
+	v, ok := p.get(name, TypedParamBoolean)
+	if !ok {
+		return false, false
+	}
+	return v.(int32) != 0, true
+}
+
+// GetString returns the value of a string parameter.
+func (p TypedParams) GetString(name string) (string, bool) {
+	v, ok := p.get(name, TypedParamString)
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// SetInt sets an int parameter.
+func (p *TypedParams) SetInt(name string, v int32) {
+	p.set(name, NewTypedParamValueInt(v))
+}
+
+// SetUint sets an unsigned int parameter.
+func (p *TypedParams) SetUint(name string, v uint32) {
+	p.set(name, NewTypedParamValueUint(v))
+}
+
+// SetLlong sets a long long parameter.
+func (p *TypedParams) SetLlong(name string, v int64) {
+	p.set(name, NewTypedParamValueLlong(v))
+}
+
+// SetUllong sets an unsigned long long parameter.
+func (p *TypedParams) SetUllong(name string, v uint64) {
+	p.set(name, NewTypedParamValueUllong(v))
+}
+
+// SetDouble sets a double parameter.
+func (p *TypedParams) SetDouble(name string, v float64) {
+	p.set(name, NewTypedParamValueDouble(v))
+}
+
+// SetBool sets a boolean parameter.
+func (p *TypedParams) SetBool(name string, v bool) {
+	var i int32
+	if v {
+		i = 1
+	}
+	p.set(name, NewTypedParamValueBoolean(i))
+}
+
+// SetString sets a string parameter.
+func (p *TypedParams) SetString(name string, v string) {
+	p.set(name, NewTypedParamValueString(v))
+}
+
+// AddString adds a string parameter, keeping any others with the same name.
+// Lists such as the MigrateParamCompression methods are passed this way.
+func (p *TypedParams) AddString(name string, v string) {
+	*p = append(*p, TypedParam{Field: name, Value: *NewTypedParamValueString(v)})
+}
+
+// GetStrings returns the values of every string parameter with the given
+// name, in order.
+func (p TypedParams) GetStrings(name string) []string {
+	var vals []string
+	for _, tp := range p {
+		if tp.Field != name || tp.Value.D != uint32(TypedParamString) {
+			continue
+		}
+		vals = append(vals, tp.Value.I.(string))
+	}
+	return vals
+}
+
+// MarshalTypedParams encodes typed parameters in the XDR format libvirt uses
+// on the wire: a count followed by each parameter's name, type and value.
+func MarshalTypedParams(p TypedParams) ([]byte, error) {
+	params := []TypedParam(p)
+	if params == nil {
+		params = []TypedParam{}
+	}
+	return encode(&params)
+}
+
+// UnmarshalTypedParams decodes typed parameters encoded by MarshalTypedParams
+// or sent by libvirt. Strings and the parameter count are limited to the
+// largest length the remote protocol allows.
+func UnmarshalTypedParams(buf []byte) (TypedParams, error) {
+	dec := xdr.NewDecoderCustomTypes(bytes.NewReader(buf), uint(constants.MaxLimit), customTypes)
+
+	var params []TypedParam
+	if _, err := dec.Decode(&params); err != nil {
+		return nil, err
+	}
+	return TypedParams(params), nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+)
+
+func TestTypedParamsGetSet(t *testing.T) {
+	var p TypedParams
+	p.SetInt("int", -1)
+	p.SetUint("uint", 2)
+	p.SetLlong("llong", -3)
+	p.SetUllong("ullong", 4)
+	p.SetDouble("double", 5.5)
+	p.SetBool("bool", true)
+	p.SetString("string", "six")
+	p.SetUint("uint", 7)
+
+	if len(p) != 7 {
+		t.Fatalf("expected 7 params, got %d", len(p))
+	}
+	if v, ok := p.GetInt("int"); !ok || v != -1 {
+		t.Errorf("GetInt: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetUint("uint"); !ok || v != 7 {
+		t.Errorf("GetUint: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetLlong("llong"); !ok || v != -3 {
+		t.Errorf("GetLlong: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetUllong("ullong"); !ok || v != 4 {
+		t.Errorf("GetUllong: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetDouble("double"); !ok || v != 5.5 {
+		t.Errorf("GetDouble: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetBool("bool"); !ok || !v {
+		t.Errorf("GetBool: got %v, %v", v, ok)
+	}
+	if v, ok := p.GetString("string"); !ok || v != "six" {
+		t.Errorf("GetString: got %v, %v", v, ok)
+	}
+
+	if _, ok := p.GetString("int"); ok {
+		t.Error("GetString succeeded on an int param")
+	}
+	if _, ok := p.GetInt("missing"); ok {
+		t.Error("GetInt succeeded on a missing param")
+	}
+}
+
+func TestTypedParamsMarshal(t *testing.T) {
+	var p TypedParams
+	p.SetUint("weight", 500)
+	p.SetString("device_weight", "/dev/sda,100")
+
+	buf, err := MarshalTypedParams(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{
+		0x00, 0x00, 0x00, 0x02, // count
+		0x00, 0x00, 0x00, 0x06, 'w', 'e', 'i', 'g', 'h', 't', 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x02, // type (uint)
+		0x00, 0x00, 0x01, 0xf4, // 500
+		0x00, 0x00, 0x00, 0x0d, 'd', 'e', 'v', 'i', 'c', 'e', '_', 'w',
+		'e', 'i', 'g', 'h', 't', 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x07, // type (string)
+		0x00, 0x00, 0x00, 0x0c, '/', 'd', 'e', 'v', '/', 's', 'd', 'a',
+		',', '1', '0', '0',
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("unexpected encoding:\n got %x\nwant %x", buf, expected)
+	}
+
+	got, err := UnmarshalTypedParams(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, p) {
+		t.Errorf("expected %v, got %v", p, got)
+	}
+}
+
+func TestTypedParamsRepeated(t *testing.T) {
+	var p TypedParams
+	p.AddString(MigrateParamCompression, "mt")
+	p.AddString(MigrateParamCompression, "xbzrle")
+	p.SetInt(MigrateParamCompressionMtLevel, 9)
+
+	buf, err := MarshalTypedParams(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnmarshalTypedParams(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 parameters, got %v", got)
+	}
+
+	methods := got.GetStrings(MigrateParamCompression)
+	if !reflect.DeepEqual(methods, []string{"mt", "xbzrle"}) {
+		t.Errorf("expected both compression methods in order, got %v", methods)
+	}
+	if v, ok := got.GetString(MigrateParamCompression); !ok || v != "mt" {
+		t.Errorf("expected GetString to return the first method, got %q, %v", v, ok)
+	}
+	if v := got.GetStrings(MigrateParamCompressionMtLevel); v != nil {
+		t.Errorf("expected no string values for an int parameter, got %v", v)
+	}
+}
+
+func TestTypedParamValueRoundTrip(t *testing.T) {
+	values := []*TypedParamValue{
+		NewTypedParamValueInt(-5),
+		NewTypedParamValueUint(5),
+		NewTypedParamValueLlong(-1 << 40),
+		NewTypedParamValueUllong(1 << 40),
+		NewTypedParamValueDouble(2.5),
+		NewTypedParamValueBoolean(1),
+		NewTypedParamValueString("sda"),
+	}
+
+	for _, v := range values {
+		buf, err := encode(v)
+		if err != nil {
+			t.Errorf("encoding %v: %v", v, err)
+			continue
+		}
+		var got TypedParamValue
+		if _, err := xdr.NewDecoder(bytes.NewReader(buf)).Decode(&got); err != nil {
+			t.Errorf("decoding %v: %v", v, err)
+			continue
+		}
+		if !reflect.DeepEqual(&got, v) {
+			t.Errorf("expected %v, got %v", v, got)
+		}
+	}
+}
+
+func TestTypedParamsDouble(t *testing.T) {
+	var p TypedParams
+	p.SetDouble("cpu.usage", 12.5)
+	buf, err := MarshalTypedParams(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{
+		0x00, 0x00, 0x00, 0x01, // count
+		0x00, 0x00, 0x00, 0x09, 'c', 'p', 'u', '.', 'u', 's', 'a', 'g',
+		'e', 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x05, // type (double)
+		0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 12.5
+	}
+	if !bytes.Equal(buf, expected) {
+		t.Fatalf("unexpected encoding:\n got %x\nwant %x", buf, expected)
+	}
+
+	// Values which don't compare equal to themselves must still keep their
+	// bits.
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1)} {
+		var p TypedParams
+		p.SetDouble("value", v)
+		buf, err := MarshalTypedParams(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := UnmarshalTypedParams(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d, ok := got.GetDouble("value"); !ok || math.Float64bits(d) != math.Float64bits(v) {
+			t.Errorf("expected %v, got %v, %v", v, d, ok)
+		}
+	}
+}
+
+func TestTypedParamValueMismatch(t *testing.T) {
+	// The value doesn't match the arm selected by the discriminant.
+	if _, err := encode(&TypedParamValue{D: 1, I: "one"}); err == nil {
+		t.Error("expected an error encoding a string in the int arm")
+	}
+	if _, err := encode(&TypedParamValue{D: 99, I: int32(1)}); err == nil {
+		t.Error("expected an error encoding an unknown discriminant")
+	}
+
+	buf := []byte{0x00, 0x00, 0x00, 0x63, 0x00, 0x00, 0x00, 0x01}
+	var got TypedParamValue
+	if _, err := xdr.NewDecoder(bytes.NewReader(buf)).Decode(&got); err == nil {
+		t.Error("expected an error decoding an unknown discriminant")
+	}
+}
+
+func TestUnmarshalTypedParamsLimit(t *testing.T) {
+	buf := []byte{
+		0x00, 0x00, 0x00, 0x01, // count
+		0xff, 0xff, 0xff, 0xf0, // name length, past any protocol limit
+	}
+	if _, err := UnmarshalTypedParams(buf); err == nil {
+		t.Error("expected an error decoding an overlong name")
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+
+	"github.com/digitalocean/go-libvirt/socket"
+	"github.com/digitalocean/go-libvirt/socket/dialers"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// remoteParams are the URI query parameters used by libvirt's remote driver to
+// connect. They are removed from the URI opened once connected.
+var remoteParams = []string{
+	"name", "command", "socket", "auth", "netcat", "keyfile", "sshauth",
+	"no_verify", "no_tty", "pkipath", "known_hosts", "known_hosts_verify",
+	"tls_priority", "mode", "proxy",
+}
+
+// URIOption is a function for setting ConnectByURI options.
+type URIOption func(*uriOptions)
+
+type uriOptions struct {
+	tlsConfig *tls.Config
+	sshConfig *ssh.ClientConfig
+}
+
+// WithURITLSConfig sets the tls config used for URIs with the tls transport,
+// in place of one using the certificates in libvirt's standard locations.
+func WithURITLSConfig(config *tls.Config) URIOption {
+	return func(o *uriOptions) {
+		o.tlsConfig = config
+	}
+}
+
+// WithURISSHConfig sets the ssh config used for URIs with the ssh transport,
+// in place of one using the ssh agent, the user's keys and known hosts.
+func WithURISSHConfig(config *ssh.ClientConfig) URIOption {
+	return func(o *uriOptions) {
+		o.sshConfig = config
+	}
+}
+
+// libvirtURI is a libvirt connection URI, split into the parts used to
+// connect.
+type libvirtURI struct {
+	// transport is unix, tcp, tls or ssh.
+	transport        string
+	host, port, user string
+	// name is the URI opened once connected: the original URI without the
+	// transport, host and the remote driver's parameters, unless the name
+	// parameter overrides it.
+	name   string
+	params url.Values
+}
+
+// parseURI parses a libvirt connection URI, such as "qemu+ssh://host/system".
+// As in libvirt, the transport defaults to unix without a host, and tls with
+// one.
+func parseURI(s string) (*libvirtURI, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Opaque != "" {
+		return nil, fmt.Errorf("invalid libvirt uri %q", s)
+	}
+
+	driver, transport := u.Scheme, ""
+	if i := strings.IndexByte(u.Scheme, '+'); i != -1 {
+		driver, transport = u.Scheme[:i], u.Scheme[i+1:]
+	}
+	if transport == "" {
+		transport = "unix"
+		if u.Host != "" {
+			transport = "tls"
+		}
+	}
+	switch transport {
+	case "unix", "tcp", "tls", "ssh":
+	default:
+		return nil, fmt.Errorf("unsupported libvirt uri transport %q", transport)
+	}
+
+	lu := &libvirtURI{
+		transport: transport,
+		host:      u.Hostname(),
+		port:      u.Port(),
+		user:      u.User.Username(),
+		params:    u.Query(),
+	}
+
+	lu.name = lu.params.Get("name")
+	if lu.name == "" {
+		query := u.Query()
+		for _, p := range remoteParams {
+			query.Del(p)
+		}
+		lu.name = driver + "://" + u.EscapedPath()
+		if len(query) > 0 {
+			lu.name += "?" + query.Encode()
+		}
+	}
+	return lu, nil
+}
+
+// dialer returns a dialer for the URI's transport.
+func (u *libvirtURI) dialer(o *uriOptions) (socket.Dialer, error) {
+	socketPath := u.params.Get("socket")
+
+	switch u.transport {
+	case "unix":
+		if socketPath == "" && strings.HasPrefix(u.name, "qemu:///session") {
+			socketPath = sessionSocket()
+		}
+		var opts []dialers.LocalOption
+		if socketPath != "" {
+			opts = append(opts, dialers.WithSocket(socketPath))
+		}
+		return dialers.NewLocal(opts...), nil
+
+	case "tcp":
+		var opts []dialers.RemoteOption
+		if u.port != "" {
+			opts = append(opts, dialers.UsePort(u.port))
+		}
+		return dialers.NewRemote(u.host, opts...), nil
+
+	case "tls":
+		config := o.tlsConfig
+		if config == nil {
+			var err error
+			if config, err = u.tlsConfig(); err != nil {
+				return nil, err
+			}
+		}
+		var opts []dialers.TLSOption
+		if u.port != "" {
+			opts = append(opts, dialers.UseTLSPort(u.port))
+		}
+		if !config.InsecureSkipVerify {
+			opts = append(opts, dialers.WithCommonNameCheck())
+		}
+		return dialers.NewTLS(u.host, config, opts...), nil
+
+	case "ssh":
+		var opts []dialers.SSHOption
+		config := o.sshConfig
+		if config == nil {
+			var err error
+			if config, err = u.sshConfig(); err != nil {
+				return nil, err
+			}
+			if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+				opts = append(opts, dialers.WithSSHAgent(sock))
+			}
+		}
+		if u.port != "" {
+			opts = append(opts, dialers.WithSSHPort(u.port))
+		}
+		if socketPath != "" {
+			opts = append(opts, dialers.WithRemoteSocket(socketPath))
+		}
+		return dialers.NewSSH(u.host, config, opts...), nil
+	}
+	return nil, fmt.Errorf("unsupported libvirt uri transport %q", u.transport)
+}
+
+// sessionSocket returns the path of the user's session daemon socket.
+func sessionSocket() string {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		home, _ := os.UserHomeDir()
+		dir = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(dir, "libvirt", "libvirt-sock")
+}
+
+// tlsConfig loads the CA certificate and client certificate libvirt uses: those
+// in the directory given by the pkipath parameter, or else those in
+// ~/.pki/libvirt if the user has a client certificate there and isn't root, or
+// else the system wide ones. The no_verify parameter disables verification of
+// the server's certificate.
+func (u *libvirtURI) tlsConfig() (*tls.Config, error) {
+	ca := "/etc/pki/CA/cacert.pem"
+	cert := "/etc/pki/libvirt/clientcert.pem"
+	key := "/etc/pki/libvirt/private/clientkey.pem"
+
+	if dir := u.params.Get("pkipath"); dir != "" {
+		ca = filepath.Join(dir, "cacert.pem")
+		cert = filepath.Join(dir, "clientcert.pem")
+		key = filepath.Join(dir, "clientkey.pem")
+	} else if home, err := os.UserHomeDir(); err == nil && os.Geteuid() != 0 {
+		dir := filepath.Join(home, ".pki", "libvirt")
+		if _, err := os.Stat(filepath.Join(dir, "clientcert.pem")); err == nil {
+			ca = filepath.Join(dir, "cacert.pem")
+			cert = filepath.Join(dir, "clientcert.pem")
+			key = filepath.Join(dir, "clientkey.pem")
+		}
+	}
+
+	caPEM, err := ioutil.ReadFile(ca)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %v", ca)
+	}
+	pair, err := tls.LoadX509KeyPair(cert, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		RootCAs:            pool,
+		Certificates:       []tls.Certificate{pair},
+		InsecureSkipVerify: u.params.Get("no_verify") == "1",
+	}, nil
+}
+
+// sshConfig returns an ssh config which logs in as the URI's user, or the
+// current user, and authenticates with the user's default keys, or the key
+// given by the keyfile parameter. The dialer adds the ssh agent. Host keys are checked
+// against ~/.ssh/known_hosts, or the file given by the known_hosts parameter,
+// unless the no_verify parameter is set.
+func (u *libvirtURI) sshConfig() (*ssh.ClientConfig, error) {
+	name := u.user
+	if name == "" {
+		cur, err := user.Current()
+		if err != nil {
+			return nil, err
+		}
+		name = cur.Username
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	var auth []ssh.AuthMethod
+	keyfiles := []string{u.params.Get("keyfile")}
+	if keyfiles[0] == "" {
+		keyfiles = []string{
+			filepath.Join(home, ".ssh", "id_ed25519"),
+			filepath.Join(home, ".ssh", "id_ecdsa"),
+			filepath.Join(home, ".ssh", "id_rsa"),
+		}
+	}
+	var signers []ssh.Signer
+	for _, f := range keyfiles {
+		pem, err := ioutil.ReadFile(f)
+		if err != nil {
+			continue
+		}
+		// Keys protected by a passphrase can only be used via the agent.
+		if s, err := ssh.ParsePrivateKey(pem); err == nil {
+			signers = append(signers, s)
+		}
+	}
+	if len(signers) > 0 {
+		auth = append(auth, ssh.PublicKeys(signers...))
+	}
+
+	hostKey := ssh.InsecureIgnoreHostKey()
+	if u.params.Get("no_verify") != "1" {
+		file := u.params.Get("known_hosts")
+		if file == "" {
+			file = filepath.Join(home, ".ssh", "known_hosts")
+		}
+		if hostKey, err = knownhosts.New(file); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ssh.ClientConfig{
+		User:            name,
+		Auth:            auth,
+		HostKeyCallback: hostKey,
+	}, nil
+}
+
+// ConnectByURI connects to libvirt as described by a libvirt connection URI,
+// such as "qemu:///system", "qemu+tcp://host/system" or
+// "qemu+ssh://user@host/system", and returns the connected client.
+//
+// As for libvirt's remote driver, the transport after the "+" chooses how to
+// connect: unix for the local socket, tcp, tls or ssh. Without one, unix is
+// used when the URI has no host, and tls otherwise. The socket parameter sets
+// the path of the unix socket, locally or for ssh on the remote host, and the
+// name parameter the URI libvirt opens, which otherwise is the URI without the
+// transport, host and these parameters. The tls transport uses libvirt's
+// certificates, and ssh the user's agent, keys and known hosts; see
+// WithURITLSConfig and WithURISSHConfig to configure them instead.
+func ConnectByURI(uri string, opts ...URIOption) (*Libvirt, error) {
+	o := &uriOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	u, err := parseURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	if u.transport != "unix" && u.host == "" {
+		return nil, errors.New("libvirt uri has no host to connect to")
+	}
+	dialer, err := u.dialer(o)
+	if err != nil {
+		return nil, err
+	}
+
+	l := NewWithDialer(dialer)
+	if err := l.ConnectToURI(ConnectURI(u.name)); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
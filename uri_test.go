@@ -0,0 +1,191 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	xdr "github.com/digitalocean/go-libvirt/internal/go-xdr/xdr2"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		uri       string
+		transport string
+		host      string
+		port      string
+		user      string
+		name      string
+	}{
+		{
+			uri:       "qemu:///system",
+			transport: "unix",
+			name:      "qemu:///system",
+		},
+		{
+			uri:       "qemu:///session?socket=/tmp/sock",
+			transport: "unix",
+			name:      "qemu:///session",
+		},
+		{
+			uri:       "qemu://host.example.com/system",
+			transport: "tls",
+			host:      "host.example.com",
+			name:      "qemu:///system",
+		},
+		{
+			uri:       "qemu+tcp://192.0.2.1:16509/system",
+			transport: "tcp",
+			host:      "192.0.2.1",
+			port:      "16509",
+			name:      "qemu:///system",
+		},
+		{
+			uri:       "qemu+ssh://root@host:2222/system?keyfile=/k&no_verify=1",
+			transport: "ssh",
+			host:      "host",
+			port:      "2222",
+			user:      "root",
+			name:      "qemu:///system",
+		},
+		{
+			uri:       "test+tcp://host/default?foo=bar&socket=/x",
+			transport: "tcp",
+			host:      "host",
+			name:      "test:///default?foo=bar",
+		},
+		{
+			uri:       "qemu+ssh://host/system?name=qemu:///session",
+			transport: "ssh",
+			host:      "host",
+			name:      "qemu:///session",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			u, err := parseURI(tt.uri)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if u.transport != tt.transport {
+				t.Errorf("expected transport %q, got %q", tt.transport, u.transport)
+			}
+			if u.host != tt.host {
+				t.Errorf("expected host %q, got %q", tt.host, u.host)
+			}
+			if u.port != tt.port {
+				t.Errorf("expected port %q, got %q", tt.port, u.port)
+			}
+			if u.user != tt.user {
+				t.Errorf("expected user %q, got %q", tt.user, u.user)
+			}
+			if u.name != tt.name {
+				t.Errorf("expected name %q, got %q", tt.name, u.name)
+			}
+		})
+	}
+}
+
+func TestParseURIInvalid(t *testing.T) {
+	for _, uri := range []string{
+		"/var/run/libvirt/libvirt-sock",
+		"qemu:system",
+		"qemu+libssh2://host/system",
+		"qemu+ext:///system?command=/bin/true",
+	} {
+		if _, err := parseURI(uri); err == nil {
+			t.Errorf("expected error parsing %q", uri)
+		}
+	}
+}
+
+func TestConnectByURINoHost(t *testing.T) {
+	for _, uri := range []string{"qemu+tcp:///system", "qemu+ssh:///system"} {
+		if _, err := ConnectByURI(uri); err == nil {
+			t.Errorf("expected error connecting to %q", uri)
+		}
+	}
+}
+
+func TestConnectByURI(t *testing.T) {
+	// Unix socket paths are limited in length, so avoid a long test temp dir.
+	dir, err := ioutil.TempDir("", "go-libvirt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "libvirt-sock")
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	// Bridge the socket to the mock server.
+	mock := libvirttest.New()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		mc, _ := mock.Dial()
+		defer mc.Close()
+		go io.Copy(mc, conn)
+		io.Copy(conn, mc)
+	}()
+
+	l, err := ConnectByURI("test:///default?socket=" + path)
+	if err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	if err := l.Disconnect(); err != nil {
+		t.Fatalf("disconnect failed: %v", err)
+	}
+
+	var open *libvirttest.Request
+	for _, r := range mock.Requests() {
+		if r.Procedure == constants.ProcConnectOpen {
+			r := r
+			open = &r
+		}
+	}
+	if open == nil {
+		t.Fatal("expected a ConnectOpen request")
+	}
+	// The name is encoded as initLibvirtComms does.
+	var args struct {
+		Padding [3]byte
+		Name    string
+		Flags   uint32
+	}
+	if _, err := xdr.Unmarshal(bytes.NewReader(open.Payload), &args); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if args.Name != "test:///default" {
+		t.Errorf("expected name %q, got %q", "test:///default", args.Name)
+	}
+}
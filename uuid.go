@@ -0,0 +1,70 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// ParseUUID parses a UUID in its canonical, hyphenated form, e.g.
+// "dc229f87-d4de-4719-8cfd-2e21c6105b01", or as 32 hex digits without hyphens.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return u, fmt.Errorf("invalid uuid %q: misplaced hyphens", s)
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	case 32:
+	default:
+		return u, fmt.Errorf("invalid uuid %q: wrong length", s)
+	}
+
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return UUID{}, fmt.Errorf("invalid uuid %q: %v", s, err)
+	}
+	return u, nil
+}
+
+// String returns the canonical, hyphenated form of a UUID.
+func (u UUID) String() string {
+	h := hex.EncodeToString(u[:])
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// DomainLookupByUUIDString looks up a domain by its UUID, given as a string
+// in the form accepted by ParseUUID. If there is no such domain, the error
+// satisfies IsNotFound.
+func (l *Libvirt) DomainLookupByUUIDString(s string) (Domain, error) {
+	u, err := ParseUUID(s)
+	if err != nil {
+		return Domain{}, err
+	}
+	return l.DomainLookupByUUID(u)
+}
+
+// SecretLookupByUUIDString looks up a secret by its UUID, given as a string
+// in the form accepted by ParseUUID. If there is no such secret, the error has
+// the code ErrNoSecret, which IsErrorCode detects.
+func (l *Libvirt) SecretLookupByUUIDString(s string) (Secret, error) {
+	u, err := ParseUUID(s)
+	if err != nil {
+		return Secret{}, err
+	}
+	return l.SecretLookupByUUID(u)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+	"github.com/digitalocean/go-libvirt/libvirttest"
+)
+
+func TestParseUUID(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr bool
+	}{
+		{in: "dc229f87-d4de-4719-8cfd-2e21c6105b01"},
+		{in: "DC229F87-D4DE-4719-8CFD-2E21C6105B01"},
+		{in: "dc229f87d4de47198cfd2e21c6105b01"},
+		{in: "dc229f87-d4de-4719-8cfd-2e21c6105b0", wantErr: true},
+		{in: "dc229f87-d4de-4719-8cfd2-e21c6105b01", wantErr: true},
+		{in: "xc229f87-d4de-4719-8cfd-2e21c6105b01", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		u, err := ParseUUID(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%q: expected an error", tt.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.in, err)
+			continue
+		}
+		if u != UUID(testUUID) {
+			t.Errorf("%q: expected %v, got %v", tt.in, testUUID, u)
+		}
+	}
+
+	if s := UUID(testUUID).String(); s != "dc229f87-d4de-4719-8cfd-2e21c6105b01" {
+		t.Errorf("unexpected string form %q", s)
+	}
+}
+
+func TestDomainLookupByUUIDString(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	payload, err := encode(&Domain{Name: "test", UUID: testUUID, ID: 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcDomainLookupByUUID, payload)
+
+	d, err := l.DomainLookupByUUIDString("dc229f87-d4de-4719-8cfd-2e21c6105b01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Name != "test" || d.UUID != testUUID {
+		t.Errorf("unexpected domain %v", d)
+	}
+
+	reqs := dialer.Requests()
+	if r := reqs[len(reqs)-1]; !bytes.Equal(r.Payload, testUUID[:]) {
+		t.Errorf("expected the uuid to be sent as 16 bytes, got %x", r.Payload)
+	}
+
+	dialer.QueueError(constants.Program, constants.ProcDomainLookupByUUID, testNoDomainError)
+	if _, err := l.DomainLookupByUUIDString("dc229f87d4de47198cfd2e21c6105b01"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	before := len(dialer.Requests())
+	if _, err := l.DomainLookupByUUIDString("not-a-uuid"); err == nil {
+		t.Error("expected an error for an invalid uuid")
+	}
+	if len(dialer.Requests()) != before {
+		t.Error("expected an invalid uuid not to be sent")
+	}
+}
+
+func TestSecretLookupByUUIDString(t *testing.T) {
+	dialer := libvirttest.New()
+	l := NewWithDialer(dialer)
+	if err := l.Connect(); err != nil {
+		t.Fatalf("connect failed: %v", err)
+	}
+	defer l.Disconnect()
+
+	want := Secret{UUID: testUUID, UsageType: int32(SecretUsageTypeVolume), UsageID: "/var/lib/libvirt/images/luks.img"}
+	payload, err := encode(&want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dialer.QueueReply(constants.Program, constants.ProcSecretLookupByUUID, payload)
+
+	s, err := l.SecretLookupByUUIDString("dc229f87-d4de-4719-8cfd-2e21c6105b01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != want {
+		t.Errorf("expected secret %+v, got %+v", want, s)
+	}
+
+	before := len(dialer.Requests())
+	if _, err := l.SecretLookupByUUIDString("not-a-uuid"); err == nil {
+		t.Error("expected an error for an invalid uuid")
+	}
+	if len(dialer.Requests()) != before {
+		t.Error("expected an invalid uuid not to be sent")
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/go-libvirt/internal/constants"
+)
+
+// VersionNumber is a version number in libvirt's encoding, major * 1,000,000 +
+// minor * 1,000 + release, as returned by ConnectGetLibVersion and
+// ConnectGetVersion. Version numbers can be compared directly, e.g.
+//
+//	if VersionNumber(v) >= NewVersionNumber(7, 2, 0) { ... }
+type VersionNumber uint64
+
+// NewVersionNumber returns the VersionNumber for major.minor.release.
+func NewVersionNumber(major, minor, release uint64) VersionNumber {
+	return VersionNumber(major*1000000 + minor*1000 + release)
+}
+
+// Major returns the major part of the version.
+func (v VersionNumber) Major() uint64 {
+	return uint64(v) / 1000000
+}
+
+// Minor returns the minor part of the version.
+func (v VersionNumber) Minor() uint64 {
+	return uint64(v) % 1000000 / 1000
+}
+
+// Release returns the release, or micro, part of the version.
+func (v VersionNumber) Release() uint64 {
+	return uint64(v) % 1000
+}
+
+// String returns the version in the form "7.0.0".
+func (v VersionNumber) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major(), v.Minor(), v.Release())
+}
+
+// ProtocolVersion returns the version of libvirt's remote protocol the client
+// speaks. libvirt doesn't negotiate the protocol version when connecting: the
+// daemon rejects any packet with a version it doesn't support, so this is
+// also the version in use on any established connection. To check for
+// features, use ConnectGetLibVersion or ConnectSupportsFeature.
+func (l *Libvirt) ProtocolVersion() uint32 {
+	return constants.ProtocolVersion
+}
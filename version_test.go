@@ -0,0 +1,47 @@
+// Copyright 2026 The go-libvirt Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package libvirt
+
+import "testing"
+
+func TestVersionNumber(t *testing.T) {
+	tests := []struct {
+		v                     VersionNumber
+		major, minor, release uint64
+		str                   string
+	}{
+		{v: 1003004, major: 1, minor: 3, release: 4, str: "1.3.4"},
+		{v: 7000000, major: 7, minor: 0, release: 0, str: "7.0.0"},
+		{v: 10010999, major: 10, minor: 10, release: 999, str: "10.10.999"},
+	}
+
+	for _, tt := range tests {
+		if tt.v.Major() != tt.major || tt.v.Minor() != tt.minor || tt.v.Release() != tt.release {
+			t.Errorf("%d: expected %d.%d.%d, got %d.%d.%d", tt.v, tt.major, tt.minor,
+				tt.release, tt.v.Major(), tt.v.Minor(), tt.v.Release())
+		}
+		if s := tt.v.String(); s != tt.str {
+			t.Errorf("%d: expected %q, got %q", tt.v, tt.str, s)
+		}
+		if v := NewVersionNumber(tt.major, tt.minor, tt.release); v != tt.v {
+			t.Errorf("NewVersionNumber(%d, %d, %d) = %d, expected %d", tt.major,
+				tt.minor, tt.release, v, tt.v)
+		}
+	}
+
+	if NewVersionNumber(6, 10, 0) >= NewVersionNumber(7, 0, 0) {
+		t.Error("expected 6.10.0 to be older than 7.0.0")
+	}
+}